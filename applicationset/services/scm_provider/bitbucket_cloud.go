@@ -175,3 +175,25 @@ func findCloneURL(cloneProtocol string, repo *bitbucket.Repository) (*string, er
 	}
 	return nil, fmt.Errorf("unknown clone protocol for Bitbucket cloud %v", cloneProtocol)
 }
+
+var _ CommitStatusService = &BitBucketCloudProvider{}
+
+// SetCommitStatus sets the build status of the commit, whose key is the context of the environment
+func (g *BitBucketCloudProvider) SetCommitStatus(_ context.Context, repo *Repository, status *CommitStatus) error {
+	statusContext := commitStatusContext(status.Environment)
+	_, err := g.client.Repositories.Commits.CreateCommitStatus(&bitbucket.CommitsOptions{
+		Owner:    repo.Organization,
+		RepoSlug: repo.Repository,
+		Revision: repo.SHA,
+	}, &bitbucket.CommitStatusOptions{
+		Key:         statusContext,
+		Url:         status.TargetURL,
+		State:       bitbucketBuildState(status.State),
+		Name:        statusContext,
+		Description: status.Description,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set build status: %w", err)
+	}
+	return nil
+}
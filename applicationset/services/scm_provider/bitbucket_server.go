@@ -205,3 +205,21 @@ func getCloneURLFromLinks(links []bitbucketv1.CloneLink, name string) string {
 	}
 	return ""
 }
+
+var _ CommitStatusService = &BitbucketServerProvider{}
+
+// SetCommitStatus sets the build status of the commit, whose key is the context of the environment
+func (b *BitbucketServerProvider) SetCommitStatus(_ context.Context, repo *Repository, status *CommitStatus) error {
+	statusContext := commitStatusContext(status.Environment)
+	_, err := b.client.DefaultApi.SetCommitStatus(repo.SHA, bitbucketv1.BuildStatus{
+		State:       bitbucketBuildState(status.State),
+		Key:         statusContext,
+		Name:        statusContext,
+		Url:         status.TargetURL,
+		Description: status.Description,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set build status: %w", err)
+	}
+	return nil
+}
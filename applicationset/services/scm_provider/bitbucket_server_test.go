@@ -570,3 +570,28 @@ func TestBitbucketServerHasPath(t *testing.T) {
 	_, err = provider.RepoHasPath(context.Background(), repo, "unauthorized-response")
 	assert.Error(t, err)
 }
+
+func TestBitbucketServerSetCommitStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/build-status/1.0/commits/a5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"state": "INPROGRESS", "key": "argocd/guestbook", "name": "argocd/guestbook", "url": "https://argocd.example.com/applications/argocd/guestbook", "description": "Sync Running", "dateAdded": 0}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	provider, err := NewBitbucketServerProviderBasicAuth(context.Background(), "user", "password", ts.URL, "PROJECT", true)
+	assert.NoError(t, err)
+	err = provider.SetCommitStatus(context.Background(), &Repository{
+		Organization: "PROJECT",
+		Repository:   "REPO",
+		SHA:          "a5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234",
+	}, &CommitStatus{
+		Environment: "guestbook",
+		State:       CommitStatePending,
+		Description: "Sync Running",
+		TargetURL:   "https://argocd.example.com/applications/argocd/guestbook",
+	})
+	assert.NoError(t, err)
+}
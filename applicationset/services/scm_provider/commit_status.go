@@ -0,0 +1,65 @@
+package scm_provider
+
+import (
+	"context"
+)
+
+// CommitState is the state of the deployment of a commit to an environment
+type CommitState string
+
+const (
+	CommitStatePending CommitState = "pending"
+	CommitStateSuccess CommitState = "success"
+	CommitStateFailure CommitState = "failure"
+	CommitStateError   CommitState = "error"
+)
+
+// CommitStatus reports the deployment of a commit to an environment
+type CommitStatus struct {
+	// Environment is the name of the environment the commit is deployed to. It identifies the status, so setting a
+	// status of the same commit and environment again replaces the previous one.
+	Environment string
+	State       CommitState
+	Description string
+	// TargetURL links to the details of the deployment
+	TargetURL string
+}
+
+// CommitStatusService reports the deployment state of commits back to an SCM provider
+type CommitStatusService interface {
+	// SetCommitStatus sets the status of the repository commit repo.SHA. Setting an unchanged status has no effect.
+	SetCommitStatus(ctx context.Context, repo *Repository, status *CommitStatus) error
+}
+
+// commitStatusContext is the context of the commit statuses of an environment, for providers which have one
+func commitStatusContext(environment string) string {
+	return "argocd/" + environment
+}
+
+// truncate shortens the string to at most max characters
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
+
+// stringOrNil returns a pointer to the string, or nil if it is empty
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// bitbucketBuildState returns the state of the Bitbucket build status corresponding to the commit state
+func bitbucketBuildState(state CommitState) string {
+	switch state {
+	case CommitStatePending:
+		return "INPROGRESS"
+	case CommitStateSuccess:
+		return "SUCCESSFUL"
+	default:
+		return "FAILED"
+	}
+}
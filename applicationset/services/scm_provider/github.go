@@ -154,3 +154,107 @@ func (g *GithubProvider) listBranches(ctx context.Context, repo *Repository) ([]
 	}
 	return branches, nil
 }
+
+// githubMaxDescriptionLength is the maximum length of the descriptions of commit and deployment statuses
+const githubMaxDescriptionLength = 140
+
+var _ CommitStatusService = &GithubProvider{}
+
+// SetCommitStatus sets the commit status with the context of the environment, and the status of the deployment of
+// the commit to the environment. The deployment is created if the commit has not been deployed to the environment
+// before.
+func (g *GithubProvider) SetCommitStatus(ctx context.Context, repo *Repository, status *CommitStatus) error {
+	description := truncate(status.Description, githubMaxDescriptionLength)
+	statusContext := commitStatusContext(status.Environment)
+	current, err := g.getCommitStatus(ctx, repo, statusContext)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.GetState() != string(status.State) || current.GetDescription() != description {
+		_, _, err = g.client.Repositories.CreateStatus(ctx, repo.Organization, repo.Repository, repo.SHA, &github.RepoStatus{
+			State:       github.String(string(status.State)),
+			TargetURL:   stringOrNil(status.TargetURL),
+			Description: stringOrNil(description),
+			Context:     github.String(statusContext),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create commit status: %w", err)
+		}
+	}
+
+	deployment, err := g.getOrCreateDeployment(ctx, repo, status.Environment)
+	if err != nil {
+		return err
+	}
+	state := githubDeploymentState(status.State)
+	statuses, _, err := g.client.Repositories.ListDeploymentStatuses(ctx, repo.Organization, repo.Repository, deployment.GetID(), &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return fmt.Errorf("failed to list deployment statuses: %w", err)
+	}
+	if len(statuses) > 0 && statuses[0].GetState() == state && statuses[0].GetDescription() == description {
+		return nil
+	}
+	_, _, err = g.client.Repositories.CreateDeploymentStatus(ctx, repo.Organization, repo.Repository, deployment.GetID(), &github.DeploymentStatusRequest{
+		State:       github.String(state),
+		LogURL:      stringOrNil(status.TargetURL),
+		Description: stringOrNil(description),
+		Environment: github.String(status.Environment),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create deployment status: %w", err)
+	}
+	return nil
+}
+
+// getCommitStatus returns the latest status of the commit with the given context, or nil if there is none
+func (g *GithubProvider) getCommitStatus(ctx context.Context, repo *Repository, statusContext string) (*github.RepoStatus, error) {
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		statuses, resp, err := g.client.Repositories.ListStatuses(ctx, repo.Organization, repo.Repository, repo.SHA, opt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list commit statuses: %w", err)
+		}
+		// statuses are listed in reverse chronological order
+		for _, status := range statuses {
+			if status.GetContext() == statusContext {
+				return status, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+func (g *GithubProvider) getOrCreateDeployment(ctx context.Context, repo *Repository, environment string) (*github.Deployment, error) {
+	deployments, _, err := g.client.Repositories.ListDeployments(ctx, repo.Organization, repo.Repository, &github.DeploymentsListOptions{
+		SHA:         repo.SHA,
+		Environment: environment,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	if len(deployments) > 0 {
+		return deployments[0], nil
+	}
+	deployment, _, err := g.client.Repositories.CreateDeployment(ctx, repo.Organization, repo.Repository, &github.DeploymentRequest{
+		Ref:         github.String(repo.SHA),
+		Environment: github.String(environment),
+		AutoMerge:   github.Bool(false),
+		// the commit is already deployed, so the deployment must not wait for other checks
+		RequiredContexts: &[]string{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deployment: %w", err)
+	}
+	return deployment, nil
+}
+
+func githubDeploymentState(state CommitState) string {
+	if state == CommitStatePending {
+		return "in_progress"
+	}
+	return string(state)
+}
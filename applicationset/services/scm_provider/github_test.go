@@ -322,3 +322,68 @@ func TestGithubGetBranches(t *testing.T) {
 		assert.Equal(t, len(repos), 1)
 	}
 }
+
+func TestGithubSetCommitStatus(t *testing.T) {
+	sha := "a5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234"
+	newServer := func(existing bool, requests *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			*requests = append(*requests, r.Method+" "+r.URL.Path)
+			var body string
+			switch r.Method + " " + r.URL.Path {
+			case "GET /api/v3/repos/argoproj/argo-cd/commits/" + sha + "/statuses":
+				body = `[]`
+				if existing {
+					body = `[{"state": "success", "description": "Sync Succeeded, health Healthy", "context": "argocd/guestbook"}]`
+				}
+			case "GET /api/v3/repos/argoproj/argo-cd/deployments":
+				assert.Equal(t, sha, r.URL.Query().Get("sha"))
+				assert.Equal(t, "guestbook", r.URL.Query().Get("environment"))
+				body = `[]`
+				if existing {
+					body = `[{"id": 1}]`
+				}
+			case "GET /api/v3/repos/argoproj/argo-cd/deployments/1/statuses":
+				body = `[]`
+				if existing {
+					body = `[{"state": "success", "description": "Sync Succeeded, health Healthy"}]`
+				}
+			case "POST /api/v3/repos/argoproj/argo-cd/deployments":
+				body = `{"id": 1}`
+			default:
+				body = `{}`
+			}
+			_, err := io.WriteString(w, body)
+			assert.NoError(t, err)
+		}))
+	}
+	repo := &Repository{Organization: "argoproj", Repository: "argo-cd", SHA: sha}
+	status := &CommitStatus{Environment: "guestbook", State: CommitStateSuccess, Description: "Sync Succeeded, health Healthy"}
+
+	var requests []string
+	ts := newServer(false, &requests)
+	defer ts.Close()
+	host, _ := NewGithubProvider(context.Background(), "argoproj", "", ts.URL, false)
+	err := host.SetCommitStatus(context.Background(), repo, status)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /api/v3/repos/argoproj/argo-cd/commits/" + sha + "/statuses",
+		"POST /api/v3/repos/argoproj/argo-cd/statuses/" + sha,
+		"GET /api/v3/repos/argoproj/argo-cd/deployments",
+		"POST /api/v3/repos/argoproj/argo-cd/deployments",
+		"GET /api/v3/repos/argoproj/argo-cd/deployments/1/statuses",
+		"POST /api/v3/repos/argoproj/argo-cd/deployments/1/statuses",
+	}, requests)
+
+	var unchangedRequests []string
+	ts = newServer(true, &unchangedRequests)
+	defer ts.Close()
+	host, _ = NewGithubProvider(context.Background(), "argoproj", "", ts.URL, false)
+	err = host.SetCommitStatus(context.Background(), repo, status)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /api/v3/repos/argoproj/argo-cd/commits/" + sha + "/statuses",
+		"GET /api/v3/repos/argoproj/argo-cd/deployments",
+		"GET /api/v3/repos/argoproj/argo-cd/deployments/1/statuses",
+	}, unchangedRequests)
+}
@@ -180,3 +180,64 @@ func (g *GitlabProvider) listBranches(_ context.Context, repo *Repository) ([]gi
 	}
 	return branches, nil
 }
+
+var _ CommitStatusService = &GitlabProvider{}
+
+// SetCommitStatus sets the status of the latest deployment to the environment if it deployed the commit, or creates a
+// new deployment of the commit to the environment otherwise. Finished deployments cannot be running again, so they
+// keep their status until they finish with a different status.
+func (g *GitlabProvider) SetCommitStatus(_ context.Context, repo *Repository, status *CommitStatus) error {
+	pid := repo.Organization + "/" + repo.Repository
+	deploymentStatus := gitlabDeploymentStatus(status.State)
+	deployments, _, err := g.client.Deployments.ListProjectDeployments(pid, &gitlab.ListProjectDeploymentsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+		OrderBy:     gitlab.String("id"),
+		Sort:        gitlab.String("desc"),
+		Environment: gitlab.String(status.Environment),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+	if len(deployments) > 0 && deployments[0].SHA == repo.SHA {
+		deployment := deployments[0]
+		if deployment.Status == string(deploymentStatus) {
+			return nil
+		}
+		running := deployment.Status == string(gitlab.DeploymentStatusCreated) || deployment.Status == string(gitlab.DeploymentStatusRunning)
+		if !running && deploymentStatus == gitlab.DeploymentStatusRunning {
+			return nil
+		}
+		_, _, err = g.client.Deployments.UpdateProjectDeployment(pid, deployment.ID, &gitlab.UpdateProjectDeploymentOptions{
+			Status: gitlab.DeploymentStatus(deploymentStatus),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update deployment: %w", err)
+		}
+		return nil
+	}
+	ref := repo.Branch
+	if ref == "" {
+		ref = repo.SHA
+	}
+	_, _, err = g.client.Deployments.CreateProjectDeployment(pid, &gitlab.CreateProjectDeploymentOptions{
+		Environment: gitlab.String(status.Environment),
+		Ref:         gitlab.String(ref),
+		SHA:         gitlab.String(repo.SHA),
+		Status:      gitlab.DeploymentStatus(deploymentStatus),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create deployment: %w", err)
+	}
+	return nil
+}
+
+func gitlabDeploymentStatus(state CommitState) gitlab.DeploymentStatusValue {
+	switch state {
+	case CommitStatePending:
+		return gitlab.DeploymentStatusRunning
+	case CommitStateSuccess:
+		return gitlab.DeploymentStatusSuccess
+	default:
+		return gitlab.DeploymentStatusFailed
+	}
+}
@@ -419,3 +419,80 @@ func TestGitlabGetBranches(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGitlabSetCommitStatus(t *testing.T) {
+	sha := "a5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234"
+	cases := []struct {
+		name       string
+		deployment string
+		state      CommitState
+		request    string
+	}{
+		{
+			name:    "no deployment",
+			state:   CommitStatePending,
+			request: `POST /api/v4/projects/test-argocd-proton/argocd/deployments {"environment":"guestbook","ref":"main","sha":"` + sha + `","status":"running"}`,
+		},
+		{
+			name:       "running deployment",
+			deployment: `{"id": 1, "sha": "` + sha + `", "status": "running"}`,
+			state:      CommitStateSuccess,
+			request:    `PUT /api/v4/projects/test-argocd-proton/argocd/deployments/1 {"status":"success"}`,
+		},
+		{
+			name:       "unchanged deployment",
+			deployment: `{"id": 1, "sha": "` + sha + `", "status": "success"}`,
+			state:      CommitStateSuccess,
+		},
+		{
+			name:       "finished deployment",
+			deployment: `{"id": 1, "sha": "` + sha + `", "status": "success"}`,
+			state:      CommitStateFailure,
+			request:    `PUT /api/v4/projects/test-argocd-proton/argocd/deployments/1 {"status":"failed"}`,
+		},
+		{
+			name:       "progressing finished deployment",
+			deployment: `{"id": 1, "sha": "` + sha + `", "status": "success"}`,
+			state:      CommitStatePending,
+		},
+		{
+			name:       "deployment of another commit",
+			deployment: `{"id": 1, "sha": "b5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234", "status": "success"}`,
+			state:      CommitStatePending,
+			request:    `POST /api/v4/projects/test-argocd-proton/argocd/deployments {"environment":"guestbook","ref":"main","sha":"` + sha + `","status":"running"}`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "guestbook", r.URL.Query().Get("environment"))
+					_, err := io.WriteString(w, "["+c.deployment+"]")
+					assert.NoError(t, err)
+					return
+				}
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+				_, err = io.WriteString(w, `{}`)
+				assert.NoError(t, err)
+			}))
+			defer ts.Close()
+			host, _ := NewGitlabProvider(context.Background(), "test-argocd-proton", "", ts.URL, false, true)
+			err := host.SetCommitStatus(context.Background(), &Repository{
+				Organization: "test-argocd-proton",
+				Repository:   "argocd",
+				Branch:       "main",
+				SHA:          sha,
+			}, &CommitStatus{Environment: "guestbook", State: c.state})
+			assert.NoError(t, err)
+			if c.request == "" {
+				assert.Empty(t, requests)
+			} else {
+				assert.Equal(t, []string{c.request}, requests)
+			}
+		})
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 
 	notificationscontroller "github.com/argoproj/argo-cd/v2/notification_controller/controller"
@@ -55,6 +58,12 @@ func NewCommand() *cobra.Command {
 		argocdRepoServerStrictTLS bool
 		configMapName             string
 		secretName                string
+		cacheSrc                  func() (*appstatecache.Cache, error)
+		deliveryMaxAttempts       int
+		deliveryRetryBaseDelay    time.Duration
+		deliveryRetryMaxDelay     time.Duration
+		deliveryHistorySize       int
+		deliveryHistoryInMemory   bool
 	)
 	var command = cobra.Command{
 		Use:   "controller",
@@ -123,7 +132,11 @@ func NewCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, 5, tlsConfig)
-			argocdService, err := service.NewArgoCDService(k8sClient, namespace, repoClientset)
+			appStateCache, err := cacheSrc()
+			if err != nil {
+				return err
+			}
+			argocdService, err := service.NewArgoCDService(k8sClient, namespace, repoClientset, appStateCache)
 			if err != nil {
 				return err
 			}
@@ -138,7 +151,18 @@ func NewCommand() *cobra.Command {
 			log.Infof("serving metrics on port %d", metricsPort)
 			log.Infof("loading configuration %d", metricsPort)
 
-			ctrl := notificationscontroller.NewController(k8sClient, dynamicClient, argocdService, namespace, appLabelSelector, registry, secretName, configMapName)
+			deliveryOpts := notificationscontroller.DeliveryOptions{
+				MaxAttempts:    deliveryMaxAttempts,
+				RetryBaseDelay: deliveryRetryBaseDelay,
+				RetryMaxDelay:  deliveryRetryMaxDelay,
+			}
+			if deliveryHistoryInMemory {
+				deliveryOpts.Store = delivery.NewStore(nil, deliveryHistorySize)
+			} else {
+				deliveryOpts.Store = delivery.NewStore(appStateCache.Cache, deliveryHistorySize)
+				deliveryOpts.DigestCache = appStateCache.Cache
+			}
+			ctrl := notificationscontroller.NewController(k8sClient, dynamicClient, argocdService, namespace, appLabelSelector, registry, secretName, configMapName, deliveryOpts)
 			err = ctrl.Init(ctx)
 			if err != nil {
 				return err
@@ -161,5 +185,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&argocdRepoServerStrictTLS, "argocd-repo-server-strict-tls", false, "Perform strict validation of TLS certificates when connecting to repo server")
 	command.Flags().StringVar(&configMapName, "config-map-name", "argocd-notifications-cm", "Set notifications ConfigMap name")
 	command.Flags().StringVar(&secretName, "secret-name", "argocd-notifications-secret", "Set notifications Secret name")
+	command.Flags().IntVar(&deliveryMaxAttempts, "delivery-max-attempts", 5, "Number of attempts to deliver a notification before it is dead-lettered. Failed deliveries are not retried if less than 2.")
+	command.Flags().DurationVar(&deliveryRetryBaseDelay, "delivery-retry-base-delay", 5*time.Second, "Delay before retrying a failed delivery for the first time. The delay doubles with each further retry.")
+	command.Flags().DurationVar(&deliveryRetryMaxDelay, "delivery-retry-max-delay", 5*time.Minute, "Maximum delay between retries of a failed delivery")
+	command.Flags().IntVar(&deliveryHistorySize, "delivery-history-size", delivery.DefaultHistorySize, "Number of delivery attempts kept in the delivery history and in the dead-letter list")
+	command.Flags().BoolVar(&deliveryHistoryInMemory, "delivery-history-in-memory", false, "Keep the delivery history and the events collected for digests in memory instead of Redis. The history is not available through the API server and CLI then.")
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
 }
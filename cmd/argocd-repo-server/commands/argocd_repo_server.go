@@ -78,6 +78,8 @@ func NewCommand() *cobra.Command {
 		redisClient                       *redis.Client
 		disableTLS                        bool
 		maxCombinedDirectoryManifestsSize string
+		maxDirectoryManifestFiles         int
+		maxDirectoryManifestsOutputSize   string
 		cmpTarExcludedGlobs               []string
 		allowOutOfBoundsSymlinks          bool
 		streamedManifestMaxTarSize        string
@@ -114,6 +116,9 @@ func NewCommand() *cobra.Command {
 			maxCombinedDirectoryManifestsQuantity, err := resource.ParseQuantity(maxCombinedDirectoryManifestsSize)
 			errors.CheckError(err)
 
+			maxDirectoryManifestsOutputQuantity, err := resource.ParseQuantity(maxDirectoryManifestsOutputSize)
+			errors.CheckError(err)
+
 			streamedManifestMaxTarSizeQuantity, err := resource.ParseQuantity(streamedManifestMaxTarSize)
 			errors.CheckError(err)
 
@@ -130,6 +135,8 @@ func NewCommand() *cobra.Command {
 				PauseGenerationOnFailureForRequests:          getPauseGenerationOnFailureForRequests(),
 				SubmoduleEnabled:                             getSubmoduleEnabled(),
 				MaxCombinedDirectoryManifestsSize:            maxCombinedDirectoryManifestsQuantity,
+				MaxDirectoryManifestFiles:                    maxDirectoryManifestFiles,
+				MaxDirectoryManifestsOutputSize:              maxDirectoryManifestsOutputQuantity,
 				CMPTarExcludedGlobs:                          cmpTarExcludedGlobs,
 				AllowOutOfBoundsSymlinks:                     allowOutOfBoundsSymlinks,
 				StreamedManifestMaxExtractedSize:             streamedManifestMaxExtractedSizeQuantity.ToDec().Value(),
@@ -212,6 +219,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_REPO_SERVER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS on the gRPC endpoint")
 	command.Flags().StringVar(&maxCombinedDirectoryManifestsSize, "max-combined-directory-manifests-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MAX_COMBINED_DIRECTORY_MANIFESTS_SIZE", "10M"), "Max combined size of manifest files in a directory-type Application")
+	command.Flags().IntVar(&maxDirectoryManifestFiles, "max-directory-manifest-files", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_MAX_DIRECTORY_MANIFEST_FILES", 0, 0, math.MaxInt32), "Max number of manifest files in a directory-type Application. Zero means no limit.")
+	command.Flags().StringVar(&maxDirectoryManifestsOutputSize, "max-directory-manifests-output-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MAX_DIRECTORY_MANIFESTS_OUTPUT_SIZE", "0"), "Max combined size of the manifests generated for a directory-type Application, including the output of jsonnet files. Zero means no limit.")
 	command.Flags().StringArrayVar(&cmpTarExcludedGlobs, "plugin-tar-exclude", env.StringsFromEnv("ARGOCD_REPO_SERVER_PLUGIN_TAR_EXCLUSIONS", []string{}, ";"), "Globs to filter when sending tarballs to plugins.")
 	command.Flags().BoolVar(&allowOutOfBoundsSymlinks, "allow-oob-symlinks", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ALLOW_OUT_OF_BOUNDS_SYMLINKS", false), "Allow out-of-bounds symlinks in repositories (not recommended)")
 	command.Flags().StringVar(&streamedManifestMaxTarSize, "streamed-manifest-max-tar-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_TAR_SIZE", "100M"), "Maximum size of streamed manifest archives")
@@ -74,6 +74,7 @@ func NewCommand() *cobra.Command {
 		staticAssetsDir          string
 		applicationNamespaces    []string
 		enableProxyExtension     bool
+		auditLogSinks            []string
 	)
 	var command = &cobra.Command{
 		Use:               cliName,
@@ -190,6 +191,7 @@ func NewCommand() *cobra.Command {
 				StaticAssetsDir:       staticAssetsDir,
 				ApplicationNamespaces: applicationNamespaces,
 				EnableProxyExtension:  enableProxyExtension,
+				AuditLogSinks:         auditLogSinks,
 			}
 
 			stats.RegisterStackDumper()
@@ -244,6 +246,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&dexServerStrictTLS, "dex-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to dex server")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces where application resources can be managed in")
 	command.Flags().BoolVar(&enableProxyExtension, "enable-proxy-extension", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_PROXY_EXTENSION", false), "Enable Proxy Extension feature")
+	command.Flags().StringSliceVar(&auditLogSinks, "audit-log-sink", env.StringsFromEnv("ARGOCD_SERVER_AUDIT_LOG_SINKS", []string{}, ","), "Record mutating API calls to the given sinks. One or more of: kubernetes|file:<path>|<webhook url>")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client *redis.Client) {
 		redisClient = client
@@ -40,10 +40,13 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountUpdatePasswordCommand(clientOpts))
 	command.AddCommand(NewAccountGetUserInfoCommand(clientOpts))
 	command.AddCommand(NewAccountCanICommand(clientOpts))
+	command.AddCommand(NewAccountListPermissionsCommand(clientOpts))
+	command.AddCommand(NewAccountWhoCanCommand(clientOpts))
 	command.AddCommand(NewAccountListCommand(clientOpts))
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountTokensCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
 }
@@ -221,6 +224,115 @@ Resources: %v
 	}
 }
 
+func printPolicyMatchesTable(items []*accountpkg.PolicyMatch) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SUBJECT\tROLE\tRESOURCE\tACTION\tOBJECT\tEFFECT\tPROJECT\tRESULT\n")
+	for _, m := range items {
+		r := m.Rule
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", m.Subject, r.Subject, r.Resource, r.Action, r.Object, r.Effect, r.Project, m.Effect)
+	}
+	_ = w.Flush()
+}
+
+func printPolicyMatches(list *accountpkg.PolicyMatchList, output string) {
+	switch output {
+	case "yaml", "json":
+		err := PrintResourceList(list.Items, output, false)
+		errors.CheckError(err)
+	case "wide", "":
+		printPolicyMatchesTable(list.Items)
+		for _, warning := range list.Warnings {
+			fmt.Fprintf(os.Stderr, "WARNING: %s\n", warning)
+		}
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+}
+
+func NewAccountListPermissionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		groups []string
+		output string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-permissions [SUBJECT]",
+		Short: "List the RBAC rules which apply to a subject and its groups",
+		Example: `
+# List the rules which apply to the user 'alice', who is a member of the group 'my-org:team'
+argocd account list-permissions alice --group my-org:team
+
+# List the rules which apply to the members of a group
+argocd account list-permissions --group my-org:team
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) > 1 || (len(args) == 0 && len(groups) == 0) {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var subject string
+			if len(args) == 1 {
+				subject = args[0]
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListPermissions(ctx, &accountpkg.ListPermissionsRequest{
+				Subject: subject,
+				Groups:  groups,
+			})
+			errors.CheckError(err)
+			printPolicyMatches(response, output)
+		},
+	}
+	cmd.Flags().StringArrayVar(&groups, "group", []string{}, "Group the subject is a member of (can be repeated multiple times)")
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return cmd
+}
+
+func NewAccountWhoCanCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+	)
+	cmd := &cobra.Command{
+		Use:   "who-can ACTION RESOURCE SUBRESOURCE",
+		Short: "List the subjects, groups and roles which are allowed to perform an action",
+		Example: fmt.Sprintf(`
+# Who can sync the app 'guestbook' of the 'default' project?
+argocd account who-can sync applications 'default/guestbook'
+
+# Who can create clusters?
+argocd account who-can create clusters '*'
+
+Actions: %v
+Resources: %v
+`, rbacpolicy.Actions, rbacpolicy.Resources),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListSubjects(ctx, &accountpkg.ListSubjectsRequest{
+				Action:      args[0],
+				Resource:    args[1],
+				Subresource: args[2],
+			})
+			errors.CheckError(err)
+			printPolicyMatches(response, output)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return cmd
+}
+
 func printAccountNames(accounts []*accountpkg.Account) {
 	for _, p := range accounts {
 		fmt.Println(p.Name)
@@ -424,3 +536,116 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func printPersonalAccessTokensTable(items []*accountpkg.PersonalAccessToken) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tDESCRIPTION\tSCOPES\tISSUED AT\tEXPIRING AT\tLAST USED AT\n")
+	for _, t := range items {
+		lastUsedAt := "never"
+		if t.LastUsedAt > 0 {
+			lastUsedAt = time.Unix(t.LastUsedAt, 0).Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", t.Id, t.Description, strings.Join(t.Scopes, ","),
+			time.Unix(t.IssuedAt, 0).Format(time.RFC3339), time.Unix(t.ExpiresAt, 0).Format(time.RFC3339), lastUsedAt)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+	)
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "List and manage personal access tokens of the current user",
+		Example: `# List the personal access tokens of the current user
+argocd account tokens`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListPersonalAccessTokens(ctx, &accountpkg.ListPersonalAccessTokensRequest{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printPersonalAccessTokensTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.AddCommand(NewAccountTokensCreateCommand(clientOpts))
+	cmd.AddCommand(NewAccountTokensDeleteCommand(clientOpts))
+	return cmd
+}
+
+func NewAccountTokensCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		expiresIn   string
+		scopes      []string
+		description string
+	)
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a personal access token for the current user",
+		Example: `# Create a token which can sync the applications of the 'default' project for 30 days
+argocd account tokens create --expires-in 30d --scope 'applications:sync:default/*' --scope 'applications:get:default/*'
+
+# Create a token with all permissions of the current user
+argocd account tokens create --expires-in 24h --scope '*:*:*' --description 'CI pipeline'`,
+		Args: cobra.NoArgs,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			expiresIn, err := timeutil.ParseDuration(expiresIn)
+			errors.CheckError(err)
+			response, err := client.CreatePersonalAccessToken(ctx, &accountpkg.CreatePersonalAccessTokenRequest{
+				ExpiresIn:   int64(expiresIn.Seconds()),
+				Scopes:      scopes,
+				Description: description,
+			})
+			errors.CheckError(err)
+			fmt.Println(response.Token)
+		},
+	}
+	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "", "Duration before the token will expire (required)")
+	cmd.Flags().StringArrayVar(&scopes, "scope", []string{}, "RBAC scope of the token in the form <resource>:<action>:<object>, which may contain glob patterns (can be repeated multiple times, required)")
+	cmd.Flags().StringVar(&description, "description", "", "Description of the token")
+	errors.CheckError(cmd.MarkFlagRequired("expires-in"))
+	errors.CheckError(cmd.MarkFlagRequired("scope"))
+	return cmd
+}
+
+func NewAccountTokensDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete ID",
+		Short: "Revoke and delete a personal access token",
+		Example: `# Delete a personal access token of the current user
+argocd account tokens delete ID`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			_, err := client.DeletePersonalAccessToken(ctx, &accountpkg.DeletePersonalAccessTokenRequest{Id: args[0]})
+			errors.CheckError(err)
+		},
+	}
+	return cmd
+}
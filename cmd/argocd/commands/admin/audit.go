@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewAuditCommand returns a new instance of the `argocd admin audit` command
+func NewAuditCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		file         string
+		since        time.Duration
+		filter       audit.Filter
+		output       string
+	)
+	var command = &cobra.Command{
+		Use:   "audit",
+		Short: "Query the audit log of mutating API calls",
+		Example: `
+# Print the calls recorded as Kubernetes events in the current namespace
+argocd admin audit
+
+# Print the syncs of the last day recorded in a JSON lines file
+argocd admin audit --file /var/log/argocd/audit.log --method '*/Sync' --since 24h
+
+# Print the failed calls made by members of a group
+argocd admin audit --group my-org:team-alpha --failed -o wide`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			var records []audit.Record
+			if file != "" {
+				var err error
+				records, err = readAuditFile(file)
+				errors.CheckError(err)
+			} else {
+				config, err := clientConfig.ClientConfig()
+				errors.CheckError(err)
+				namespace, _, err := clientConfig.Namespace()
+				errors.CheckError(err)
+				records, err = audit.ReadEvents(ctx, kubernetes.NewForConfigOrDie(config), namespace)
+				errors.CheckError(err)
+			}
+
+			if since > 0 {
+				filter.Since = time.Now().Add(-since)
+			}
+			records = filter.Apply(records)
+
+			switch output {
+			case "json", "yaml":
+				resources := make([]interface{}, len(records))
+				for i := range records {
+					resources[i] = records[i]
+				}
+				errors.CheckError(PrintResources(output, os.Stdout, resources...))
+			case "wide", "":
+				printAuditRecords(records, output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&file, "file", "", "Read the records from a JSON lines file instead of the Kubernetes events of the namespace")
+	command.Flags().StringVar(&filter.Subject, "subject", "", "Only print calls made by the matching subject or username")
+	command.Flags().StringVar(&filter.Group, "group", "", "Only print calls made by members of the matching group")
+	command.Flags().StringVar(&filter.Method, "method", "", "Only print calls of the matching method, e.g. '/application.ApplicationService/Sync', '*/Delete' or 'Sync'")
+	command.Flags().StringVar(&filter.Object, "object", "", "Only print calls on the matching object")
+	command.Flags().DurationVar(&since, "since", 0, "Only print calls made within the given duration, e.g. 1h")
+	command.Flags().BoolVar(&filter.Failed, "failed", false, "Only print calls which failed")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func readAuditFile(path string) ([]audit.Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(f)
+	return audit.ReadFile(f)
+}
+
+func printAuditRecords(records []audit.Record, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if wide {
+		_, _ = fmt.Fprintf(w, "TIME\tSUBJECT\tGROUPS\tMETHOD\tOBJECT\tOUTCOME\tREQUEST\n")
+	} else {
+		_, _ = fmt.Fprintf(w, "TIME\tSUBJECT\tMETHOD\tOBJECT\tOUTCOME\n")
+	}
+	for _, record := range records {
+		subject := record.Subject
+		if record.Username != "" {
+			subject = record.Username
+		}
+		if wide {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", record.Time.Format(time.RFC3339), subject, strings.Join(record.Groups, ","), record.Method(), record.Object, record.Outcome, string(record.Request))
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", record.Time.Format(time.RFC3339), subject, record.Method(), record.Object, record.Outcome)
+		}
+	}
+	_ = w.Flush()
+}
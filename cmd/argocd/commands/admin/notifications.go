@@ -3,6 +3,9 @@ package admin
 import (
 	"fmt"
 	"log"
+	"os"
+	"text/tabwriter"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
@@ -10,8 +13,12 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	settings "github.com/argoproj/argo-cd/v2/util/notification/settings"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 
@@ -31,12 +38,16 @@ func NewNotificationsCommand() *cobra.Command {
 		argocdRepoServerStrictTLS bool
 	)
 
-	var argocdService service.Service
+	var (
+		argocdService service.Service
+		kubeConfig    clientcmd.ClientConfig
+	)
 	toolsCommand := cmd.NewToolsCommand(
 		"notifications",
 		"argocd admin notifications",
 		applications,
 		settings.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm"), func(clientConfig clientcmd.ClientConfig) {
+			kubeConfig = clientConfig
 			k8sCfg, err := clientConfig.ClientConfig()
 			if err != nil {
 				log.Fatalf("Failed to parse k8s config: %v", err)
@@ -60,7 +71,7 @@ func NewNotificationsCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, 5, tlsConfig)
-			argocdService, err = service.NewArgoCDService(kubernetes.NewForConfigOrDie(k8sCfg), ns, repoClientset)
+			argocdService, err = service.NewArgoCDService(kubernetes.NewForConfigOrDie(k8sCfg), ns, repoClientset, nil)
 			if err != nil {
 				log.Fatalf("Failed to initialize Argo CD service: %v", err)
 			}
@@ -68,5 +79,95 @@ func NewNotificationsCommand() *cobra.Command {
 	toolsCommand.PersistentFlags().StringVar(&argocdRepoServer, "argocd-repo-server", common.DefaultRepoServerAddr, "Argo CD repo server address")
 	toolsCommand.PersistentFlags().BoolVar(&argocdRepoServerPlaintext, "argocd-repo-server-plaintext", false, "Use a plaintext client (non-TLS) to connect to repository server")
 	toolsCommand.PersistentFlags().BoolVar(&argocdRepoServerStrictTLS, "argocd-repo-server-strict-tls", false, "Perform strict validation of TLS certificates when connecting to repo server")
+	toolsCommand.AddCommand(newNotificationsHistoryCommand(func() clientcmd.ClientConfig {
+		return kubeConfig
+	}))
 	return toolsCommand
 }
+
+// newNotificationsHistoryCommand returns a new instance of the `argocd admin notifications history` command
+func newNotificationsHistoryCommand(getClientConfig func() clientcmd.ClientConfig) *cobra.Command {
+	var (
+		kind             string
+		namespace        string
+		name             string
+		deadLettered     bool
+		output           string
+		portForwardRedis bool
+		cacheSrc         func() (*cacheutil.Cache, error)
+	)
+	var command = &cobra.Command{
+		Use:   "history",
+		Short: "List the delivery history of notifications",
+		Example: `
+# List the delivery attempts of all notifications
+argocd admin notifications history
+
+# List the delivery attempts of the notifications about an application
+argocd admin notifications history --kind Application --name guestbook
+
+# List the notifications which could not be delivered
+argocd admin notifications history --dead-lettered`,
+		Run: func(c *cobra.Command, args []string) {
+			if portForwardRedis {
+				ns, _, err := getClientConfig().Namespace()
+				errors.CheckError(err)
+				port, err := kubeutil.PortForward(6379, ns, &clientcmd.ConfigOverrides{},
+					"app.kubernetes.io/name=argocd-redis-ha-haproxy", "app.kubernetes.io/name=argocd-redis")
+				errors.CheckError(err)
+				errors.CheckError(c.Flags().Set("redis", fmt.Sprintf("localhost:%d", port)))
+			}
+			cache, err := cacheSrc()
+			errors.CheckError(err)
+			store := delivery.NewStore(cache, 0)
+			var records []delivery.Record
+			if deadLettered {
+				records, err = store.ListDeadLetters()
+			} else {
+				records, err = store.List()
+			}
+			errors.CheckError(err)
+			records = delivery.Filter(records, kind, namespace, name)
+
+			switch output {
+			case "json", "yaml":
+				resources := make([]interface{}, len(records))
+				for i := range records {
+					resources[i] = records[i]
+				}
+				errors.CheckError(PrintResources(output, os.Stdout, resources...))
+			case "wide", "":
+				printDeliveries(records, output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&kind, "kind", "", "Only list the notifications about resources of the given kind, e.g. Application")
+	command.Flags().StringVar(&namespace, "resource-namespace", "", "Only list the notifications about resources in the given namespace")
+	command.Flags().StringVar(&name, "name", "", "Only list the notifications about resources with the given name")
+	command.Flags().BoolVar(&deadLettered, "dead-lettered", false, "List the notifications which could not be delivered instead of the delivery history")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	cacheSrc = cacheutil.AddCacheFlagsToCmd(command)
+	return command
+}
+
+func printDeliveries(records []delivery.Record, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if wide {
+		_, _ = fmt.Fprintf(w, "TIME\tKIND\tNAMESPACE\tNAME\tTRIGGER\tSERVICE\tRECIPIENT\tSTATUS\tATTEMPT\tID\tERROR\n")
+	} else {
+		_, _ = fmt.Fprintf(w, "TIME\tKIND\tNAME\tTRIGGER\tRECIPIENT\tSTATUS\tATTEMPT\tERROR\n")
+	}
+	for _, r := range records {
+		t := r.Time.Format(time.RFC3339)
+		recipient := fmt.Sprintf("%s:%s", r.Service, r.Recipient)
+		if wide {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", t, r.Kind, r.Namespace, r.Name, r.Trigger, r.Service, r.Recipient, r.Status, r.Attempt, r.ID, r.Error)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n", t, r.Kind, r.Name, r.Trigger, recipient, r.Status, r.Attempt, r.Error)
+		}
+	}
+	_ = w.Flush()
+}
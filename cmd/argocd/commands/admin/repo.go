@@ -39,8 +39,9 @@ func NewRepoCommand() *cobra.Command {
 
 func NewGenRepoSpecCommand() *cobra.Command {
 	var (
-		repoOpts     cmdutil.RepoOptions
-		outputFormat string
+		repoOpts           cmdutil.RepoOptions
+		outputFormat       string
+		credentialProvider string
 	)
 
 	// For better readability and easier formatting
@@ -57,6 +58,9 @@ func NewGenRepoSpecCommand() *cobra.Command {
   # Add a private Git repository via HTTPS using username/password without verifying the server's TLS certificate
   argocd admin repo generate-spec https://git.example.com/repos/repo --username git --password secret --insecure-skip-server-verification
 
+  # Add a private Git repository via HTTPS using short-lived tokens of the credential provider 'git-token' defined in argocd-cm
+  argocd admin repo generate-spec https://git.example.com/repos/repo --credential-provider git-token
+
   # Add a public Helm repository named 'stable' via HTTPS
   argocd admin repo generate-spec https://charts.helm.sh/stable --type helm --name stable  
 
@@ -126,6 +130,13 @@ func NewGenRepoSpecCommand() *cobra.Command {
 			repoOpts.Repo.Insecure = repoOpts.InsecureSkipServerVerification
 			repoOpts.Repo.EnableLFS = repoOpts.EnableLfs
 			repoOpts.Repo.EnableOCI = repoOpts.EnableOci
+			repoOpts.Repo.CredentialProvider = credentialProvider
+			if c.Flags().Changed("enable-submodules") {
+				repoOpts.Repo.EnableSubmodules = &repoOpts.EnableSubmodules
+			}
+			submoduleCredentials, err := repoOpts.GetSubmoduleCredentials()
+			errors.CheckError(err)
+			repoOpts.Repo.SubmoduleCredentials = submoduleCredentials
 
 			if repoOpts.Repo.Type == "helm" && repoOpts.Repo.Name == "" {
 				errors.CheckError(fmt.Errorf("must specify --name for repos of type 'helm'"))
@@ -154,7 +165,7 @@ func NewGenRepoSpecCommand() *cobra.Command {
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, ArgoCDNamespace)
 			argoDB := db.NewDB(ArgoCDNamespace, settingsMgr, kubeClientset)
 
-			_, err := argoDB.CreateRepository(ctx, &repoOpts.Repo)
+			_, err = argoDB.CreateRepository(ctx, &repoOpts.Repo)
 			errors.CheckError(err)
 
 			secret, err := kubeClientset.CoreV1().Secrets(ArgoCDNamespace).Get(ctx, db.RepoURLToSecretName(repoSecretPrefix, repoOpts.Repo.Repo), v1.GetOptions{})
@@ -165,5 +176,6 @@ func NewGenRepoSpecCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(&outputFormat, "output", "o", "yaml", "Output format. One of: json|yaml")
 	cmdutil.AddRepoFlags(command, &repoOpts)
+	command.Flags().StringVar(&credentialProvider, "credential-provider", "", "name of a credential provider defined in argocd-cm used to authenticate at the repository")
 	return command
 }
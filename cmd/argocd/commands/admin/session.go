@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+	sessionutil "github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// NewSessionCommand returns a new instance of the `argocd admin session` command
+func NewSessionCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "session",
+		Short: "Manage the login sessions of users",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewSessionListCommand())
+	command.AddCommand(NewSessionRevokeCommand())
+	return command
+}
+
+// NewSessionListCommand returns a new instance of the `argocd admin session list` command
+func NewSessionListCommand() *cobra.Command {
+	var (
+		user    string
+		output  string
+		storage func(ctx context.Context) (sessionutil.UserStateStorage, *settings.SettingsManager, error)
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List the active login sessions",
+		Example: `
+# List the active sessions of all users
+argocd admin session list
+
+# List the active sessions of a user
+argocd admin session list --user alice`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			s, _, err := storage(ctx)
+			errors.CheckError(err)
+			sessions, err := s.ListSessions(ctx, user)
+			errors.CheckError(err)
+
+			switch output {
+			case "json", "yaml":
+				resources := make([]interface{}, len(sessions))
+				for i := range sessions {
+					resources[i] = sessions[i]
+				}
+				errors.CheckError(PrintResources(output, os.Stdout, resources...))
+			case "wide", "":
+				printSessions(sessions, output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&user, "user", "", "Only list the sessions of the given user")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	storage = addSessionStorageFlags(command)
+	return command
+}
+
+// NewSessionRevokeCommand returns a new instance of the `argocd admin session revoke` command
+func NewSessionRevokeCommand() *cobra.Command {
+	var (
+		user    string
+		id      string
+		storage func(ctx context.Context) (sessionutil.UserStateStorage, *settings.SettingsManager, error)
+	)
+	var command = &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke a login session, or all sessions and tokens of a user",
+		Example: `
+# Revoke all sessions and tokens of a user issued so far, e.g. when offboarding the user
+argocd admin session revoke --user alice
+
+# Revoke a single session of a user
+argocd admin session revoke --user alice --id 9cd2c0b4-3a0b-4e55-8a1f-4cfd7a1c3f1e`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if user == "" {
+				errors.CheckError(fmt.Errorf("--user is required"))
+			}
+			s, settingsMgr, err := storage(ctx)
+			errors.CheckError(err)
+			if id == "" {
+				lifetime, err := sessionutil.MaxTokenLifetime(ctx, settingsMgr, s, user)
+				errors.CheckError(err)
+				errors.CheckError(s.RevokeSubject(ctx, user, time.Now(), lifetime))
+				fmt.Printf("All sessions and tokens of '%s' revoked\n", user)
+				return
+			}
+			sessions, err := s.ListSessions(ctx, user)
+			errors.CheckError(err)
+			for _, session := range sessions {
+				if session.ID == id {
+					errors.CheckError(s.RevokeToken(ctx, id, time.Until(time.Unix(session.ExpiresAt, 0))))
+					fmt.Printf("Session '%s' of '%s' revoked\n", id, user)
+					return
+				}
+			}
+			errors.CheckError(fmt.Errorf("session '%s' of '%s' does not exist", id, user))
+		},
+	}
+	command.Flags().StringVar(&user, "user", "", "The user whose sessions are revoked")
+	command.Flags().StringVar(&id, "id", "", "Only revoke the session with the given id")
+	storage = addSessionStorageFlags(command)
+	return command
+}
+
+// addSessionStorageFlags adds the flags to connect to redis to the command, and returns a function returning the
+// session storage and the settings manager
+func addSessionStorageFlags(command *cobra.Command) func(ctx context.Context) (sessionutil.UserStateStorage, *settings.SettingsManager, error) {
+	var (
+		clientConfig     clientcmd.ClientConfig
+		portForwardRedis bool
+		redisClient      *redis.Client
+	)
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	cacheSrc := cacheutil.AddCacheFlagsToCmd(command, func(client *redis.Client) {
+		redisClient = client
+	})
+	return func(ctx context.Context) (sessionutil.UserStateStorage, *settings.SettingsManager, error) {
+		namespace, _, err := clientConfig.Namespace()
+		if err != nil {
+			return nil, nil, err
+		}
+		conf, err := clientConfig.ClientConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		kubeClientset, err := kubernetes.NewForConfig(conf)
+		if err != nil {
+			return nil, nil, err
+		}
+		if portForwardRedis {
+			overrides := clientcmd.ConfigOverrides{}
+			port, err := kubeutil.PortForward(6379, namespace, &overrides,
+				"app.kubernetes.io/name=argocd-redis-ha-haproxy", "app.kubernetes.io/name=argocd-redis")
+			if err != nil {
+				return nil, nil, err
+			}
+			redisClient = redis.NewClient(&redis.Options{Addr: fmt.Sprintf("localhost:%d", port)})
+		} else if _, err := cacheSrc(); err != nil {
+			return nil, nil, err
+		}
+		return sessionutil.NewUserStateStorage(redisClient), settings.NewSettingsManager(ctx, kubeClientset, namespace), nil
+	}
+}
+
+func printSessions(sessions []sessionutil.Session, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if wide {
+		_, _ = fmt.Fprintf(w, "ID\tSUBJECT\tISSUER\tISSUED AT\tEXPIRES AT\n")
+	} else {
+		_, _ = fmt.Fprintf(w, "ID\tSUBJECT\tISSUED AT\tEXPIRES AT\n")
+	}
+	for _, session := range sessions {
+		issuedAt := time.Unix(session.IssuedAt, 0).Format(time.RFC3339)
+		expiresAt := time.Unix(session.ExpiresAt, 0).Format(time.RFC3339)
+		if wide {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", session.ID, session.Subject, session.Issuer, issuedAt, expiresAt)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", session.ID, session.Subject, issuedAt, expiresAt)
+		}
+	}
+	_ = w.Flush()
+}
@@ -434,7 +434,7 @@ func NewResourceHealthCommand(cmdCtx commandContext) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "health RESOURCE_YAML_PATH",
 		Short: "Assess resource health",
-		Long:  "Assess resource health using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Long:  "Assess resource health using the lua script or CEL expression configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
 		Example: `
 argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -447,7 +447,7 @@ argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path .
 
 			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
-				if override.HealthLua == "" {
+				if override.HealthLua == "" && override.HealthCEL == "" {
 					_, _ = fmt.Printf("Health script is not configured for '%s/%s'\n", gvk.Group, gvk.Kind)
 					return
 				}
@@ -467,7 +467,7 @@ func NewResourceActionListCommand(cmdCtx commandContext) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "list-actions RESOURCE_YAML_PATH",
 		Short: "List available resource actions",
-		Long:  "List actions available for given resource action using the lua scripts configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
+		Long:  "List actions available for given resource action using the lua scripts or CEL expression configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
 		Example: `
 argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -486,10 +486,17 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 				}
 
 				luaVM := lua.VM{ResourceOverrides: overrides}
-				discoveryScript, err := luaVM.GetResourceActionDiscovery(&res)
+				discoveryExpression, err := luaVM.GetResourceActionDiscoveryCEL(&res)
 				errors.CheckError(err)
 
-				availableActions, err := luaVM.ExecuteResourceActionDiscovery(&res, discoveryScript)
+				var availableActions []v1alpha1.ResourceAction
+				if discoveryExpression != "" {
+					availableActions, err = luaVM.ExecuteResourceActionDiscoveryCEL(&res, discoveryExpression)
+				} else {
+					discoveryScript, scriptErr := luaVM.GetResourceActionDiscovery(&res)
+					errors.CheckError(scriptErr)
+					availableActions, err = luaVM.ExecuteResourceActionDiscovery(&res, discoveryScript)
+				}
 				errors.CheckError(err)
 				sort.Slice(availableActions, func(i, j int) bool {
 					return availableActions[i].Name < availableActions[j].Name
@@ -535,16 +542,26 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua)
+				impactedResources, err := luaVM.ExecuteResourceAction(&res, action.ActionLua)
 				errors.CheckError(err)
 
-				if reflect.DeepEqual(&res, modifiedRes) {
-					_, _ = fmt.Printf("No fields had been changed by action: \n%s\n", action.Name)
-					return
-				}
+				for _, impactedResource := range impactedResources {
+					result := impactedResource.UnstructuredObj
+					switch impactedResource.K8SOperation {
+					case lua.PatchOperation:
+						if reflect.DeepEqual(&res, result) {
+							_, _ = fmt.Printf("No fields had been changed by action: \n%s\n", action.Name)
+							continue
+						}
 
-				_, _ = fmt.Printf("Following fields have been changed:\n\n")
-				_ = cli.PrintDiff(res.GetName(), &res, modifiedRes)
+						_, _ = fmt.Printf("Following fields have been changed:\n\n")
+						_ = cli.PrintDiff(res.GetName(), &res, result)
+					case lua.CreateOperation:
+						yamlBytes, err := yaml.Marshal(result)
+						errors.CheckError(err)
+						_, _ = fmt.Printf("Following resource will be created:\n\n%s\n", string(yamlBytes))
+					}
+				}
 			})
 		},
 	}
@@ -61,9 +61,11 @@ var validRBACResources map[string]bool = map[string]bool{
 // List of allowed RBAC actions
 var validRBACActions map[string]bool = map[string]bool{
 	rbacpolicy.ActionAction:   true,
+	rbacpolicy.ActionApprove:  true,
 	rbacpolicy.ActionCreate:   true,
 	rbacpolicy.ActionDelete:   true,
 	rbacpolicy.ActionGet:      true,
+	rbacpolicy.ActionOwn:      true,
 	rbacpolicy.ActionOverride: true,
 	rbacpolicy.ActionSync:     true,
 	rbacpolicy.ActionUpdate:   true,
@@ -219,8 +221,16 @@ a local file, and in either CSV or K8s ConfigMap format.
 				log.Fatalf("Please specify policy to validate using --policy-file")
 			}
 			userPolicy, _, _ := getPolicy(ctx, policyFile, nil, "")
+			groups, err := getLocalGroupsFromFile(policyFile)
+			if err != nil {
+				fmt.Printf("Policy is invalid: %v\n", err)
+				os.Exit(1)
+			}
 			if userPolicy != "" {
 				if err := rbac.ValidatePolicy(userPolicy); err == nil {
+					for _, warning := range rbac.GroupWarnings(userPolicy, groups, nil, nil) {
+						fmt.Printf("WARNING: %s\n", warning)
+					}
 					fmt.Printf("Policy is valid.\n")
 					os.Exit(0)
 				} else {
@@ -283,6 +293,19 @@ func getPolicyFromFile(policyFile string) (string, string, string, error) {
 	return userPolicy, defaultRole, matchMode, nil
 }
 
+// getLocalGroupsFromFile loads the local RBAC groups from the given path, if it is a ConfigMap
+func getLocalGroupsFromFile(policyFile string) ([]rbac.LocalGroup, error) {
+	upol, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	var upolCM *corev1.ConfigMap
+	if err := yaml.Unmarshal(upol, &upolCM); err != nil || upolCM == nil {
+		return nil, nil
+	}
+	return rbac.ParseLocalGroups(upolCM.Data)
+}
+
 // Retrieve policy information from a ConfigMap
 func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
 	var (
@@ -290,10 +313,7 @@ func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
 		defaultRole string
 		ok          bool
 	)
-	userPolicy, ok = cm.Data[rbac.ConfigMapPolicyCSVKey]
-	if !ok {
-		userPolicy = ""
-	}
+	userPolicy = rbac.PolicyCSV(cm.Data)
 	defaultRole, ok = cm.Data[rbac.ConfigMapPolicyDefaultKey]
 	if !ok {
 		defaultRole = ""
@@ -195,3 +195,22 @@ p, role:, certificates, get, .*, allow`
 		require.True(t, ok)
 	})
 }
+
+func Test_PolicyWithLocalGroups(t *testing.T) {
+	ctx := context.Background()
+
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-rbac-cm",
+			Namespace: "argocd",
+		},
+		Data: map[string]string{
+			"policy.csv": "g, platform-team, role:admin",
+			"groups":     "- name: platform-team\n  accounts: [alice]\n",
+		},
+	})
+	uPol, dRole, matchMode := getPolicy(ctx, "", kubeclientset, "argocd")
+
+	require.True(t, checkPolicy("alice", "update", "clusters", "*", assets.BuiltinPolicyCSV, uPol, dRole, matchMode, true))
+	require.False(t, checkPolicy("bob", "update", "clusters", "*", assets.BuiltinPolicyCSV, uPol, dRole, matchMode, true))
+}
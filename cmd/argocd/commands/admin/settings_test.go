@@ -326,6 +326,19 @@ func TestResourceOverrideHealth(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, out, "Progressing")
 	})
+
+	t.Run("HealthAssessmentConfiguredWithCEL", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations.healthCEL.apps_Deployment": `{"status": "Degraded", "message": obj.metadata.name + " is degraded"}`}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"health", f})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, "STATUS: Degraded")
+		assert.Contains(t, out, "MESSAGE: nginx-deployment is degraded")
+	})
 }
 
 func TestResourceOverrideAction(t *testing.T) {
@@ -379,6 +392,25 @@ func TestResourceOverrideAction(t *testing.T) {
 		assert.Contains(t, out, `NAME     ENABLED
 restart  false
 resume   false
+`)
+	})
+
+	t.Run("ActionDiscoveryConfiguredWithCEL", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": `apps/Deployment:
+  actions: |
+    discovery.cel: |
+      {"resume": {"disabled": !has(obj.spec.paused)}, "restart": {}}
+`}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"list-actions", f})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, `NAME     ENABLED
+restart  false
+resume   true
 `)
 	})
 }
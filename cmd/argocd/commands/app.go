@@ -84,6 +84,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncApproveCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
@@ -503,6 +504,9 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		syncStatusStr += fmt.Sprintf(" (%s)", app.Status.Sync.Revision[0:7])
 	}
 	fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
+	if pending := app.Status.PendingSync; pending != nil {
+		fmt.Printf(printOpFmtStr, "Pending Sync:", fmt.Sprintf("%s to %s requested by %s, expires %s", pending.Phase, pending.Revision(), pending.Requester, pending.ExpiresAt.Format(time.RFC3339)))
+	}
 	healthStr := string(app.Status.Health.Status)
 	if app.Status.Health.Message != "" {
 		healthStr = fmt.Sprintf("%s (%s)", app.Status.Health.Status, app.Status.Health.Message)
@@ -794,8 +798,9 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 				}
 			}
 		}
-		if opts.valuesLiteral && source.Helm.Values != "" {
+		if opts.valuesLiteral && !source.Helm.ValuesIsEmpty() {
 			source.Helm.Values = ""
+			source.Helm.ValuesObject = nil
 			updated = true
 		}
 		for _, valuesFile := range opts.valuesFiles {
@@ -925,6 +930,8 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		localRepoRoot      string
 		serverSideGenerate bool
 		localIncludes      []string
+		fromRevisions      []string
+		toRevisions        []string
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	var command = &cobra.Command{
@@ -942,6 +949,22 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer argoio.Close(conn)
 			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			if len(fromRevisions) > 0 || len(toRevisions) > 0 {
+				if revision != "" || local != "" {
+					log.Fatal("--from-revision and --to-revision cannot be used together with --revision or --local")
+				}
+				res, err := appIf.RevisionDiff(ctx, &application.ApplicationRevisionDiffQuery{
+					Name:          &appName,
+					AppNamespace:  &appNs,
+					FromRevisions: fromRevisions,
+					ToRevisions:   toRevisions,
+				})
+				errors.CheckError(err)
+				if printRevisionDiff(res) && exitCode {
+					os.Exit(1)
+				}
+				return
+			}
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{
 				Name:         &appName,
 				Refresh:      getRefreshType(refresh, hardRefresh),
@@ -1004,9 +1027,29 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
 	command.Flags().BoolVar(&serverSideGenerate, "server-side-generate", false, "Used with --local, this will send your manifests to the server for diffing")
 	command.Flags().StringArrayVar(&localIncludes, "local-include", []string{"*.yaml", "*.yml", "*.json"}, "Used with --server-side-generate, specify patterns of filenames to send. Matching is based on filename and not path.")
+	command.Flags().StringArrayVar(&fromRevisions, "from-revision", []string{}, "Compare the manifests rendered at this revision to the ones rendered at --to-revision instead of the live state. Repeat once per source for applications with multiple sources, an empty value uses the target revision of the source")
+	command.Flags().StringArrayVar(&toRevisions, "to-revision", []string{}, "Compare the manifests rendered at --from-revision to the ones rendered at this revision. Repeat once per source for applications with multiple sources, an empty value uses the target revision of the source")
 	return command
 }
 
+// printRevisionDiff prints the difference between the manifests rendered at two revisions, returns true if a difference is found
+func printRevisionDiff(res *application.ApplicationRevisionDiffResponse) bool {
+	var foundDiffs bool
+	for _, item := range res.Items {
+		if !item.GetModified() {
+			continue
+		}
+		from, err := argoappv1.UnmarshalToUnstructured(item.GetFromState())
+		errors.CheckError(err)
+		to, err := argoappv1.UnmarshalToUnstructured(item.GetToState())
+		errors.CheckError(err)
+		fmt.Printf("\n===== %s/%s %s/%s ======\n", item.GetGroup(), item.GetKind(), item.GetNamespace(), item.GetName())
+		foundDiffs = true
+		_ = cli.PrintDiff(item.GetName(), from, to)
+	}
+	return foundDiffs
+}
+
 // DifferenceOption struct to store diff options
 type DifferenceOption struct {
 	local         string
@@ -1772,8 +1815,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						fmt.Printf("====== No Differences found ======\n")
 					}
 				}
-				_, err = appIf.Sync(ctx, &syncReq)
+				syncedApp, err := appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
+				if syncedApp.Operation == nil && syncedApp.Status.PendingSync.IsPending(time.Now()) {
+					printPendingSync(appQualifiedName, syncedApp.Status.PendingSync)
+					continue
+				}
 
 				if !async {
 					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources)
@@ -2282,13 +2329,17 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			depInfo, err := findRevisionHistory(app, int64(depID))
 			errors.CheckError(err)
 
-			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
+			rolledBackApp, err := appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
 				Name:         &appName,
 				AppNamespace: &appNs,
 				Id:           pointer.Int64(depInfo.ID),
 				Prune:        pointer.Bool(prune),
 			})
 			errors.CheckError(err)
+			if rolledBackApp.Operation == nil && rolledBackApp.Status.PendingSync.IsPending(time.Now()) {
+				printPendingSync(app.QualifiedName(), rolledBackApp.Status.PendingSync)
+				return
+			}
 
 			_, _, err = waitOnApplicationStatus(ctx, acdClient, app.QualifiedName(), timeout, watchOpts{
 				operation: true,
@@ -2442,6 +2493,54 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationSyncApproveCommand returns a new instance of an `argocd app sync-approve` command
+func NewApplicationSyncApproveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		reject bool
+	)
+	var command = &cobra.Command{
+		Use:   "sync-approve APPNAME",
+		Short: "Approve or reject the pending sync of an application",
+		Example: `  # Approve the pending sync of an application
+  argocd app sync-approve my-app
+
+  # Reject the pending sync of an application
+  argocd app sync-approve my-app --reject`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.SyncApprove(ctx, &application.ApplicationSyncApprovalRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Reject:       &reject,
+			})
+			errors.CheckError(err)
+			if reject {
+				fmt.Printf("Pending sync of application '%s' rejected\n", appName)
+			} else {
+				fmt.Printf("Pending sync of application '%s' approved\n", appName)
+			}
+		},
+	}
+	command.Flags().BoolVar(&reject, "reject", false, "Reject the pending sync instead of approving it")
+	return command
+}
+
+func printPendingSync(appName string, pending *argoappv1.PendingSync) {
+	fmt.Printf("Sync of application '%s' to %s awaits approval until %s\n", appName, pending.Revision(), pending.ExpiresAt.Format(time.RFC3339))
+	if pending.DiffSummary != "" {
+		fmt.Printf("Changes: %s\n", pending.DiffSummary)
+	}
+	fmt.Printf("It can be approved by a different user with 'argocd app sync-approve %s'\n", appName)
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "edit APPNAME",
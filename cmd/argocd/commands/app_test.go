@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -873,6 +874,12 @@ func Test_unset(t *testing.T) {
 	assert.False(t, updated)
 	assert.False(t, nothingToUnset)
 
+	helmSource.Helm.ValuesObject = &runtime.RawExtension{Raw: []byte(`{"some":"yaml"}`)}
+	updated, nothingToUnset = unset(helmSource, unsetOpts{valuesLiteral: true})
+	assert.Nil(t, helmSource.Helm.ValuesObject)
+	assert.True(t, updated)
+	assert.False(t, nothingToUnset)
+
 	assert.Equal(t, true, helmSource.Helm.IgnoreMissingValueFiles)
 	updated, nothingToUnset = unset(helmSource, unsetOpts{ignoreMissingValueFiles: true})
 	assert.Equal(t, false, helmSource.Helm.IgnoreMissingValueFiles)
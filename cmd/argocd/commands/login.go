@@ -42,6 +42,8 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		sso         bool
 		ssoPort     int
 		skipTestTLS bool
+		idTokenFile string
+		idTokenEnv  string
 	)
 	var command = &cobra.Command{
 		Use:   "login SERVER",
@@ -53,6 +55,12 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using a token of a trusted OIDC issuer, e.g. a projected Kubernetes service account token
+argocd login cd.argoproj.io --id-token-file /var/run/secrets/tokens/argocd
+
+# Login to Argo CD using a token of a trusted OIDC issuer stored in an environment variable
+argocd login cd.argoproj.io --id-token-env CI_ID_TOKEN
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -65,6 +73,10 @@ argocd login cd.argoproj.io --core`,
 				os.Exit(1)
 			}
 
+			if (idTokenFile != "" || idTokenEnv != "") && (sso || username != "" || password != "") {
+				errors.CheckError(fmt.Errorf("--id-token-file and --id-token-env cannot be combined with --sso, --username or --password"))
+			}
+
 			if globalClientOpts.PortForward {
 				server = "port-forward"
 			} else if globalClientOpts.Core {
@@ -123,7 +135,11 @@ argocd login cd.argoproj.io --core`,
 				acdClient := headless.NewClientOrDie(&clientOpts, c)
 				setConn, setIf := acdClient.NewSettingsClientOrDie()
 				defer io.Close(setConn)
-				if !sso {
+				if idTokenFile != "" || idTokenEnv != "" {
+					idToken, err := readIDToken(idTokenFile, idTokenEnv)
+					errors.CheckError(err)
+					tokenString = idTokenLogin(ctx, acdClient, idToken)
+				} else if !sso {
 					tokenString = passwordLogin(ctx, acdClient, username, password)
 				} else {
 					httpClient, err := acdClient.HTTPClient()
@@ -180,6 +196,8 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
 	command.Flags().BoolVar(&sso, "sso", false, "perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
+	command.Flags().StringVar(&idTokenFile, "id-token-file", "", "path to a file containing an OIDC token of a trusted issuer to exchange for a session")
+	command.Flags().StringVar(&idTokenEnv, "id-token-env", "", "name of an environment variable containing an OIDC token of a trusted issuer to exchange for a session")
 	command.Flags().
 		BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	return command
@@ -357,3 +375,33 @@ func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username,
 	errors.CheckError(err)
 	return createdSession.Token
 }
+
+// readIDToken reads an OIDC token from the given file or environment variable
+func readIDToken(file string, envVar string) (string, error) {
+	if file != "" && envVar != "" {
+		return "", fmt.Errorf("only one of --id-token-file and --id-token-env can be specified")
+	}
+	var idToken string
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %w", err)
+		}
+		idToken = string(data)
+	} else {
+		idToken = os.Getenv(envVar)
+	}
+	idToken = strings.TrimSpace(idToken)
+	if idToken == "" {
+		return "", fmt.Errorf("OIDC token is empty")
+	}
+	return idToken, nil
+}
+
+func idTokenLogin(ctx context.Context, acdClient argocdclient.Client, idToken string) string {
+	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
+	defer io.Close(sessConn)
+	createdSession, err := sessionIf.Create(ctx, &sessionpkg.SessionCreateRequest{IdToken: idToken})
+	errors.CheckError(err)
+	return createdSession.Token
+}
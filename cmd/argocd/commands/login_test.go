@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -27,3 +29,24 @@ func Test_userDisplayName_sub(t *testing.T) {
 	expectedName := "foo"
 	assert.Equal(t, expectedName, actualName)
 }
+
+func Test_readIDToken(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(file, []byte("file-token\n"), 0600))
+	t.Setenv("TEST_ID_TOKEN", "env-token")
+
+	idToken, err := readIDToken(file, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "file-token", idToken)
+
+	idToken, err = readIDToken("", "TEST_ID_TOKEN")
+	assert.NoError(t, err)
+	assert.Equal(t, "env-token", idToken)
+
+	_, err = readIDToken(file, "TEST_ID_TOKEN")
+	assert.Error(t, err)
+	_, err = readIDToken("", "TEST_ID_TOKEN_MISSING")
+	assert.Error(t, err)
+	_, err = readIDToken(filepath.Join(t.TempDir(), "missing"), "")
+	assert.Error(t, err)
+}
@@ -159,9 +159,9 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			i := int64(appOpts.revisionHistoryLimit)
 			spec.RevisionHistoryLimit = &i
 		case "values":
-			setHelmOpt(source, helmOpts{valueFiles: appOpts.valuesFiles})
+			errors.CheckError(setHelmOpt(source, helmOpts{valueFiles: appOpts.valuesFiles}))
 		case "ignore-missing-value-files":
-			setHelmOpt(source, helmOpts{ignoreMissingValueFiles: appOpts.ignoreMissingValueFiles})
+			errors.CheckError(setHelmOpt(source, helmOpts{ignoreMissingValueFiles: appOpts.ignoreMissingValueFiles}))
 		case "values-literal-file":
 			var data []byte
 
@@ -173,21 +173,21 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 				data, err = config.ReadRemoteFile(appOpts.values)
 			}
 			errors.CheckError(err)
-			setHelmOpt(source, helmOpts{values: string(data)})
+			errors.CheckError(setHelmOpt(source, helmOpts{values: string(data)}))
 		case "release-name":
-			setHelmOpt(source, helmOpts{releaseName: appOpts.releaseName})
+			errors.CheckError(setHelmOpt(source, helmOpts{releaseName: appOpts.releaseName}))
 		case "helm-version":
-			setHelmOpt(source, helmOpts{version: appOpts.helmVersion})
+			errors.CheckError(setHelmOpt(source, helmOpts{version: appOpts.helmVersion}))
 		case "helm-pass-credentials":
-			setHelmOpt(source, helmOpts{passCredentials: appOpts.helmPassCredentials})
+			errors.CheckError(setHelmOpt(source, helmOpts{passCredentials: appOpts.helmPassCredentials}))
 		case "helm-set":
-			setHelmOpt(source, helmOpts{helmSets: appOpts.helmSets})
+			errors.CheckError(setHelmOpt(source, helmOpts{helmSets: appOpts.helmSets}))
 		case "helm-set-string":
-			setHelmOpt(source, helmOpts{helmSetStrings: appOpts.helmSetStrings})
+			errors.CheckError(setHelmOpt(source, helmOpts{helmSetStrings: appOpts.helmSetStrings}))
 		case "helm-set-file":
-			setHelmOpt(source, helmOpts{helmSetFiles: appOpts.helmSetFiles})
+			errors.CheckError(setHelmOpt(source, helmOpts{helmSetFiles: appOpts.helmSetFiles}))
 		case "helm-skip-crds":
-			setHelmOpt(source, helmOpts{skipCrds: appOpts.helmSkipCrds})
+			errors.CheckError(setHelmOpt(source, helmOpts{skipCrds: appOpts.helmSkipCrds}))
 		case "directory-recurse":
 			if source.Directory != nil {
 				source.Directory.Recurse = appOpts.directoryRecurse
@@ -416,7 +416,7 @@ type helmOpts struct {
 	skipCrds                bool
 }
 
-func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
+func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) error {
 	if src.Helm == nil {
 		src.Helm = &argoappv1.ApplicationSourceHelm{}
 	}
@@ -427,7 +427,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 		src.Helm.IgnoreMissingValueFiles = opts.ignoreMissingValueFiles
 	}
 	if len(opts.values) > 0 {
-		src.Helm.Values = opts.values
+		if err := src.Helm.SetValuesString(opts.values); err != nil {
+			return fmt.Errorf("invalid values: %w", err)
+		}
 	}
 	if opts.releaseName != "" {
 		src.Helm.ReleaseName = opts.releaseName
@@ -444,27 +446,28 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
 		src.Helm.AddParameter(*p)
 	}
 	for _, text := range opts.helmSetStrings {
 		p, err := argoappv1.NewHelmParameter(text, true)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
 		src.Helm.AddParameter(*p)
 	}
 	for _, text := range opts.helmSetFiles {
 		p, err := argoappv1.NewHelmFileParameter(text)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
 		src.Helm.AddFileParameter(*p)
 	}
 	if src.Helm.IsZero() {
 		src.Helm = nil
 	}
+	return nil
 }
 
 func setJsonnetOpt(src *argoappv1.ApplicationSource, tlaParameters []string, code bool) {
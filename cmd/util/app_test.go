@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 
@@ -16,52 +17,75 @@ import (
 func Test_setHelmOpt(t *testing.T) {
 	t.Run("Zero", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{})
+		err := setHelmOpt(&src, helmOpts{})
+		require.NoError(t, err)
 		assert.Nil(t, src.Helm)
 	})
 	t.Run("ValueFiles", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{valueFiles: []string{"foo"}})
+		err := setHelmOpt(&src, helmOpts{valueFiles: []string{"foo"}})
+		require.NoError(t, err)
 		assert.Equal(t, []string{"foo"}, src.Helm.ValueFiles)
 	})
 	t.Run("IgnoreMissingValueFiles", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{ignoreMissingValueFiles: true})
+		err := setHelmOpt(&src, helmOpts{ignoreMissingValueFiles: true})
+		require.NoError(t, err)
 		assert.Equal(t, true, src.Helm.IgnoreMissingValueFiles)
 	})
 	t.Run("ReleaseName", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{releaseName: "foo"})
+		err := setHelmOpt(&src, helmOpts{releaseName: "foo"})
+		require.NoError(t, err)
 		assert.Equal(t, "foo", src.Helm.ReleaseName)
 	})
 	t.Run("HelmSets", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{helmSets: []string{"foo=bar"}})
+		err := setHelmOpt(&src, helmOpts{helmSets: []string{"foo=bar"}})
+		require.NoError(t, err)
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar"}}, src.Helm.Parameters)
 	})
 	t.Run("HelmSetStrings", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{helmSetStrings: []string{"foo=bar"}})
+		err := setHelmOpt(&src, helmOpts{helmSetStrings: []string{"foo=bar"}})
+		require.NoError(t, err)
 		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "foo", Value: "bar", ForceString: true}}, src.Helm.Parameters)
 	})
 	t.Run("HelmSetFiles", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{helmSetFiles: []string{"foo=bar"}})
+		err := setHelmOpt(&src, helmOpts{helmSetFiles: []string{"foo=bar"}})
+		require.NoError(t, err)
 		assert.Equal(t, []v1alpha1.HelmFileParameter{{Name: "foo", Path: "bar"}}, src.Helm.FileParameters)
 	})
 	t.Run("Version", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{version: "v3"})
+		err := setHelmOpt(&src, helmOpts{version: "v3"})
+		require.NoError(t, err)
 		assert.Equal(t, "v3", src.Helm.Version)
 	})
 	t.Run("HelmPassCredentials", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{passCredentials: true})
+		err := setHelmOpt(&src, helmOpts{passCredentials: true})
+		require.NoError(t, err)
 		assert.Equal(t, true, src.Helm.PassCredentials)
 	})
+	t.Run("Values", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		err := setHelmOpt(&src, helmOpts{values: "foo:\n  bar: baz\n"})
+		require.NoError(t, err)
+		assert.Equal(t, "", src.Helm.Values)
+		require.NotNil(t, src.Helm.ValuesObject)
+		assert.JSONEq(t, `{"foo":{"bar":"baz"}}`, string(src.Helm.ValuesObject.Raw))
+	})
+	t.Run("InvalidValues", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		err := setHelmOpt(&src, helmOpts{values: "- foo"})
+		assert.ErrorContains(t, err, "invalid values")
+	})
 	t.Run("HelmSkipCrds", func(t *testing.T) {
 		src := v1alpha1.ApplicationSource{}
-		setHelmOpt(&src, helmOpts{skipCrds: true})
+		err := setHelmOpt(&src, helmOpts{skipCrds: true})
+		require.NoError(t, err)
 		assert.Equal(t, true, src.Helm.SkipCrds)
 	})
 }
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -24,6 +27,22 @@ type RepoOptions struct {
 	Proxy                          string
 	GCPServiceAccountKeyPath       string
 	ForceHttpBasicAuth             bool
+	EnableSubmodules               bool
+	SubmoduleCreds                 []string
+}
+
+// GetSubmoduleCredentials parses the submodule credential mappings, which are given as URL prefix optionally followed
+// by "=" and the URL of a credential template
+func (opts *RepoOptions) GetSubmoduleCredentials() ([]appsv1.SubmoduleCredentials, error) {
+	var res []appsv1.SubmoduleCredentials
+	for _, mapping := range opts.SubmoduleCreds {
+		url, template, _ := strings.Cut(mapping, "=")
+		if url == "" {
+			return nil, fmt.Errorf("invalid submodule credentials %q: URL prefix must not be empty", mapping)
+		}
+		res = append(res, appsv1.SubmoduleCredentials{URL: url, CredentialTemplate: template})
+	}
+	return res, nil
 }
 
 func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
@@ -46,4 +65,6 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().StringVar(&opts.Proxy, "proxy", "", "use proxy to access repository")
 	command.Flags().StringVar(&opts.GCPServiceAccountKeyPath, "gcp-service-account-key-path", "", "service account key for the Google Cloud Platform")
 	command.Flags().BoolVar(&opts.ForceHttpBasicAuth, "force-http-basic-auth", false, "whether to force use of basic auth when connecting repository via HTTP")
+	command.Flags().BoolVar(&opts.EnableSubmodules, "enable-submodules", false, "whether to check out submodules of this repository (defaults to the repo server setting)")
+	command.Flags().StringArrayVar(&opts.SubmoduleCreds, "submodule-creds", []string{}, "use credential template for submodules with URL prefix, given as PREFIX[=TEMPLATE_URL] (can be repeated multiple times)")
 }
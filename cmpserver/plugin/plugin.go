@@ -21,6 +21,7 @@ import (
 	repoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/buffered_context"
 	"github.com/argoproj/argo-cd/v2/util/cmp"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/io/files"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -87,6 +88,16 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 	// Make sure the command is killed immediately on timeout. https://stackoverflow.com/a/38133948/684776
 	cmd.SysProcAttr = newSysProcAttr(true)
 
+	var cgroup *executil.Cgroup
+	if limits := executil.GetResourceLimits(); !limits.IsZero() {
+		cgroup, err = executil.NewCgroup(limits)
+		if err != nil {
+			return "", fmt.Errorf("failed to apply resource limits: %w", err)
+		}
+		defer cgroup.Close()
+		cgroup.Apply(cmd)
+	}
+
 	start := time.Now()
 	err = cmd.Start()
 	if err != nil {
@@ -101,6 +112,9 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 	}()
 
 	err = cmd.Wait()
+	if cgroup != nil {
+		err = cgroup.CheckError(cmd, err)
+	}
 
 	duration := time.Since(start)
 	output := stdout.String()
@@ -66,6 +66,8 @@ const (
 	DefaultPathTLSConfig = "/app/config/tls"
 	// DefaultPathSSHConfig is the default path where SSH known hosts are stored
 	DefaultPathSSHConfig = "/app/config/ssh"
+	// DefaultPathCredentialProvidersConfig is the default path of the file containing the repository credential providers
+	DefaultPathCredentialProvidersConfig = "/app/config/credential-providers/providers.yaml"
 	// DefaultSSHKnownHostsName is the Default name for the SSH known hosts file
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
@@ -173,6 +175,10 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+
+	// AnnotationKeyCommitStatusProvider is the type of the Git provider the sync and health state of the Application is
+	// reported to as the status of the deployed commits, one of github, gitlab, bitbucket-server and bitbucket-cloud
+	AnnotationKeyCommitStatusProvider = "argocd.argoproj.io/commit-status-provider"
 )
 
 // Environment variables for tuning and debugging Argo CD
@@ -185,6 +191,8 @@ const (
 	EnvVarSSHDataPath = "ARGOCD_SSH_DATA_PATH"
 	// EnvVarTLSDataPath overrides the location where TLS certificate for repo access data is stored
 	EnvVarTLSDataPath = "ARGOCD_TLS_DATA_PATH"
+	// EnvVarCredentialProvidersPath overrides the location of the file containing the repository credential providers
+	EnvVarCredentialProvidersPath = "ARGOCD_CREDENTIAL_PROVIDERS_PATH"
 	// EnvGitAttemptsCount specifies number of git remote operations attempts count
 	EnvGitAttemptsCount = "ARGOCD_GIT_ATTEMPTS_COUNT"
 	// EnvGitRetryMaxDuration specifices max duration of git remote operation retry
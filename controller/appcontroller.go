@@ -40,6 +40,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
+	"github.com/argoproj/argo-cd/v2/controller/feedback"
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	"github.com/argoproj/argo-cd/v2/controller/sharding"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
@@ -120,6 +121,7 @@ type ApplicationController struct {
 	clusterFilter                 func(cluster *appv1.Cluster) bool
 	projByNameCache               sync.Map
 	applicationNamespaces         []string
+	feedbackReporter              *feedback.Reporter
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -172,6 +174,7 @@ func NewApplicationController(
 	}
 	kubectl.SetOnKubectlRun(ctrl.onKubectlRun)
 	appInformer, appLister := ctrl.newApplicationInformerAndLister()
+	ctrl.feedbackReporter = feedback.NewReporter(namespace, db, settingsMgr, appLister)
 	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
 	projInformer := v1alpha1.NewAppProjectInformer(applicationClientset, namespace, appResyncPeriod, indexers)
 	projInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -481,6 +484,12 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 			}
 		}
 	}
+	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource overrides: %w", err)
+	}
+	setAggregatedHealth(nodes, resourceOverrides)
+
 	orphanedNodes := make([]appv1.ResourceNode, 0)
 	for k := range orphanedNodesMap {
 		if k.Namespace != "" && proj.IsGroupKindPermitted(k.GroupKind(), true) && !isKnownOrphanedResourceExclusion(k, proj) {
@@ -731,6 +740,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.feedbackReporter.Run(ctx)
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -1342,6 +1352,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
+	if origApp.Status.PendingSync.IsExpired(time.Now()) {
+		ctrl.expirePendingSync(origApp)
+	}
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
@@ -1469,6 +1482,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	app.Status.Sync = *compareResult.syncStatus
 	app.Status.Health = *compareResult.healthStatus
+	if previous, ok := recordHealthTransition(app, app.Status.Health.Status, now); ok && previous != nil {
+		ctrl.metricsServer.ObserveHealthStateDuration(app, previous.Status, now.Sub(previous.TransitionedAt.Time))
+		if duration, ok := getTimeToHealthy(app); ok {
+			ctrl.metricsServer.ObserveTimeToHealthy(app, duration)
+		}
+	}
 	app.Status.Resources = compareResult.resources
 	sort.Slice(app.Status.Resources, func(i, j int) bool {
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
@@ -1627,6 +1646,34 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	}
 }
 
+// expirePendingSync marks the pending sync of the application as expired. The patch is conditional on the resource
+// version of the application, so that a sync which was approved concurrently is never marked as expired.
+func (ctrl *ApplicationController) expirePendingSync(app *appv1.Application) {
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": app.ResourceVersion,
+		},
+		"status": map[string]interface{}{
+			"pendingSync": map[string]interface{}{
+				"phase": appv1.PendingSyncPhaseExpired,
+			},
+		},
+	})
+	if err != nil {
+		logCtx.Errorf("Error constructing pending sync patch: %v", err)
+		return
+	}
+	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	_, err = appClient.Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		logCtx.Warnf("Failed to expire pending sync: %v", err)
+		return
+	}
+	message := fmt.Sprintf("Sync to %s requested by %s expired without approval", app.Status.PendingSync.Revision(), app.Status.PendingSync.Requester)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonSyncApprovalExpired, Type: v1.EventTypeWarning}, message, "")
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
@@ -1638,6 +1685,12 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil
 	}
+	// syncs of projects which require approval are only performed once they are approved
+	if proj, err := ctrl.getAppProj(app); err == nil && proj.Spec.SyncApproval.IsRequired() {
+		message := fmt.Sprintf("Skipping auto-sync: project %s requires approval of syncs", proj.Name)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+	}
 	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil
@@ -1931,6 +1984,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					log.WithField("application", newApp.QualifiedName()).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
 				}
+				if oldOK && newOK && feedback.StateChanged(oldApp, newApp) {
+					ctrl.feedbackReporter.Enqueue(newApp)
+				}
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
 			},
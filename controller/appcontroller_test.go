@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -375,6 +376,29 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncSyncApproval(t *testing.T) {
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApproval: &v1alpha1.SyncApproval{Enabled: true},
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}})
+	require.NotNil(t, cond)
+	assert.Contains(t, cond.Message, "requires approval of syncs")
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
 func TestAutoSyncNotAllowEmpty(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy.Automated.Prune = true
@@ -1159,6 +1183,53 @@ func TestRefreshAppConditions(t *testing.T) {
 	})
 }
 
+func TestExpirePendingSync(t *testing.T) {
+	app := newFakeApp()
+	app.ResourceVersion = "10"
+	app.Status.PendingSync = &v1alpha1.PendingSync{
+		Operation:   v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "abc123"}},
+		Requester:   "alice",
+		RequestedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+		ExpiresAt:   metav1.NewTime(time.Now().Add(-time.Hour)),
+		Phase:       v1alpha1.PendingSyncPhasePending,
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	})
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	fakeAppCs.ReactionChain = nil
+	var expirePatch map[string]interface{}
+	fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			patch := map[string]interface{}{}
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &patch))
+			if _, ok, _ := unstructured.NestedString(patch, "status", "pendingSync", "phase"); ok {
+				expirePatch = patch
+			}
+		}
+		return true, nil, nil
+	})
+
+	ctrl.appRefreshQueue.Add(key)
+	ctrl.processAppRefreshQueueItem()
+
+	if !assert.NotNil(t, expirePatch) {
+		return
+	}
+	phase, _, _ := unstructured.NestedString(expirePatch, "status", "pendingSync", "phase")
+	assert.Equal(t, string(v1alpha1.PendingSyncPhaseExpired), phase)
+	resourceVersion, _, _ := unstructured.NestedString(expirePatch, "metadata", "resourceVersion")
+	assert.Equal(t, "10", resourceVersion)
+}
+
 func TestUpdateReconciledAt(t *testing.T) {
 	app := newFakeApp()
 	reconciledAt := metav1.NewTime(time.Now().Add(-1 * time.Second))
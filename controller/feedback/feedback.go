@@ -0,0 +1,339 @@
+package feedback
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v2/applicationset/services/scm_provider"
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	ProviderGitHub          = "github"
+	ProviderGitLab          = "gitlab"
+	ProviderBitbucketServer = "bitbucket-server"
+	ProviderBitbucketCloud  = "bitbucket-cloud"
+
+	// maxRetries is the number of times the state of an application is tried to be reported before giving up
+	maxRetries = 5
+	// reportTimeout is the maximum time to report the state of an application
+	reportTimeout = time.Minute
+)
+
+// Reporter reports the sync and health state of applications back to the Git providers of their sources, as the
+// status of the deployment of the synced commits to an environment named after the application. Only applications
+// with the commit status provider annotation are reported.
+type Reporter struct {
+	namespace   string
+	db          db.ArgoDB
+	settingsMgr *settings.SettingsManager
+	appLister   applisters.ApplicationLister
+	queue       workqueue.RateLimitingInterface
+	lock        sync.Mutex
+	// reported are the last statuses reported per application and repository, so unchanged statuses are not
+	// reported again
+	reported map[string]reportedStatus
+	// newCommitStatusService returns a client of the Git provider of the given type and API URL
+	newCommitStatusService func(ctx context.Context, providerType string, apiURL string, owner string, repo *appv1.Repository) (scm_provider.CommitStatusService, error)
+}
+
+// reportedStatus is the status reported for a revision
+type reportedStatus struct {
+	revision string
+	status   scm_provider.CommitStatus
+}
+
+// NewReporter returns a reporter of the state of the applications of the given lister
+func NewReporter(namespace string, argoDB db.ArgoDB, settingsMgr *settings.SettingsManager, appLister applisters.ApplicationLister) *Reporter {
+	return &Reporter{
+		namespace:              namespace,
+		db:                     argoDB,
+		settingsMgr:            settingsMgr,
+		appLister:              appLister,
+		queue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "app_commit_status_queue"),
+		reported:               map[string]reportedStatus{},
+		newCommitStatusService: newCommitStatusService,
+	}
+}
+
+// StateChanged returns whether the operation phase, the health or the synced revision of the application changed
+func StateChanged(old *appv1.Application, new *appv1.Application) bool {
+	return operationPhase(old) != operationPhase(new) ||
+		old.Status.Health.Status != new.Status.Health.Status ||
+		old.Status.Sync.Revision != new.Status.Sync.Revision ||
+		strings.Join(old.Status.Sync.Revisions, ",") != strings.Join(new.Status.Sync.Revisions, ",")
+}
+
+func operationPhase(app *appv1.Application) string {
+	if app.Status.OperationState == nil {
+		return ""
+	}
+	return string(app.Status.OperationState.Phase)
+}
+
+// Enqueue requests to report the state of the application, if it has the commit status provider annotation
+func (r *Reporter) Enqueue(app *appv1.Application) {
+	if app.Annotations[common.AnnotationKeyCommitStatusProvider] == "" {
+		return
+	}
+	if key, err := cache.MetaNamespaceKeyFunc(app); err == nil {
+		r.queue.Add(key)
+	}
+}
+
+// Run reports the state of the enqueued applications until the context is done
+func (r *Reporter) Run(ctx context.Context) {
+	defer r.queue.ShutDown()
+	go wait.Until(func() {
+		for r.processNextItem(ctx) {
+		}
+	}, time.Second, ctx.Done())
+	<-ctx.Done()
+}
+
+func (r *Reporter) processNextItem(ctx context.Context) bool {
+	key, shutdown := r.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer r.queue.Done(key)
+	ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+	defer cancel()
+	if err := r.report(ctx, key.(string)); err != nil {
+		logCtx := log.WithField("application", key)
+		if r.queue.NumRequeues(key) < maxRetries {
+			logCtx.Warnf("Failed to report commit status, retrying: %v", err)
+			r.queue.AddRateLimited(key)
+			return true
+		}
+		logCtx.Errorf("Failed to report commit status: %v", err)
+	}
+	r.queue.Forget(key)
+	return true
+}
+
+// report reports the state of the application to the Git providers of all its Git sources
+func (r *Reporter) report(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	app, err := r.appLister.Applications(namespace).Get(name)
+	if apierr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error getting application: %w", err)
+	}
+	providerType := app.Annotations[common.AnnotationKeyCommitStatusProvider]
+	if providerType == "" || app.Status.OperationState == nil {
+		return nil
+	}
+	status, err := r.commitStatus(app)
+	if err != nil {
+		return err
+	}
+	sources, revisions := syncedRevisions(app)
+	for i, source := range sources {
+		if source.IsHelm() || !git.IsCommitSHA(revisions[i]) {
+			continue
+		}
+		if err := r.reportSource(ctx, key, app, providerType, source, revisions[i], status); err != nil {
+			return fmt.Errorf("failed to report commit status to %s: %w", source.RepoURL, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reporter) reportSource(ctx context.Context, key string, app *appv1.Application, providerType string, source appv1.ApplicationSource, revision string, status scm_provider.CommitStatus) error {
+	reportedKey := fmt.Sprintf("%s|%s", key, source.RepoURL)
+	reported := reportedStatus{revision: revision, status: status}
+	r.lock.Lock()
+	last, ok := r.reported[reportedKey]
+	r.lock.Unlock()
+	if ok && last == reported {
+		return nil
+	}
+	host, owner, repoName, err := parseRepoURL(providerType, source.RepoURL)
+	if err != nil {
+		return err
+	}
+	// the API URL is only configurable by the administrators, since the credentials of the repository are sent to it
+	apiURLs, err := r.settingsMgr.GetCommitStatusAPIURLs()
+	if err != nil {
+		return fmt.Errorf("error getting commit status API URLs: %w", err)
+	}
+	apiURL, ok := apiURLs[host]
+	if !ok {
+		apiURL = defaultAPIURL(providerType, host)
+	}
+	repo, err := r.db.GetRepository(ctx, source.RepoURL)
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+	svc, err := r.newCommitStatusService(ctx, providerType, apiURL, owner, repo)
+	if err != nil {
+		return err
+	}
+	branch := source.TargetRevision
+	if branch == "HEAD" || git.IsTruncatedCommitSHA(branch) {
+		branch = ""
+	}
+	err = svc.SetCommitStatus(ctx, &scm_provider.Repository{
+		Organization: owner,
+		Repository:   repoName,
+		Branch:       branch,
+		SHA:          revision,
+	}, &status)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	r.reported[reportedKey] = reported
+	r.lock.Unlock()
+	return nil
+}
+
+// commitStatus returns the status of the synced commits of the application
+func (r *Reporter) commitStatus(app *appv1.Application) (scm_provider.CommitStatus, error) {
+	argoSettings, err := r.settingsMgr.GetSettings()
+	if err != nil {
+		return scm_provider.CommitStatus{}, fmt.Errorf("error getting settings: %w", err)
+	}
+	status := scm_provider.CommitStatus{Environment: app.InstanceName(r.namespace)}
+	if argoSettings.URL != "" {
+		status.TargetURL = fmt.Sprintf("%s/applications/%s/%s", strings.TrimSuffix(argoSettings.URL, "/"), app.Namespace, app.Name)
+	}
+	state := app.Status.OperationState
+	switch {
+	case !state.Phase.Completed():
+		status.State = scm_provider.CommitStatePending
+		status.Description = fmt.Sprintf("Sync %s", state.Phase)
+	case !state.Phase.Successful():
+		status.State = scm_provider.CommitStateFailure
+		if state.Phase == synccommon.OperationError {
+			status.State = scm_provider.CommitStateError
+		}
+		status.Description = fmt.Sprintf("Sync %s: %s", state.Phase, state.Message)
+	default:
+		healthStatus := app.Status.Health.Status
+		switch healthStatus {
+		case health.HealthStatusProgressing:
+			status.State = scm_provider.CommitStatePending
+		case health.HealthStatusDegraded, health.HealthStatusMissing:
+			status.State = scm_provider.CommitStateFailure
+		default:
+			status.State = scm_provider.CommitStateSuccess
+		}
+		status.Description = fmt.Sprintf("Sync %s, health %s", state.Phase, healthStatus)
+	}
+	return status, nil
+}
+
+// syncedRevisions returns the sources of the application together with the revisions of the last sync operation
+func syncedRevisions(app *appv1.Application) (appv1.ApplicationSources, []string) {
+	result := app.Status.OperationState.SyncResult
+	if app.Spec.HasMultipleSources() {
+		if result != nil && len(result.Sources) == len(result.Revisions) {
+			return result.Sources, result.Revisions
+		}
+		if len(app.Status.Sync.Revisions) == len(app.Spec.Sources) {
+			return app.Spec.Sources, app.Status.Sync.Revisions
+		}
+		return nil, nil
+	}
+	if result != nil && result.Revision != "" {
+		return appv1.ApplicationSources{result.Source}, []string{result.Revision}
+	}
+	return appv1.ApplicationSources{app.Spec.GetSource()}, []string{app.Status.Sync.Revision}
+}
+
+// parseRepoURL returns the host, owner and name of the repository with the given HTTPS or SSH URL. The owner of
+// GitLab repositories includes the groups of the repository.
+func parseRepoURL(providerType string, repoURL string) (string, string, string, error) {
+	rawURL := repoURL
+	if ok, _ := git.IsSSHURL(rawURL); ok && !strings.HasPrefix(rawURL, "ssh://") {
+		// replace the colon of git@server:owner/repo URLs, which would be interpreted as port otherwise
+		rawURL = "ssh://" + strings.Replace(rawURL, ":", "/", 1)
+	}
+	u, err := url.Parse(strings.TrimSuffix(rawURL, ".git"))
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to parse repository URL %s: %w", repoURL, err)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	// HTTP clone URLs of Bitbucket Server have a scm path prefix
+	if providerType == ProviderBitbucketServer && len(parts) > 2 && parts[0] == "scm" {
+		parts = parts[1:]
+	}
+	if len(parts) < 2 || parts[0] == "" {
+		return "", "", "", fmt.Errorf("repository URL %s has no owner and name", repoURL)
+	}
+	return u.Hostname(), strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1], nil
+}
+
+// defaultAPIURL returns the API URL of the Git provider of the given type at the given host. An empty URL is returned
+// for the public github.com, gitlab.com and bitbucket.org providers, whose clients default to their APIs.
+func defaultAPIURL(providerType string, host string) string {
+	switch {
+	case providerType == ProviderGitHub && host == "github.com",
+		providerType == ProviderGitLab && host == "gitlab.com",
+		providerType == ProviderBitbucketCloud:
+		return ""
+	}
+	return "https://" + host
+}
+
+// repoToken returns the token used to authenticate at the API of the Git provider of the repository. Credential
+// providers are only available at the repo server, so their tokens cannot be used.
+func repoToken(repo *appv1.Repository) (string, error) {
+	if repo.Password == "" && repo.CredentialProvider != "" {
+		return "", fmt.Errorf("credential provider %q of repository %s cannot be used to report commit statuses", repo.CredentialProvider, repo.Repo)
+	}
+	return repo.Password, nil
+}
+
+func newCommitStatusService(ctx context.Context, providerType string, apiURL string, owner string, repo *appv1.Repository) (scm_provider.CommitStatusService, error) {
+	if providerType == ProviderGitHub && repo.GithubAppId > 0 {
+		return scm_provider.NewGithubAppProviderFor(github_app_auth.Authentication{
+			Id:                repo.GithubAppId,
+			InstallationId:    repo.GithubAppInstallationId,
+			EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+			PrivateKey:        repo.GithubAppPrivateKey,
+		}, owner, apiURL, false)
+	}
+	token, err := repoToken(repo)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, fmt.Errorf("repository %s has no token or GitHub App credentials", repo.Repo)
+	}
+	switch providerType {
+	case ProviderGitHub:
+		return scm_provider.NewGithubProvider(ctx, owner, token, apiURL, false)
+	case ProviderGitLab:
+		return scm_provider.NewGitlabProvider(ctx, owner, token, apiURL, false, false)
+	case ProviderBitbucketServer:
+		return scm_provider.NewBitbucketServerProviderBasicAuth(ctx, repo.Username, token, apiURL, owner, false)
+	case ProviderBitbucketCloud:
+		return scm_provider.NewBitBucketCloudProvider(ctx, owner, repo.Username, token, false)
+	}
+	return nil, fmt.Errorf("unknown commit status provider %s", providerType)
+}
@@ -0,0 +1,217 @@
+package feedback
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/services/scm_provider"
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testSHA = "a5ee6e8bb1b5fbcc31d0ac66b0e6ec1b6e4a1234"
+
+type fakeCommitStatusService struct {
+	repos    []scm_provider.Repository
+	statuses []scm_provider.CommitStatus
+	err      error
+}
+
+func (s *fakeCommitStatusService) SetCommitStatus(_ context.Context, repo *scm_provider.Repository, status *scm_provider.CommitStatus) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.repos = append(s.repos, *repo)
+	s.statuses = append(s.statuses, *status)
+	return nil
+}
+
+func newApp(phase synccommon.OperationPhase, healthStatus health.HealthStatusCode) *appv1.Application {
+	source := appv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook", TargetRevision: "main"}
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "guestbook",
+			Namespace:   test.FakeArgoCDNamespace,
+			Annotations: map[string]string{common.AnnotationKeyCommitStatusProvider: ProviderGitHub},
+		},
+		Spec: appv1.ApplicationSpec{
+			Source: &source,
+		},
+		Status: appv1.ApplicationStatus{
+			Health: appv1.HealthStatus{Status: healthStatus},
+			OperationState: &appv1.OperationState{
+				Phase:      phase,
+				Message:    "one or more objects failed to apply",
+				SyncResult: &appv1.SyncOperationResult{Revision: testSHA, Source: source},
+			},
+		},
+	}
+}
+
+func newTestReporter(t *testing.T, svc *fakeCommitStatusService, apps ...*appv1.Application) *Reporter {
+	cm := test.NewFakeConfigMap()
+	cm.Data["url"] = "https://argocd.example.com"
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(cm, test.NewFakeSecret()), test.FakeArgoCDNamespace)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		require.NoError(t, indexer.Add(app))
+	}
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetRepository", mock.Anything, mock.Anything).Return(&appv1.Repository{Password: "token"}, nil)
+	reporter := NewReporter(test.FakeArgoCDNamespace, argoDB, settingsMgr, applisters.NewApplicationLister(indexer))
+	reporter.newCommitStatusService = func(_ context.Context, providerType string, apiURL string, owner string, repo *appv1.Repository) (scm_provider.CommitStatusService, error) {
+		assert.Equal(t, ProviderGitHub, providerType)
+		assert.Equal(t, "", apiURL)
+		assert.Equal(t, "argoproj", owner)
+		return svc, nil
+	}
+	return reporter
+}
+
+func TestReport(t *testing.T) {
+	svc := &fakeCommitStatusService{}
+	app := newApp(synccommon.OperationSucceeded, health.HealthStatusHealthy)
+	reporter := newTestReporter(t, svc, app)
+
+	require.NoError(t, reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook"))
+	require.Len(t, svc.statuses, 1)
+	assert.Equal(t, scm_provider.Repository{Organization: "argoproj", Repository: "argocd-example-apps", Branch: "main", SHA: testSHA}, svc.repos[0])
+	assert.Equal(t, scm_provider.CommitStatus{
+		Environment: "guestbook",
+		State:       scm_provider.CommitStateSuccess,
+		Description: "Sync Succeeded, health Healthy",
+		TargetURL:   "https://argocd.example.com/applications/" + test.FakeArgoCDNamespace + "/guestbook",
+	}, svc.statuses[0])
+
+	t.Run("UnchangedStatusIsNotReportedAgain", func(t *testing.T) {
+		require.NoError(t, reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook"))
+		assert.Len(t, svc.statuses, 1)
+	})
+
+	t.Run("NotAnnotated", func(t *testing.T) {
+		svc := &fakeCommitStatusService{}
+		app := newApp(synccommon.OperationSucceeded, health.HealthStatusHealthy)
+		app.Annotations = nil
+		reporter := newTestReporter(t, svc, app)
+		require.NoError(t, reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook"))
+		assert.Empty(t, svc.statuses)
+	})
+
+	t.Run("HelmSourceIsSkipped", func(t *testing.T) {
+		svc := &fakeCommitStatusService{}
+		app := newApp(synccommon.OperationSucceeded, health.HealthStatusHealthy)
+		app.Spec.Source = &appv1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.0.0"}
+		app.Status.OperationState.SyncResult.Source = *app.Spec.Source
+		reporter := newTestReporter(t, svc, app)
+		require.NoError(t, reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook"))
+		assert.Empty(t, svc.statuses)
+	})
+
+	t.Run("ConfiguredAPIURL", func(t *testing.T) {
+		svc := &fakeCommitStatusService{}
+		reporter := newTestReporter(t, svc, newApp(synccommon.OperationSucceeded, health.HealthStatusHealthy))
+		cm := test.NewFakeConfigMap()
+		cm.Data["commitStatus.apiURLs"] = "github.com: https://github-api.example.com\ngitlab.example.com: https://gitlab-api.example.com"
+		reporter.settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(cm, test.NewFakeSecret()), test.FakeArgoCDNamespace)
+		var apiURL string
+		reporter.newCommitStatusService = func(_ context.Context, _ string, url string, _ string, _ *appv1.Repository) (scm_provider.CommitStatusService, error) {
+			apiURL = url
+			return svc, nil
+		}
+		require.NoError(t, reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook"))
+		assert.Equal(t, "https://github-api.example.com", apiURL)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		svc := &fakeCommitStatusService{err: errors.New("forbidden")}
+		reporter := newTestReporter(t, svc, newApp(synccommon.OperationSucceeded, health.HealthStatusHealthy))
+		err := reporter.report(context.Background(), test.FakeArgoCDNamespace+"/guestbook")
+		assert.ErrorContains(t, err, "forbidden")
+	})
+}
+
+func TestCommitStatus(t *testing.T) {
+	reporter := newTestReporter(t, &fakeCommitStatusService{})
+	for _, tc := range []struct {
+		phase       synccommon.OperationPhase
+		health      health.HealthStatusCode
+		state       scm_provider.CommitState
+		description string
+	}{
+		{synccommon.OperationRunning, health.HealthStatusHealthy, scm_provider.CommitStatePending, "Sync Running"},
+		{synccommon.OperationFailed, health.HealthStatusHealthy, scm_provider.CommitStateFailure, "Sync Failed: one or more objects failed to apply"},
+		{synccommon.OperationError, health.HealthStatusHealthy, scm_provider.CommitStateError, "Sync Error: one or more objects failed to apply"},
+		{synccommon.OperationSucceeded, health.HealthStatusProgressing, scm_provider.CommitStatePending, "Sync Succeeded, health Progressing"},
+		{synccommon.OperationSucceeded, health.HealthStatusDegraded, scm_provider.CommitStateFailure, "Sync Succeeded, health Degraded"},
+		{synccommon.OperationSucceeded, health.HealthStatusSuspended, scm_provider.CommitStateSuccess, "Sync Succeeded, health Suspended"},
+	} {
+		t.Run(string(tc.phase)+string(tc.health), func(t *testing.T) {
+			status, err := reporter.commitStatus(newApp(tc.phase, tc.health))
+			require.NoError(t, err)
+			assert.Equal(t, tc.state, status.State)
+			assert.Equal(t, tc.description, status.Description)
+		})
+	}
+}
+
+func TestStateChanged(t *testing.T) {
+	app := newApp(synccommon.OperationRunning, health.HealthStatusHealthy)
+	assert.False(t, StateChanged(app, app.DeepCopy()))
+
+	completed := app.DeepCopy()
+	completed.Status.OperationState.Phase = synccommon.OperationSucceeded
+	assert.True(t, StateChanged(app, completed))
+
+	degraded := app.DeepCopy()
+	degraded.Status.Health.Status = health.HealthStatusDegraded
+	assert.True(t, StateChanged(app, degraded))
+}
+
+func TestParseRepoURL(t *testing.T) {
+	for _, tc := range []struct {
+		providerType string
+		repoURL      string
+		host         string
+		owner        string
+		name         string
+	}{
+		{ProviderGitHub, "https://github.com/argoproj/argo-cd.git", "github.com", "argoproj", "argo-cd"},
+		{ProviderGitHub, "git@github.com:argoproj/argo-cd.git", "github.com", "argoproj", "argo-cd"},
+		{ProviderGitLab, "https://gitlab.example.com/group/subgroup/project", "gitlab.example.com", "group/subgroup", "project"},
+		{ProviderGitLab, "ssh://git@gitlab.example.com:2222/group/project.git", "gitlab.example.com", "group", "project"},
+		{ProviderBitbucketServer, "https://bitbucket.example.com/scm/PROJ/repo.git", "bitbucket.example.com", "PROJ", "repo"},
+		{ProviderBitbucketCloud, "https://user@bitbucket.org/workspace/repo.git", "bitbucket.org", "workspace", "repo"},
+	} {
+		t.Run(tc.repoURL, func(t *testing.T) {
+			host, owner, name, err := parseRepoURL(tc.providerType, tc.repoURL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.host, host)
+			assert.Equal(t, tc.owner, owner)
+			assert.Equal(t, tc.name, name)
+		})
+	}
+
+	_, _, _, err := parseRepoURL(ProviderGitHub, "https://github.com/argoproj")
+	assert.Error(t, err)
+}
+
+func TestDefaultAPIURL(t *testing.T) {
+	assert.Equal(t, "", defaultAPIURL(ProviderGitHub, "github.com"))
+	assert.Equal(t, "https://github.example.com", defaultAPIURL(ProviderGitHub, "github.example.com"))
+	assert.Equal(t, "", defaultAPIURL(ProviderGitLab, "gitlab.com"))
+	assert.Equal(t, "https://bitbucket.example.com", defaultAPIURL(ProviderBitbucketServer, "bitbucket.example.com"))
+}
@@ -2,24 +2,48 @@ package controller
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
-// setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (*appv1.HealthStatus, error) {
+// hierarchyIterator executes the callback against the resource specified by the key and all its children
+type hierarchyIterator func(key kubeutil.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error
+
+// setApplicationHealth updates the health statuses of all resources performed in the comparison. The children of
+// resources with aggregated health are iterated with iterateHierarchy, if set.
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool, iterateHierarchy hierarchyIterator) (*appv1.HealthStatus, error) {
 	var savedErr error
 	var errCount uint
 	appHealth := appv1.HealthStatus{Status: health.HealthStatusHealthy}
+	healthOverrides := aggregatedHealthOverride{overrides: resourceOverrides, iterateHierarchy: iterateHierarchy}
+	policy := app.Spec.HealthPolicy
+	progressingExpired, err := isProgressingGracePeriodExpired(app, time.Now())
+	if err != nil {
+		errCount++
+		savedErr = err
+	}
+	var requiredFound []bool
+	var progressingMessage string
+	// whether a resource is Degraded by itself rather than by the expired progressing grace period
+	resourceDegraded := false
+	if policy != nil {
+		requiredFound = make([]bool, len(policy.Require))
+	}
 	for i, res := range resources {
 		if res.Target != nil && hookutil.Skip(res.Target) {
 			continue
@@ -31,7 +55,6 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 
 		var healthStatus *health.HealthStatus
 		var err error
-		healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 		gvk := schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind}
 		if res.Live == nil {
 			healthStatus = &health.HealthStatus{Status: health.HealthStatusMissing}
@@ -49,31 +72,69 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			}
 		}
 
-		if healthStatus == nil {
-			continue
+		ignored, required := false, false
+		if policy != nil {
+			ignored = matchesHealthPolicySelectors(policy.Ignore, res)
+			for j, selector := range policy.Require {
+				if !ignored && selector.Matches(res.Group, res.Kind, res.Namespace, res.Name) {
+					requiredFound[j] = true
+					required = true
+				}
+			}
 		}
 
-		if persistResourceHealth {
+		if healthStatus == nil {
+			if !required {
+				continue
+			}
+			// existing required resources without health check are Healthy
+			healthStatus = &health.HealthStatus{Status: health.HealthStatusHealthy}
+		} else if persistResourceHealth {
 			resHealth := appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
 			statuses[i].Health = &resHealth
 		} else {
 			statuses[i].Health = nil
 		}
 
+		if ignored {
+			continue
+		}
+
 		// Is health status is missing but resource has not built-in/custom health check then it should not affect parent app health
-		if _, hasOverride := healthOverrides[lua.GetConfigMapKey(gvk)]; healthStatus.Status == health.HealthStatusMissing && !hasOverride && health.GetHealthCheckFunc(gvk) == nil {
+		if _, hasOverride := resourceOverrides[lua.GetConfigMapKey(gvk)]; !required && healthStatus.Status == health.HealthStatusMissing && !hasOverride && health.GetHealthCheckFunc(gvk) == nil {
 			continue
 		}
 
 		// Missing or Unknown health status of child Argo CD app should not affect parent
-		if res.Kind == application.ApplicationKind && res.Group == application.Group && (healthStatus.Status == health.HealthStatusMissing || healthStatus.Status == health.HealthStatusUnknown) {
+		if !required && res.Kind == application.ApplicationKind && res.Group == application.Group && (healthStatus.Status == health.HealthStatusMissing || healthStatus.Status == health.HealthStatusUnknown) {
 			continue
 		}
 
-		if health.IsWorse(appHealth.Status, healthStatus.Status) {
-			appHealth.Status = healthStatus.Status
+		status := healthStatus.Status
+		if status == health.HealthStatusProgressing && progressingExpired {
+			status = health.HealthStatusDegraded
+			if progressingMessage == "" {
+				progressingMessage = fmt.Sprintf("%s %s is still Progressing after the grace period of %s", res.Kind, res.Name, policy.ProgressingGracePeriod)
+			}
+		} else if status == health.HealthStatusDegraded {
+			resourceDegraded = true
+		}
+		if health.IsWorse(appHealth.Status, status) {
+			appHealth.Status = status
+		}
+	}
+	var missingRequired []string
+	for j, found := range requiredFound {
+		if !found {
+			missingRequired = append(missingRequired, formatHealthPolicySelector(policy.Require[j]))
 		}
 	}
+	if len(missingRequired) > 0 && !health.IsWorse(health.HealthStatusMissing, appHealth.Status) {
+		appHealth.Status = health.HealthStatusMissing
+		appHealth.Message = fmt.Sprintf("required resources not found: %s", strings.Join(missingRequired, ", "))
+	} else if appHealth.Status == health.HealthStatusDegraded && !resourceDegraded {
+		appHealth.Message = progressingMessage
+	}
 	if persistResourceHealth {
 		app.Status.ResourceHealthSource = appv1.ResourceHealthLocationInline
 	} else {
@@ -84,3 +145,197 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	}
 	return &appHealth, savedErr
 }
+
+// matchesHealthPolicySelectors returns whether any of the selectors of an application health policy match the resource
+func matchesHealthPolicySelectors(selectors []appv1.HealthPolicyResourceSelector, res managedResource) bool {
+	for _, selector := range selectors {
+		if selector.Matches(res.Group, res.Kind, res.Namespace, res.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatHealthPolicySelector returns the selector in the form group/kind/namespace/name, with * for empty fields
+func formatHealthPolicySelector(selector appv1.HealthPolicyResourceSelector) string {
+	fields := []string{selector.Group, selector.Kind, selector.Namespace, selector.Name}
+	for i := range fields {
+		if fields[i] == "" {
+			fields[i] = "*"
+		}
+	}
+	return strings.Join(fields, "/")
+}
+
+// isProgressingGracePeriodExpired returns whether the progressing grace period of the application health policy has
+// passed since the last sync started, or since the application was created if it was never synced
+func isProgressingGracePeriodExpired(app *appv1.Application, now time.Time) (bool, error) {
+	gracePeriod, ok, err := app.Spec.HealthPolicy.GetProgressingGracePeriod()
+	if err != nil || !ok {
+		return false, err
+	}
+	since := app.CreationTimestamp.Time
+	if app.Status.OperationState != nil {
+		since = app.Status.OperationState.StartedAt.Time
+	}
+	return now.After(since.Add(gracePeriod)), nil
+}
+
+// aggregatedHealthOverride assesses the health of resources with the resource overrides. Resources with aggregated
+// health, which have no health check of their own, get the worst health of their children.
+type aggregatedHealthOverride struct {
+	overrides        map[string]appv1.ResourceOverride
+	iterateHierarchy hierarchyIterator
+}
+
+func (o aggregatedHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	healthStatus, err := lua.ResourceHealthOverrides(o.overrides).GetResourceHealth(obj)
+	if err != nil || healthStatus != nil || o.iterateHierarchy == nil {
+		return healthStatus, err
+	}
+	aggregatedHealth := getAggregatedHealth(o.overrides, obj.GroupVersionKind())
+	if aggregatedHealth == nil {
+		return nil, nil
+	}
+	var nodes []appv1.ResourceNode
+	err = o.iterateHierarchy(kubeutil.GetResourceKey(obj), func(child appv1.ResourceNode, _ string) bool {
+		nodes = append(nodes, child)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error iterating children: %w", err)
+	}
+	setAggregatedHealth(nodes, o.overrides)
+	return aggregateChildrenHealth(string(obj.GetUID()), nodes, aggregatedHealth.ChildKinds), nil
+}
+
+// getAggregatedHealth returns the aggregated health override of the resource kind, or nil if the resource kind has a
+// health check of its own or no aggregated health
+func getAggregatedHealth(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) *appv1.OverrideAggregatedHealth {
+	if health.GetHealthCheckFunc(gvk) != nil {
+		return nil
+	}
+	if override, ok := overrides[lua.GetConfigMapKey(gvk)]; ok && override.AggregatedHealth != nil {
+		return override.AggregatedHealth
+	}
+	if wildcardKey := lua.GetWildcardConfigMapKey(lua.VM{ResourceOverrides: overrides}, gvk); wildcardKey != "" {
+		return overrides[wildcardKey].AggregatedHealth
+	}
+	return nil
+}
+
+// aggregateChildrenHealth returns the worst health of the descendants of the node with the given UID, considering only
+// descendants of the given kinds if any. Returns nil if none of them has a health.
+func aggregateChildrenHealth(uid string, nodes []appv1.ResourceNode, childKinds []string) *health.HealthStatus {
+	childrenByParent := make(map[string][]int)
+	for i, node := range nodes {
+		for _, parentRef := range node.ParentRefs {
+			childrenByParent[parentRef.UID] = append(childrenByParent[parentRef.UID], i)
+		}
+	}
+
+	var worst *appv1.ResourceNode
+	visited := map[string]bool{uid: true}
+	queue := []string{uid}
+	for len(queue) > 0 {
+		parentUID := queue[0]
+		queue = queue[1:]
+		for _, i := range childrenByParent[parentUID] {
+			child := &nodes[i]
+			if visited[child.UID] {
+				continue
+			}
+			visited[child.UID] = true
+			queue = append(queue, child.UID)
+			if child.Health == nil || !matchesChildKinds(child.ResourceRef, childKinds) {
+				continue
+			}
+			if worst == nil || health.IsWorse(worst.Health.Status, child.Health.Status) {
+				worst = child
+			}
+		}
+	}
+	if worst == nil {
+		return nil
+	}
+	message := fmt.Sprintf("%s %s is %s", worst.Kind, worst.Name, worst.Health.Status)
+	if worst.Health.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, worst.Health.Message)
+	}
+	return &health.HealthStatus{Status: worst.Health.Status, Message: message}
+}
+
+func matchesChildKinds(ref appv1.ResourceRef, childKinds []string) bool {
+	if len(childKinds) == 0 {
+		return true
+	}
+	key := lua.GetConfigMapKey(schema.GroupVersionKind{Group: ref.Group, Kind: ref.Kind})
+	for _, childKind := range childKinds {
+		if glob.Match(childKind, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// setAggregatedHealth sets the health of the nodes with aggregated health, which have no health of their own, to the
+// worst health of their descendants. Children must follow their parents in the nodes, like they do when iterating the
+// resource hierarchy, so the aggregated health of nested resources is set before the one of their parents.
+func setAggregatedHealth(nodes []appv1.ResourceNode, resourceOverrides map[string]appv1.ResourceOverride) {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := &nodes[i]
+		if node.Health != nil {
+			continue
+		}
+		aggregatedHealth := getAggregatedHealth(resourceOverrides, schema.GroupVersionKind{Group: node.Group, Version: node.Version, Kind: node.Kind})
+		if aggregatedHealth == nil {
+			continue
+		}
+		if healthStatus := aggregateChildrenHealth(node.UID, nodes, aggregatedHealth.ChildKinds); healthStatus != nil {
+			node.Health = &appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
+		}
+	}
+}
+
+// recordHealthTransition appends a transition to the health history of the application if its health status changed.
+// Returns the transition it replaces as the latest one, if any, and whether a transition was recorded.
+func recordHealthTransition(app *appv1.Application, status health.HealthStatusCode, now metav1.Time) (*appv1.HealthTransition, bool) {
+	history := app.Status.HealthHistory
+	var previous *appv1.HealthTransition
+	if len(history) > 0 {
+		last := history.LastTransition()
+		if last.Status == status {
+			return nil, false
+		}
+		previous = &last
+	}
+	transitions := make(appv1.HealthHistory, 0, len(history)+1)
+	transitions = append(transitions, history...)
+	transitions = append(transitions, appv1.HealthTransition{Status: status, TransitionedAt: now})
+	app.Status.HealthHistory = transitions.Trunc(appv1.HealthHistoryLimit)
+	return previous, true
+}
+
+// getTimeToHealthy returns the time from the start of the last sync of the application until it became healthy at the
+// latest transition of its health history. It returns false if the application was already healthy after the sync
+// started, or if the sync did not succeed.
+func getTimeToHealthy(app *appv1.Application) (time.Duration, bool) {
+	history := app.Status.HealthHistory
+	op := app.Status.OperationState
+	if len(history) == 0 || history.LastTransition().Status != health.HealthStatusHealthy || op == nil || op.Phase != synccommon.OperationSucceeded {
+		return 0, false
+	}
+	healthyAt := history.LastTransition().TransitionedAt
+	for i := len(history) - 2; i >= 0; i-- {
+		if history[i].Status == health.HealthStatusHealthy {
+			if op.StartedAt.Before(&history[i].TransitionedAt) {
+				return 0, false
+			}
+			break
+		}
+	}
+	if healthyAt.Before(&op.StartedAt) {
+		return 0, false
+	}
+	return healthyAt.Sub(op.StartedAt.Time), true
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -51,7 +52,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 
@@ -60,7 +61,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 }
@@ -73,7 +74,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 
@@ -87,7 +88,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 		Group: "", Version: "v1", Kind: "Pod", Target: &pod}, {}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 }
@@ -100,7 +101,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 	resourceStatuses := initStatuses(resources)
 
 	t.Run("NoOverride", func(t *testing.T) {
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 		assert.Equal(t, resourceStatuses[0].Health.Status, health.HealthStatusMissing)
@@ -111,7 +112,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 			lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}): appv1.ResourceOverride{
 				HealthLua: "some health check",
 			},
-		}, app, true)
+		}, app, true, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 	})
@@ -161,7 +162,7 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 	})
@@ -172,8 +173,298 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 	})
 }
+
+func newAggregatedHealthNodes() []appv1.ResourceNode {
+	return []appv1.ResourceNode{{
+		ResourceRef: appv1.ResourceRef{Group: "example.com", Version: "v1", Kind: "Database", Name: "db", UID: "1"},
+	}, {
+		ResourceRef: appv1.ResourceRef{Group: "apps", Version: "v1", Kind: "StatefulSet", Name: "db", UID: "2"},
+		ParentRefs:  []appv1.ResourceRef{{UID: "1"}},
+		Health:      &appv1.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for 1 pods to be ready"},
+	}, {
+		ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Pod", Name: "db-0", UID: "3"},
+		ParentRefs:  []appv1.ResourceRef{{UID: "2"}},
+		Health:      &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "back-off restarting failed container"},
+	}, {
+		ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Service", Name: "db", UID: "4"},
+		ParentRefs:  []appv1.ResourceRef{{UID: "1"}},
+		Health:      &appv1.HealthStatus{Status: health.HealthStatusHealthy},
+	}}
+}
+
+func TestAggregateChildrenHealth(t *testing.T) {
+	nodes := newAggregatedHealthNodes()
+
+	healthStatus := aggregateChildrenHealth("1", nodes, nil)
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Pod db-0 is Degraded: back-off restarting failed container"}, healthStatus)
+
+	healthStatus = aggregateChildrenHealth("1", nodes, []string{"apps/*", "Service"})
+	assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "StatefulSet db is Progressing: Waiting for 1 pods to be ready"}, healthStatus)
+
+	assert.Nil(t, aggregateChildrenHealth("1", nodes, []string{"ConfigMap"}))
+	assert.Nil(t, aggregateChildrenHealth("3", nodes, nil))
+}
+
+func TestSetAggregatedHealth(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{
+		"example.com/Database": {AggregatedHealth: &appv1.OverrideAggregatedHealth{}},
+		"apps/StatefulSet":     {AggregatedHealth: &appv1.OverrideAggregatedHealth{}},
+	}
+
+	t.Run("Aggregated", func(t *testing.T) {
+		nodes := newAggregatedHealthNodes()
+		setAggregatedHealth(nodes, overrides)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Pod db-0 is Degraded: back-off restarting failed container"}, nodes[0].Health)
+		// resources with a health of their own keep it
+		assert.Equal(t, health.HealthStatusProgressing, nodes[1].Health.Status)
+	})
+
+	t.Run("NotOptedIn", func(t *testing.T) {
+		nodes := newAggregatedHealthNodes()
+		setAggregatedHealth(nodes, nil)
+		assert.Nil(t, nodes[0].Health)
+	})
+
+	t.Run("NestedAggregatedHealth", func(t *testing.T) {
+		nodes := newAggregatedHealthNodes()
+		// the StatefulSet is replaced by a custom resource without health of its own
+		nodes[1].Group = "example.com"
+		nodes[1].Kind = "Cluster"
+		nodes[1].Health = nil
+		setAggregatedHealth(nodes, map[string]appv1.ResourceOverride{
+			"example.com/*": {AggregatedHealth: &appv1.OverrideAggregatedHealth{ChildKinds: []string{"example.com/*", "Pod"}}},
+		})
+		assert.Equal(t, health.HealthStatusDegraded, nodes[1].Health.Status)
+		assert.Equal(t, "Cluster db is Degraded: Pod db-0 is Degraded: back-off restarting failed container", nodes[0].Health.Message)
+	})
+}
+
+func TestSetApplicationHealthAggregated(t *testing.T) {
+	database := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Database",
+		"metadata":   map[string]interface{}{"name": "db", "namespace": "default", "uid": "1"},
+	}}
+	resources := []managedResource{{Group: "example.com", Version: "v1", Kind: "Database", Live: database}}
+	iterateHierarchy := func(key kube.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error {
+		assert.Equal(t, kube.GetResourceKey(database), key)
+		for _, node := range newAggregatedHealthNodes() {
+			action(node, "")
+		}
+		return nil
+	}
+
+	t.Run("Aggregated", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		overrides := map[string]appv1.ResourceOverride{
+			"example.com/Database": {AggregatedHealth: &appv1.OverrideAggregatedHealth{ChildKinds: []string{"Pod"}}},
+		}
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, iterateHierarchy)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Pod db-0 is Degraded: back-off restarting failed container"}, resourceStatuses[0].Health)
+	})
+
+	t.Run("HealthCheckTakesPrecedence", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		overrides := map[string]appv1.ResourceOverride{
+			"example.com/Database": {HealthCEL: `{"status": "Healthy"}`, AggregatedHealth: &appv1.OverrideAggregatedHealth{}},
+		}
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, iterateHierarchy)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+	})
+
+	t.Run("NotOptedIn", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, nil, app, true, iterateHierarchy)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Nil(t, resourceStatuses[0].Health)
+	})
+}
+
+func TestRecordHealthTransition(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	app := &appv1.Application{}
+
+	previous, ok := recordHealthTransition(app, health.HealthStatusProgressing, start)
+	assert.True(t, ok)
+	assert.Nil(t, previous)
+
+	_, ok = recordHealthTransition(app, health.HealthStatusProgressing, metav1.NewTime(start.Add(time.Minute)))
+	assert.False(t, ok)
+	assert.Len(t, app.Status.HealthHistory, 1)
+
+	previous, ok = recordHealthTransition(app, health.HealthStatusHealthy, metav1.NewTime(start.Add(2*time.Minute)))
+	assert.True(t, ok)
+	assert.Equal(t, health.HealthStatusProgressing, previous.Status)
+	assert.Equal(t, start, previous.TransitionedAt)
+	assert.Equal(t, health.HealthStatusHealthy, app.Status.HealthHistory.LastTransition().Status)
+
+	t.Run("Truncated", func(t *testing.T) {
+		app := &appv1.Application{}
+		for i := 0; i < appv1.HealthHistoryLimit+5; i++ {
+			status := health.HealthStatusHealthy
+			if i%2 == 0 {
+				status = health.HealthStatusDegraded
+			}
+			recordHealthTransition(app, status, metav1.NewTime(start.Add(time.Duration(i)*time.Minute)))
+		}
+		assert.Len(t, app.Status.HealthHistory, appv1.HealthHistoryLimit)
+		assert.Equal(t, metav1.NewTime(start.Add(5*time.Minute)), app.Status.HealthHistory[0].TransitionedAt)
+	})
+}
+
+func TestGetTimeToHealthy(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) metav1.Time {
+		return metav1.NewTime(start.Add(time.Duration(minutes) * time.Minute))
+	}
+	newApp := func(syncedAt metav1.Time, phase synccommon.OperationPhase, history ...appv1.HealthTransition) *appv1.Application {
+		return &appv1.Application{Status: appv1.ApplicationStatus{
+			OperationState: &appv1.OperationState{Phase: phase, StartedAt: syncedAt},
+			HealthHistory:  history,
+		}}
+	}
+
+	duration, ok := getTimeToHealthy(newApp(at(10), synccommon.OperationSucceeded,
+		appv1.HealthTransition{Status: health.HealthStatusHealthy, TransitionedAt: at(0)},
+		appv1.HealthTransition{Status: health.HealthStatusProgressing, TransitionedAt: at(11)},
+		appv1.HealthTransition{Status: health.HealthStatusHealthy, TransitionedAt: at(13)},
+	))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Minute, duration)
+
+	t.Run("HealthyAfterSync", func(t *testing.T) {
+		_, ok := getTimeToHealthy(newApp(at(10), synccommon.OperationSucceeded,
+			appv1.HealthTransition{Status: health.HealthStatusHealthy, TransitionedAt: at(11)},
+			appv1.HealthTransition{Status: health.HealthStatusDegraded, TransitionedAt: at(12)},
+			appv1.HealthTransition{Status: health.HealthStatusHealthy, TransitionedAt: at(13)},
+		))
+		assert.False(t, ok)
+	})
+
+	t.Run("SyncFailed", func(t *testing.T) {
+		_, ok := getTimeToHealthy(newApp(at(10), synccommon.OperationFailed,
+			appv1.HealthTransition{Status: health.HealthStatusHealthy, TransitionedAt: at(13)},
+		))
+		assert.False(t, ok)
+	})
+
+	t.Run("NotHealthy", func(t *testing.T) {
+		_, ok := getTimeToHealthy(newApp(at(10), synccommon.OperationSucceeded,
+			appv1.HealthTransition{Status: health.HealthStatusDegraded, TransitionedAt: at(13)},
+		))
+		assert.False(t, ok)
+	})
+}
+
+func TestSetApplicationHealthPolicy(t *testing.T) {
+	failedJob := resourceFromFile("./testdata/job-failed.yaml")
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	pendingPod := runningPod.DeepCopy()
+	pendingPod.SetName("pending")
+	_ = unstructured.SetNestedField(pendingPod.Object, "Pending", "status", "phase")
+
+	resources := []managedResource{{
+		Group: "", Version: "v1", Kind: "Pod", Name: runningPod.GetName(), Live: &runningPod}, {
+		Group: "batch", Version: "v1", Kind: "Job", Name: failedJob.GetName(), Live: &failedJob,
+	}}
+
+	t.Run("IgnoredResource", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{
+			Ignore: []appv1.HealthPolicyResourceSelector{{Group: "batch", Kind: "Job"}},
+		}}}
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		// the health of ignored resources is still assessed
+		assert.Equal(t, health.HealthStatusDegraded, resourceStatuses[1].Health.Status)
+	})
+
+	t.Run("RequiredResourceMissing", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{
+			Ignore:  []appv1.HealthPolicyResourceSelector{{Kind: "Job"}},
+			Require: []appv1.HealthPolicyResourceSelector{{Kind: "Pod"}, {Kind: "Service", Name: "guestbook-*"}},
+		}}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
+		assert.Equal(t, "required resources not found: */Service/*/guestbook-*", healthStatus.Message)
+	})
+
+	t.Run("RequiredResourceWithoutHealthCheck", func(t *testing.T) {
+		resources := []managedResource{{Group: "example.com", Version: "v1", Kind: "Foo", Name: "foo"}}
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{
+			Require: []appv1.HealthPolicyResourceSelector{{Group: "example.com", Kind: "Foo"}},
+		}}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
+	})
+
+	t.Run("ExistingRequiredResourceWithoutHealthCheck", func(t *testing.T) {
+		foo := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "example.com/v1", "kind": "Foo", "metadata": map[string]interface{}{"name": "foo"}}}
+		resources := []managedResource{{Group: "example.com", Version: "v1", Kind: "Foo", Name: "foo", Live: foo}}
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{
+			Require: []appv1.HealthPolicyResourceSelector{{Group: "example.com", Kind: "Foo"}},
+		}}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Empty(t, healthStatus.Message)
+	})
+
+	resources = append(resources, managedResource{Group: "", Version: "v1", Kind: "Pod", Name: pendingPod.GetName(), Live: pendingPod})
+	policy := &appv1.ApplicationHealthPolicy{
+		Ignore:                 []appv1.HealthPolicyResourceSelector{{Kind: "Job"}},
+		ProgressingGracePeriod: "10m",
+	}
+
+	t.Run("WithinProgressingGracePeriod", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: policy}, Status: appv1.ApplicationStatus{
+			OperationState: &appv1.OperationState{StartedAt: metav1.NewTime(time.Now().Add(-time.Minute))},
+		}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("ProgressingGracePeriodExpired", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: policy}, Status: appv1.ApplicationStatus{
+			OperationState: &appv1.OperationState{StartedAt: metav1.NewTime(time.Now().Add(-time.Hour))},
+		}}
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, "Pod pending is still Progressing after the grace period of 10m", healthStatus.Message)
+		// the resource itself is still Progressing
+		assert.Equal(t, health.HealthStatusProgressing, resourceStatuses[2].Health.Status)
+	})
+
+	t.Run("ProgressingGracePeriodExpiredWithDegradedResource", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{ProgressingGracePeriod: "10m"}}, Status: appv1.ApplicationStatus{
+			OperationState: &appv1.OperationState{StartedAt: metav1.NewTime(time.Now().Add(-time.Hour))},
+		}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		// the failed job degrades the application regardless of the grace period
+		assert.Empty(t, healthStatus.Message)
+	})
+
+	t.Run("InvalidProgressingGracePeriod", func(t *testing.T) {
+		app := &appv1.Application{Spec: appv1.ApplicationSpec{HealthPolicy: &appv1.ApplicationHealthPolicy{ProgressingGracePeriod: "soon"}}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), nil, app, true, nil)
+		assert.ErrorContains(t, err, `invalid progressing grace period "soon"`)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+}
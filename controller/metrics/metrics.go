@@ -33,6 +33,8 @@ type MetricsServer struct {
 	clusterEventsCounter    *prometheus.CounterVec
 	redisRequestCounter     *prometheus.CounterVec
 	reconcileHistogram      *prometheus.HistogramVec
+	healthStateHistogram    *prometheus.HistogramVec
+	timeToHealthyHistogram  *prometheus.HistogramVec
 	redisRequestHistogram   *prometheus.HistogramVec
 	registry                *prometheus.Registry
 	hostname                string
@@ -117,6 +119,24 @@ var (
 		[]string{"namespace", "dest_server"},
 	)
 
+	healthStateHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_health_state_duration_seconds",
+			Help:    "Application health state durations, observed when the health status changes.",
+			Buckets: []float64{10, 30, 60, 300, 900, 1800, 3600, 10800, 43200, 86400},
+		},
+		[]string{"namespace", "dest_server", "health_status"},
+	)
+
+	timeToHealthyHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_sync_to_healthy_duration_seconds",
+			Help:    "Application duration from the start of a sync until it became healthy.",
+			Buckets: []float64{5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		},
+		[]string{"namespace", "dest_server"},
+	)
+
 	clusterEventsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "argocd_cluster_events_total",
 		Help: "Number of processes k8s resource events.",
@@ -174,6 +194,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
 	registry.MustRegister(reconcileHistogram)
+	registry.MustRegister(healthStateHistogram)
+	registry.MustRegister(timeToHealthyHistogram)
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
@@ -189,6 +211,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		kubectlExecCounter:      kubectlExecCounter,
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
 		reconcileHistogram:      reconcileHistogram,
+		healthStateHistogram:    healthStateHistogram,
+		timeToHealthyHistogram:  timeToHealthyHistogram,
 		clusterEventsCounter:    clusterEventsCounter,
 		redisRequestCounter:     redisRequestCounter,
 		redisRequestHistogram:   redisRequestHistogram,
@@ -273,6 +297,16 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// ObserveHealthStateDuration observes how long an application had the given health status
+func (m *MetricsServer) ObserveHealthStateDuration(app *argoappv1.Application, status health.HealthStatusCode, duration time.Duration) {
+	m.healthStateHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server, string(status)).Observe(duration.Seconds())
+}
+
+// ObserveTimeToHealthy observes how long an application took from the start of a sync until it became healthy
+func (m *MetricsServer) ObserveTimeToHealthy(app *argoappv1.Application, duration time.Duration) {
+	m.timeToHealthyHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
@@ -293,6 +327,8 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.clusterEventsCounter.Reset()
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
+		m.healthStateHistogram.Reset()
+		m.timeToHealthyHistogram.Reset()
 		m.redisRequestHistogram.Reset()
 	})
 	if err != nil {
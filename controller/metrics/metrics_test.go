@@ -11,6 +11,7 @@ import (
 	"time"
 
 	gitopsCache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -401,6 +402,43 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestHealthMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	assert.NoError(t, err)
+
+	appHealthMetrics := `
+# HELP argocd_app_health_state_duration_seconds Application health state durations, observed when the health status changes.
+# TYPE argocd_app_health_state_duration_seconds histogram
+argocd_app_health_state_duration_seconds_bucket{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd",le="10"} 0
+argocd_app_health_state_duration_seconds_bucket{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd",le="30"} 0
+argocd_app_health_state_duration_seconds_bucket{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd",le="60"} 1
+argocd_app_health_state_duration_seconds_bucket{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd",le="300"} 1
+argocd_app_health_state_duration_seconds_bucket{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd",le="+Inf"} 1
+argocd_app_health_state_duration_seconds_sum{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd"} 45
+argocd_app_health_state_duration_seconds_count{dest_server="https://localhost:6443",health_status="Progressing",namespace="argocd"} 1
+# HELP argocd_app_sync_to_healthy_duration_seconds Application duration from the start of a sync until it became healthy.
+# TYPE argocd_app_sync_to_healthy_duration_seconds histogram
+argocd_app_sync_to_healthy_duration_seconds_bucket{dest_server="https://localhost:6443",namespace="argocd",le="60"} 0
+argocd_app_sync_to_healthy_duration_seconds_bucket{dest_server="https://localhost:6443",namespace="argocd",le="120"} 1
+argocd_app_sync_to_healthy_duration_seconds_sum{dest_server="https://localhost:6443",namespace="argocd"} 90
+argocd_app_sync_to_healthy_duration_seconds_count{dest_server="https://localhost:6443",namespace="argocd"} 1
+`
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.ObserveHealthStateDuration(fakeApp, health.HealthStatusProgressing, 45*time.Second)
+	metricsServ.ObserveTimeToHealthy(fakeApp, 90*time.Second)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, appHealthMetrics, body)
+}
+
 func TestMetricsReset(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
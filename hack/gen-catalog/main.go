@@ -12,9 +12,9 @@ import (
 	"github.com/spf13/cobra/doc"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/admin"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
 
 	"github.com/argoproj/notifications-engine/pkg/services"
-	"github.com/argoproj/notifications-engine/pkg/triggers"
 	"github.com/argoproj/notifications-engine/pkg/util/misc"
 	"github.com/olekukonko/tablewriter"
 	log "github.com/sirupsen/logrus"
@@ -116,7 +116,7 @@ func newDocsCommand() *cobra.Command {
 	}
 }
 
-func generateBuiltInTriggersDocs(out io.Writer, triggers map[string][]triggers.Condition, templates map[string]services.Notification) {
+func generateBuiltInTriggersDocs(out io.Writer, triggers map[string][]settings.TriggerCondition, templates map[string]services.Notification) {
 	_, _ = fmt.Fprintln(out, "# Triggers and Templates Catalog")
 	_, _ = fmt.Fprintln(out, "## Triggers")
 
@@ -184,7 +184,7 @@ func dieOnError(err error, msg string) {
 	}
 }
 
-func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]services.Notification, map[string][]triggers.Condition, error) {
+func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]services.Notification, map[string][]settings.TriggerCondition, error) {
 	templatesCfg := map[string]services.Notification{}
 	err := filepath.Walk(templatesDir, func(p string, info os.FileInfo, e error) error {
 		if e != nil {
@@ -209,7 +209,7 @@ func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]serv
 		return nil, nil, err
 	}
 
-	triggersCfg := map[string][]triggers.Condition{}
+	triggersCfg := map[string][]settings.TriggerCondition{}
 	err = filepath.Walk(triggersDir, func(p string, info os.FileInfo, e error) error {
 		if e != nil {
 			return e
@@ -222,7 +222,7 @@ func buildConfigFromFS(templatesDir string, triggersDir string) (map[string]serv
 			return err
 		}
 		name := strings.Split(path.Base(p), ".")[0]
-		var trigger []triggers.Condition
+		var trigger []settings.TriggerCondition
 		if err := yaml.Unmarshal(data, &trigger); err != nil {
 			return err
 		}
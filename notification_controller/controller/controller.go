@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/argoproj/notifications-engine/pkg/subscriptions"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,8 +35,9 @@ const (
 )
 
 var (
-	applications = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
-	appProjects  = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
+	applications    = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.ApplicationPlural}
+	applicationSets = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: "applicationsets"}
+	appProjects     = schema.GroupVersionResource{Group: application.Group, Version: "v1alpha1", Resource: application.AppProjectPlural}
 )
 
 func newAppProjClient(client dynamic.Interface, namespace string) dynamic.ResourceInterface {
@@ -57,20 +59,33 @@ func NewController(
 	registry *controller.MetricsRegistry,
 	secretName string,
 	configMapName string,
+	deliveryOpts DeliveryOptions,
 ) *notificationController {
 	appClient := client.Resource(applications)
 	appInformer := newInformer(appClient.Namespace(namespace), appLabelSelector)
+	appSetClient := client.Resource(applicationSets)
+	appSetInformer := newInformer(appSetClient.Namespace(namespace), "")
+	appProjClient := client.Resource(appProjects)
 	appProjInformer := newInformer(newAppProjClient(client, namespace), "")
 	secretInformer := k8s.NewSecretInformer(k8sClient, namespace, secretName)
 	configMapInformer := k8s.NewConfigMapInformer(k8sClient, namespace, configMapName)
-	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, secretInformer, configMapInformer)
+	apiFactory := newDeliveryFactory(api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, secretInformer, configMapInformer), deliveryOpts)
 
 	res := &notificationController{
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
+		configMapKey:      fmt.Sprintf("%s/%s", namespace, configMapName),
 		appInformer:       appInformer,
+		appSetInformer:    appSetInformer,
 		appProjInformer:   appProjInformer,
 		apiFactory:        apiFactory}
+	res.digester = newDigester(apiFactory, configMapInformer, namespace, configMapName, appInformer, appClient, deliveryOpts.DigestCache, res.mergeDestinations)
+	appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: res.digester.enqueue,
+		UpdateFunc: func(_, newObj interface{}) {
+			res.digester.enqueue(newObj)
+		},
+	})
 	res.ctrl = controller.NewController(appClient, appInformer, apiFactory,
 		controller.WithSkipProcessing(func(obj v1.Object) (bool, string) {
 			app, ok := (obj).(*unstructured.Unstructured)
@@ -81,22 +96,64 @@ func NewController(
 		}),
 		controller.WithMetricsRegistry(registry),
 		controller.WithAlterDestinations(res.alterDestinations))
+	res.appSetCtrl = controller.NewController(appSetClient, appSetInformer, apiFactory,
+		controller.WithMetricsRegistry(registry),
+		controller.WithAlterDestinations(res.filterDestinations(settings.KindApplicationSet)))
+	res.appProjCtrl = controller.NewController(appProjClient, appProjInformer, apiFactory,
+		controller.WithMetricsRegistry(registry),
+		controller.WithAlterDestinations(res.filterDestinations(settings.KindAppProject)))
 	return res
 }
 
+// alterDestinations adds the subscriptions of the project and of the application set of the application to its
+// destinations, and drops the subscriptions to digests and to triggers of other kinds of resources
 func (c *notificationController) alterDestinations(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
 	app, ok := (obj).(*unstructured.Unstructured)
 	if !ok {
 		return destinations
 	}
+	destinations = c.mergeDestinations(app, destinations, cfg)
+	return c.digester.filterDestinations(settings.FilterDestinations(destinations, c.triggerKinds(), settings.KindApplication))
+}
 
+// mergeDestinations adds the subscriptions of the project and of the application set of the application to its
+// destinations
+func (c *notificationController) mergeDestinations(app *unstructured.Unstructured, destinations services.Destinations, cfg api.Config) services.Destinations {
 	if proj := getAppProj(app, c.appProjInformer); proj != nil {
 		destinations.Merge(subscriptions.NewAnnotations(proj.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 		destinations.Merge(settings.GetLegacyDestinations(proj.GetAnnotations(), cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
 	}
+	if appSet := getAppSet(app, c.appSetInformer); appSet != nil {
+		destinations.Merge(subscriptions.NewAnnotations(appSet.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+	}
 	return destinations
 }
 
+// filterDestinations returns a function which drops the subscriptions to triggers of other kinds of resources
+func (c *notificationController) filterDestinations(kind string) func(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+	return func(obj v1.Object, destinations services.Destinations, cfg api.Config) services.Destinations {
+		return settings.FilterDestinations(destinations, c.triggerKinds(), kind)
+	}
+}
+
+// triggerKinds returns the kinds of the resources the triggers of the notifications ConfigMap are evaluated for
+func (c *notificationController) triggerKinds() map[string]string {
+	obj, ok, err := c.configMapInformer.GetIndexer().GetByKey(c.configMapKey)
+	if !ok || err != nil {
+		return nil
+	}
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return nil
+	}
+	kinds, err := settings.ParseTriggerKinds(cm.Data)
+	if err != nil {
+		log.Warnf("Failed to parse trigger kinds: %v", err)
+		return nil
+	}
+	return kinds
+}
+
 func newInformer(resClient dynamic.ResourceInterface, selector string) cache.SharedIndexInformer {
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
@@ -117,27 +174,38 @@ func newInformer(resClient dynamic.ResourceInterface, selector string) cache.Sha
 }
 
 type notificationController struct {
-	apiFactory        api.Factory
+	apiFactory        *deliveryFactory
+	digester          *digester
 	ctrl              controller.NotificationController
+	appSetCtrl        controller.NotificationController
+	appProjCtrl       controller.NotificationController
 	appInformer       cache.SharedIndexInformer
+	appSetInformer    cache.SharedIndexInformer
 	appProjInformer   cache.SharedIndexInformer
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
+	configMapKey      string
 }
 
 func (c *notificationController) Init(ctx context.Context) error {
 	go c.appInformer.Run(ctx.Done())
+	go c.appSetInformer.Run(ctx.Done())
 	go c.appProjInformer.Run(ctx.Done())
 	go c.secretInformer.Run(ctx.Done())
 	go c.configMapInformer.Run(ctx.Done())
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.appInformer.HasSynced, c.appSetInformer.HasSynced, c.appProjInformer.HasSynced, c.secretInformer.HasSynced, c.configMapInformer.HasSynced) {
 		return errors.New("Timed out waiting for caches to sync")
 	}
 	return nil
 }
 
 func (c *notificationController) Run(ctx context.Context, processors int) {
+	go c.appSetCtrl.Run(processors, ctx.Done())
+	go c.appProjCtrl.Run(processors, ctx.Done())
+	go c.apiFactory.runRetries(ctx)
+	go c.apiFactory.runFlushes(ctx)
+	go c.digester.run(ctx)
 	c.ctrl.Run(processors, ctx.Done())
 }
 
@@ -160,6 +228,25 @@ func getAppProj(app *unstructured.Unstructured, appProjInformer cache.SharedInde
 	return proj
 }
 
+// getAppSet returns the application set which owns the application, if any
+func getAppSet(app *unstructured.Unstructured, appSetInformer cache.SharedIndexInformer) *unstructured.Unstructured {
+	for _, ref := range app.GetOwnerReferences() {
+		if ref.Kind != settings.KindApplicationSet {
+			continue
+		}
+		appSetObj, ok, err := appSetInformer.GetIndexer().GetByKey(fmt.Sprintf("%s/%s", app.GetNamespace(), ref.Name))
+		if !ok || err != nil {
+			return nil
+		}
+		appSet, ok := appSetObj.(*unstructured.Unstructured)
+		if !ok {
+			return nil
+		}
+		return appSet
+	}
+	return nil
+}
+
 // Checks if the application SyncStatus has been refreshed by Argo CD after an operation has completed
 func isAppSyncStatusRefreshed(app *unstructured.Unstructured, logEntry *log.Entry) bool {
 	_, ok, err := unstructured.NestedMap(app.Object, "status", "operationState")
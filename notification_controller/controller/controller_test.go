@@ -6,8 +6,12 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
@@ -100,6 +104,68 @@ func TestGetAppProj_invalidProjectNestedString(t *testing.T) {
 	assert.Nil(t, proj)
 }
 
+func TestGetAppSet(t *testing.T) {
+	informer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	appSet := &unstructured.Unstructured{}
+	appSet.SetNamespace("argocd")
+	appSet.SetName("guestbook")
+	err := informer.GetIndexer().Add(appSet)
+	if err != nil {
+		t.Fatalf("Error adding the application set: %v", err)
+	}
+
+	app := &unstructured.Unstructured{}
+	app.SetNamespace("argocd")
+	app.SetOwnerReferences([]v1.OwnerReference{{Kind: "ApplicationSet", Name: "guestbook"}})
+	assert.Equal(t, appSet, getAppSet(app, informer))
+
+	app.SetOwnerReferences([]v1.OwnerReference{{Kind: "ApplicationSet", Name: "unknown"}})
+	assert.Nil(t, getAppSet(app, informer))
+
+	app.SetOwnerReferences(nil)
+	assert.Nil(t, getAppSet(app, informer))
+}
+
+func TestAlterDestinations(t *testing.T) {
+	appProjInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	appSetInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	proj := &unstructured.Unstructured{}
+	proj.SetNamespace("argocd")
+	proj.SetName("default")
+	proj.SetAnnotations(map[string]string{"notifications.argoproj.io/subscribe.on-orphaned-resources.slack": "proj-channel"})
+	appSet := &unstructured.Unstructured{}
+	appSet.SetNamespace("argocd")
+	appSet.SetName("guestbook")
+	appSet.SetAnnotations(map[string]string{
+		"notifications.argoproj.io/subscribe.on-created.slack":      "appset-channel",
+		"notifications.argoproj.io/subscribe.on-appset-error.slack": "appset-channel",
+		"notifications.argoproj.io/subscribe.daily.slack":           "appset-channel",
+	})
+	assert.NoError(t, appProjInformer.GetIndexer().Add(proj))
+	assert.NoError(t, appSetInformer.GetIndexer().Add(appSet))
+	configMapInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	assert.NoError(t, configMapInformer.GetIndexer().Add(&corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Namespace: "argocd", Name: "argocd-notifications-cm"},
+		Data: map[string]string{
+			"digest.daily":            "schedule: '0 9 * * *'\ntrigger: on-deployed\nsend: [digest]",
+			"trigger.on-appset-error": "- when: appsets.Condition(appset, 'ErrorOccurred') != nil\n  kind: ApplicationSet\n  send: [appset-error]",
+		},
+	}))
+	c := &notificationController{appProjInformer: appProjInformer, appSetInformer: appSetInformer, configMapInformer: configMapInformer, configMapKey: "argocd/argocd-notifications-cm"}
+	c.digester = newDigester(nil, configMapInformer, "argocd", "argocd-notifications-cm", nil, nil, nil, c.mergeDestinations)
+
+	app := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{"project": "default"}}}
+	app.SetNamespace("argocd")
+	app.SetName("guestbook-dev")
+	app.SetOwnerReferences([]v1.OwnerReference{{Kind: "ApplicationSet", Name: "guestbook"}})
+
+	destinations := c.alterDestinations(app, services.Destinations{}, api.Config{})
+	assert.Equal(t, services.Destinations{
+		"on-orphaned-resources": {{Service: "slack", Recipient: "proj-channel"}},
+		"on-created":            {{Service: "slack", Recipient: "appset-channel"}},
+	}, destinations)
+}
+
 func TestInit(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.SchemeBuilder.AddToScheme(scheme)
@@ -119,6 +185,7 @@ func TestInit(t *testing.T) {
 		nil,
 		"my-secret",
 		"my-configmap",
+		DeliveryOptions{},
 	)
 
 	assert.NotNil(t, nc)
@@ -150,6 +217,7 @@ func TestInitTimeout(t *testing.T) {
 		nil,
 		"my-secret",
 		"my-configmap",
+		DeliveryOptions{},
 	)
 
 	assert.NotNil(t, nc)
@@ -0,0 +1,221 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+)
+
+const (
+	defaultRetryBaseDelay = 5 * time.Second
+	defaultRetryMaxDelay  = 5 * time.Minute
+	// deliveryFlushInterval is the interval in which the recorded deliveries are written to the store
+	deliveryFlushInterval = 10 * time.Second
+)
+
+// DeliveryOptions configures how the deliveries of notifications are recorded and retried
+type DeliveryOptions struct {
+	// Store keeps the history of the deliveries. Deliveries are kept in memory if nil.
+	Store delivery.Store
+	// MaxAttempts is the number of attempts to deliver a notification before it is dead-lettered. Failed deliveries
+	// are not retried if not greater than 1.
+	MaxAttempts int
+	// RetryBaseDelay is the delay before the first retry, which doubles with each further retry
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay is the maximum delay between retries
+	RetryMaxDelay time.Duration
+	// DigestCache keeps the events collected for digests across restarts. Events are kept in memory if nil.
+	DigestCache *cacheutil.Cache
+}
+
+// retry is a notification whose delivery is retried
+type retry struct {
+	record    delivery.Record
+	obj       map[string]interface{}
+	templates []string
+	dest      services.Destination
+}
+
+// deliveryFactory wraps the API factory, so the deliveries of the APIs are recorded and failed deliveries are retried
+type deliveryFactory struct {
+	api.Factory
+	store       delivery.Store
+	maxAttempts int
+	queue       workqueue.RateLimitingInterface
+	now         func() time.Time
+}
+
+func newDeliveryFactory(factory api.Factory, opts DeliveryOptions) *deliveryFactory {
+	if opts.Store == nil {
+		opts.Store = delivery.NewStore(nil, delivery.DefaultHistorySize)
+	}
+	if opts.RetryBaseDelay <= 0 {
+		opts.RetryBaseDelay = defaultRetryBaseDelay
+	}
+	if opts.RetryMaxDelay <= 0 {
+		opts.RetryMaxDelay = defaultRetryMaxDelay
+	}
+	return &deliveryFactory{
+		Factory:     factory,
+		store:       opts.Store,
+		maxAttempts: opts.MaxAttempts,
+		queue:       workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(opts.RetryBaseDelay, opts.RetryMaxDelay)),
+		now:         time.Now,
+	}
+}
+
+// GetAPI returns an API which records its deliveries. The notifications controller gets an API for each processed
+// resource and runs the triggers and sends the notifications of the resource sequentially, so the API remembers the
+// last run trigger to attribute the deliveries to it.
+func (f *deliveryFactory) GetAPI() (api.API, error) {
+	a, err := f.Factory.GetAPI()
+	if err != nil {
+		return nil, err
+	}
+	return &deliveryAPI{API: a, factory: f}, nil
+}
+
+// record adds the delivery attempt to the history
+func (f *deliveryFactory) record(record delivery.Record) {
+	record.Time = f.now()
+	if err := f.store.Add(record); err != nil {
+		log.Warnf("Failed to record delivery of notification %s to %s: %v", record.Trigger, record.Recipient, err)
+	}
+}
+
+// send sends the notification and records the attempt. Failed deliveries are queued for a retry until the maximum
+// number of attempts is reached, and dead-lettered then. The retry is forgotten by the queue once it is not retried
+// anymore.
+func (f *deliveryFactory) send(a api.API, r *retry) error {
+	r.record.Attempt++
+	err := a.Send(r.obj, r.templates, r.dest)
+	record := r.record
+	switch {
+	case err == nil:
+		record.Status = delivery.StatusSent
+		f.queue.Forget(r)
+	case r.record.Attempt < f.maxAttempts:
+		record.Status = delivery.StatusFailed
+		record.Error = err.Error()
+		f.queue.AddRateLimited(r)
+	default:
+		record.Status = delivery.StatusDeadLettered
+		record.Error = err.Error()
+		f.queue.Forget(r)
+	}
+	f.record(record)
+	return err
+}
+
+// runFlushes writes the recorded deliveries to the store periodically until the context is done
+func (f *deliveryFactory) runFlushes(ctx context.Context) {
+	ticker := time.NewTicker(deliveryFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			f.flush()
+			return
+		case <-ticker.C:
+			f.flush()
+		}
+	}
+}
+
+func (f *deliveryFactory) flush() {
+	if err := f.store.Flush(); err != nil {
+		log.Warnf("Failed to write the delivery history: %v", err)
+	}
+}
+
+// runRetries retries the failed deliveries until the context is done
+func (f *deliveryFactory) runRetries(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		f.queue.ShutDown()
+	}()
+	for f.processNextRetry() {
+	}
+}
+
+func (f *deliveryFactory) processNextRetry() bool {
+	item, shutdown := f.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer f.queue.Done(item)
+	r := item.(*retry)
+	a, err := f.Factory.GetAPI()
+	if err != nil {
+		log.Warnf("Failed to retry delivery of notification %s to %s: %v", r.record.Trigger, r.record.Recipient, err)
+		f.queue.AddRateLimited(r)
+		return true
+	}
+	if err := f.send(a, r); err != nil {
+		log.Warnf("Attempt %d to deliver notification %s to %s failed: %v", r.record.Attempt, r.record.Trigger, r.record.Recipient, err)
+	}
+	return true
+}
+
+// deliveryAPI records the deliveries of the wrapped API
+type deliveryAPI struct {
+	api.API
+	factory *deliveryFactory
+	trigger string
+}
+
+func (a *deliveryAPI) RunTrigger(triggerName string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	a.trigger = triggerName
+	return a.API.RunTrigger(triggerName, vars)
+}
+
+// Send sends the notification and records the delivery. If the delivery fails and is going to be retried, no error
+// is returned, so the notifications controller does not send the notification again on its own.
+func (a *deliveryAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	r := &retry{
+		record:    newRecord(obj, a.trigger, dest),
+		obj:       obj,
+		templates: templates,
+		dest:      dest,
+	}
+	err := a.factory.send(a.API, r)
+	if err != nil && r.record.Attempt < a.factory.maxAttempts {
+		log.Warnf("Failed to deliver notification %s to %s, retrying: %v", r.record.Trigger, r.record.Recipient, err)
+		return nil
+	}
+	return err
+}
+
+// newRecord returns the record of the first delivery attempt of a notification about the given resource
+func newRecord(obj map[string]interface{}, trigger string, dest services.Destination) delivery.Record {
+	un := &unstructured.Unstructured{Object: obj}
+	record := delivery.Record{
+		ID:        uuid.NewString(),
+		Kind:      un.GetKind(),
+		Namespace: un.GetNamespace(),
+		Name:      un.GetName(),
+		Trigger:   trigger,
+		Service:   dest.Service,
+		Recipient: dest.Recipient,
+	}
+	switch record.Kind {
+	case settings.KindApplicationSet:
+		record.Project, _, _ = unstructured.NestedString(obj, "spec", "template", "spec", "project")
+	case settings.KindAppProject:
+		record.Project = un.GetName()
+	default:
+		record.Project, _, _ = unstructured.NestedString(obj, "spec", "project")
+	}
+	return record
+}
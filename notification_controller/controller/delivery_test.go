@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+)
+
+type fakeAPI struct {
+	api.API
+	config  api.Config
+	results []triggers.ConditionResult
+	errors  []error
+	sent    int
+	objs    []map[string]interface{}
+}
+
+func (a *fakeAPI) GetConfig() api.Config {
+	return a.config
+}
+
+func (a *fakeAPI) RunTrigger(string, map[string]interface{}) ([]triggers.ConditionResult, error) {
+	return a.results, nil
+}
+
+func (a *fakeAPI) Send(obj map[string]interface{}, _ []string, _ services.Destination) error {
+	a.sent++
+	a.objs = append(a.objs, obj)
+	if len(a.errors) == 0 {
+		return nil
+	}
+	err := a.errors[0]
+	a.errors = a.errors[1:]
+	return err
+}
+
+type fakeFactory struct {
+	api *fakeAPI
+}
+
+func (f *fakeFactory) GetAPI() (api.API, error) {
+	return f.api, nil
+}
+
+// forgetRecorder is a rate limiter which records the items it forgets
+type forgetRecorder struct {
+	workqueue.RateLimiter
+	forgotten []interface{}
+}
+
+func (r *forgetRecorder) Forget(item interface{}) {
+	r.forgotten = append(r.forgotten, item)
+	r.RateLimiter.Forget(item)
+}
+
+// recordForgets replaces the retry queue of the factory with one which records the retries it forgets
+func recordForgets(f *deliveryFactory) *forgetRecorder {
+	recorder := &forgetRecorder{RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond)}
+	f.queue = workqueue.NewRateLimitingQueue(recorder)
+	return recorder
+}
+
+func newTestApp() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata":   map[string]interface{}{"namespace": "argocd", "name": "guestbook"},
+		"spec":       map[string]interface{}{"project": "default"},
+	}
+}
+
+func sendNotification(t *testing.T, f *deliveryFactory) error {
+	a, err := f.GetAPI()
+	require.NoError(t, err)
+	_, err = a.RunTrigger("on-sync-failed", nil)
+	require.NoError(t, err)
+	return a.Send(newTestApp(), []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "channel"})
+}
+
+func TestDeliveryFactory_Sent(t *testing.T) {
+	store := delivery.NewStore(nil, 0)
+	f := newDeliveryFactory(&fakeFactory{api: &fakeAPI{}}, DeliveryOptions{Store: store, MaxAttempts: 3})
+
+	require.NoError(t, sendNotification(t, f))
+
+	records, err := store.List()
+	require.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.NotEmpty(t, records[0].ID)
+		assert.False(t, records[0].Time.IsZero())
+		records[0].ID = ""
+		records[0].Time = time.Time{}
+		assert.Equal(t, delivery.Record{
+			Kind:      "Application",
+			Namespace: "argocd",
+			Name:      "guestbook",
+			Project:   "default",
+			Trigger:   "on-sync-failed",
+			Service:   "slack",
+			Recipient: "channel",
+			Status:    delivery.StatusSent,
+			Attempt:   1,
+		}, records[0])
+	}
+	assert.Equal(t, 0, f.queue.Len())
+}
+
+func TestDeliveryFactory_Retried(t *testing.T) {
+	store := delivery.NewStore(nil, 0)
+	fake := &fakeAPI{errors: []error{errors.New("boom"), errors.New("boom")}}
+	f := newDeliveryFactory(&fakeFactory{api: fake}, DeliveryOptions{Store: store, MaxAttempts: 3, RetryBaseDelay: time.Millisecond})
+
+	// the failed delivery is queued for a retry, so no error is returned to the notifications controller
+	recorder := recordForgets(f)
+
+	require.NoError(t, sendNotification(t, f))
+	assert.True(t, f.processNextRetry())
+	assert.True(t, f.processNextRetry())
+	assert.Equal(t, 3, fake.sent)
+	if assert.Len(t, recorder.forgotten, 1) {
+		assert.Equal(t, 0, recorder.NumRequeues(recorder.forgotten[0]))
+	}
+
+	records, err := store.List()
+	require.NoError(t, err)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, delivery.StatusSent, records[0].Status)
+		assert.Equal(t, 3, records[0].Attempt)
+		assert.Equal(t, delivery.StatusFailed, records[1].Status)
+		assert.Equal(t, "boom", records[1].Error)
+		assert.Equal(t, records[0].ID, records[2].ID)
+	}
+	deadLetters, err := store.ListDeadLetters()
+	require.NoError(t, err)
+	assert.Empty(t, deadLetters)
+}
+
+func TestDeliveryFactory_DeadLettered(t *testing.T) {
+	store := delivery.NewStore(nil, 0)
+	fake := &fakeAPI{errors: []error{errors.New("boom"), errors.New("boom")}}
+	f := newDeliveryFactory(&fakeFactory{api: fake}, DeliveryOptions{Store: store, MaxAttempts: 2, RetryBaseDelay: time.Millisecond})
+
+	recorder := recordForgets(f)
+
+	require.NoError(t, sendNotification(t, f))
+	assert.True(t, f.processNextRetry())
+	if assert.Len(t, recorder.forgotten, 1) {
+		assert.Equal(t, 0, recorder.NumRequeues(recorder.forgotten[0]))
+	}
+
+	deadLetters, err := store.ListDeadLetters()
+	require.NoError(t, err)
+	if assert.Len(t, deadLetters, 1) {
+		assert.Equal(t, 2, deadLetters[0].Attempt)
+		assert.Equal(t, "on-sync-failed", deadLetters[0].Trigger)
+	}
+	assert.Equal(t, 0, f.queue.Len())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f.runRetries(ctx)
+}
+
+func TestDeliveryFactory_NoRetries(t *testing.T) {
+	store := delivery.NewStore(nil, 0)
+	f := newDeliveryFactory(&fakeFactory{api: &fakeAPI{errors: []error{errors.New("boom")}}}, DeliveryOptions{Store: store})
+
+	assert.EqualError(t, sendNotification(t, f), "boom")
+
+	deadLetters, err := store.ListDeadLetters()
+	require.NoError(t, err)
+	assert.Len(t, deadLetters, 1)
+}
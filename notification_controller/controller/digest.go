@@ -0,0 +1,399 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/controller"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/subscriptions"
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+)
+
+const (
+	// digestCheckInterval is the interval in which the digester checks whether digest notifications are due and saves
+	// the collected events
+	digestCheckInterval = time.Minute
+	// digestCacheKeyPrefix is the prefix of the keys of the collected events of the digests in Redis
+	digestCacheKeyPrefix = "notifications|digest|"
+	// digestCacheExpiration is the time the collected events are kept in Redis after they were last saved
+	digestCacheExpiration = 7 * 24 * time.Hour
+	// digestStatePrefix is the prefix of the digests in the keys of the notified state of applications, which
+	// separates them from the triggers evaluated by the notifications controller
+	digestStatePrefix = "digest."
+)
+
+// digestEvent is a notification collected for a digest
+type digestEvent struct {
+	Trigger string                 `json:"trigger"`
+	Time    time.Time              `json:"time"`
+	App     map[string]interface{} `json:"app"`
+}
+
+// digestBuffer holds the events collected for a digest since the last digest notifications
+type digestBuffer struct {
+	since  time.Time
+	events map[services.Destination][]digestEvent
+	// dirty is whether the buffer changed since it was last saved
+	dirty bool
+}
+
+// savedDigestBuffer is the representation of a digest buffer in Redis, which is saved as JSON since the events hold
+// arbitrary application objects
+type savedDigestBuffer struct {
+	Since  time.Time                `json:"since"`
+	Events []savedDestinationEvents `json:"events,omitempty"`
+}
+
+type savedDestinationEvents struct {
+	Destination services.Destination `json:"destination"`
+	Events      []digestEvent        `json:"events"`
+}
+
+// digester collects the notifications of the triggers of the digests about applications, and sends them as digest
+// notifications on the schedules of the digests
+type digester struct {
+	lock              sync.Mutex
+	factory           *deliveryFactory
+	configMapInformer cache.SharedIndexInformer
+	configMapKey      string
+	appInformer       cache.SharedIndexInformer
+	appClient         dynamic.NamespaceableResourceInterface
+	mergeDestinations func(app *unstructured.Unstructured, destinations services.Destinations, cfg api.Config) services.Destinations
+	queue             workqueue.RateLimitingInterface
+	// cache keeps the buffers across restarts of the controller, which are kept in memory only if nil
+	cache   *cacheutil.Cache
+	buffers map[string]*digestBuffer
+	now     func() time.Time
+}
+
+func newDigester(factory *deliveryFactory, configMapInformer cache.SharedIndexInformer, namespace string, configMapName string,
+	appInformer cache.SharedIndexInformer, appClient dynamic.NamespaceableResourceInterface, digestCache *cacheutil.Cache,
+	mergeDestinations func(app *unstructured.Unstructured, destinations services.Destinations, cfg api.Config) services.Destinations) *digester {
+	return &digester{
+		factory:           factory,
+		configMapInformer: configMapInformer,
+		configMapKey:      fmt.Sprintf("%s/%s", namespace, configMapName),
+		appInformer:       appInformer,
+		appClient:         appClient,
+		mergeDestinations: mergeDestinations,
+		queue:             workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		cache:             digestCache,
+		buffers:           map[string]*digestBuffer{},
+		now:               time.Now,
+	}
+}
+
+// enqueue queues the application for collecting the events of the digests it is subscribed to
+func (d *digester) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Warnf("Failed to get key of application: %v", err)
+		return
+	}
+	d.queue.Add(key)
+}
+
+// digests returns the digests defined in the notifications ConfigMap
+func (d *digester) digests() map[string]settings.Digest {
+	obj, ok, err := d.configMapInformer.GetIndexer().GetByKey(d.configMapKey)
+	if !ok || err != nil {
+		return nil
+	}
+	cm, ok := obj.(*v1.ConfigMap)
+	if !ok {
+		return nil
+	}
+	digests, err := settings.ParseDigests(cm.Data)
+	if err != nil {
+		log.Warnf("Failed to parse digests: %v", err)
+		return nil
+	}
+	return digests
+}
+
+// filterDestinations drops the subscriptions to digests, which are not triggers evaluated by the notifications
+// controller
+func (d *digester) filterDestinations(destinations services.Destinations) services.Destinations {
+	digests := d.digests()
+	if len(digests) == 0 {
+		return destinations
+	}
+	res := services.Destinations{}
+	for trigger, dests := range destinations {
+		if _, ok := digests[trigger]; !ok {
+			res[trigger] = dests
+		}
+	}
+	return res
+}
+
+// buffer returns the buffer of the digest, which is loaded from Redis if it is not in memory yet
+func (d *digester) buffer(name string) *digestBuffer {
+	buffer, ok := d.buffers[name]
+	if ok {
+		return buffer
+	}
+	buffer = &digestBuffer{since: d.now(), events: map[services.Destination][]digestEvent{}}
+	if d.cache != nil {
+		var data []byte
+		var saved savedDigestBuffer
+		err := d.cache.GetItem(digestCacheKeyPrefix+name, &data)
+		if err == nil {
+			err = json.Unmarshal(data, &saved)
+		}
+		switch {
+		case err == nil:
+			buffer.since = saved.Since
+			for _, item := range saved.Events {
+				buffer.events[item.Destination] = item.Events
+			}
+		case !errors.Is(err, cacheutil.ErrCacheMiss):
+			log.Warnf("Failed to load events of digest %s: %v", name, err)
+		}
+	}
+	d.buffers[name] = buffer
+	return buffer
+}
+
+// save saves the buffers which changed since they were last saved to Redis
+func (d *digester) save() {
+	if d.cache == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for name, buffer := range d.buffers {
+		if !buffer.dirty {
+			continue
+		}
+		saved := savedDigestBuffer{Since: buffer.since}
+		for dest, events := range buffer.events {
+			saved.Events = append(saved.Events, savedDestinationEvents{Destination: dest, Events: events})
+		}
+		data, err := json.Marshal(saved)
+		if err == nil {
+			err = d.cache.SetItem(digestCacheKeyPrefix+name, data, digestCacheExpiration, false)
+		}
+		if err != nil {
+			log.Warnf("Failed to save events of digest %s: %v", name, err)
+			continue
+		}
+		buffer.dirty = false
+	}
+}
+
+// processNext collects the events of the next application in the queue, and returns false once the queue is shut down
+func (d *digester) processNext() bool {
+	key, shutdown := d.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer d.queue.Done(key)
+	obj, exists, err := d.appInformer.GetIndexer().GetByKey(key.(string))
+	if err != nil || !exists {
+		d.queue.Forget(key)
+		return true
+	}
+	if err := d.collect(obj); err != nil {
+		log.Warnf("Failed to collect digest events of application %s: %v", key, err)
+		d.queue.AddRateLimited(key)
+		return true
+	}
+	d.queue.Forget(key)
+	return true
+}
+
+// collect runs the triggers of the digests the application is subscribed to, and collects the conditions which were
+// not notified yet. The notified conditions are recorded in the notified state of the application, the same way the
+// notifications controller records the notifications of triggers, so each condition is collected only once until it
+// stops being triggered, or only once per value of the oncePer field of the trigger.
+func (d *digester) collect(obj interface{}) error {
+	app, ok := obj.(*unstructured.Unstructured)
+	if !ok || !isAppSyncStatusRefreshed(app, log.WithField("app", app.GetName())) {
+		return nil
+	}
+	digests := d.digests()
+	if len(digests) == 0 {
+		return nil
+	}
+	a, err := d.factory.Factory.GetAPI()
+	if err != nil {
+		return err
+	}
+	cfg := a.GetConfig()
+	destinations := cfg.GetGlobalDestinations(app.GetLabels())
+	destinations.Merge(subscriptions.NewAnnotations(app.GetAnnotations()).GetDestinations(cfg.DefaultTriggers, cfg.ServiceDefaultTriggers))
+	destinations = d.mergeDestinations(app, destinations, cfg).Dedup()
+
+	state := controller.NewStateFromRes(app)
+	events := map[string]map[services.Destination][]digestEvent{}
+	for name, digest := range digests {
+		dests := destinations[name]
+		if len(dests) == 0 {
+			continue
+		}
+		res, err := a.RunTrigger(digest.Trigger, app.Object)
+		if err != nil {
+			log.Warnf("Failed to execute condition of trigger %s of digest %s: %v", digest.Trigger, name, err)
+			continue
+		}
+		for _, cr := range res {
+			for _, dest := range dests {
+				if !state.SetAlreadyNotified(digestStatePrefix+name, cr, dest, cr.Triggered) || !cr.Triggered {
+					continue
+				}
+				if events[name] == nil {
+					events[name] = map[services.Destination][]digestEvent{}
+				}
+				events[name][dest] = append(events[name][dest], digestEvent{Trigger: digest.Trigger, Time: d.now(), App: app.DeepCopy().Object})
+			}
+		}
+	}
+
+	if err := d.persistState(app, state); err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for name, destEvents := range events {
+		buffer := d.buffer(name)
+		for dest, items := range destEvents {
+			for _, event := range items {
+				if len(buffer.events[dest]) >= digests[name].MaxEvents {
+					log.Warnf("Digest %s for %s reached the maximum number of events", name, dest)
+					break
+				}
+				buffer.events[dest] = append(buffer.events[dest], event)
+				buffer.dirty = true
+			}
+		}
+	}
+	return nil
+}
+
+// persistState patches the notified state annotation of the application if it changed. The patch fails on conflicts
+// so the events are collected again from the latest version of the application.
+func (d *digester) persistState(app *unstructured.Unstructured, state controller.NotificationsState) error {
+	annotations, err := state.Persist(app)
+	if err != nil {
+		return err
+	}
+	key := subscriptions.NotifiedAnnotationKey()
+	value, ok := annotations[key]
+	if current, exists := app.GetAnnotations()[key]; ok == exists && value == current {
+		return nil
+	}
+	var annotation interface{}
+	if ok {
+		annotation = value
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": app.GetResourceVersion(),
+			"annotations":     map[string]interface{}{key: annotation},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	patched, err := d.appClient.Namespace(app.GetNamespace()).Patch(context.Background(), app.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	return d.appInformer.GetStore().Update(patched)
+}
+
+// run collects the events of the queued applications, and sends the due digest notifications until the context is
+// done
+func (d *digester) run(ctx context.Context) {
+	go func() {
+		for d.processNext() {
+		}
+	}()
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			d.queue.ShutDown()
+			d.save()
+			return
+		case <-ticker.C:
+			d.sendDue()
+			d.save()
+		}
+	}
+}
+
+// sendDue sends the digest notifications whose scheduled time has passed
+func (d *digester) sendDue() {
+	for name, digest := range d.digests() {
+		schedule, err := cron.ParseStandard(digest.Schedule)
+		if err != nil {
+			continue
+		}
+		d.lock.Lock()
+		buffer := d.buffer(name)
+		now := d.now()
+		if schedule.Next(buffer.since).After(now) {
+			d.lock.Unlock()
+			continue
+		}
+		events := buffer.events
+		since := buffer.since
+		buffer.events = map[services.Destination][]digestEvent{}
+		buffer.since = now
+		buffer.dirty = true
+		d.lock.Unlock()
+
+		for dest, destEvents := range events {
+			d.send(name, digest, since, now, dest, destEvents)
+		}
+	}
+}
+
+// send sends the digest notification with the given events to the destination
+func (d *digester) send(name string, digest settings.Digest, since time.Time, until time.Time, dest services.Destination, events []digestEvent) {
+	a, err := d.factory.Factory.GetAPI()
+	if err != nil {
+		log.Warnf("Failed to send digest %s to %s: %v", name, dest, err)
+		return
+	}
+	items := make([]interface{}, len(events))
+	for i, event := range events {
+		items[i] = map[string]interface{}{
+			"trigger": event.Trigger,
+			"time":    event.Time.UTC().Format(time.RFC3339),
+			"app":     event.App,
+		}
+	}
+	obj := map[string]interface{}{
+		"kind":     settings.KindDigest,
+		"metadata": map[string]interface{}{"name": name},
+		"since":    since.UTC().Format(time.RFC3339),
+		"until":    until.UTC().Format(time.RFC3339),
+		"events":   items,
+	}
+	deliveries := &deliveryAPI{API: a, factory: d.factory, trigger: name}
+	if err := deliveries.Send(obj, digest.Send, dest); err != nil {
+		log.Warnf("Failed to send digest %s to %s: %v", name, dest, err)
+	}
+}
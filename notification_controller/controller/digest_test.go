@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/controller"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+)
+
+func newTestDigester(t *testing.T, fake *fakeAPI, store delivery.Store, digestCache *cacheutil.Cache, apps ...*unstructured.Unstructured) *digester {
+	configMapInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	require.NoError(t, configMapInformer.GetIndexer().Add(&corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Namespace: "argocd", Name: "argocd-notifications-cm"},
+		Data:       map[string]string{"digest.daily": "schedule: '0 9 * * *'\ntrigger: on-deployed\nsend: [digest]"},
+	}))
+	appInformer := cache.NewSharedIndexInformer(nil, nil, 0, cache.Indexers{})
+	var objs []runtime.Object
+	for _, app := range apps {
+		require.NoError(t, appInformer.GetIndexer().Add(app))
+		objs = append(objs, app)
+	}
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objs...)
+	f := newDeliveryFactory(&fakeFactory{api: fake}, DeliveryOptions{Store: store})
+	return newDigester(f, configMapInformer, "argocd", "argocd-notifications-cm", appInformer, client.Resource(applications), digestCache,
+		func(app *unstructured.Unstructured, destinations services.Destinations, cfg api.Config) services.Destinations {
+			return destinations
+		})
+}
+
+func newDigestApp(name string, subscribed bool) *unstructured.Unstructured {
+	app := &unstructured.Unstructured{Object: newTestApp()}
+	app.SetName(name)
+	if subscribed {
+		app.SetAnnotations(map[string]string{"notifications.argoproj.io/subscribe.daily.slack": "management"})
+	}
+	return app
+}
+
+// process queues the application and collects its events
+func process(t *testing.T, d *digester, name string) {
+	d.enqueue(newDigestApp(name, false))
+	require.True(t, d.processNext())
+}
+
+func TestDigester(t *testing.T) {
+	fake := &fakeAPI{results: []triggers.ConditionResult{{Key: "[0].rev1", Triggered: true}}}
+	store := delivery.NewStore(nil, 0)
+	d := newTestDigester(t, fake, store, nil,
+		newDigestApp("guestbook", true), newDigestApp("unsubscribed", false), newDigestApp("other", true))
+	now := time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	process(t, d, "guestbook")
+	// the condition is collected once until it stops being triggered
+	process(t, d, "guestbook")
+	process(t, d, "unsubscribed")
+
+	fake.results = []triggers.ConditionResult{{Key: "[0].rev2", Triggered: true}}
+	process(t, d, "guestbook")
+	process(t, d, "other")
+
+	// not due before the scheduled time
+	now = now.Add(30 * time.Minute)
+	d.sendDue()
+	assert.Equal(t, 0, fake.sent)
+
+	now = now.Add(time.Hour)
+	d.sendDue()
+	require.Equal(t, 1, fake.sent)
+	obj := fake.objs[0]
+	assert.Equal(t, "Digest", obj["kind"])
+	assert.Equal(t, "2023-01-01T08:00:00Z", obj["since"])
+	assert.Equal(t, "2023-01-01T09:30:00Z", obj["until"])
+	events := obj["events"].([]interface{})
+	if assert.Len(t, events, 3) {
+		event := events[0].(map[string]interface{})
+		assert.Equal(t, "on-deployed", event["trigger"])
+		assert.Equal(t, "guestbook", event["app"].(map[string]interface{})["metadata"].(map[string]interface{})["name"])
+	}
+
+	records, err := store.List()
+	require.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "Digest", records[0].Kind)
+		assert.Equal(t, "daily", records[0].Name)
+		assert.Equal(t, "daily", records[0].Trigger)
+		assert.Equal(t, "management", records[0].Recipient)
+	}
+
+	// no digest is sent without events
+	now = now.Add(24 * time.Hour)
+	d.sendDue()
+	assert.Equal(t, 1, fake.sent)
+}
+
+func TestDigester_FilterDestinations(t *testing.T) {
+	d := newTestDigester(t, &fakeAPI{}, delivery.NewStore(nil, 0), nil)
+	destinations := services.Destinations{
+		"daily":       {{Service: "slack", Recipient: "management"}},
+		"on-deployed": {{Service: "slack", Recipient: "team"}},
+	}
+	assert.Equal(t, services.Destinations{
+		"on-deployed": {{Service: "slack", Recipient: "team"}},
+	}, d.filterDestinations(destinations))
+}
+
+func TestDigester_NotifiedState(t *testing.T) {
+	fake := &fakeAPI{results: []triggers.ConditionResult{{Key: "[0].rev1", Triggered: true, OncePer: "v1"}}}
+	d := newTestDigester(t, fake, delivery.NewStore(nil, 0), nil, newDigestApp("guestbook", true))
+
+	process(t, d, "guestbook")
+	assert.Len(t, d.buffers["daily"].events[services.Destination{Service: "slack", Recipient: "management"}], 1)
+
+	// the state is recorded in the annotation of the engine
+	app, err := d.appClient.Namespace("argocd").Get(context.Background(), "guestbook", v1.GetOptions{})
+	require.NoError(t, err)
+	state := controller.NewStateFromRes(app)
+	assert.Contains(t, state, "v1:digest.daily:[0].rev1:slack:management")
+
+	// conditions with oncePer are not collected again once they stopped being triggered
+	fake.results[0].Triggered = false
+	process(t, d, "guestbook")
+	fake.results[0].Triggered = true
+	process(t, d, "guestbook")
+	assert.Len(t, d.buffers["daily"].events[services.Destination{Service: "slack", Recipient: "management"}], 1)
+}
+
+func TestDigester_Save(t *testing.T) {
+	digestCache := cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour))
+	fake := &fakeAPI{results: []triggers.ConditionResult{{Key: "[0].rev1", Triggered: true}}}
+	d := newTestDigester(t, fake, delivery.NewStore(nil, 0), digestCache, newDigestApp("guestbook", true))
+	now := time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	process(t, d, "guestbook")
+	d.save()
+
+	// the events are loaded by the digester after a restart
+	restarted := newTestDigester(t, fake, delivery.NewStore(nil, 0), digestCache)
+	restarted.now = func() time.Time { return now.Add(2 * time.Hour) }
+	restarted.sendDue()
+	require.Equal(t, 1, fake.sent)
+	assert.Equal(t, "2023-01-01T08:00:00Z", fake.objs[0]["since"])
+	assert.Len(t, fake.objs[0]["events"], 1)
+}
@@ -414,6 +414,234 @@ func (m *ApplicationManifestQuery) GetAppNamespace() string {
 	return ""
 }
 
+// ApplicationRevisionDiffQuery is a query for the difference between the manifests of an application rendered at two revisions
+type ApplicationRevisionDiffQuery struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	// fromRevisions are the revisions to diff from, one per source of the application. Missing or empty revisions default to the target revision of the source.
+	FromRevisions []string `protobuf:"bytes,3,rep,name=fromRevisions" json:"fromRevisions,omitempty"`
+	// toRevisions are the revisions to diff to, one per source of the application. Missing or empty revisions default to the target revision of the source.
+	ToRevisions          []string `protobuf:"bytes,4,rep,name=toRevisions" json:"toRevisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRevisionDiffQuery) Reset()         { *m = ApplicationRevisionDiffQuery{} }
+func (m *ApplicationRevisionDiffQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevisionDiffQuery) ProtoMessage()    {}
+func (*ApplicationRevisionDiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{5}
+}
+func (m *ApplicationRevisionDiffQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationRevisionDiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationRevisionDiffQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationRevisionDiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevisionDiffQuery.Merge(m, src)
+}
+func (m *ApplicationRevisionDiffQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationRevisionDiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevisionDiffQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevisionDiffQuery proto.InternalMessageInfo
+
+func (m *ApplicationRevisionDiffQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationRevisionDiffQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationRevisionDiffQuery) GetFromRevisions() []string {
+	if m != nil {
+		return m.FromRevisions
+	}
+	return nil
+}
+
+func (m *ApplicationRevisionDiffQuery) GetToRevisions() []string {
+	if m != nil {
+		return m.ToRevisions
+	}
+	return nil
+}
+
+// ResourceRevisionDiff is the difference of a resource between the manifests of an application rendered at two revisions
+type ResourceRevisionDiff struct {
+	Group     *string `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
+	Kind      *string `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	// fromState is the resource rendered at the from revisions, empty if it is not rendered there
+	FromState *string `protobuf:"bytes,5,opt,name=fromState" json:"fromState,omitempty"`
+	// toState is the resource rendered at the to revisions, empty if it is not rendered there
+	ToState *string `protobuf:"bytes,6,opt,name=toState" json:"toState,omitempty"`
+	// diff is a JSON merge patch from the from state to the to state, empty if the resource is only rendered at one of the revisions
+	Diff                 *string  `protobuf:"bytes,7,opt,name=diff" json:"diff,omitempty"`
+	Modified             *bool    `protobuf:"varint,8,opt,name=modified" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceRevisionDiff) Reset()         { *m = ResourceRevisionDiff{} }
+func (m *ResourceRevisionDiff) String() string { return proto.CompactTextString(m) }
+func (*ResourceRevisionDiff) ProtoMessage()    {}
+func (*ResourceRevisionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{6}
+}
+func (m *ResourceRevisionDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRevisionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceRevisionDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceRevisionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRevisionDiff.Merge(m, src)
+}
+func (m *ResourceRevisionDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRevisionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRevisionDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRevisionDiff proto.InternalMessageInfo
+
+func (m *ResourceRevisionDiff) GetGroup() string {
+	if m != nil && m.Group != nil {
+		return *m.Group
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetFromState() string {
+	if m != nil && m.FromState != nil {
+		return *m.FromState
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetToState() string {
+	if m != nil && m.ToState != nil {
+		return *m.ToState
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
+func (m *ResourceRevisionDiff) GetModified() bool {
+	if m != nil && m.Modified != nil {
+		return *m.Modified
+	}
+	return false
+}
+
+type ApplicationRevisionDiffResponse struct {
+	Items                []*ResourceRevisionDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ApplicationRevisionDiffResponse) Reset()         { *m = ApplicationRevisionDiffResponse{} }
+func (m *ApplicationRevisionDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationRevisionDiffResponse) ProtoMessage()    {}
+func (*ApplicationRevisionDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{7}
+}
+func (m *ApplicationRevisionDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationRevisionDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationRevisionDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationRevisionDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRevisionDiffResponse.Merge(m, src)
+}
+func (m *ApplicationRevisionDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationRevisionDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRevisionDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRevisionDiffResponse proto.InternalMessageInfo
+
+func (m *ApplicationRevisionDiffResponse) GetItems() []*ResourceRevisionDiff {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -425,7 +653,7 @@ func (m *FileChunk) Reset()         { *m = FileChunk{} }
 func (m *FileChunk) String() string { return proto.CompactTextString(m) }
 func (*FileChunk) ProtoMessage()    {}
 func (*FileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{5}
+	return fileDescriptor_df6e82b174b5eaec, []int{8}
 }
 func (m *FileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +702,7 @@ func (m *ApplicationManifestQueryWithFiles) Reset()         { *m = ApplicationMa
 func (m *ApplicationManifestQueryWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFiles) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{6}
+	return fileDescriptor_df6e82b174b5eaec, []int{9}
 }
 func (m *ApplicationManifestQueryWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +768,7 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Reset() {
 func (m *ApplicationManifestQueryWithFilesWrapper) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFilesWrapper) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFilesWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{7}
+	return fileDescriptor_df6e82b174b5eaec, []int{10}
 }
 func (m *ApplicationManifestQueryWithFilesWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,7 +854,7 @@ func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
 func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResponse) ProtoMessage()    {}
 func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{8}
+	return fileDescriptor_df6e82b174b5eaec, []int{11}
 }
 func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +896,7 @@ func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateReque
 func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationCreateRequest) ProtoMessage()    {}
 func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{9}
+	return fileDescriptor_df6e82b174b5eaec, []int{12}
 }
 func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +958,7 @@ func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateReque
 func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateRequest) ProtoMessage()    {}
 func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{10}
+	return fileDescriptor_df6e82b174b5eaec, []int{13}
 }
 func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -787,7 +1015,7 @@ func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteReque
 func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDeleteRequest) ProtoMessage()    {}
 func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{11}
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
 }
 func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1083,7 @@ func (m *SyncOptions) Reset()         { *m = SyncOptions{} }
 func (m *SyncOptions) String() string { return proto.CompactTextString(m) }
 func (*SyncOptions) ProtoMessage()    {}
 func (*SyncOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{12}
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
 }
 func (m *SyncOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1141,7 @@ func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{}
 func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncRequest) ProtoMessage()    {}
 func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{13}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,6 +1247,71 @@ func (m *ApplicationSyncRequest) GetAppNamespace() string {
 	return ""
 }
 
+// ApplicationSyncApprovalRequest is a request to approve or reject the pending sync of an application
+type ApplicationSyncApprovalRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	// Reject rejects the pending sync instead of approving it
+	Reject               *bool    `protobuf:"varint,3,opt,name=reject" json:"reject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSyncApprovalRequest) Reset()         { *m = ApplicationSyncApprovalRequest{} }
+func (m *ApplicationSyncApprovalRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncApprovalRequest) ProtoMessage()    {}
+func (*ApplicationSyncApprovalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
+}
+func (m *ApplicationSyncApprovalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncApprovalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncApprovalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSyncApprovalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncApprovalRequest.Merge(m, src)
+}
+func (m *ApplicationSyncApprovalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncApprovalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncApprovalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSyncApprovalRequest proto.InternalMessageInfo
+
+func (m *ApplicationSyncApprovalRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSyncApprovalRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSyncApprovalRequest) GetReject() bool {
+	if m != nil && m.Reject != nil {
+		return *m.Reject
+	}
+	return false
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -1034,7 +1327,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1399,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1471,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1259,7 +1552,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1649,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1760,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1577,7 +1870,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1673,7 +1966,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1720,7 +2013,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,7 +2074,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1931,7 +2224,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2007,7 +2300,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2062,7 +2355,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2118,7 +2411,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2475,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2249,7 +2542,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2295,7 +2588,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2384,7 +2677,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2434,7 +2727,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2502,7 +2795,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2550,7 +2843,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2599,6 +2892,9 @@ func init() {
 	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
 	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
 	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
+	proto.RegisterType((*ApplicationRevisionDiffQuery)(nil), "application.ApplicationRevisionDiffQuery")
+	proto.RegisterType((*ResourceRevisionDiff)(nil), "application.ResourceRevisionDiff")
+	proto.RegisterType((*ApplicationRevisionDiffResponse)(nil), "application.ApplicationRevisionDiffResponse")
 	proto.RegisterType((*FileChunk)(nil), "application.FileChunk")
 	proto.RegisterType((*ApplicationManifestQueryWithFiles)(nil), "application.ApplicationManifestQueryWithFiles")
 	proto.RegisterType((*ApplicationManifestQueryWithFilesWrapper)(nil), "application.ApplicationManifestQueryWithFilesWrapper")
@@ -2608,6 +2904,7 @@ func init() {
 	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
 	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
 	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*ApplicationSyncApprovalRequest)(nil), "application.ApplicationSyncApprovalRequest")
 	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
 	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
 	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
@@ -2636,172 +2933,186 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x8f, 0x1c, 0x49,
-	0xd1, 0xff, 0xb2, 0xe7, 0xd5, 0x1d, 0x3d, 0x7e, 0xe5, 0xda, 0xf3, 0xd5, 0xb6, 0xc7, 0x66, 0x5c,
-	0x7e, 0x8d, 0xc7, 0x9e, 0x6e, 0xbb, 0x31, 0xc8, 0x3b, 0xbb, 0x2b, 0xb0, 0xc7, 0x4f, 0x18, 0x7b,
-	0x4d, 0x8d, 0x8d, 0xd1, 0x72, 0x80, 0xdc, 0xaa, 0x9c, 0x9e, 0x62, 0xaa, 0xab, 0xca, 0x55, 0xd5,
-	0x6d, 0x8d, 0x8c, 0x2f, 0x8b, 0xb8, 0xad, 0x16, 0x69, 0x77, 0x0f, 0x68, 0xb5, 0x42, 0x68, 0x57,
-	0x7b, 0xe1, 0xc2, 0x0d, 0x21, 0x71, 0x81, 0x0b, 0x02, 0x09, 0x24, 0xc4, 0xe3, 0x02, 0x17, 0x64,
-	0x71, 0xe3, 0xc2, 0x81, 0x3f, 0x00, 0x65, 0x56, 0x66, 0x55, 0x56, 0x77, 0x75, 0x75, 0x0d, 0x33,
-	0x68, 0x7d, 0xab, 0xc8, 0xce, 0x8c, 0xf8, 0x45, 0x64, 0x64, 0x44, 0x64, 0x64, 0xc3, 0xa9, 0x90,
-	0x06, 0x7d, 0x1a, 0xb4, 0x88, 0xef, 0x3b, 0xb6, 0x49, 0x22, 0xdb, 0x73, 0xd5, 0xef, 0xa6, 0x1f,
-	0x78, 0x91, 0x87, 0xeb, 0xca, 0x50, 0x63, 0xbe, 0xe3, 0x79, 0x1d, 0x87, 0xb6, 0x88, 0x6f, 0xb7,
-	0x88, 0xeb, 0x7a, 0x11, 0x1f, 0x0e, 0xe3, 0xa9, 0x0d, 0x7d, 0xeb, 0x4a, 0xd8, 0xb4, 0x3d, 0xfe,
-	0xab, 0xe9, 0x05, 0xb4, 0xd5, 0xbf, 0xd4, 0xea, 0x50, 0x97, 0x06, 0x24, 0xa2, 0x96, 0x98, 0x73,
-	0x39, 0x9d, 0xd3, 0x25, 0xe6, 0xa6, 0xed, 0xd2, 0x60, 0xbb, 0xe5, 0x6f, 0x75, 0xd8, 0x40, 0xd8,
-	0xea, 0xd2, 0x88, 0xe4, 0xad, 0x5a, 0xeb, 0xd8, 0xd1, 0x66, 0xef, 0xad, 0xa6, 0xe9, 0x75, 0x5b,
-	0x24, 0xe8, 0x78, 0x7e, 0xe0, 0x7d, 0x87, 0x7f, 0x2c, 0x9b, 0x56, 0xab, 0xdf, 0x4e, 0x19, 0xa8,
-	0xba, 0xf4, 0x2f, 0x11, 0xc7, 0xdf, 0x24, 0xc3, 0xdc, 0x6e, 0x8c, 0xe1, 0x16, 0x50, 0xdf, 0x13,
-	0xb6, 0xe1, 0x9f, 0x76, 0xe4, 0x05, 0xdb, 0xca, 0x67, 0xcc, 0x46, 0xff, 0x37, 0x82, 0x83, 0x57,
-	0x53, 0x79, 0x5f, 0xeb, 0xd1, 0x60, 0x1b, 0x63, 0x98, 0x74, 0x49, 0x97, 0x6a, 0x68, 0x01, 0x2d,
-	0xd6, 0x0c, 0xfe, 0x8d, 0x35, 0x98, 0x09, 0xe8, 0x46, 0x40, 0xc3, 0x4d, 0xad, 0xc2, 0x87, 0x25,
-	0x89, 0x1b, 0x50, 0x65, 0xc2, 0xa9, 0x19, 0x85, 0xda, 0xc4, 0xc2, 0xc4, 0x62, 0xcd, 0x48, 0x68,
-	0xbc, 0x08, 0x07, 0x02, 0x1a, 0x7a, 0xbd, 0xc0, 0xa4, 0x5f, 0xa7, 0x41, 0x68, 0x7b, 0xae, 0x36,
-	0xc9, 0x57, 0x0f, 0x0e, 0x33, 0x2e, 0x21, 0x75, 0xa8, 0x19, 0x79, 0x81, 0x36, 0xc5, 0xa7, 0x24,
-	0x34, 0xc3, 0xc3, 0x80, 0x6b, 0xd3, 0x31, 0x1e, 0xf6, 0x8d, 0x75, 0x98, 0x25, 0xbe, 0x7f, 0x8f,
-	0x74, 0x69, 0xe8, 0x13, 0x93, 0x6a, 0x33, 0xfc, 0xb7, 0xcc, 0x18, 0xc3, 0x2c, 0x90, 0x68, 0x55,
-	0x0e, 0x4c, 0x92, 0xfa, 0x2a, 0xd4, 0xee, 0x79, 0x16, 0x1d, 0xad, 0xee, 0x20, 0xfb, 0xca, 0x30,
-	0x7b, 0x7d, 0x0b, 0x8e, 0x18, 0xb4, 0x6f, 0x33, 0xf8, 0x77, 0x69, 0x44, 0x2c, 0x12, 0x91, 0x41,
-	0x86, 0x95, 0x84, 0x61, 0x03, 0xaa, 0x81, 0x98, 0xac, 0x55, 0xf8, 0x78, 0x42, 0x0f, 0x09, 0x9b,
-	0xc8, 0x11, 0xf6, 0x3b, 0x04, 0xc7, 0x95, 0x8d, 0x32, 0x84, 0xf9, 0x6e, 0xf4, 0xa9, 0x1b, 0x85,
-	0xa3, 0xc5, 0x5e, 0x80, 0x43, 0xd2, 0xd2, 0x83, 0xca, 0x0c, 0xff, 0xc0, 0x80, 0xa8, 0x83, 0x12,
-	0x88, 0x3a, 0x86, 0x17, 0xa0, 0x2e, 0xe9, 0x87, 0x77, 0xae, 0x8b, 0xed, 0x54, 0x87, 0x86, 0xd4,
-	0x99, 0xca, 0x51, 0xc7, 0x05, 0x4d, 0xd1, 0xe6, 0x2e, 0x71, 0xed, 0x0d, 0x1a, 0x46, 0x65, 0xcd,
-	0x87, 0x76, 0x6c, 0xbe, 0x13, 0x50, 0xbb, 0x69, 0x3b, 0x74, 0x75, 0xb3, 0xe7, 0x6e, 0xe1, 0xc3,
-	0x30, 0x65, 0xb2, 0x0f, 0x2e, 0x61, 0xd6, 0x88, 0x09, 0xfd, 0x09, 0x9c, 0x18, 0x05, 0xe9, 0x91,
-	0x1d, 0x6d, 0xb2, 0xe5, 0xe1, 0x28, 0x6c, 0xe6, 0x26, 0x35, 0xb7, 0xc2, 0x5e, 0x57, 0x6e, 0xad,
-	0xa4, 0x4b, 0x61, 0xfb, 0x09, 0x82, 0xc5, 0xb1, 0x92, 0x1f, 0x05, 0xc4, 0xf7, 0x69, 0x80, 0x6f,
-	0xc2, 0xd4, 0x63, 0xf6, 0x03, 0xf7, 0xd6, 0x7a, 0xbb, 0xd9, 0x54, 0xa3, 0xdd, 0x58, 0x2e, 0xb7,
-	0xff, 0xcf, 0x88, 0x97, 0xe3, 0xa6, 0xb4, 0x41, 0x85, 0xf3, 0x99, 0xcb, 0xf0, 0x49, 0x4c, 0xc5,
-	0xe6, 0xf3, 0x69, 0xd7, 0xa6, 0x61, 0xd2, 0x27, 0x41, 0xa4, 0x1f, 0x81, 0x97, 0xb2, 0x6e, 0xe8,
-	0x7b, 0x6e, 0x48, 0xf5, 0x5f, 0xa0, 0xcc, 0x86, 0xae, 0x06, 0x94, 0x44, 0xd4, 0xa0, 0x8f, 0x7b,
-	0x34, 0x8c, 0xf0, 0x16, 0xa8, 0x01, 0x98, 0xdb, 0xae, 0xde, 0xbe, 0xd3, 0x4c, 0x23, 0x58, 0x53,
-	0x46, 0x30, 0xfe, 0xf1, 0x2d, 0xd3, 0x6a, 0xf6, 0xdb, 0x4d, 0x7f, 0xab, 0xd3, 0x64, 0xf1, 0x30,
-	0x83, 0x4c, 0xc6, 0x43, 0x55, 0x55, 0x43, 0xe5, 0x8e, 0xe7, 0x60, 0xba, 0xe7, 0x87, 0x34, 0x88,
-	0xb8, 0x66, 0x55, 0x43, 0x50, 0x6c, 0x97, 0xfa, 0xc4, 0xb1, 0x2d, 0x12, 0xc5, 0xbb, 0x50, 0x35,
-	0x12, 0x5a, 0xff, 0x24, 0x8b, 0xfe, 0xa1, 0x6f, 0x7d, 0x56, 0xe8, 0x55, 0x94, 0x95, 0x01, 0x94,
-	0x1f, 0x66, 0x51, 0x5e, 0xa7, 0x0e, 0x4d, 0x51, 0xe6, 0x39, 0xa6, 0x06, 0x33, 0x26, 0x09, 0x4d,
-	0x62, 0x49, 0x5e, 0x92, 0x64, 0x61, 0xc1, 0x0f, 0x3c, 0x9f, 0x74, 0x38, 0xa7, 0xfb, 0x9e, 0x63,
-	0x9b, 0xdb, 0xc2, 0x37, 0x87, 0x7f, 0x18, 0x72, 0xe2, 0xc9, 0x1c, 0x27, 0x3e, 0x09, 0xf5, 0xf5,
-	0x6d, 0xd7, 0x7c, 0xc3, 0xe7, 0xc9, 0x94, 0x1d, 0x31, 0x3b, 0xa2, 0xdd, 0x50, 0x43, 0x3c, 0xf0,
-	0xc6, 0x84, 0xfe, 0xd1, 0x14, 0xcc, 0x29, 0x1a, 0xb0, 0x05, 0x45, 0xf8, 0x8b, 0x0e, 0xfd, 0x1c,
-	0x4c, 0x5b, 0xc1, 0xb6, 0xd1, 0x73, 0xc5, 0x66, 0x0a, 0x8a, 0x09, 0xf6, 0x83, 0x9e, 0x1b, 0x83,
-	0xac, 0x1a, 0x31, 0x81, 0x37, 0xa0, 0x1a, 0x46, 0x2c, 0x7d, 0x76, 0xb6, 0x79, 0x38, 0xaa, 0xb7,
-	0xbf, 0xb2, 0xbb, 0x0d, 0x64, 0xd0, 0xd7, 0x05, 0x47, 0x23, 0xe1, 0x8d, 0x1f, 0x43, 0x4d, 0x46,
-	0xc2, 0x50, 0x9b, 0x59, 0x98, 0x58, 0xac, 0xb7, 0xd7, 0x77, 0x2f, 0xe8, 0x0d, 0x9f, 0xa5, 0x7e,
-	0x25, 0xea, 0x1b, 0xa9, 0x14, 0x3c, 0x0f, 0xb5, 0xae, 0x38, 0xeb, 0xa1, 0x48, 0x73, 0xe9, 0x00,
-	0xfe, 0x06, 0x4c, 0xd9, 0xee, 0x86, 0x17, 0x6a, 0x35, 0x0e, 0xe6, 0xda, 0xee, 0xc0, 0xdc, 0x71,
-	0x37, 0x3c, 0x23, 0x66, 0x88, 0x1f, 0xc3, 0xbe, 0x80, 0x46, 0xc1, 0xb6, 0xb4, 0x82, 0x06, 0xdc,
-	0xae, 0x5f, 0xdd, 0x9d, 0x04, 0x43, 0x65, 0x69, 0x64, 0x25, 0xe0, 0x15, 0xa8, 0x87, 0xa9, 0x8f,
-	0x69, 0x75, 0x2e, 0x50, 0xcb, 0x30, 0x52, 0x7c, 0xd0, 0x50, 0x27, 0x0f, 0xf9, 0xf0, 0x6c, 0x8e,
-	0x0f, 0xff, 0x05, 0xc1, 0xfc, 0x50, 0x18, 0x58, 0xf7, 0x69, 0xa1, 0x93, 0x12, 0x98, 0x0c, 0x7d,
-	0x6a, 0xf2, 0xc8, 0x5f, 0x6f, 0xdf, 0xdd, 0xb3, 0xb8, 0xc0, 0xe5, 0x72, 0xd6, 0x45, 0xa1, 0xab,
-	0xd4, 0xd9, 0xfc, 0x3e, 0x82, 0xff, 0x57, 0x38, 0xdf, 0x27, 0x91, 0xb9, 0x59, 0xa4, 0x12, 0x3b,
-	0x43, 0x6c, 0x8e, 0xc8, 0x66, 0x31, 0xc1, 0x1c, 0x8d, 0x7f, 0x3c, 0xd8, 0xf6, 0x19, 0x0c, 0xf6,
-	0x4b, 0x3a, 0x50, 0x2a, 0xe9, 0xbf, 0x87, 0xa0, 0xa1, 0x46, 0x3e, 0xcf, 0x71, 0xde, 0x22, 0xe6,
-	0x56, 0x11, 0x94, 0xfd, 0x50, 0xb1, 0x2d, 0x8e, 0x63, 0xc2, 0xa8, 0xd8, 0xd6, 0x0e, 0x8f, 0xfd,
-	0x20, 0xa8, 0xe9, 0x1c, 0x50, 0x7f, 0x1d, 0x00, 0x25, 0x8f, 0x58, 0x01, 0xa8, 0x79, 0xa8, 0xb9,
-	0x03, 0xc5, 0x54, 0x3a, 0x90, 0x53, 0x44, 0x55, 0x86, 0x8a, 0x28, 0x0d, 0x66, 0xfa, 0x49, 0x3d,
-	0xcc, 0x7e, 0x96, 0x24, 0x53, 0xa4, 0x13, 0x78, 0x3d, 0x5f, 0x18, 0x30, 0x26, 0x18, 0x8a, 0x2d,
-	0xdb, 0xb5, 0xb4, 0xe9, 0x18, 0x05, 0xfb, 0x2e, 0x53, 0x01, 0xeb, 0xef, 0x57, 0xe0, 0x73, 0x39,
-	0xca, 0x8d, 0xf5, 0x80, 0x17, 0x43, 0xc3, 0xc4, 0x0f, 0x67, 0x46, 0xfa, 0x61, 0x75, 0x9c, 0x1f,
-	0xd6, 0x72, 0xac, 0xf2, 0x6e, 0x05, 0x16, 0x72, 0xac, 0x32, 0x3e, 0xa1, 0xbe, 0x30, 0x66, 0xd9,
-	0xf0, 0x02, 0xb1, 0xe3, 0x55, 0x23, 0x26, 0xd8, 0xc9, 0xf0, 0x02, 0x7f, 0x93, 0xb8, 0x5a, 0x35,
-	0x3e, 0x19, 0x31, 0x55, 0xca, 0x20, 0xff, 0x42, 0xa0, 0x49, 0x2b, 0x5c, 0x35, 0xb9, 0x4d, 0x7a,
-	0xee, 0x8b, 0x6f, 0x88, 0x39, 0x98, 0x26, 0x1c, 0xad, 0x70, 0x10, 0x41, 0x0d, 0xa9, 0x5c, 0xcd,
-	0x8f, 0x89, 0x47, 0xb3, 0x2a, 0x87, 0x6b, 0x76, 0x18, 0xc9, 0x82, 0x16, 0x6f, 0xc0, 0x4c, 0xcc,
-	0x2d, 0x2e, 0x61, 0xea, 0xed, 0xb5, 0xdd, 0x26, 0xb6, 0x8c, 0x79, 0x25, 0x73, 0xfd, 0x15, 0x38,
-	0x9a, 0x1b, 0x7d, 0x04, 0x8c, 0x06, 0x54, 0x65, 0x32, 0x17, 0x1b, 0x90, 0xd0, 0xfa, 0x3f, 0x27,
-	0xb2, 0x61, 0xdd, 0xb3, 0xd6, 0xbc, 0x4e, 0xc1, 0x5d, 0xb0, 0x78, 0xd3, 0xd8, 0x65, 0xd9, 0xb3,
-	0x94, 0x6b, 0x9f, 0x24, 0xd9, 0x3a, 0xd3, 0x73, 0x23, 0x62, 0xbb, 0x34, 0x10, 0xf9, 0x25, 0x1d,
-	0x60, 0xc6, 0x0e, 0x6d, 0xd7, 0xa4, 0xeb, 0xd4, 0xf4, 0x5c, 0x2b, 0xe4, 0xbb, 0x36, 0x61, 0x64,
-	0xc6, 0xf0, 0x6d, 0xa8, 0x71, 0xfa, 0x81, 0xdd, 0x8d, 0x83, 0x70, 0xbd, 0xbd, 0xd4, 0x8c, 0x9b,
-	0x28, 0x4d, 0xb5, 0x89, 0x92, 0xda, 0xb0, 0x4b, 0x23, 0xd2, 0xec, 0x5f, 0x6a, 0xb2, 0x15, 0x46,
-	0xba, 0x98, 0x61, 0x89, 0x88, 0xed, 0xac, 0xd9, 0x2e, 0x2f, 0xb0, 0x98, 0xa8, 0x74, 0x80, 0x39,
-	0xc4, 0x86, 0xe7, 0x38, 0xde, 0x13, 0x79, 0x06, 0x62, 0x8a, 0xad, 0xea, 0xb9, 0x91, 0xed, 0x70,
-	0xf9, 0xf1, 0x01, 0x48, 0x07, 0xf8, 0x2a, 0xdb, 0x89, 0x68, 0xc0, 0x4b, 0x98, 0x9a, 0x21, 0xa8,
-	0xc4, 0xe5, 0xea, 0x71, 0x5f, 0x40, 0x9e, 0xbd, 0xd8, 0x39, 0x67, 0x55, 0xe7, 0x1c, 0x74, 0xf8,
-	0x7d, 0x39, 0xf7, 0x66, 0xde, 0x26, 0xa1, 0x7d, 0xdb, 0xeb, 0x85, 0xda, 0xfe, 0x38, 0x89, 0x4b,
-	0x7a, 0xc8, 0x61, 0x0f, 0xe4, 0x38, 0xec, 0x2f, 0x11, 0x54, 0xd7, 0xbc, 0xce, 0x0d, 0x37, 0x0a,
-	0xb6, 0x79, 0x65, 0xef, 0xb9, 0x11, 0x75, 0xa5, 0x57, 0x48, 0x92, 0x99, 0x3a, 0xb2, 0xbb, 0x74,
-	0x3d, 0x22, 0x5d, 0x5f, 0xd4, 0x24, 0x3b, 0x32, 0x75, 0xb2, 0x98, 0xa9, 0xef, 0x90, 0x30, 0xe2,
-	0xa7, 0xb7, 0x6a, 0xf0, 0x6f, 0x06, 0x34, 0x99, 0xb0, 0x1e, 0x05, 0xe2, 0xe8, 0x66, 0xc6, 0x54,
-	0x47, 0x9a, 0x8a, 0xb1, 0x09, 0x52, 0x5f, 0x87, 0x97, 0x93, 0x52, 0xf6, 0x01, 0x0d, 0xba, 0xb6,
-	0x4b, 0x8a, 0xe3, 0x6d, 0x99, 0x2e, 0xcc, 0xc3, 0xcc, 0x01, 0x62, 0xf5, 0xdf, 0x23, 0xdb, 0xb5,
-	0xbc, 0x27, 0x05, 0x07, 0xa1, 0x0c, 0xdb, 0x3f, 0x66, 0xfb, 0x2d, 0x0a, 0xdf, 0xe4, 0x6c, 0xde,
-	0x86, 0x7d, 0xec, 0x14, 0xf7, 0xa9, 0xf8, 0x41, 0x04, 0x0a, 0x7d, 0xd4, 0x95, 0x3c, 0xe5, 0x61,
-	0x64, 0x17, 0xe2, 0x35, 0x38, 0x40, 0xc2, 0xd0, 0xee, 0xb8, 0xd4, 0x92, 0xbc, 0x2a, 0xa5, 0x79,
-	0x0d, 0x2e, 0x8d, 0xaf, 0x7d, 0x7c, 0x86, 0xd8, 0x3b, 0x49, 0xea, 0xdf, 0x43, 0x70, 0x24, 0x97,
-	0x49, 0xe2, 0xeb, 0x48, 0x09, 0xaf, 0x0d, 0xa8, 0x86, 0xe6, 0x26, 0xb5, 0x7a, 0x0e, 0x95, 0x7d,
-	0x0d, 0x49, 0xb3, 0xdf, 0xac, 0x5e, 0xbc, 0x93, 0x22, 0xbc, 0x27, 0x34, 0x3e, 0x0e, 0xd0, 0x25,
-	0x6e, 0x8f, 0x38, 0x1c, 0xc2, 0x24, 0x87, 0xa0, 0x8c, 0xe8, 0xf3, 0xd0, 0xc8, 0x73, 0x03, 0xd1,
-	0x49, 0xf8, 0x33, 0x82, 0xfd, 0x32, 0x0c, 0x8a, 0x3d, 0x5c, 0x84, 0x03, 0x8a, 0x19, 0xee, 0xa5,
-	0xdb, 0x39, 0x38, 0x3c, 0x26, 0xc4, 0x49, 0x5f, 0x98, 0xc8, 0xf6, 0x35, 0xfb, 0x99, 0xce, 0x64,
-	0xe9, 0x3c, 0x84, 0x76, 0x54, 0x89, 0x7d, 0x17, 0xb4, 0xbb, 0xc4, 0x25, 0x1d, 0x6a, 0x25, 0xca,
-	0x25, 0x8e, 0xf4, 0x6d, 0xf5, 0xb2, 0xbc, 0xeb, 0xab, 0x69, 0x52, 0xce, 0xd8, 0x1b, 0x1b, 0xf2,
-	0xe2, 0x1d, 0x40, 0x75, 0xcd, 0x76, 0xb7, 0xd8, 0xfd, 0x8d, 0xe9, 0x15, 0xd9, 0x91, 0x23, 0x6d,
-	0x18, 0x13, 0xf8, 0x20, 0x4c, 0xf4, 0x02, 0x47, 0xec, 0x33, 0xfb, 0xc4, 0x0b, 0x50, 0xb7, 0x68,
-	0x68, 0x06, 0xb6, 0x2f, 0x76, 0x99, 0x37, 0xfa, 0x94, 0x21, 0x66, 0x6d, 0xdb, 0xf4, 0xdc, 0x55,
-	0x87, 0x84, 0xa1, 0x4c, 0x0c, 0xc9, 0x80, 0xfe, 0x1a, 0xec, 0x63, 0x32, 0x53, 0x35, 0xcf, 0x67,
-	0xd5, 0x3c, 0x92, 0x81, 0x2f, 0xe1, 0x49, 0xc4, 0xb7, 0xe0, 0x25, 0x96, 0x8f, 0xaf, 0xfa, 0xbe,
-	0x60, 0x52, 0xb2, 0x18, 0x99, 0x18, 0xd8, 0xf4, 0xf6, 0xdf, 0x4e, 0x02, 0x56, 0x7d, 0x9e, 0x06,
-	0x7d, 0xdb, 0xa4, 0xf8, 0x3d, 0x04, 0x93, 0x4c, 0x00, 0x3e, 0x36, 0xea, 0x88, 0x71, 0xdf, 0x6b,
-	0xec, 0xdd, 0x85, 0x8e, 0x49, 0xd3, 0xe7, 0xdf, 0xfe, 0xd3, 0x3f, 0xde, 0xaf, 0xcc, 0xe1, 0xc3,
-	0xfc, 0x81, 0xa1, 0x7f, 0x49, 0x6d, 0xf6, 0x87, 0xf8, 0x1d, 0x04, 0x58, 0x54, 0x21, 0x4a, 0x77,
-	0x17, 0x9f, 0x1f, 0x05, 0x31, 0xa7, 0x0b, 0xdc, 0x38, 0xa6, 0x44, 0xfb, 0xa6, 0xe9, 0x05, 0x94,
-	0xc5, 0x76, 0x3e, 0x81, 0x03, 0x58, 0xe2, 0x00, 0x4e, 0x61, 0x3d, 0x0f, 0x40, 0xeb, 0x29, 0xb3,
-	0xdb, 0xb3, 0x16, 0x8d, 0xe5, 0x7e, 0x8c, 0x60, 0xea, 0x11, 0xaf, 0xb9, 0xc7, 0x18, 0x69, 0x7d,
-	0xcf, 0x8c, 0xc4, 0xc5, 0x71, 0xb4, 0xfa, 0x49, 0x8e, 0xf4, 0x18, 0x3e, 0x2a, 0x91, 0x86, 0x51,
-	0x40, 0x49, 0x37, 0x03, 0xf8, 0x22, 0xc2, 0x9f, 0x22, 0x98, 0x8e, 0xdb, 0x8d, 0xf8, 0xf4, 0x28,
-	0x94, 0x99, 0x76, 0x64, 0x63, 0xef, 0x7a, 0x77, 0xfa, 0x39, 0x8e, 0xf1, 0xa4, 0x9e, 0xbb, 0x9d,
-	0x2b, 0x99, 0xce, 0xde, 0x07, 0x08, 0x26, 0x6e, 0xd1, 0xb1, 0xfe, 0xb6, 0x87, 0xe0, 0x86, 0x0c,
-	0x98, 0xb3, 0xd5, 0xf8, 0x13, 0x04, 0x2f, 0xdf, 0xa2, 0x51, 0x7e, 0xaa, 0xc3, 0x8b, 0xe3, 0xf3,
-	0x8f, 0x70, 0xbb, 0xf3, 0x25, 0x66, 0x26, 0x31, 0xbe, 0xc5, 0x91, 0x9d, 0xc3, 0x67, 0x8b, 0x9c,
-	0x30, 0xdc, 0x76, 0xcd, 0x27, 0x02, 0xc7, 0x6f, 0x11, 0x1c, 0x1c, 0x7c, 0x6b, 0xc1, 0xd9, 0xe4,
-	0x98, 0xfb, 0x14, 0xd3, 0xb8, 0xb7, 0xdb, 0x58, 0x9a, 0x65, 0xaa, 0x5f, 0xe5, 0xc8, 0x5f, 0xc5,
-	0xaf, 0x14, 0x21, 0x97, 0x4d, 0xca, 0xb0, 0xf5, 0x54, 0x7e, 0x3e, 0xe3, 0xcf, 0x82, 0x1c, 0xf6,
-	0xef, 0x11, 0x1c, 0x96, 0x7c, 0x57, 0x37, 0x49, 0x10, 0x5d, 0xa7, 0xac, 0x82, 0x0d, 0x4b, 0xe9,
-	0xb3, 0xcb, 0xdc, 0xa0, 0xca, 0xd3, 0x6f, 0x70, 0x5d, 0xbe, 0x84, 0x5f, 0xdf, 0xb1, 0x2e, 0x26,
-	0x63, 0x63, 0x09, 0xd8, 0x6f, 0x23, 0x98, 0xbd, 0x45, 0xa3, 0xbb, 0x49, 0xcf, 0xf1, 0x74, 0xa9,
-	0x37, 0x89, 0xc6, 0x7c, 0x53, 0x79, 0x8d, 0x94, 0x3f, 0x25, 0x2e, 0xb2, 0xcc, 0xc1, 0x9d, 0xc5,
-	0xa7, 0x8b, 0xc0, 0xa5, 0x7d, 0xce, 0x8f, 0x11, 0x1c, 0x51, 0x41, 0xa4, 0x2f, 0x36, 0x5f, 0xd8,
-	0xd9, 0x0b, 0x89, 0x78, 0x67, 0x19, 0x83, 0xae, 0xcd, 0xd1, 0x5d, 0xd0, 0xf3, 0x1d, 0xb8, 0x3b,
-	0x84, 0x62, 0x05, 0x2d, 0x2d, 0x22, 0xfc, 0x2b, 0x04, 0xd3, 0x71, 0x53, 0x71, 0xb4, 0x8d, 0x32,
-	0x6f, 0x0f, 0x7b, 0x19, 0x0d, 0xc4, 0x6e, 0x37, 0x2e, 0xe6, 0x1b, 0x54, 0x5d, 0x2f, 0x5d, 0xb5,
-	0xc9, 0xad, 0x9c, 0x0d, 0x63, 0x3f, 0x43, 0x00, 0x69, 0x63, 0x14, 0x9f, 0x2b, 0xd6, 0x43, 0x69,
-	0x9e, 0x36, 0xf6, 0xb6, 0x35, 0xaa, 0x37, 0xb9, 0x3e, 0x8b, 0x8d, 0x85, 0xc2, 0x18, 0xe2, 0x53,
-	0x73, 0x25, 0x6e, 0xa2, 0xfe, 0x18, 0xc1, 0x14, 0xef, 0x7b, 0xe1, 0x53, 0xa3, 0x30, 0xab, 0x6d,
-	0xb1, 0xbd, 0x34, 0xfd, 0x19, 0x0e, 0x75, 0xa1, 0x5d, 0x14, 0x88, 0x57, 0xd0, 0x12, 0xee, 0xc3,
-	0x74, 0xdc, 0x83, 0x1a, 0xed, 0x1e, 0x99, 0x1e, 0x55, 0x63, 0xa1, 0xa0, 0x30, 0x88, 0x1d, 0x55,
-	0xe4, 0x80, 0xa5, 0x71, 0x39, 0x60, 0x92, 0x85, 0x69, 0x7c, 0xb2, 0x28, 0x88, 0xff, 0x0f, 0x0c,
-	0x73, 0x9e, 0xa3, 0x3b, 0xad, 0x2f, 0x8c, 0xcb, 0x03, 0xcc, 0x3a, 0x3f, 0x44, 0x70, 0x70, 0xb0,
-	0x84, 0xc6, 0x47, 0x07, 0x62, 0xa6, 0x7a, 0x6f, 0x68, 0x64, 0xad, 0x38, 0xaa, 0xfc, 0xd6, 0xbf,
-	0xcc, 0x51, 0xac, 0xe0, 0x2b, 0x63, 0x4f, 0xc6, 0x3d, 0x19, 0x75, 0x18, 0xa3, 0xe5, 0xf4, 0x0d,
-	0xe6, 0xe7, 0x08, 0x66, 0x25, 0xdf, 0x07, 0x01, 0xa5, 0xc5, 0xb0, 0xf6, 0xee, 0x20, 0x30, 0x59,
-	0xfa, 0x6b, 0x1c, 0xfe, 0x17, 0xf1, 0xe5, 0x92, 0xf0, 0x25, 0xec, 0xe5, 0x88, 0x21, 0xfd, 0x35,
-	0x82, 0x43, 0x8f, 0x62, 0xbf, 0xff, 0x8c, 0xf0, 0xaf, 0x72, 0xfc, 0xaf, 0xe3, 0x57, 0x0b, 0xea,
-	0xbc, 0x71, 0x6a, 0x5c, 0x44, 0xf8, 0xa7, 0x08, 0xaa, 0xf2, 0x45, 0x01, 0x9f, 0x1d, 0x79, 0x30,
-	0xb2, 0x6f, 0x0e, 0x7b, 0xe9, 0xcc, 0xa2, 0xa8, 0xd1, 0x4f, 0x15, 0xa6, 0x53, 0x21, 0x9f, 0x39,
-	0xf4, 0x07, 0x08, 0x70, 0x72, 0xff, 0x4d, 0x6e, 0xc4, 0xf8, 0x4c, 0x46, 0xd4, 0xc8, 0x86, 0x49,
-	0xe3, 0xec, 0xd8, 0x79, 0xd9, 0x54, 0xba, 0x54, 0x98, 0x4a, 0xbd, 0x44, 0xfe, 0xbb, 0x08, 0xea,
-	0xb7, 0x68, 0x72, 0x07, 0x29, 0xb0, 0x65, 0xf6, 0xa9, 0xa4, 0xb1, 0x38, 0x7e, 0xa2, 0x40, 0x74,
-	0x81, 0x23, 0x3a, 0x83, 0x8b, 0x4d, 0x25, 0x01, 0x7c, 0x84, 0x60, 0xdf, 0x7d, 0xd5, 0x45, 0xf1,
-	0x85, 0x71, 0x92, 0x32, 0x91, 0xbc, 0x3c, 0xae, 0xcf, 0x73, 0x5c, 0xcb, 0x7a, 0x29, 0x5c, 0x2b,
-	0xe2, 0x3d, 0xe2, 0x47, 0x28, 0xbe, 0xaa, 0x0e, 0x74, 0x93, 0xff, 0x5b, 0xbb, 0x15, 0x34, 0xa5,
-	0xf5, 0xcb, 0x1c, 0x5f, 0x13, 0x5f, 0x28, 0x83, 0xaf, 0x25, 0x5a, 0xcc, 0xf8, 0x43, 0x04, 0x87,
-	0x78, 0x3f, 0x5f, 0x65, 0x3c, 0x90, 0x62, 0x46, 0x75, 0xff, 0x4b, 0xa4, 0x18, 0x11, 0x7f, 0xf4,
-	0x1d, 0x81, 0x5a, 0x91, 0xbd, 0xfa, 0x1f, 0x20, 0xd8, 0x2f, 0x93, 0x9a, 0xd8, 0xdd, 0xe5, 0x71,
-	0x86, 0xdb, 0x69, 0x12, 0x14, 0xee, 0xb6, 0x54, 0xce, 0xdd, 0x3e, 0x45, 0x30, 0x23, 0x7a, 0xe9,
-	0x05, 0xa5, 0x82, 0xd2, 0x6c, 0x6f, 0x0c, 0x74, 0x32, 0x44, 0x93, 0x56, 0xff, 0x26, 0x17, 0xfb,
-	0x10, 0xb7, 0x8a, 0xc4, 0xfa, 0x9e, 0x15, 0xb6, 0x9e, 0x8a, 0x0e, 0xe9, 0xb3, 0x96, 0xe3, 0x75,
-	0xc2, 0x37, 0x75, 0x5c, 0x98, 0x10, 0xd9, 0x9c, 0x8b, 0x08, 0x47, 0x50, 0x63, 0xce, 0xc1, 0xdb,
-	0x23, 0x78, 0x61, 0xa0, 0x99, 0x32, 0xd4, 0x39, 0x69, 0x34, 0x86, 0xda, 0x2d, 0x69, 0x06, 0x14,
-	0xd7, 0x58, 0x7c, 0xa2, 0x50, 0x2c, 0x17, 0xf4, 0x0e, 0x82, 0x43, 0xaa, 0xb7, 0xc7, 0xe2, 0x4b,
-	0xfb, 0x7a, 0x11, 0x0a, 0x51, 0x54, 0xe3, 0xa5, 0x52, 0x8e, 0xc4, 0xe1, 0x5c, 0xbb, 0xf9, 0x9b,
-	0xe7, 0xc7, 0xd1, 0x1f, 0x9e, 0x1f, 0x47, 0x7f, 0x7f, 0x7e, 0x1c, 0xbd, 0x79, 0xa5, 0xdc, 0x5f,
-	0x2c, 0x4d, 0xc7, 0xa6, 0x6e, 0xa4, 0xb2, 0xff, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x97,
-	0xe3, 0x09, 0x48, 0x2a, 0x00, 0x00,
+	// 2852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x8f, 0x1c, 0x47,
+	0xf5, 0xff, 0xd6, 0xec, 0xaf, 0x99, 0x37, 0xbb, 0xfe, 0x51, 0x89, 0xf7, 0xdb, 0x19, 0x6f, 0xcc,
+	0xb8, 0xfd, 0x6b, 0xb2, 0xde, 0x9d, 0xb1, 0x97, 0x00, 0xce, 0x26, 0x11, 0xd8, 0xeb, 0x9f, 0x61,
+	0xed, 0x98, 0x5e, 0x1b, 0x23, 0x73, 0x80, 0x4e, 0x77, 0xcd, 0x6c, 0xb3, 0x3d, 0xdd, 0xed, 0xee,
+	0x9e, 0xb1, 0x56, 0xc6, 0x97, 0x20, 0x6e, 0x51, 0x90, 0x92, 0x1c, 0x20, 0x8a, 0x22, 0x94, 0x28,
+	0x17, 0x2e, 0x5c, 0x10, 0x42, 0x70, 0x81, 0x0b, 0x02, 0x09, 0x24, 0xc4, 0x8f, 0x4b, 0x4e, 0xc8,
+	0xe2, 0xc6, 0x85, 0x03, 0x7f, 0x00, 0xaa, 0xea, 0xaa, 0xee, 0xea, 0x99, 0x9e, 0x9e, 0x5e, 0x76,
+	0x50, 0x7c, 0xab, 0x57, 0x53, 0xf5, 0xde, 0xa7, 0x5e, 0xbd, 0x7a, 0xef, 0xf5, 0x7b, 0x03, 0x27,
+	0x03, 0xe2, 0xf7, 0x89, 0xdf, 0xd2, 0x3d, 0xcf, 0xb6, 0x0c, 0x3d, 0xb4, 0x5c, 0x47, 0x1e, 0x37,
+	0x3d, 0xdf, 0x0d, 0x5d, 0x5c, 0x95, 0xa6, 0x6a, 0x4b, 0x1d, 0xd7, 0xed, 0xd8, 0xa4, 0xa5, 0x7b,
+	0x56, 0x4b, 0x77, 0x1c, 0x37, 0x64, 0xd3, 0x41, 0xb4, 0xb4, 0xa6, 0xee, 0x5c, 0x08, 0x9a, 0x96,
+	0xcb, 0x7e, 0x35, 0x5c, 0x9f, 0xb4, 0xfa, 0xe7, 0x5b, 0x1d, 0xe2, 0x10, 0x5f, 0x0f, 0x89, 0xc9,
+	0xd7, 0xbc, 0x98, 0xac, 0xe9, 0xea, 0xc6, 0xb6, 0xe5, 0x10, 0x7f, 0xb7, 0xe5, 0xed, 0x74, 0xe8,
+	0x44, 0xd0, 0xea, 0x92, 0x50, 0xcf, 0xda, 0xb5, 0xd9, 0xb1, 0xc2, 0xed, 0xde, 0x1b, 0x4d, 0xc3,
+	0xed, 0xb6, 0x74, 0xbf, 0xe3, 0x7a, 0xbe, 0xfb, 0x1d, 0x36, 0x58, 0x35, 0xcc, 0x56, 0x7f, 0x2d,
+	0x61, 0x20, 0x9f, 0xa5, 0x7f, 0x5e, 0xb7, 0xbd, 0x6d, 0x7d, 0x98, 0xdb, 0x95, 0x31, 0xdc, 0x7c,
+	0xe2, 0xb9, 0x5c, 0x37, 0x6c, 0x68, 0x85, 0xae, 0xbf, 0x2b, 0x0d, 0x23, 0x36, 0xea, 0xbf, 0x11,
+	0x1c, 0xba, 0x98, 0xc8, 0xfb, 0x5a, 0x8f, 0xf8, 0xbb, 0x18, 0xc3, 0xb4, 0xa3, 0x77, 0x89, 0x82,
+	0xea, 0xa8, 0x51, 0xd1, 0xd8, 0x18, 0x2b, 0x30, 0xe7, 0x93, 0xb6, 0x4f, 0x82, 0x6d, 0xa5, 0xc4,
+	0xa6, 0x05, 0x89, 0x6b, 0x50, 0xa6, 0xc2, 0x89, 0x11, 0x06, 0xca, 0x54, 0x7d, 0xaa, 0x51, 0xd1,
+	0x62, 0x1a, 0x37, 0xe0, 0xa0, 0x4f, 0x02, 0xb7, 0xe7, 0x1b, 0xe4, 0xeb, 0xc4, 0x0f, 0x2c, 0xd7,
+	0x51, 0xa6, 0xd9, 0xee, 0xc1, 0x69, 0xca, 0x25, 0x20, 0x36, 0x31, 0x42, 0xd7, 0x57, 0x66, 0xd8,
+	0x92, 0x98, 0xa6, 0x78, 0x28, 0x70, 0x65, 0x36, 0xc2, 0x43, 0xc7, 0x58, 0x85, 0x79, 0xdd, 0xf3,
+	0x6e, 0xe9, 0x5d, 0x12, 0x78, 0xba, 0x41, 0x94, 0x39, 0xf6, 0x5b, 0x6a, 0x8e, 0x62, 0xe6, 0x48,
+	0x94, 0x32, 0x03, 0x26, 0x48, 0x75, 0x03, 0x2a, 0xb7, 0x5c, 0x93, 0x8c, 0x3e, 0xee, 0x20, 0xfb,
+	0xd2, 0x30, 0x7b, 0x75, 0x07, 0x8e, 0x68, 0xa4, 0x6f, 0x51, 0xf8, 0x37, 0x49, 0xa8, 0x9b, 0x7a,
+	0xa8, 0x0f, 0x32, 0x2c, 0xc5, 0x0c, 0x6b, 0x50, 0xf6, 0xf9, 0x62, 0xa5, 0xc4, 0xe6, 0x63, 0x7a,
+	0x48, 0xd8, 0x54, 0x86, 0xb0, 0x3f, 0x20, 0x38, 0x26, 0x5d, 0x94, 0xc6, 0xd5, 0x77, 0xa5, 0x4f,
+	0x9c, 0x30, 0x18, 0x2d, 0x76, 0x05, 0x0e, 0x0b, 0x4d, 0x0f, 0x1e, 0x66, 0xf8, 0x07, 0x0a, 0x44,
+	0x9e, 0x14, 0x40, 0xe4, 0x39, 0x5c, 0x87, 0xaa, 0xa0, 0xef, 0xde, 0xb8, 0xcc, 0xaf, 0x53, 0x9e,
+	0x1a, 0x3a, 0xce, 0x4c, 0xc6, 0x71, 0x1c, 0x50, 0xa4, 0xd3, 0xdc, 0xd4, 0x1d, 0xab, 0x4d, 0x82,
+	0xb0, 0xa8, 0xfa, 0xd0, 0x9e, 0xd5, 0xf7, 0x21, 0x82, 0xa5, 0x94, 0xfa, 0xa2, 0xbd, 0x97, 0xad,
+	0x76, 0x7b, 0xb4, 0xd0, 0x02, 0x46, 0x80, 0x4f, 0xc2, 0x42, 0xdb, 0x77, 0xbb, 0x82, 0xa1, 0x78,
+	0x02, 0xe9, 0x49, 0xaa, 0xb4, 0xd0, 0x4d, 0xd6, 0x4c, 0xb3, 0x35, 0xf2, 0x94, 0xfa, 0x29, 0x82,
+	0x67, 0xc5, 0xa5, 0xca, 0xe8, 0xf0, 0xb3, 0x30, 0xd3, 0xf1, 0xdd, 0x9e, 0xc7, 0xcd, 0x33, 0x22,
+	0x28, 0xdc, 0x1d, 0xcb, 0x31, 0x39, 0x24, 0x36, 0xc6, 0x4b, 0x50, 0x71, 0x06, 0x94, 0x90, 0x4c,
+	0xc4, 0x07, 0x9c, 0x96, 0xac, 0x7c, 0x09, 0x2a, 0x14, 0xe7, 0x56, 0xa8, 0x87, 0xe2, 0x9a, 0x92,
+	0x09, 0xfa, 0x7c, 0x42, 0x37, 0xfa, 0x2d, 0x7a, 0x79, 0x82, 0xa4, 0xbc, 0x4c, 0xab, 0xdd, 0xe6,
+	0x8f, 0x8e, 0x8d, 0xe9, 0x0d, 0x75, 0x5d, 0xd3, 0x6a, 0x5b, 0xc4, 0x54, 0xca, 0x75, 0xd4, 0x28,
+	0x6b, 0x31, 0xad, 0xde, 0x87, 0xcf, 0x8d, 0x50, 0xbe, 0x46, 0x02, 0xcf, 0x75, 0x02, 0x82, 0xbf,
+	0x04, 0x33, 0x56, 0x48, 0xba, 0x81, 0x82, 0xea, 0x53, 0x8d, 0xea, 0xda, 0xf1, 0xa6, 0xec, 0xc5,
+	0xb3, 0x14, 0xa3, 0x45, 0xeb, 0xd5, 0xe3, 0x50, 0xb9, 0x6a, 0xd9, 0x64, 0x63, 0xbb, 0xe7, 0xec,
+	0x50, 0x65, 0x19, 0x74, 0xc0, 0xae, 0x71, 0x5e, 0x8b, 0x08, 0xf5, 0x21, 0x1c, 0x1f, 0x65, 0x6c,
+	0xf7, 0xac, 0x70, 0x9b, 0x6e, 0x0f, 0x46, 0x59, 0x9d, 0xb1, 0x4d, 0x8c, 0x9d, 0xa0, 0xd7, 0x15,
+	0x8f, 0x56, 0xd0, 0x85, 0xac, 0xee, 0x27, 0x08, 0x1a, 0x63, 0x25, 0xdf, 0xf3, 0x75, 0xcf, 0x23,
+	0x3e, 0xbe, 0x0a, 0x33, 0x0f, 0xe8, 0x0f, 0xec, 0xa2, 0xab, 0x6b, 0xcd, 0x94, 0x06, 0xc6, 0x72,
+	0xb9, 0xfe, 0x7f, 0x5a, 0xb4, 0x1d, 0x37, 0x85, 0x0e, 0x4a, 0x8c, 0xcf, 0x62, 0x8a, 0x4f, 0xac,
+	0x2a, 0xba, 0x9e, 0x2d, 0xbb, 0x34, 0x0b, 0xd3, 0x9e, 0xee, 0x87, 0xea, 0x11, 0x78, 0x26, 0xed,
+	0x60, 0xd8, 0xc5, 0xa8, 0xbf, 0x42, 0xa9, 0xa7, 0xba, 0xe1, 0x13, 0x3d, 0x24, 0x1a, 0x79, 0xd0,
+	0x23, 0x41, 0x88, 0x77, 0x40, 0x0e, 0xad, 0x4c, 0x77, 0xd5, 0xb5, 0x1b, 0xcd, 0x24, 0x36, 0x35,
+	0x45, 0x6c, 0x62, 0x83, 0x6f, 0x19, 0x66, 0xb3, 0xbf, 0xd6, 0xf4, 0x76, 0x3a, 0x4d, 0x1a, 0xe9,
+	0x52, 0xc8, 0x44, 0xa4, 0x93, 0x8f, 0xaa, 0xc9, 0xdc, 0xf1, 0x22, 0xcc, 0xf6, 0xbc, 0x80, 0xf8,
+	0x21, 0x3b, 0x59, 0x59, 0xe3, 0x14, 0xbd, 0xa5, 0xbe, 0x6e, 0x5b, 0x26, 0x35, 0xd4, 0xa9, 0xc8,
+	0xf2, 0x04, 0xad, 0x7e, 0x9c, 0x46, 0x7f, 0xd7, 0x33, 0x3f, 0x2b, 0xf4, 0x32, 0xca, 0xd2, 0x00,
+	0xca, 0xf7, 0xd3, 0x28, 0x2f, 0x13, 0x9b, 0x24, 0x28, 0xb3, 0x0c, 0x53, 0x81, 0x39, 0x43, 0x0f,
+	0x0c, 0xdd, 0x14, 0xbc, 0x04, 0x49, 0x1d, 0xbe, 0xe7, 0xbb, 0x9e, 0xde, 0x61, 0x9c, 0x6e, 0xbb,
+	0xb6, 0x65, 0xec, 0x72, 0xdb, 0x1c, 0xfe, 0x61, 0xc8, 0x88, 0xa7, 0x33, 0x8c, 0xf8, 0x04, 0x54,
+	0xb7, 0x76, 0x1d, 0xe3, 0x75, 0x8f, 0xa5, 0x49, 0xf4, 0x89, 0x25, 0x0f, 0xb5, 0x22, 0x5e, 0xe1,
+	0x07, 0x33, 0xb0, 0x28, 0x9d, 0x80, 0x6e, 0xc8, 0xc3, 0x9f, 0xe7, 0xce, 0x17, 0x61, 0xd6, 0xf4,
+	0x77, 0xb5, 0x9e, 0xc3, 0x2f, 0x93, 0x53, 0x54, 0xb0, 0xe7, 0xf7, 0x9c, 0x08, 0x64, 0x59, 0x8b,
+	0x08, 0xdc, 0x86, 0x72, 0x10, 0xd2, 0xc4, 0xa8, 0xb3, 0xcb, 0x3c, 0x58, 0x75, 0xed, 0xb5, 0xfd,
+	0x5d, 0x20, 0x85, 0xbe, 0xc5, 0x39, 0x6a, 0x31, 0x6f, 0xfc, 0x00, 0x2a, 0x22, 0xc6, 0x05, 0xca,
+	0x1c, 0xf3, 0x51, 0x5b, 0xfb, 0x17, 0xf4, 0xba, 0x47, 0x93, 0x3a, 0x29, 0x9e, 0x6b, 0x89, 0x14,
+	0xea, 0x9d, 0xbb, 0xfc, 0xad, 0x07, 0x3c, 0x81, 0x49, 0x26, 0xf0, 0x37, 0x60, 0xc6, 0x72, 0xda,
+	0x6e, 0xa0, 0x54, 0x18, 0x98, 0x4b, 0xfb, 0x03, 0x73, 0xc3, 0x69, 0xbb, 0x5a, 0xc4, 0x10, 0x3f,
+	0x80, 0x05, 0x9f, 0x84, 0xfe, 0xae, 0xd0, 0x82, 0x02, 0x4c, 0xaf, 0x5f, 0xdd, 0x9f, 0x04, 0x4d,
+	0x66, 0xa9, 0xa5, 0x25, 0xe0, 0x75, 0xa8, 0x06, 0x89, 0x8d, 0x29, 0x55, 0x26, 0x50, 0x49, 0x31,
+	0x92, 0x6c, 0x50, 0x93, 0x17, 0x0f, 0xd9, 0xf0, 0x7c, 0x86, 0x0d, 0x7b, 0xa9, 0xe4, 0x89, 0xb2,
+	0xba, 0xe8, 0x79, 0xbe, 0xdb, 0xd7, 0xed, 0x3c, 0x2b, 0x2d, 0x12, 0xff, 0x17, 0x61, 0xd6, 0x27,
+	0x2c, 0xc5, 0xe4, 0xd6, 0x1a, 0x51, 0xea, 0xdf, 0xd2, 0x09, 0x47, 0xe4, 0x78, 0xb6, 0x3c, 0x92,
+	0xfb, 0x2c, 0x74, 0x98, 0x0e, 0x3c, 0x62, 0xb0, 0x58, 0x53, 0x5d, 0xbb, 0x39, 0x31, 0x4f, 0xc4,
+	0xe4, 0x32, 0xd6, 0x79, 0xce, 0xb2, 0x90, 0x37, 0xf8, 0x3e, 0x82, 0xff, 0x97, 0x38, 0xdf, 0xd6,
+	0x43, 0x63, 0x3b, 0xef, 0x48, 0xf4, 0xd5, 0xd2, 0x35, 0x3c, 0x7e, 0x46, 0x04, 0x35, 0x6d, 0x36,
+	0xb8, 0xb3, 0xeb, 0x51, 0x18, 0xf4, 0x97, 0x64, 0xa2, 0x50, 0x02, 0xf9, 0x0e, 0x82, 0x9a, 0xec,
+	0x6b, 0x5d, 0xdb, 0x7e, 0x43, 0x37, 0x76, 0xf2, 0xa0, 0x1c, 0x80, 0x92, 0x65, 0x32, 0x1c, 0x53,
+	0x5a, 0xc9, 0x32, 0xf7, 0xe8, 0x68, 0x06, 0x41, 0xcd, 0x66, 0x80, 0xfa, 0x74, 0x00, 0x54, 0x9c,
+	0xb6, 0x8c, 0x06, 0x95, 0x4a, 0xda, 0x4a, 0x83, 0x49, 0xdb, 0x70, 0x42, 0x5e, 0x1a, 0x4a, 0xc8,
+	0x15, 0x98, 0xeb, 0xc7, 0xdf, 0x56, 0xf4, 0x67, 0x41, 0x26, 0xa9, 0xe3, 0x4c, 0x56, 0xea, 0x38,
+	0x1b, 0xa1, 0x60, 0xa9, 0x63, 0x81, 0xaf, 0x29, 0xf5, 0xdd, 0xd2, 0x40, 0x16, 0x17, 0x61, 0x18,
+	0x6b, 0x01, 0x4f, 0xc7, 0x09, 0x63, 0x3b, 0x9c, 0x1b, 0x69, 0x87, 0xe5, 0x71, 0x76, 0x58, 0xc9,
+	0xd0, 0xca, 0xdb, 0x25, 0xa8, 0x67, 0x68, 0x65, 0x7c, 0x08, 0x7f, 0x6a, 0xd4, 0xd2, 0x76, 0x7d,
+	0x7e, 0xe3, 0x65, 0x2d, 0x22, 0xe8, 0xcb, 0x70, 0x7d, 0x6f, 0x5b, 0x77, 0x78, 0x26, 0xcf, 0xa9,
+	0x42, 0x0a, 0xf9, 0x17, 0x02, 0x45, 0x68, 0xe1, 0xa2, 0xc1, 0x74, 0xd2, 0x73, 0x9e, 0x7e, 0x45,
+	0x2c, 0xc2, 0xac, 0xce, 0xd0, 0x72, 0x03, 0xe1, 0xd4, 0xd0, 0x91, 0xcb, 0xd9, 0x3e, 0xf1, 0x68,
+	0xfa, 0xc8, 0xc1, 0xa6, 0x15, 0x84, 0xf1, 0xb7, 0x4d, 0x1b, 0xe6, 0x22, 0x6e, 0xe2, 0xeb, 0x66,
+	0x73, 0xbf, 0xa1, 0x34, 0xa5, 0x5e, 0xc1, 0x5c, 0x7d, 0x09, 0x8e, 0x66, 0x7a, 0x1f, 0x0e, 0x83,
+	0x7e, 0xa1, 0xf1, 0xf4, 0x81, 0x5f, 0x40, 0x4c, 0xab, 0xff, 0x9c, 0x4a, 0xbb, 0x75, 0xd7, 0xdc,
+	0x74, 0x3b, 0x39, 0x75, 0x85, 0xfc, 0x4b, 0x53, 0x60, 0xce, 0x73, 0x4d, 0xa9, 0x84, 0x20, 0x48,
+	0xba, 0xcf, 0x70, 0x9d, 0x50, 0xb7, 0x1c, 0xe2, 0xf3, 0xf8, 0x92, 0x4c, 0x50, 0x65, 0x07, 0x96,
+	0x63, 0x90, 0x2d, 0x62, 0xb8, 0x8e, 0x19, 0xb0, 0x5b, 0x9b, 0xd2, 0x52, 0x73, 0xf8, 0x3a, 0x54,
+	0x18, 0x7d, 0xc7, 0xea, 0x46, 0x4e, 0xb8, 0xba, 0xb6, 0xdc, 0x8c, 0x0a, 0x72, 0x4d, 0xb9, 0x20,
+	0x97, 0xe8, 0xb0, 0x4b, 0x42, 0xbd, 0xd9, 0x3f, 0xdf, 0xa4, 0x3b, 0xb4, 0x64, 0x33, 0xc5, 0x12,
+	0xea, 0x96, 0xbd, 0x69, 0x39, 0x2c, 0xa5, 0xa3, 0xa2, 0x92, 0x09, 0x6a, 0x10, 0x6d, 0xd7, 0xb6,
+	0xdd, 0x87, 0xe2, 0x0d, 0x44, 0x14, 0xdd, 0xd5, 0x73, 0x42, 0xcb, 0x66, 0xf2, 0xa3, 0x07, 0x90,
+	0x4c, 0xb0, 0x5d, 0x96, 0x1d, 0x12, 0x9f, 0x25, 0x4d, 0x15, 0x8d, 0x53, 0xb1, 0xc9, 0x55, 0xa5,
+	0xef, 0xf5, 0xd8, 0x38, 0xe7, 0x65, 0xe3, 0x1c, 0x34, 0xf8, 0x85, 0x8c, 0x1a, 0x0c, 0x2b, 0xb9,
+	0x91, 0xbe, 0xe5, 0xf6, 0x02, 0xe5, 0x40, 0x14, 0xc4, 0x05, 0x3d, 0x64, 0xb0, 0x07, 0x33, 0x0c,
+	0xf6, 0xd7, 0x08, 0xca, 0x9b, 0x6e, 0xe7, 0x8a, 0x13, 0xfa, 0xbb, 0xec, 0x5b, 0xc2, 0x75, 0x42,
+	0xe2, 0x08, 0xab, 0x10, 0x24, 0x55, 0x75, 0x68, 0x75, 0xc9, 0x56, 0xa8, 0x77, 0x3d, 0x9e, 0x93,
+	0xec, 0x49, 0xd5, 0xf1, 0x66, 0x7a, 0x7c, 0x5b, 0x0f, 0x42, 0xf6, 0x7a, 0xcb, 0x1a, 0x1b, 0x53,
+	0xa0, 0xf1, 0x82, 0xad, 0xd0, 0xe7, 0x4f, 0x37, 0x35, 0x27, 0x1b, 0xd2, 0x4c, 0x84, 0x8d, 0x93,
+	0xea, 0x16, 0x3c, 0x17, 0x27, 0xcf, 0x77, 0x88, 0xdf, 0xb5, 0x1c, 0x3d, 0xdf, 0xdf, 0x16, 0xa9,
+	0xe8, 0xdd, 0x4d, 0x3d, 0x20, 0x9a, 0x26, 0xde, 0xb3, 0x1c, 0xd3, 0x7d, 0x18, 0xec, 0xab, 0x46,
+	0xa4, 0xfe, 0x19, 0x0d, 0xa5, 0x9f, 0x9c, 0x6f, 0xfc, 0x36, 0xaf, 0xc3, 0x02, 0x7d, 0xc5, 0x7d,
+	0xc2, 0x7f, 0xe0, 0x8e, 0x42, 0x1d, 0x55, 0x04, 0x48, 0x78, 0x68, 0xe9, 0x8d, 0x78, 0x13, 0x0e,
+	0xea, 0x41, 0x60, 0x75, 0x1c, 0x62, 0x0a, 0x5e, 0xa5, 0xc2, 0xbc, 0x06, 0xb7, 0x46, 0x1f, 0x9a,
+	0x6c, 0x05, 0xbf, 0x3b, 0x41, 0xaa, 0xdf, 0x43, 0x70, 0x24, 0x93, 0x49, 0x6c, 0xeb, 0x48, 0x72,
+	0xaf, 0x35, 0x28, 0x07, 0xc6, 0x36, 0x31, 0x7b, 0x36, 0x11, 0x95, 0x14, 0x41, 0xd3, 0xdf, 0xcc,
+	0x5e, 0x74, 0x93, 0xdc, 0xbd, 0xc7, 0x34, 0x3e, 0x06, 0xd0, 0xd5, 0x9d, 0x9e, 0x6e, 0x33, 0x08,
+	0xd3, 0x0c, 0x82, 0x34, 0xa3, 0x2e, 0x41, 0x2d, 0xcb, 0x0c, 0x78, 0xed, 0xe2, 0xaf, 0x08, 0x0e,
+	0x08, 0x37, 0xc8, 0xef, 0xb0, 0x01, 0x07, 0x25, 0x35, 0xdc, 0x4a, 0xae, 0x73, 0x70, 0x7a, 0x8c,
+	0x8b, 0x13, 0xb6, 0x30, 0x95, 0xae, 0x91, 0xf7, 0x53, 0x55, 0xee, 0xc2, 0x71, 0x08, 0xed, 0x29,
+	0x13, 0xfb, 0x2e, 0x28, 0x37, 0x75, 0x47, 0xef, 0x10, 0x33, 0x3e, 0x5c, 0x6c, 0x48, 0xdf, 0x4e,
+	0xd7, 0xd1, 0x5e, 0x9b, 0x4c, 0xa4, 0x91, 0x0b, 0x6e, 0x3e, 0x94, 0x37, 0x2d, 0x67, 0x87, 0x7e,
+	0x31, 0xd2, 0x73, 0x85, 0x56, 0x68, 0x0b, 0x1d, 0x46, 0x04, 0x3e, 0x04, 0x53, 0x3d, 0xdf, 0xe6,
+	0xf7, 0x4c, 0x87, 0xb8, 0x0e, 0x55, 0x93, 0x04, 0x86, 0x6f, 0x79, 0xfc, 0x96, 0x59, 0xd1, 0x58,
+	0x9a, 0xa2, 0xda, 0xb6, 0x0c, 0xd7, 0xd9, 0xb0, 0xf5, 0x20, 0x10, 0x81, 0x21, 0x9e, 0x50, 0x5f,
+	0x81, 0x05, 0x2a, 0x33, 0x39, 0xe6, 0xd9, 0xf4, 0x31, 0x8f, 0xa4, 0xe0, 0x0b, 0x78, 0x02, 0xf1,
+	0x35, 0x78, 0x86, 0xc6, 0xe3, 0x8b, 0x9e, 0xc7, 0x99, 0x14, 0x4c, 0x46, 0x06, 0x6b, 0xa8, 0x6b,
+	0x3f, 0x3b, 0x0d, 0x58, 0xb6, 0x79, 0xe2, 0xf7, 0x2d, 0x83, 0xe0, 0x77, 0x10, 0x4c, 0x53, 0x01,
+	0xf8, 0xf9, 0x51, 0x4f, 0x8c, 0xd9, 0x5e, 0x6d, 0x72, 0x1f, 0x74, 0x54, 0x9a, 0xba, 0xf4, 0xe6,
+	0x5f, 0xfe, 0xf1, 0x6e, 0x69, 0x11, 0x3f, 0xcb, 0x9a, 0x55, 0xfd, 0xf3, 0x72, 0xe3, 0x28, 0xc0,
+	0x6f, 0x21, 0xc0, 0x3c, 0x0b, 0x91, 0x3a, 0x05, 0xf8, 0xec, 0x28, 0x88, 0x19, 0x1d, 0x85, 0xda,
+	0xf3, 0x92, 0xb7, 0x6f, 0x1a, 0xae, 0x4f, 0xa8, 0x6f, 0x67, 0x0b, 0x18, 0x80, 0x65, 0x06, 0xe0,
+	0x24, 0x56, 0xb3, 0x00, 0xb4, 0x1e, 0x51, 0xbd, 0x3d, 0x6e, 0x91, 0x48, 0xee, 0x47, 0x08, 0x66,
+	0xee, 0xb1, 0x9c, 0x7b, 0x8c, 0x92, 0xb6, 0x26, 0xa6, 0x24, 0x26, 0x8e, 0xa1, 0x55, 0x4f, 0x30,
+	0xa4, 0xcf, 0xe3, 0xa3, 0x02, 0x69, 0x10, 0xfa, 0x44, 0xef, 0xa6, 0x00, 0x9f, 0x43, 0xf8, 0x13,
+	0x04, 0xb3, 0x51, 0x81, 0x13, 0x9f, 0x1a, 0x85, 0x32, 0x55, 0x00, 0xad, 0x4d, 0xae, 0x5a, 0xa8,
+	0xbe, 0xc0, 0x30, 0x9e, 0x50, 0x33, 0xaf, 0x73, 0x3d, 0x55, 0x4b, 0x7c, 0x0f, 0xc1, 0xd4, 0x35,
+	0x32, 0xd6, 0xde, 0x26, 0x08, 0x6e, 0x48, 0x81, 0x19, 0x57, 0x8d, 0x3f, 0x46, 0xf0, 0xdc, 0x35,
+	0x12, 0x66, 0x87, 0x3a, 0xdc, 0x18, 0x1f, 0x7f, 0xb8, 0xd9, 0x9d, 0x2d, 0xb0, 0x32, 0xf6, 0xf1,
+	0x2d, 0x86, 0xec, 0x05, 0x7c, 0x26, 0xcf, 0x08, 0x83, 0x5d, 0xc7, 0x78, 0xc8, 0x71, 0xfc, 0x1e,
+	0xc1, 0xa1, 0xc1, 0xbe, 0x1d, 0x56, 0x07, 0xfa, 0x0d, 0x19, 0x6d, 0xbd, 0xda, 0xad, 0xfd, 0xfa,
+	0xd2, 0x34, 0x53, 0xf5, 0x22, 0x43, 0xfe, 0x32, 0x7e, 0x29, 0x0f, 0xb9, 0x28, 0x8b, 0x06, 0xad,
+	0x47, 0x62, 0xf8, 0x98, 0xb5, 0x98, 0x19, 0xec, 0x3f, 0xb2, 0xae, 0x51, 0x34, 0xbd, 0xb1, 0xad,
+	0xfb, 0xe1, 0x65, 0x42, 0x33, 0xd8, 0xa0, 0xd0, 0x79, 0xf6, 0x19, 0x1b, 0x64, 0x79, 0xea, 0x15,
+	0x76, 0x96, 0x2f, 0xe3, 0x57, 0xf7, 0x7c, 0x16, 0x83, 0xb2, 0x31, 0x39, 0xec, 0x37, 0x11, 0xcc,
+	0x5f, 0x23, 0xe1, 0xcd, 0xb8, 0xca, 0x79, 0xaa, 0x50, 0x17, 0xa4, 0xb6, 0xd4, 0x94, 0x3a, 0xdb,
+	0xe2, 0xa7, 0xd8, 0x44, 0x56, 0x19, 0xb8, 0x33, 0xf8, 0x54, 0x1e, 0xb8, 0xa4, 0xb2, 0xfa, 0x23,
+	0x04, 0xf3, 0xa9, 0x16, 0xdc, 0x0b, 0xa3, 0x7d, 0xe6, 0x40, 0x1b, 0xb1, 0xb6, 0x52, 0x64, 0x69,
+	0x0c, 0xec, 0x1c, 0x03, 0xb6, 0x8c, 0x1b, 0x45, 0xb4, 0xc6, 0xba, 0x6c, 0x1f, 0x21, 0x38, 0x22,
+	0x2b, 0x28, 0xe9, 0x5f, 0x7d, 0x61, 0x6f, 0xfd, 0x22, 0xde, 0x75, 0x1a, 0xa3, 0xb9, 0x35, 0x06,
+	0x70, 0x45, 0xcd, 0x7e, 0x5c, 0xdd, 0x21, 0x14, 0xeb, 0x68, 0xb9, 0x81, 0xf0, 0x6f, 0x10, 0xcc,
+	0x46, 0x05, 0xcf, 0xd1, 0xf7, 0x97, 0xea, 0xc4, 0x4c, 0xd2, 0x53, 0x71, 0x4b, 0xac, 0x9d, 0xcb,
+	0xd6, 0xa9, 0xbc, 0x5f, 0x3c, 0xa3, 0x26, 0x53, 0x74, 0xda, 0xc5, 0xfe, 0x1c, 0x01, 0x24, 0x45,
+	0xdb, 0xd1, 0x26, 0x30, 0x54, 0xd8, 0xad, 0x4d, 0xb6, 0x6c, 0xab, 0x36, 0xd9, 0x79, 0x1a, 0xb5,
+	0x7a, 0xae, 0x7f, 0xf3, 0x88, 0xb1, 0x1e, 0x15, 0x78, 0x7f, 0x8c, 0x60, 0x86, 0xd5, 0xe4, 0xf0,
+	0xc9, 0x51, 0x98, 0xe5, 0x92, 0xdd, 0x24, 0x55, 0x7f, 0x9a, 0x41, 0xad, 0xaf, 0xe5, 0x05, 0x89,
+	0x75, 0xb4, 0x8c, 0xfb, 0x30, 0x1b, 0xd5, 0xc7, 0x46, 0x9b, 0x47, 0xaa, 0x7e, 0x56, 0xab, 0xe7,
+	0x24, 0x2d, 0x91, 0xa1, 0xf2, 0xf8, 0xb4, 0x3c, 0x2e, 0x3e, 0x4d, 0xd3, 0x10, 0x82, 0x4f, 0xe4,
+	0x05, 0x98, 0xff, 0x81, 0x62, 0xce, 0x32, 0x74, 0xa7, 0xd4, 0xfa, 0xb8, 0x18, 0x45, 0xb5, 0xf3,
+	0x43, 0x04, 0x87, 0x06, 0xd3, 0x7b, 0x7c, 0x34, 0xb3, 0x1f, 0xce, 0xe3, 0x65, 0x5a, 0x8b, 0xa3,
+	0x3e, 0x0d, 0xd4, 0xaf, 0x30, 0x14, 0xeb, 0xf8, 0xc2, 0xd8, 0x97, 0x71, 0x4b, 0x78, 0x44, 0xca,
+	0x68, 0x35, 0xe9, 0x48, 0xfd, 0x82, 0x79, 0xc6, 0x88, 0xba, 0xe3, 0x13, 0x92, 0x0f, 0x6b, 0x72,
+	0x0f, 0x81, 0xca, 0x52, 0x5f, 0x61, 0xf0, 0xbf, 0x88, 0x5f, 0x2c, 0x08, 0x5f, 0xc0, 0x5e, 0x0d,
+	0x29, 0xd2, 0xdf, 0x22, 0x38, 0x7c, 0x2f, 0xb2, 0xfb, 0xcf, 0x08, 0xff, 0x06, 0xc3, 0xff, 0x2a,
+	0x7e, 0x39, 0x27, 0x07, 0x1d, 0x77, 0x8c, 0x73, 0x08, 0xff, 0x12, 0x45, 0xfd, 0xd8, 0xa8, 0x81,
+	0x45, 0x70, 0x6e, 0xb2, 0x34, 0xd0, 0xe5, 0x9a, 0xa4, 0x4d, 0x7f, 0x9e, 0x1d, 0x67, 0x55, 0x6d,
+	0x8c, 0xb3, 0x69, 0xfa, 0x03, 0x45, 0x4a, 0x6d, 0xfb, 0xa7, 0x08, 0xca, 0xa2, 0x55, 0x83, 0xcf,
+	0x8c, 0x7c, 0xd5, 0xe9, 0x66, 0xce, 0x24, 0x51, 0xf3, 0x6c, 0x51, 0x3d, 0x99, 0x1b, 0x71, 0xb9,
+	0x7c, 0x8a, 0xf8, 0x3d, 0x04, 0x38, 0x2e, 0x2c, 0xc4, 0xa5, 0x06, 0x7c, 0x3a, 0x25, 0x6a, 0x64,
+	0x25, 0xaa, 0x76, 0x66, 0xec, 0xba, 0x74, 0x8e, 0xb2, 0x9c, 0x9b, 0xa3, 0xb8, 0xb1, 0xfc, 0xb7,
+	0x11, 0x54, 0xaf, 0x91, 0xf8, 0xe3, 0x2e, 0x47, 0x97, 0xe9, 0x1e, 0x54, 0xad, 0x31, 0x7e, 0x21,
+	0x47, 0xb4, 0xc2, 0x10, 0x9d, 0xc6, 0xf9, 0xaa, 0x12, 0x00, 0x3e, 0x40, 0xb0, 0x70, 0x5b, 0x7e,
+	0x5f, 0x78, 0x65, 0x9c, 0xa4, 0x54, 0x18, 0x2a, 0x8e, 0x4b, 0x18, 0x5e, 0x21, 0x5c, 0xeb, 0xbc,
+	0xd1, 0xf3, 0x21, 0x8a, 0x6a, 0x00, 0x03, 0x65, 0xfa, 0xff, 0x56, 0x6f, 0x39, 0xd5, 0x7e, 0xf5,
+	0x45, 0x86, 0xaf, 0x89, 0x57, 0x8a, 0xe0, 0x6b, 0xf1, 0xda, 0x3d, 0x7e, 0x1f, 0xc1, 0x61, 0xd6,
+	0x28, 0x91, 0x19, 0x0f, 0xc4, 0xc7, 0x51, 0x6d, 0x95, 0x02, 0xf1, 0x91, 0x3b, 0x4f, 0x75, 0x4f,
+	0xa0, 0xd6, 0x45, 0x13, 0xe4, 0x07, 0x08, 0x0e, 0x88, 0x88, 0xcc, 0x6f, 0x77, 0x75, 0x9c, 0xe2,
+	0xf6, 0x1a, 0xc1, 0xb9, 0xb9, 0x2d, 0x17, 0x33, 0xb7, 0x4f, 0x10, 0xcc, 0xf1, 0x26, 0x45, 0x4e,
+	0x9e, 0x23, 0x75, 0x31, 0x6a, 0x03, 0x25, 0x22, 0x5e, 0xfd, 0x56, 0xbf, 0xc9, 0xc4, 0xde, 0xc5,
+	0xad, 0x3c, 0xb1, 0x9e, 0x6b, 0x06, 0xad, 0x47, 0xbc, 0xf4, 0xfc, 0xb8, 0x65, 0xbb, 0x9d, 0xe0,
+	0xbe, 0x8a, 0x73, 0xa3, 0x39, 0x5d, 0x73, 0x0e, 0xe1, 0x10, 0x2a, 0xd4, 0x38, 0x58, 0xdd, 0x09,
+	0xd7, 0x07, 0xaa, 0x54, 0x43, 0x25, 0xa9, 0x5a, 0x6d, 0xa8, 0x8e, 0x95, 0x84, 0x6f, 0x5e, 0x1f,
+	0xc0, 0xc7, 0x73, 0xc5, 0x32, 0x41, 0x6f, 0x21, 0x38, 0x2c, 0x5b, 0x7b, 0x24, 0xbe, 0xb0, 0xad,
+	0xe7, 0xa1, 0xe0, 0x5f, 0x04, 0x78, 0xb9, 0x90, 0x21, 0x31, 0x38, 0x97, 0xae, 0xfe, 0xee, 0xc9,
+	0x31, 0xf4, 0xa7, 0x27, 0xc7, 0xd0, 0xdf, 0x9f, 0x1c, 0x43, 0xf7, 0x2f, 0x14, 0xfb, 0x1f, 0xb4,
+	0x61, 0x5b, 0xc4, 0x09, 0x65, 0xf6, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xa3, 0x5a, 0x95,
+	0xed, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2834,6 +3145,8 @@ type ApplicationServiceClient interface {
 	RevisionChartDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error)
 	// GetManifests returns application manifests
 	GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error)
+	// RevisionDiff returns the difference between the manifests of an application rendered at two revisions
+	RevisionDiff(ctx context.Context, in *ApplicationRevisionDiffQuery, opts ...grpc.CallOption) (*ApplicationRevisionDiffResponse, error)
 	// GetManifestsWithFiles returns application manifests using provided files to generate them
 	GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error)
 	// Update updates an application
@@ -2852,6 +3165,8 @@ type ApplicationServiceClient interface {
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
 	WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error)
+	// SyncApprove approves or rejects the pending sync of an application
+	SyncApprove(ctx context.Context, in *ApplicationSyncApprovalRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// Rollback syncs an application to its target state
 	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
@@ -2986,6 +3301,15 @@ func (c *applicationServiceClient) GetManifests(ctx context.Context, in *Applica
 	return out, nil
 }
 
+func (c *applicationServiceClient) RevisionDiff(ctx context.Context, in *ApplicationRevisionDiffQuery, opts ...grpc.CallOption) (*ApplicationRevisionDiffResponse, error) {
+	out := new(ApplicationRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/application.ApplicationService/GetManifestsWithFiles", opts...)
 	if err != nil {
@@ -3115,6 +3439,15 @@ func (x *applicationServiceWatchResourceTreeClient) Recv() (*v1alpha1.Applicatio
 	return m, nil
 }
 
+func (c *applicationServiceClient) SyncApprove(ctx context.Context, in *ApplicationSyncApprovalRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/SyncApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
 	out := new(v1alpha1.Application)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/Rollback", in, out, opts...)
@@ -3248,6 +3581,8 @@ type ApplicationServiceServer interface {
 	RevisionChartDetails(context.Context, *RevisionMetadataQuery) (*v1alpha1.ChartDetails, error)
 	// GetManifests returns application manifests
 	GetManifests(context.Context, *ApplicationManifestQuery) (*apiclient.ManifestResponse, error)
+	// RevisionDiff returns the difference between the manifests of an application rendered at two revisions
+	RevisionDiff(context.Context, *ApplicationRevisionDiffQuery) (*ApplicationRevisionDiffResponse, error)
 	// GetManifestsWithFiles returns application manifests using provided files to generate them
 	GetManifestsWithFiles(ApplicationService_GetManifestsWithFilesServer) error
 	// Update updates an application
@@ -3266,6 +3601,8 @@ type ApplicationServiceServer interface {
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
 	WatchResourceTree(*ResourcesQuery, ApplicationService_WatchResourceTreeServer) error
+	// SyncApprove approves or rejects the pending sync of an application
+	SyncApprove(context.Context, *ApplicationSyncApprovalRequest) (*v1alpha1.Application, error)
 	// Rollback syncs an application to its target state
 	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
 	// TerminateOperation terminates the currently running operation
@@ -3319,6 +3656,9 @@ func (*UnimplementedApplicationServiceServer) RevisionChartDetails(ctx context.C
 func (*UnimplementedApplicationServiceServer) GetManifests(ctx context.Context, req *ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetManifests not implemented")
 }
+func (*UnimplementedApplicationServiceServer) RevisionDiff(ctx context.Context, req *ApplicationRevisionDiffQuery) (*ApplicationRevisionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisionDiff not implemented")
+}
 func (*UnimplementedApplicationServiceServer) GetManifestsWithFiles(srv ApplicationService_GetManifestsWithFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetManifestsWithFiles not implemented")
 }
@@ -3346,6 +3686,9 @@ func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context,
 func (*UnimplementedApplicationServiceServer) WatchResourceTree(req *ResourcesQuery, srv ApplicationService_WatchResourceTreeServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchResourceTree not implemented")
 }
+func (*UnimplementedApplicationServiceServer) SyncApprove(ctx context.Context, req *ApplicationSyncApprovalRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncApprove not implemented")
+}
 func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req *ApplicationRollbackRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
@@ -3546,10 +3889,28 @@ func _ApplicationService_GetManifests_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetManifestsWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ApplicationServiceServer).GetManifestsWithFiles(&applicationServiceGetManifestsWithFilesServer{stream})
-}
-
+func _ApplicationService_RevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRevisionDiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionDiff(ctx, req.(*ApplicationRevisionDiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetManifestsWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApplicationServiceServer).GetManifestsWithFiles(&applicationServiceGetManifestsWithFilesServer{stream})
+}
+
 type ApplicationService_GetManifestsWithFilesServer interface {
 	SendAndClose(*apiclient.ManifestResponse) error
 	Recv() (*ApplicationManifestQueryWithFilesWrapper, error)
@@ -3719,6 +4080,24 @@ func (x *applicationServiceWatchResourceTreeServer) Send(m *v1alpha1.Application
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApplicationService_SyncApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SyncApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/SyncApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SyncApprove(ctx, req.(*ApplicationSyncApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationRollbackRequest)
 	if err := dec(in); err != nil {
@@ -3938,6 +4317,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetManifests",
 			Handler:    _ApplicationService_GetManifests_Handler,
 		},
+		{
+			MethodName: "RevisionDiff",
+			Handler:    _ApplicationService_RevisionDiff_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _ApplicationService_Update_Handler,
@@ -3966,6 +4349,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
 		},
+		{
+			MethodName: "SyncApprove",
+			Handler:    _ApplicationService_SyncApprove_Handler,
+		},
 		{
 			MethodName: "Rollback",
 			Handler:    _ApplicationService_Rollback_Handler,
@@ -4322,6 +4709,194 @@ func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationRevisionDiffQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRevisionDiffQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationRevisionDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ToRevisions) > 0 {
+		for iNdEx := len(m.ToRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRevisions[iNdEx])
+			copy(dAtA[i:], m.ToRevisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.ToRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FromRevisions) > 0 {
+		for iNdEx := len(m.FromRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FromRevisions[iNdEx])
+			copy(dAtA[i:], m.FromRevisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.FromRevisions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceRevisionDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRevisionDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceRevisionDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Modified != nil {
+		i--
+		if *m.Modified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Diff != nil {
+		i -= len(*m.Diff)
+		copy(dAtA[i:], *m.Diff)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Diff)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ToState != nil {
+		i -= len(*m.ToState)
+		copy(dAtA[i:], *m.ToState)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ToState)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FromState != nil {
+		i -= len(*m.FromState)
+		copy(dAtA[i:], *m.FromState)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.FromState)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationRevisionDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRevisionDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationRevisionDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FileChunk) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4866,6 +5441,59 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSyncApprovalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncApprovalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncApprovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reject != nil {
+		i--
+		if *m.Reject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6412,30 +7040,123 @@ func (m *ApplicationManifestQuery) Size() (n int) {
 	return n
 }
 
-func (m *FileChunk) Size() (n int) {
+func (m *ApplicationRevisionDiffQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Chunk != nil {
-		l = len(m.Chunk)
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if len(m.FromRevisions) > 0 {
+		for _, s := range m.FromRevisions {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.ToRevisions) > 0 {
+		for _, s := range m.ToRevisions {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ApplicationManifestQueryWithFiles) Size() (n int) {
+func (m *ResourceRevisionDiff) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.FromState != nil {
+		l = len(*m.FromState)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ToState != nil {
+		l = len(*m.ToState)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Diff != nil {
+		l = len(*m.Diff)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Modified != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationRevisionDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = len(m.Chunk)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQueryWithFiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
 		n += 1 + l + sovApplication(uint64(l))
 	}
 	if m.Checksum != nil {
@@ -6649,6 +7370,29 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSyncApprovalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Reject != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationUpdateSpecRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8245,7 +8989,7 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileChunk) Unmarshal(dAtA []byte) error {
+func (m *ApplicationRevisionDiffQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -8269,17 +9013,17 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileChunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationRevisionDiffQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationRevisionDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8289,85 +9033,29 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.Chunk == nil {
-				m.Chunk = []byte{}
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8396,12 +9084,11 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8429,13 +9116,11 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Checksum = &s
+			m.FromRevisions = append(m.FromRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRevisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8463,8 +9148,7 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.ToRevisions = append(m.ToRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8485,16 +9169,13 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error {
+func (m *ResourceRevisionDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8517,17 +9198,17 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRevisionDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRevisionDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8537,32 +9218,30 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplicationManifestQueryWithFiles{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ApplicationManifestQueryWithFilesWrapper_Query{v}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8572,38 +9251,677 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &FileChunk{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ApplicationManifestQueryWithFilesWrapper_Chunk{v}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.FromState = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ToState = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Diff = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Modified = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationRevisionDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationRevisionDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationRevisionDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ResourceRevisionDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileChunk) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Checksum = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationManifestQueryWithFiles{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ApplicationManifestQueryWithFilesWrapper_Query{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FileChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ApplicationManifestQueryWithFilesWrapper_Chunk{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
 			iNdEx += skippy
@@ -9577,6 +10895,149 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSyncApprovalRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncApprovalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncApprovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reject", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Reject = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
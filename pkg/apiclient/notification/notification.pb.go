@@ -431,6 +431,262 @@ func (m *TemplatesListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TemplatesListRequest proto.InternalMessageInfo
 
+type Delivery struct {
+	Id *string `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	// time is the time of the delivery attempt in seconds since the epoch
+	Time                 *int64   `protobuf:"varint,2,opt,name=time" json:"time,omitempty"`
+	Kind                 *string  `protobuf:"bytes,3,opt,name=kind" json:"kind,omitempty"`
+	Namespace            *string  `protobuf:"bytes,4,opt,name=namespace" json:"namespace,omitempty"`
+	Name                 *string  `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	Project              *string  `protobuf:"bytes,6,opt,name=project" json:"project,omitempty"`
+	Trigger              *string  `protobuf:"bytes,7,opt,name=trigger" json:"trigger,omitempty"`
+	Service              *string  `protobuf:"bytes,8,opt,name=service" json:"service,omitempty"`
+	Recipient            *string  `protobuf:"bytes,9,opt,name=recipient" json:"recipient,omitempty"`
+	Status               *string  `protobuf:"bytes,10,opt,name=status" json:"status,omitempty"`
+	Attempt              *int64   `protobuf:"varint,11,opt,name=attempt" json:"attempt,omitempty"`
+	Error                *string  `protobuf:"bytes,12,opt,name=error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delivery) Reset()         { *m = Delivery{} }
+func (m *Delivery) String() string { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()    {}
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{9}
+}
+func (m *Delivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delivery.Merge(m, src)
+}
+func (m *Delivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delivery proto.InternalMessageInfo
+
+func (m *Delivery) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Delivery) GetTime() int64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+func (m *Delivery) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *Delivery) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *Delivery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Delivery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *Delivery) GetTrigger() string {
+	if m != nil && m.Trigger != nil {
+		return *m.Trigger
+	}
+	return ""
+}
+
+func (m *Delivery) GetService() string {
+	if m != nil && m.Service != nil {
+		return *m.Service
+	}
+	return ""
+}
+
+func (m *Delivery) GetRecipient() string {
+	if m != nil && m.Recipient != nil {
+		return *m.Recipient
+	}
+	return ""
+}
+
+func (m *Delivery) GetStatus() string {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return ""
+}
+
+func (m *Delivery) GetAttempt() int64 {
+	if m != nil && m.Attempt != nil {
+		return *m.Attempt
+	}
+	return 0
+}
+
+func (m *Delivery) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+type DeliveryList struct {
+	Items                []*Delivery `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DeliveryList) Reset()         { *m = DeliveryList{} }
+func (m *DeliveryList) String() string { return proto.CompactTextString(m) }
+func (*DeliveryList) ProtoMessage()    {}
+func (*DeliveryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{10}
+}
+func (m *DeliveryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryList.Merge(m, src)
+}
+func (m *DeliveryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryList proto.InternalMessageInfo
+
+func (m *DeliveryList) GetItems() []*Delivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type DeliveriesListRequest struct {
+	// kind, namespace and name filter the deliveries by the resource the notifications are about
+	Kind      *string `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// deadLettered lists the deliveries which failed for good instead of the delivery history
+	DeadLettered         *bool    `protobuf:"varint,4,opt,name=deadLettered" json:"deadLettered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveriesListRequest) Reset()         { *m = DeliveriesListRequest{} }
+func (m *DeliveriesListRequest) String() string { return proto.CompactTextString(m) }
+func (*DeliveriesListRequest) ProtoMessage()    {}
+func (*DeliveriesListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{11}
+}
+func (m *DeliveriesListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveriesListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveriesListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveriesListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveriesListRequest.Merge(m, src)
+}
+func (m *DeliveriesListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveriesListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveriesListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveriesListRequest proto.InternalMessageInfo
+
+func (m *DeliveriesListRequest) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetDeadLettered() bool {
+	if m != nil && m.DeadLettered != nil {
+		return *m.DeadLettered
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Trigger)(nil), "notification.Trigger")
 	proto.RegisterType((*TriggerList)(nil), "notification.TriggerList")
@@ -441,6 +697,9 @@ func init() {
 	proto.RegisterType((*Template)(nil), "notification.Template")
 	proto.RegisterType((*TemplateList)(nil), "notification.TemplateList")
 	proto.RegisterType((*TemplatesListRequest)(nil), "notification.TemplatesListRequest")
+	proto.RegisterType((*Delivery)(nil), "notification.Delivery")
+	proto.RegisterType((*DeliveryList)(nil), "notification.DeliveryList")
+	proto.RegisterType((*DeliveriesListRequest)(nil), "notification.DeliveriesListRequest")
 }
 
 func init() {
@@ -448,31 +707,45 @@ func init() {
 }
 
 var fileDescriptor_e1dead44d55a8ff4 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x4e, 0xf3, 0x30,
-	0x10, 0xc7, 0x95, 0x7e, 0x1f, 0x02, 0xdc, 0xb2, 0xb8, 0xb4, 0x2a, 0x15, 0x84, 0x36, 0x43, 0xa9,
-	0x04, 0xd4, 0xa2, 0x1b, 0x88, 0x89, 0x85, 0x05, 0x31, 0x94, 0x4e, 0x6c, 0x21, 0x18, 0x63, 0x68,
-	0xe2, 0x60, 0x5f, 0x23, 0x66, 0x5e, 0x81, 0x97, 0x62, 0x44, 0xe2, 0x05, 0x50, 0xc5, 0x5b, 0xb0,
-	0xa0, 0xb8, 0xb6, 0x14, 0x57, 0xa9, 0x60, 0x73, 0xee, 0x7e, 0xbe, 0x9f, 0xee, 0xef, 0xa0, 0x9e,
-	0xa2, 0x32, 0xa3, 0x92, 0x24, 0x02, 0xf8, 0x1d, 0x8f, 0x42, 0xe0, 0x22, 0x71, 0x3e, 0x06, 0xa9,
-	0x14, 0x20, 0x70, 0xad, 0x58, 0x6b, 0x6f, 0x33, 0x21, 0xd8, 0x84, 0x92, 0x30, 0xe5, 0x24, 0x4c,
-	0x12, 0x01, 0xba, 0xac, 0xe6, 0x6c, 0xb0, 0x83, 0x56, 0xc7, 0x92, 0x33, 0x46, 0x25, 0xc6, 0xe8,
-	0x7f, 0x12, 0xc6, 0xb4, 0xe5, 0x75, 0x2a, 0xfd, 0xf5, 0x91, 0x3e, 0x07, 0x27, 0xa8, 0x6a, 0xda,
-	0x17, 0x5c, 0x01, 0xde, 0x47, 0x2b, 0x1c, 0x68, 0xac, 0x5a, 0x5e, 0xe7, 0x5f, 0xbf, 0x3a, 0x6c,
-	0x0c, 0x1c, 0xbb, 0x21, 0x47, 0x73, 0x26, 0x68, 0xa0, 0xba, 0xa9, 0xa8, 0xfc, 0xf2, 0x88, 0x3e,
-	0x4d, 0xa9, 0x82, 0xdc, 0x78, 0x45, 0x65, 0xc6, 0x23, 0xba, 0xcc, 0x68, 0xda, 0x7f, 0x30, 0x1a,
-	0xb2, 0x60, 0x34, 0x15, 0xc7, 0xe8, 0xa3, 0xb5, 0x31, 0x8d, 0xd3, 0x49, 0x08, 0xe5, 0xca, 0x53,
-	0x54, 0xb3, 0x7d, 0xed, 0x3c, 0x70, 0x9d, 0xcd, 0x85, 0x2d, 0x0d, 0x6a, 0xa5, 0x4d, 0xb4, 0x69,
-	0x4b, 0x45, 0xeb, 0xf0, 0xbb, 0x82, 0xea, 0x97, 0x85, 0x8b, 0x76, 0x69, 0x40, 0xb5, 0x1c, 0xb3,
-	0xd1, 0xe0, 0x6e, 0x69, 0x88, 0xc5, 0x51, 0xed, 0xad, 0x52, 0x24, 0x27, 0x82, 0xde, 0xcb, 0xc7,
-	0xd7, 0x6b, 0xa5, 0x83, 0x7d, 0xfd, 0xbe, 0xd9, 0x91, 0xf3, 0x3f, 0x28, 0x02, 0xd6, 0x62, 0xac,
-	0x36, 0x9e, 0x45, 0x6b, 0x49, 0x6c, 0x8b, 0xd6, 0xc2, 0xab, 0xfc, 0x66, 0x55, 0xd6, 0xf2, 0x8c,
-	0x36, 0xf4, 0xae, 0x36, 0x1f, 0x1c, 0x94, 0x67, 0xe9, 0x78, 0xdb, 0xe5, 0x8c, 0x16, 0xef, 0x69,
-	0x71, 0x17, 0xef, 0x2e, 0x59, 0xd7, 0xce, 0x3b, 0x3b, 0x7f, 0x9b, 0xf9, 0xde, 0xfb, 0xcc, 0xf7,
-	0x3e, 0x67, 0xbe, 0x77, 0x7d, 0xcc, 0x38, 0xdc, 0x4f, 0x6f, 0x06, 0x91, 0x88, 0x49, 0x28, 0x99,
-	0x48, 0xa5, 0x78, 0xd0, 0x87, 0xc3, 0xe8, 0x96, 0x64, 0x43, 0x92, 0x3e, 0xb2, 0x7c, 0x60, 0x34,
-	0xe1, 0x34, 0x01, 0x67, 0xe6, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x19, 0x88, 0x22, 0x75,
-	0x03, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb5, 0x9b, 0xb6, 0x49, 0x9c, 0xd0, 0x83, 0xdb, 0x44, 0x26, 0x2a, 0x61, 0xbb, 0x48,
+	0x25, 0x12, 0x90, 0x15, 0xb9, 0x81, 0x38, 0x21, 0x24, 0x2e, 0x15, 0x87, 0xd0, 0x13, 0xb7, 0x65,
+	0x77, 0x58, 0x4c, 0xb3, 0x7f, 0xb0, 0x27, 0x11, 0x88, 0x13, 0xbc, 0x02, 0x2f, 0xc1, 0xa3, 0x70,
+	0x44, 0xe2, 0x05, 0x50, 0xc4, 0x43, 0x70, 0x44, 0xf6, 0xda, 0xe9, 0x6e, 0xb4, 0x81, 0xde, 0x3c,
+	0xdf, 0x8c, 0xfd, 0xb3, 0x67, 0xfc, 0x91, 0x33, 0x09, 0x62, 0x05, 0x22, 0xc8, 0x72, 0xe4, 0x6f,
+	0x78, 0x14, 0x22, 0xcf, 0xb3, 0x5a, 0x30, 0x2d, 0x44, 0x8e, 0x39, 0xed, 0x57, 0xb5, 0xd1, 0x49,
+	0x92, 0xe7, 0xc9, 0x02, 0x82, 0xb0, 0xe0, 0x41, 0x98, 0x65, 0x39, 0x6a, 0x59, 0x96, 0xb5, 0xfe,
+	0x2d, 0xd2, 0xbe, 0x10, 0x3c, 0x49, 0x40, 0x50, 0x4a, 0xf6, 0xb2, 0x30, 0x05, 0xe6, 0x78, 0xee,
+	0xa4, 0x3b, 0xd7, 0x6b, 0xff, 0x31, 0xe9, 0x99, 0xf4, 0x39, 0x97, 0x48, 0xef, 0x91, 0x7d, 0x8e,
+	0x90, 0x4a, 0xe6, 0x78, 0xad, 0x49, 0x6f, 0x36, 0x98, 0xd6, 0xe8, 0xa6, 0x72, 0x5e, 0xd6, 0xf8,
+	0x03, 0x72, 0x64, 0x14, 0xa9, 0x36, 0xcf, 0xe1, 0xfd, 0x12, 0x24, 0x2a, 0xe2, 0x4b, 0x10, 0x2b,
+	0x1e, 0xc1, 0x2e, 0xa2, 0x49, 0x5f, 0x83, 0x68, 0x2a, 0x2b, 0x44, 0xa3, 0xd4, 0x88, 0x63, 0xd2,
+	0xb9, 0x80, 0xb4, 0x58, 0x84, 0xd8, 0x8c, 0x7c, 0x42, 0xfa, 0x36, 0xaf, 0x99, 0xf7, 0xeb, 0xcc,
+	0xe1, 0xd6, 0x2b, 0x4d, 0xa9, 0x85, 0x0e, 0xc9, 0xb1, 0x95, 0x6a, 0xd4, 0x6f, 0x2e, 0xe9, 0x3c,
+	0x83, 0x05, 0x5f, 0x81, 0xf8, 0x48, 0x0f, 0x89, 0xcb, 0x63, 0x03, 0x75, 0x79, 0xac, 0xae, 0x81,
+	0x3c, 0x05, 0xe6, 0x7a, 0xce, 0xa4, 0x35, 0xd7, 0x6b, 0xa5, 0x5d, 0xf2, 0x2c, 0x66, 0x2d, 0xcf,
+	0x51, 0x57, 0x53, 0x6b, 0x7a, 0x42, 0xba, 0xea, 0x8a, 0xb2, 0x08, 0x23, 0x60, 0x7b, 0x3a, 0x71,
+	0x25, 0x6c, 0x1e, 0xb3, 0x5f, 0xee, 0x50, 0x6b, 0xca, 0x48, 0xbb, 0x10, 0xf9, 0x3b, 0x88, 0x90,
+	0x1d, 0x68, 0xd9, 0x86, 0x2a, 0x83, 0xe5, 0x3c, 0x58, 0xbb, 0xcc, 0x98, 0x50, 0x65, 0x64, 0xd9,
+	0x37, 0xd6, 0x29, 0x33, 0x26, 0x54, 0x7c, 0x01, 0x11, 0x2f, 0x38, 0x64, 0xc8, 0xba, 0x25, 0x7f,
+	0x23, 0xd0, 0x21, 0x39, 0x90, 0x18, 0xe2, 0x52, 0x32, 0xa2, 0x53, 0x26, 0x52, 0xe7, 0x85, 0x88,
+	0x90, 0x16, 0xc8, 0x7a, 0xfa, 0x81, 0x36, 0xa4, 0xc7, 0x64, 0x1f, 0x84, 0xc8, 0x05, 0xeb, 0xeb,
+	0x0d, 0x65, 0xa0, 0x06, 0x60, 0x3b, 0x75, 0x8d, 0x01, 0xd8, 0x52, 0x3b, 0x80, 0xcf, 0x0e, 0x19,
+	0x18, 0x8d, 0xd7, 0x46, 0xb0, 0xe9, 0xa8, 0xb3, 0xab, 0xa3, 0xee, 0xae, 0x8e, 0xb6, 0x2a, 0x1d,
+	0xf5, 0x49, 0x3f, 0x86, 0x30, 0x3e, 0x07, 0x44, 0x10, 0x10, 0xeb, 0x31, 0x74, 0xe6, 0x35, 0x6d,
+	0xf6, 0xa7, 0x45, 0x8e, 0x5e, 0x54, 0x2e, 0x69, 0x7f, 0x38, 0x92, 0xbe, 0xba, 0x90, 0xf5, 0x01,
+	0x3d, 0x6d, 0x74, 0x4c, 0xf5, 0xd2, 0xa3, 0x9b, 0x8d, 0x25, 0xaa, 0xc2, 0x3f, 0xfb, 0xf2, 0xf3,
+	0xf7, 0x57, 0xd7, 0xa3, 0x63, 0x6d, 0xe6, 0xd5, 0xc3, 0x9a, 0xf9, 0x65, 0x80, 0x96, 0x62, 0xa8,
+	0xd6, 0x0b, 0xdb, 0xd4, 0x06, 0x8f, 0x6c, 0x53, 0x2b, 0x16, 0xfc, 0x1f, 0x55, 0x5a, 0xca, 0x07,
+	0x72, 0x43, 0xbf, 0xd5, 0x9a, 0x81, 0xfa, 0xcd, 0xc6, 0xa9, 0x71, 0x47, 0xcd, 0x35, 0x1a, 0x7c,
+	0x57, 0x83, 0x4f, 0xe9, 0xed, 0x1d, 0xcf, 0xdd, 0x80, 0x3e, 0x91, 0x43, 0xb5, 0xe1, 0xea, 0x13,
+	0xd0, 0x3b, 0x8d, 0x5f, 0x86, 0xff, 0x93, 0x5d, 0xfd, 0x82, 0xfe, 0x44, 0xb3, 0x7d, 0xea, 0x35,
+	0xb3, 0xe3, 0xcd, 0x81, 0x4f, 0x9f, 0x7f, 0x5f, 0x8f, 0x9d, 0x1f, 0xeb, 0xb1, 0xf3, 0x6b, 0x3d,
+	0x76, 0x5e, 0x3d, 0x4a, 0x38, 0xbe, 0x5d, 0xbe, 0x9e, 0x46, 0x79, 0x1a, 0x84, 0x22, 0xc9, 0x95,
+	0xf9, 0xf4, 0xe2, 0x41, 0x14, 0x07, 0xab, 0x59, 0x50, 0x5c, 0x26, 0xea, 0xc4, 0x68, 0xa1, 0x0c,
+	0x54, 0x3b, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xa9, 0x90, 0x43, 0xdf, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -493,6 +766,8 @@ type NotificationServiceClient interface {
 	ListServices(ctx context.Context, in *ServicesListRequest, opts ...grpc.CallOption) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(ctx context.Context, in *TemplatesListRequest, opts ...grpc.CallOption) (*TemplateList, error)
+	// ListDeliveries returns the delivery history or the dead-lettered deliveries
+	ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error)
 }
 
 type notificationServiceClient struct {
@@ -530,6 +805,15 @@ func (c *notificationServiceClient) ListTemplates(ctx context.Context, in *Templ
 	return out, nil
 }
 
+func (c *notificationServiceClient) ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error) {
+	out := new(DeliveryList)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/ListDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 type NotificationServiceServer interface {
 	// List returns list of triggers
@@ -538,6 +822,8 @@ type NotificationServiceServer interface {
 	ListServices(context.Context, *ServicesListRequest) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(context.Context, *TemplatesListRequest) (*TemplateList, error)
+	// ListDeliveries returns the delivery history or the dead-lettered deliveries
+	ListDeliveries(context.Context, *DeliveriesListRequest) (*DeliveryList, error)
 }
 
 // UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
@@ -553,6 +839,9 @@ func (*UnimplementedNotificationServiceServer) ListServices(ctx context.Context,
 func (*UnimplementedNotificationServiceServer) ListTemplates(ctx context.Context, req *TemplatesListRequest) (*TemplateList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
+func (*UnimplementedNotificationServiceServer) ListDeliveries(ctx context.Context, req *DeliveriesListRequest) (*DeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
 
 func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
 	s.RegisterService(&_NotificationService_serviceDesc, srv)
@@ -612,6 +901,24 @@ func _NotificationService_ListTemplates_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveriesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, req.(*DeliveriesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notification.NotificationService",
 	HandlerType: (*NotificationServiceServer)(nil),
@@ -628,6 +935,10 @@ var _NotificationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplates",
 			Handler:    _NotificationService_ListTemplates_Handler,
 		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _NotificationService_ListDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/notification/notification.proto",
@@ -945,84 +1256,292 @@ func (m *TemplatesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNotification(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Delivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Delivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovNotification(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *TriggerList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovNotification(uint64(l))
-		}
+	if m.Attempt != nil {
+		i = encodeVarintNotification(dAtA, i, uint64(*m.Attempt))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Status != nil {
+		i -= len(*m.Status)
+		copy(dAtA[i:], *m.Status)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Status)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-
-func (m *TriggersListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Recipient != nil {
+		i -= len(*m.Recipient)
+		copy(dAtA[i:], *m.Recipient)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Recipient)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Service != nil {
+		i -= len(*m.Service)
+		copy(dAtA[i:], *m.Service)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Service)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Trigger != nil {
+		i -= len(*m.Trigger)
+		copy(dAtA[i:], *m.Trigger)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Trigger)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
 	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovNotification(uint64(l))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *ServiceList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
+	if m.Time != nil {
+		i = encodeVarintNotification(dAtA, i, uint64(*m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNotification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveriesListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveriesListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveriesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeadLettered != nil {
+		i--
+		if *m.DeadLettered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNotification(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggersListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServiceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.Items) > 0 {
 		for _, e := range m.Items {
@@ -1094,6 +1613,109 @@ func (m *TemplatesListRequest) Size() (n int) {
 	return n
 }
 
+func (m *Delivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Time != nil {
+		n += 1 + sovNotification(uint64(*m.Time))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = len(*m.Trigger)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Service != nil {
+		l = len(*m.Service)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = len(*m.Recipient)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Status != nil {
+		l = len(*m.Status)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Attempt != nil {
+		n += 1 + sovNotification(uint64(*m.Attempt))
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveriesListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.DeadLettered != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovNotification(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1775,6 +2397,688 @@ func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Delivery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Time = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Trigger = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Service = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Recipient = &s
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Status = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attempt = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Error = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Delivery{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveriesListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveriesListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveriesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLettered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DeadLettered = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNotification(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -248,6 +248,10 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	if _, err := p.Spec.SyncApproval.GetTimeout(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	return nil
 }
 
@@ -445,6 +449,76 @@ func (proj AppProject) isDestinationMatched(dst ApplicationDestination) bool {
 	return anyDestinationMatched && noDenyDestinationsMatched
 }
 
+// IsSourceRepoPermitted returns whether project owners may add the repository URL pattern to the source repositories
+// of the project. Deny patterns only restrict the project and are always permitted.
+func (c *ProjectCeiling) IsSourceRepoPermitted(repoURL string) bool {
+	if isDenyPattern(repoURL) {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	ceiling := AppProject{Spec: AppProjectSpec{SourceRepos: c.SourceRepos}}
+	return ceiling.IsSourcePermitted(ApplicationSource{RepoURL: repoURL})
+}
+
+// IsDestinationPermitted returns whether project owners may add the destination pattern to the destinations of the
+// project. Destinations with only deny patterns only restrict the project and are always permitted. Since a deny pattern
+// matches everything but the denied value, the other fields of a destination with a deny pattern must be permitted by a
+// ceiling destination which matches everything in place of the deny pattern.
+func (c *ProjectCeiling) IsDestinationPermitted(dst ApplicationDestination) bool {
+	denyCluster := (isDenyPattern(dst.Server) || isDenyPattern(dst.Name)) &&
+		(dst.Server == "" || isDenyPattern(dst.Server)) && (dst.Name == "" || isDenyPattern(dst.Name))
+	denyNamespace := isDenyPattern(dst.Namespace)
+	if denyCluster && denyNamespace {
+		return true
+	}
+	if c == nil {
+		return false
+	}
+	switch {
+	case denyCluster:
+		dst.Server, dst.Name = "*", ""
+	case isDenyPattern(dst.Server):
+		dst.Server = ""
+	case isDenyPattern(dst.Name):
+		dst.Name = ""
+	}
+	if denyNamespace {
+		dst.Namespace = "*"
+	}
+	ceiling := AppProject{Spec: AppProjectSpec{Destinations: c.Destinations}}
+	return ceiling.isDestinationMatched(dst)
+}
+
+// IsClusterResourcePermitted returns whether project owners may add the group kind pattern to the cluster resource
+// whitelist of the project
+func (c *ProjectCeiling) IsClusterResourcePermitted(gk metav1.GroupKind) bool {
+	return c != nil && isResourceInList(gk, c.ClusterResourceWhitelist)
+}
+
+// IsSourceRepoRemovalPermitted returns whether project owners may remove the repository URL pattern from the source
+// repositories of the project. Removing a deny pattern permits the denied repositories, which the ceiling must permit.
+func (c *ProjectCeiling) IsSourceRepoRemovalPermitted(repoURL string) bool {
+	if !isDenyPattern(repoURL) {
+		return true
+	}
+	return c.IsSourceRepoPermitted(strings.TrimPrefix(repoURL, "!"))
+}
+
+// IsDestinationRemovalPermitted returns whether project owners may remove the destination pattern from the destinations
+// of the project. Removing a destination with deny patterns permits the denied destinations, which the ceiling must
+// permit.
+func (c *ProjectCeiling) IsDestinationRemovalPermitted(dst ApplicationDestination) bool {
+	if !isDenyPattern(dst.Server) && !isDenyPattern(dst.Name) && !isDenyPattern(dst.Namespace) {
+		return true
+	}
+	dst.Server = strings.TrimPrefix(dst.Server, "!")
+	dst.Name = strings.TrimPrefix(dst.Name, "!")
+	dst.Namespace = strings.TrimPrefix(dst.Namespace, "!")
+	return c.IsDestinationPermitted(dst)
+}
+
 func isDenyPattern(pattern string) bool {
 	return strings.HasPrefix(pattern, "!")
 }
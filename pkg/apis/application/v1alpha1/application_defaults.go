@@ -21,6 +21,9 @@ const (
 	// RevisionHistoryLimit is the max number of successful sync to keep in history
 	RevisionHistoryLimit = 10
 
+	// HealthHistoryLimit is the max number of health transitions to keep in the health history
+	HealthHistoryLimit = 20
+
 	// KubernetesInternalAPIServerAddr is address of the k8s API server when accessing internal to the cluster
 	KubernetesInternalAPIServerAddr = "https://kubernetes.default.svc"
 )
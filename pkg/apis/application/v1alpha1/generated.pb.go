@@ -17,6 +17,7 @@ import (
 	v12 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
 	math_bits "math/bits"
@@ -262,10 +263,38 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationHealthPolicy) Reset()      { *m = ApplicationHealthPolicy{} }
+func (*ApplicationHealthPolicy) ProtoMessage() {}
+func (*ApplicationHealthPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{8}
+}
+func (m *ApplicationHealthPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHealthPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationHealthPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHealthPolicy.Merge(m, src)
+}
+func (m *ApplicationHealthPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHealthPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHealthPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHealthPolicy proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{9}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +322,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{10}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +350,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +378,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{12}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +406,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{13}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +434,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +462,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +490,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +518,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{17}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -517,7 +546,7 @@ var xxx_messageInfo_ApplicationSetNestedGenerator proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{18}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +574,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{19}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +602,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +630,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +658,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{22}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +686,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +714,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +742,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{25}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +770,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +798,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{27}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +826,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +854,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +882,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +910,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{31}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +938,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +966,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,7 +994,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{34}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1022,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1021,7 +1050,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{36}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1049,7 +1078,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1106,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1134,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1162,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{40}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,7 +1190,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1218,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{42}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1246,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,7 +1274,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1302,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{45}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1330,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{46}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,7 +1358,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{47}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1386,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{48}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1385,7 +1414,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1442,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1470,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{51}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,7 +1498,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,7 +1526,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,7 +1554,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{54}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1553,7 +1582,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{55}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1581,7 +1610,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,7 +1638,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1666,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{58}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1665,7 +1694,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{59}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1693,7 +1722,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{60}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1718,10 +1747,38 @@ func (m *GnuPGPublicKeyList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 
+func (m *HealthPolicyResourceSelector) Reset()      { *m = HealthPolicyResourceSelector{} }
+func (*HealthPolicyResourceSelector) ProtoMessage() {}
+func (*HealthPolicyResourceSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{61}
+}
+func (m *HealthPolicyResourceSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthPolicyResourceSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HealthPolicyResourceSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthPolicyResourceSelector.Merge(m, src)
+}
+func (m *HealthPolicyResourceSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthPolicyResourceSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthPolicyResourceSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthPolicyResourceSelector proto.InternalMessageInfo
+
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1746,10 +1803,38 @@ func (m *HealthStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 
+func (m *HealthTransition) Reset()      { *m = HealthTransition{} }
+func (*HealthTransition) ProtoMessage() {}
+func (*HealthTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{63}
+}
+func (m *HealthTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HealthTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthTransition.Merge(m, src)
+}
+func (m *HealthTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthTransition proto.InternalMessageInfo
+
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1777,7 +1862,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1805,7 +1890,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,7 +1918,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,7 +1946,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1889,7 +1974,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1917,7 +2002,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1945,7 +2030,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1973,7 +2058,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2001,7 +2086,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2029,7 +2114,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2057,7 +2142,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{75}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,7 +2170,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{76}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2113,7 +2198,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2226,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2169,7 +2254,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,7 +2282,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2225,7 +2310,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,7 +2338,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2281,7 +2366,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2309,7 +2394,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2337,7 +2422,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2365,7 +2450,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2393,7 +2478,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2421,7 +2506,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2449,7 +2534,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2474,10 +2559,38 @@ func (m *OrphanedResourcesMonitorSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 
+func (m *OverrideAggregatedHealth) Reset()      { *m = OverrideAggregatedHealth{} }
+func (*OverrideAggregatedHealth) ProtoMessage() {}
+func (*OverrideAggregatedHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{90}
+}
+func (m *OverrideAggregatedHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverrideAggregatedHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OverrideAggregatedHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverrideAggregatedHealth.Merge(m, src)
+}
+func (m *OverrideAggregatedHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverrideAggregatedHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverrideAggregatedHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverrideAggregatedHealth proto.InternalMessageInfo
+
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{91}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2502,10 +2615,38 @@ func (m *OverrideIgnoreDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 
+func (m *PendingSync) Reset()      { *m = PendingSync{} }
+func (*PendingSync) ProtoMessage() {}
+func (*PendingSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{92}
+}
+func (m *PendingSync) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSync.Merge(m, src)
+}
+func (m *PendingSync) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSync proto.InternalMessageInfo
+
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{93}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2533,7 +2674,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{89}
+	return fileDescriptor_030104ce3b95bcac, []int{94}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2561,7 +2702,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{90}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2586,10 +2727,38 @@ func (m *PluginInput) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 
+func (m *ProjectCeiling) Reset()      { *m = ProjectCeiling{} }
+func (*ProjectCeiling) ProtoMessage() {}
+func (*ProjectCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{96}
+}
+func (m *ProjectCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectCeiling.Merge(m, src)
+}
+func (m *ProjectCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectCeiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectCeiling proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{91}
+	return fileDescriptor_030104ce3b95bcac, []int{97}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2617,7 +2786,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{92}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2645,7 +2814,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{93}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,7 +2842,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{94}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,7 +2870,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{95}
+	return fileDescriptor_030104ce3b95bcac, []int{101}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2729,7 +2898,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{96}
+	return fileDescriptor_030104ce3b95bcac, []int{102}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2757,7 +2926,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{97}
+	return fileDescriptor_030104ce3b95bcac, []int{103}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2785,7 +2954,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{98}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2813,7 +2982,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{99}
+	return fileDescriptor_030104ce3b95bcac, []int{105}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2841,7 +3010,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{100}
+	return fileDescriptor_030104ce3b95bcac, []int{106}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2869,7 +3038,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{101}
+	return fileDescriptor_030104ce3b95bcac, []int{107}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2897,7 +3066,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{102}
+	return fileDescriptor_030104ce3b95bcac, []int{108}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2925,7 +3094,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{103}
+	return fileDescriptor_030104ce3b95bcac, []int{109}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2953,7 +3122,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{104}
+	return fileDescriptor_030104ce3b95bcac, []int{110}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2981,7 +3150,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{105}
+	return fileDescriptor_030104ce3b95bcac, []int{111}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3009,7 +3178,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{106}
+	return fileDescriptor_030104ce3b95bcac, []int{112}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3037,7 +3206,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{107}
+	return fileDescriptor_030104ce3b95bcac, []int{113}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3065,7 +3234,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{108}
+	return fileDescriptor_030104ce3b95bcac, []int{114}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3093,7 +3262,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{109}
+	return fileDescriptor_030104ce3b95bcac, []int{115}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,7 +3290,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{110}
+	return fileDescriptor_030104ce3b95bcac, []int{116}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3149,7 +3318,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{111}
+	return fileDescriptor_030104ce3b95bcac, []int{117}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3177,7 +3346,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{112}
+	return fileDescriptor_030104ce3b95bcac, []int{118}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3205,7 +3374,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{113}
+	return fileDescriptor_030104ce3b95bcac, []int{119}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3233,7 +3402,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{114}
+	return fileDescriptor_030104ce3b95bcac, []int{120}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3261,7 +3430,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{115}
+	return fileDescriptor_030104ce3b95bcac, []int{121}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3458,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{116}
+	return fileDescriptor_030104ce3b95bcac, []int{122}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3317,7 +3486,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{117}
+	return fileDescriptor_030104ce3b95bcac, []int{123}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3345,7 +3514,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{118}
+	return fileDescriptor_030104ce3b95bcac, []int{124}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3373,7 +3542,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{119}
+	return fileDescriptor_030104ce3b95bcac, []int{125}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3401,7 +3570,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{120}
+	return fileDescriptor_030104ce3b95bcac, []int{126}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3429,7 +3598,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{121}
+	return fileDescriptor_030104ce3b95bcac, []int{127}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3457,7 +3626,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{122}
+	return fileDescriptor_030104ce3b95bcac, []int{128}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3485,7 +3654,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{123}
+	return fileDescriptor_030104ce3b95bcac, []int{129}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3513,7 +3682,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{124}
+	return fileDescriptor_030104ce3b95bcac, []int{130}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3541,7 +3710,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{125}
+	return fileDescriptor_030104ce3b95bcac, []int{131}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3569,7 +3738,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{126}
+	return fileDescriptor_030104ce3b95bcac, []int{132}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3597,7 +3766,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{127}
+	return fileDescriptor_030104ce3b95bcac, []int{133}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3625,7 +3794,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{128}
+	return fileDescriptor_030104ce3b95bcac, []int{134}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3653,7 +3822,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{129}
+	return fileDescriptor_030104ce3b95bcac, []int{135}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,7 +3850,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{130}
+	return fileDescriptor_030104ce3b95bcac, []int{136}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3706,10 +3875,66 @@ func (m *SignatureKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 
+func (m *SubmoduleCredentials) Reset()      { *m = SubmoduleCredentials{} }
+func (*SubmoduleCredentials) ProtoMessage() {}
+func (*SubmoduleCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{137}
+}
+func (m *SubmoduleCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmoduleCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SubmoduleCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmoduleCredentials.Merge(m, src)
+}
+func (m *SubmoduleCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmoduleCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmoduleCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmoduleCredentials proto.InternalMessageInfo
+
+func (m *SyncApproval) Reset()      { *m = SyncApproval{} }
+func (*SyncApproval) ProtoMessage() {}
+func (*SyncApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{138}
+}
+func (m *SyncApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncApproval.Merge(m, src)
+}
+func (m *SyncApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncApproval proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{131}
+	return fileDescriptor_030104ce3b95bcac, []int{139}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3737,7 +3962,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{132}
+	return fileDescriptor_030104ce3b95bcac, []int{140}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3765,7 +3990,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{133}
+	return fileDescriptor_030104ce3b95bcac, []int{141}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3793,7 +4018,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{134}
+	return fileDescriptor_030104ce3b95bcac, []int{142}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3821,7 +4046,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{135}
+	return fileDescriptor_030104ce3b95bcac, []int{143}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3849,7 +4074,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{136}
+	return fileDescriptor_030104ce3b95bcac, []int{144}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3877,7 +4102,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{137}
+	return fileDescriptor_030104ce3b95bcac, []int{145}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3905,7 +4130,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{138}
+	return fileDescriptor_030104ce3b95bcac, []int{146}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3933,7 +4158,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{139}
+	return fileDescriptor_030104ce3b95bcac, []int{147}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3961,7 +4186,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{140}
+	return fileDescriptor_030104ce3b95bcac, []int{148}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3989,7 +4214,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{141}
+	return fileDescriptor_030104ce3b95bcac, []int{149}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4017,7 +4242,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{142}
+	return fileDescriptor_030104ce3b95bcac, []int{150}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4052,6 +4277,7 @@ func init() {
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationHealthPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationHealthPolicy")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationMatchExpression)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationMatchExpression")
 	proto.RegisterType((*ApplicationPreservedFields)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationPreservedFields")
@@ -4114,7 +4340,9 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GitGenerator.ValuesEntry")
 	proto.RegisterType((*GnuPGPublicKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GnuPGPublicKey")
 	proto.RegisterType((*GnuPGPublicKeyList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GnuPGPublicKeyList")
+	proto.RegisterType((*HealthPolicyResourceSelector)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HealthPolicyResourceSelector")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HealthStatus")
+	proto.RegisterType((*HealthTransition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HealthTransition")
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmParameter")
@@ -4144,12 +4372,15 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OptionalMap.MapEntry")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
+	proto.RegisterType((*OverrideAggregatedHealth)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideAggregatedHealth")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
+	proto.RegisterType((*PendingSync)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PendingSync")
 	proto.RegisterType((*PluginConfigMapRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginConfigMapRef")
 	proto.RegisterType((*PluginGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginGenerator.ValuesEntry")
 	proto.RegisterType((*PluginInput)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput")
 	proto.RegisterMapType((PluginParameters)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PluginInput.ParametersEntry")
+	proto.RegisterType((*ProjectCeiling)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectCeiling")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*PullRequestGenerator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGenerator")
 	proto.RegisterType((*PullRequestGeneratorBitbucketServer)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PullRequestGeneratorBitbucketServer")
@@ -4193,6 +4424,8 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SignatureKey")
+	proto.RegisterType((*SubmoduleCredentials)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SubmoduleCredentials")
+	proto.RegisterType((*SyncApproval)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncApproval")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResult")
@@ -4212,654 +4445,694 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 10341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0x90, 0x68, 0x92, 0x77, 0x38, 0xea, 0xee, 0x40,
-	0xcf, 0x95, 0x4f, 0xa7, 0xe8, 0x04, 0xf8, 0xa8, 0x93, 0x72, 0xf1, 0xd9, 0x92, 0xb1, 0x00, 0x09,
-	0x82, 0x04, 0x08, 0x5c, 0x03, 0x24, 0xa5, 0x93, 0x4f, 0xa7, 0xc1, 0x6c, 0xef, 0x62, 0x88, 0xd9,
-	0x99, 0xe5, 0xcc, 0x2c, 0x08, 0x9c, 0x25, 0x59, 0xb2, 0x2d, 0x5b, 0x89, 0x3e, 0x4e, 0x91, 0x52,
-	0x65, 0x29, 0x89, 0x1c, 0xf9, 0xa3, 0x52, 0x71, 0x25, 0xaa, 0x28, 0x95, 0x1f, 0x71, 0xe2, 0xa4,
-	0x5c, 0xb2, 0xf3, 0x43, 0x29, 0x25, 0x15, 0x55, 0xe2, 0xb2, 0x9c, 0xd8, 0x46, 0x24, 0xa4, 0x52,
-	0x49, 0xa5, 0x2a, 0xae, 0xca, 0xc7, 0x9f, 0xb0, 0x52, 0x95, 0x54, 0x7f, 0xf7, 0xcc, 0xee, 0x12,
-	0x0b, 0xec, 0x80, 0xa4, 0x94, 0xfb, 0xb7, 0xdb, 0xef, 0xcd, 0x7b, 0x3d, 0x3d, 0xdd, 0xaf, 0xdf,
-	0x7b, 0xfd, 0xde, 0x6b, 0x58, 0x6e, 0x78, 0xc9, 0x56, 0x7b, 0x73, 0xc6, 0x0d, 0x9b, 0xb3, 0x4e,
-	0xd4, 0x08, 0x5b, 0x51, 0x78, 0x9b, 0xfd, 0x78, 0xb7, 0x5b, 0x9b, 0xdd, 0xb9, 0x38, 0xdb, 0xda,
-	0x6e, 0xcc, 0x3a, 0x2d, 0x2f, 0x9e, 0x75, 0x5a, 0x2d, 0xdf, 0x73, 0x9d, 0xc4, 0x0b, 0x83, 0xd9,
-	0x9d, 0x17, 0x1c, 0xbf, 0xb5, 0xe5, 0xbc, 0x30, 0xdb, 0x20, 0x01, 0x89, 0x9c, 0x84, 0xd4, 0x66,
-	0x5a, 0x51, 0x98, 0x84, 0xe8, 0xa7, 0x34, 0xb5, 0x19, 0x49, 0x8d, 0xfd, 0x78, 0xdd, 0xad, 0xcd,
-	0xec, 0x5c, 0x9c, 0x69, 0x6d, 0x37, 0x66, 0x28, 0xb5, 0x19, 0x83, 0xda, 0x8c, 0xa4, 0x76, 0xfe,
-	0xdd, 0x46, 0x5f, 0x1a, 0x61, 0x23, 0x9c, 0x65, 0x44, 0x37, 0xdb, 0x75, 0xf6, 0x8f, 0xfd, 0x61,
-	0xbf, 0x38, 0xb3, 0xf3, 0xf6, 0xf6, 0x4b, 0xf1, 0x8c, 0x17, 0xd2, 0xee, 0xcd, 0xba, 0x61, 0x44,
-	0x66, 0x77, 0x3a, 0x3a, 0x74, 0xfe, 0x8a, 0xc6, 0x21, 0xbb, 0x09, 0x09, 0x62, 0x2f, 0x0c, 0xe2,
-	0x77, 0xd3, 0x2e, 0x90, 0x68, 0x87, 0x44, 0xe6, 0xeb, 0x19, 0x08, 0xdd, 0x28, 0xbd, 0xa8, 0x29,
-	0x35, 0x1d, 0x77, 0xcb, 0x0b, 0x48, 0xb4, 0xa7, 0x1f, 0x6f, 0x92, 0xc4, 0xe9, 0xf6, 0xd4, 0x6c,
-	0xaf, 0xa7, 0xa2, 0x76, 0x90, 0x78, 0x4d, 0xd2, 0xf1, 0xc0, 0xfb, 0x0e, 0x7b, 0x20, 0x76, 0xb7,
-	0x48, 0xd3, 0xe9, 0x78, 0xee, 0x3d, 0xbd, 0x9e, 0x6b, 0x27, 0x9e, 0x3f, 0xeb, 0x05, 0x49, 0x9c,
-	0x44, 0xd9, 0x87, 0xec, 0x3b, 0x30, 0x3e, 0x77, 0x6b, 0x7d, 0xae, 0x9d, 0x6c, 0xcd, 0x87, 0x41,
-	0xdd, 0x6b, 0xa0, 0xf7, 0xc2, 0xa8, 0xeb, 0xb7, 0xe3, 0x84, 0x44, 0xd7, 0x9d, 0x26, 0x99, 0xb2,
-	0x2e, 0x58, 0xcf, 0x55, 0xaa, 0x67, 0xbe, 0xbd, 0x3f, 0xfd, 0xb6, 0x83, 0xfd, 0xe9, 0xd1, 0x79,
-	0x0d, 0xc2, 0x26, 0x1e, 0x7a, 0x27, 0x8c, 0x44, 0xa1, 0x4f, 0xe6, 0xf0, 0xf5, 0xa9, 0x02, 0x7b,
-	0xe4, 0x94, 0x78, 0x64, 0x04, 0xf3, 0x66, 0x2c, 0xe1, 0xf6, 0x1f, 0x15, 0x00, 0xe6, 0x5a, 0xad,
-	0xb5, 0x28, 0xbc, 0x4d, 0xdc, 0x04, 0x7d, 0x14, 0xca, 0x74, 0xe8, 0x6a, 0x4e, 0xe2, 0x30, 0x6e,
-	0xa3, 0x17, 0x7f, 0x62, 0x86, 0xbf, 0xc9, 0x8c, 0xf9, 0x26, 0x7a, 0xe2, 0x50, 0xec, 0x99, 0x9d,
-	0x17, 0x66, 0x56, 0x37, 0xe9, 0xf3, 0x2b, 0x24, 0x71, 0xaa, 0x48, 0x30, 0x03, 0xdd, 0x86, 0x15,
-	0x55, 0x14, 0xc0, 0x50, 0xdc, 0x22, 0x2e, 0xeb, 0xd8, 0xe8, 0xc5, 0xe5, 0x99, 0x41, 0x66, 0xe8,
-	0x8c, 0xee, 0xf9, 0x7a, 0x8b, 0xb8, 0xd5, 0x31, 0xc1, 0x79, 0x88, 0xfe, 0xc3, 0x8c, 0x0f, 0xda,
-	0x81, 0xe1, 0x38, 0x71, 0x92, 0x76, 0x3c, 0x55, 0x64, 0x1c, 0xaf, 0xe7, 0xc6, 0x91, 0x51, 0xad,
-	0x4e, 0x08, 0x9e, 0xc3, 0xfc, 0x3f, 0x16, 0xdc, 0xec, 0x3f, 0xb3, 0x60, 0x42, 0x23, 0x2f, 0x7b,
-	0x71, 0x82, 0x7e, 0xb6, 0x63, 0x70, 0x67, 0xfa, 0x1b, 0x5c, 0xfa, 0x34, 0x1b, 0xda, 0xd3, 0x82,
-	0x59, 0x59, 0xb6, 0x18, 0x03, 0xdb, 0x84, 0x92, 0x97, 0x90, 0x66, 0x3c, 0x55, 0xb8, 0x50, 0x7c,
-	0x6e, 0xf4, 0xe2, 0x95, 0xbc, 0xde, 0xb3, 0x3a, 0x2e, 0x98, 0x96, 0x96, 0x28, 0x79, 0xcc, 0xb9,
-	0xd8, 0xbf, 0x3d, 0x66, 0xbe, 0x1f, 0x1d, 0x70, 0xf4, 0x02, 0x8c, 0xc6, 0x61, 0x3b, 0x72, 0x09,
-	0x26, 0xad, 0x30, 0x9e, 0xb2, 0x2e, 0x14, 0xe9, 0xd4, 0xa3, 0x33, 0x75, 0x5d, 0x37, 0x63, 0x13,
-	0x07, 0x7d, 0xc1, 0x82, 0xb1, 0x1a, 0x89, 0x13, 0x2f, 0x60, 0xfc, 0x65, 0xe7, 0x37, 0x06, 0xee,
-	0xbc, 0x6c, 0x5c, 0xd0, 0xc4, 0xab, 0x67, 0xc5, 0x8b, 0x8c, 0x19, 0x8d, 0x31, 0x4e, 0xf1, 0xa7,
-	0x2b, 0xae, 0x46, 0x62, 0x37, 0xf2, 0x5a, 0xf4, 0x3f, 0x9b, 0x33, 0xc6, 0x8a, 0x5b, 0xd0, 0x20,
-	0x6c, 0xe2, 0xa1, 0x00, 0x4a, 0x74, 0x45, 0xc5, 0x53, 0x43, 0xac, 0xff, 0x4b, 0x83, 0xf5, 0x5f,
-	0x0c, 0x2a, 0x5d, 0xac, 0x7a, 0xf4, 0xe9, 0xbf, 0x18, 0x73, 0x36, 0xe8, 0xf3, 0x16, 0x4c, 0x89,
-	0x15, 0x8f, 0x09, 0x1f, 0xd0, 0x5b, 0x5b, 0x5e, 0x42, 0x7c, 0x2f, 0x4e, 0xa6, 0x4a, 0xac, 0x0f,
-	0xb3, 0xfd, 0xcd, 0xad, 0xc5, 0x28, 0x6c, 0xb7, 0xae, 0x79, 0x41, 0xad, 0x7a, 0x41, 0x70, 0x9a,
-	0x9a, 0xef, 0x41, 0x18, 0xf7, 0x64, 0x89, 0xbe, 0x6c, 0xc1, 0xf9, 0xc0, 0x69, 0x92, 0xb8, 0xe5,
-	0xd0, 0x4f, 0xcb, 0xc1, 0x55, 0xdf, 0x71, 0xb7, 0x59, 0x8f, 0x86, 0x8f, 0xd7, 0x23, 0x5b, 0xf4,
-	0xe8, 0xfc, 0xf5, 0x9e, 0xa4, 0xf1, 0x7d, 0xd8, 0xa2, 0xdf, 0xb4, 0x60, 0x32, 0x8c, 0x5a, 0x5b,
-	0x4e, 0x40, 0x6a, 0x12, 0x1a, 0x4f, 0x8d, 0xb0, 0xa5, 0xf7, 0x91, 0xc1, 0x3e, 0xd1, 0x6a, 0x96,
-	0xec, 0x4a, 0x18, 0x78, 0x49, 0x18, 0xad, 0x93, 0x24, 0xf1, 0x82, 0x46, 0x5c, 0x3d, 0x77, 0xb0,
-	0x3f, 0x3d, 0xd9, 0x81, 0x85, 0x3b, 0xfb, 0x83, 0x7e, 0x0e, 0x46, 0xe3, 0xbd, 0xc0, 0xbd, 0xe5,
-	0x05, 0xb5, 0xf0, 0x6e, 0x3c, 0x55, 0xce, 0x63, 0xf9, 0xae, 0x2b, 0x82, 0x62, 0x01, 0x6a, 0x06,
-	0xd8, 0xe4, 0xd6, 0xfd, 0xc3, 0xe9, 0xa9, 0x54, 0xc9, 0xfb, 0xc3, 0xe9, 0xc9, 0x74, 0x1f, 0xb6,
-	0xe8, 0x57, 0x2c, 0x18, 0x8f, 0xbd, 0x46, 0xe0, 0x24, 0xed, 0x88, 0x5c, 0x23, 0x7b, 0xf1, 0x14,
-	0xb0, 0x8e, 0x5c, 0x1d, 0x70, 0x54, 0x0c, 0x92, 0xd5, 0x73, 0xa2, 0x8f, 0xe3, 0x66, 0x6b, 0x8c,
-	0xd3, 0x7c, 0xbb, 0x2d, 0x34, 0x3d, 0xad, 0x47, 0xf3, 0x5d, 0x68, 0x7a, 0x52, 0xf7, 0x64, 0x89,
-	0x7e, 0x06, 0x4e, 0xf3, 0x26, 0x35, 0xb2, 0xf1, 0xd4, 0x18, 0x13, 0xb4, 0x67, 0x0f, 0xf6, 0xa7,
-	0x4f, 0xaf, 0x67, 0x60, 0xb8, 0x03, 0x1b, 0xdd, 0x81, 0xe9, 0x16, 0x89, 0x9a, 0x5e, 0xb2, 0x1a,
-	0xf8, 0x7b, 0x52, 0x7c, 0xbb, 0x61, 0x8b, 0xd4, 0x44, 0x77, 0xe2, 0xa9, 0xf1, 0x0b, 0xd6, 0x73,
-	0xe5, 0xea, 0x3b, 0x44, 0x37, 0xa7, 0xd7, 0xee, 0x8f, 0x8e, 0x0f, 0xa3, 0x67, 0xff, 0x8b, 0x02,
-	0x9c, 0xce, 0x6e, 0x9c, 0xe8, 0x6f, 0x5b, 0x70, 0xea, 0xf6, 0xdd, 0x64, 0x23, 0xdc, 0x26, 0x41,
-	0x5c, 0xdd, 0xa3, 0xe2, 0x8d, 0x6d, 0x19, 0xa3, 0x17, 0xdd, 0x7c, 0xb7, 0xe8, 0x99, 0xab, 0x69,
-	0x2e, 0x97, 0x82, 0x24, 0xda, 0xab, 0x3e, 0x2e, 0xde, 0xee, 0xd4, 0xd5, 0x5b, 0x1b, 0x26, 0x14,
-	0x67, 0x3b, 0x75, 0xfe, 0xb3, 0x16, 0x9c, 0xed, 0x46, 0x02, 0x9d, 0x86, 0xe2, 0x36, 0xd9, 0xe3,
-	0x5a, 0x19, 0xa6, 0x3f, 0xd1, 0x6b, 0x50, 0xda, 0x71, 0xfc, 0x36, 0x11, 0xda, 0xcd, 0xe2, 0x60,
-	0x2f, 0xa2, 0x7a, 0x86, 0x39, 0xd5, 0x9f, 0x2c, 0xbc, 0x64, 0xd9, 0xff, 0xba, 0x08, 0xa3, 0xc6,
-	0xfe, 0xf6, 0x00, 0x34, 0xb6, 0x30, 0xa5, 0xb1, 0xad, 0xe4, 0xb6, 0x35, 0xf7, 0x54, 0xd9, 0xee,
-	0x66, 0x54, 0xb6, 0xd5, 0xfc, 0x58, 0xde, 0x57, 0x67, 0x43, 0x09, 0x54, 0xc2, 0x16, 0xd5, 0xc8,
-	0xe9, 0xd6, 0x3f, 0x94, 0xc7, 0x27, 0x5c, 0x95, 0xe4, 0xaa, 0xe3, 0x07, 0xfb, 0xd3, 0x15, 0xf5,
-	0x17, 0x6b, 0x46, 0xf6, 0xf7, 0x2c, 0x38, 0x6b, 0xf4, 0x71, 0x3e, 0x0c, 0x6a, 0x1e, 0xfb, 0xb4,
-	0x17, 0x60, 0x28, 0xd9, 0x6b, 0x49, 0xb5, 0x5f, 0x8d, 0xd4, 0xc6, 0x5e, 0x8b, 0x60, 0x06, 0xa1,
-	0x8a, 0x7e, 0x93, 0xc4, 0xb1, 0xd3, 0x20, 0x59, 0x45, 0x7f, 0x85, 0x37, 0x63, 0x09, 0x47, 0x11,
-	0x20, 0xdf, 0x89, 0x93, 0x8d, 0xc8, 0x09, 0x62, 0x46, 0x7e, 0xc3, 0x6b, 0x12, 0x31, 0xc0, 0x7f,
-	0xa1, 0xbf, 0x19, 0x43, 0x9f, 0xa8, 0x3e, 0x76, 0xb0, 0x3f, 0x8d, 0x96, 0x3b, 0x28, 0xe1, 0x2e,
-	0xd4, 0xed, 0x2f, 0x5b, 0xf0, 0x58, 0x77, 0x5d, 0x0c, 0x3d, 0x0b, 0xc3, 0xdc, 0xe4, 0x13, 0x6f,
-	0xa7, 0x3f, 0x09, 0x6b, 0xc5, 0x02, 0x8a, 0x66, 0xa1, 0xa2, 0xf6, 0x09, 0xf1, 0x8e, 0x93, 0x02,
-	0xb5, 0xa2, 0x37, 0x17, 0x8d, 0x43, 0x07, 0x8d, 0xfe, 0x11, 0x9a, 0x9b, 0x1a, 0x34, 0x66, 0x24,
-	0x31, 0x88, 0xfd, 0x1f, 0x2c, 0x38, 0x65, 0xf4, 0xea, 0x01, 0xa8, 0xe6, 0x41, 0x5a, 0x35, 0x5f,
-	0xca, 0x6d, 0x3e, 0xf7, 0xd0, 0xcd, 0x3f, 0x6f, 0xc1, 0x79, 0x03, 0x6b, 0xc5, 0x49, 0xdc, 0xad,
-	0x4b, 0xbb, 0xad, 0x88, 0xc4, 0xd4, 0x9c, 0x46, 0x4f, 0x19, 0x72, 0xab, 0x3a, 0x2a, 0x28, 0x14,
-	0xaf, 0x91, 0x3d, 0x2e, 0xc4, 0x9e, 0x87, 0x32, 0x9f, 0x9c, 0x61, 0x24, 0x46, 0x5c, 0xbd, 0xdb,
-	0xaa, 0x68, 0xc7, 0x0a, 0x03, 0xd9, 0x30, 0xcc, 0x84, 0x13, 0x5d, 0xac, 0x74, 0x1b, 0x02, 0xfa,
-	0x11, 0x6f, 0xb2, 0x16, 0x2c, 0x20, 0xf6, 0x6a, 0xaa, 0x3b, 0x6b, 0x11, 0x61, 0x1f, 0xb7, 0x76,
-	0xd9, 0x23, 0x7e, 0x2d, 0xa6, 0x66, 0x83, 0x13, 0x04, 0x61, 0x22, 0x2c, 0x00, 0xc3, 0x6c, 0x98,
-	0xd3, 0xcd, 0xd8, 0xc4, 0xb1, 0x0f, 0x0a, 0xcc, 0xf8, 0x50, 0xcb, 0x9a, 0x3c, 0x08, 0xcb, 0x35,
-	0x4a, 0xc9, 0xc1, 0xb5, 0xfc, 0x84, 0x12, 0xe9, 0x6d, 0xbd, 0xbe, 0x91, 0x11, 0x85, 0x38, 0x57,
-	0xae, 0xf7, 0xb7, 0x60, 0xbf, 0x55, 0x80, 0xe9, 0xf4, 0x03, 0x1d, 0x92, 0x94, 0x9a, 0x4b, 0x06,
-	0xa3, 0xac, 0x83, 0xc2, 0xc0, 0xc7, 0x26, 0x5e, 0x0f, 0x61, 0x54, 0x38, 0x49, 0x61, 0x64, 0xca,
-	0xca, 0xe2, 0x21, 0xb2, 0xf2, 0x59, 0x35, 0xea, 0x43, 0x19, 0xe1, 0x94, 0xde, 0x2f, 0x2e, 0xc0,
-	0x50, 0x9c, 0x90, 0xd6, 0x54, 0x29, 0x2d, 0x6b, 0xd6, 0x13, 0xd2, 0xc2, 0x0c, 0x62, 0xff, 0xd7,
-	0x02, 0x3c, 0x9e, 0x1e, 0x43, 0x2d, 0xde, 0x3f, 0x90, 0x12, 0xef, 0xef, 0x32, 0xc5, 0xfb, 0xbd,
-	0xfd, 0xe9, 0xb7, 0xf7, 0x78, 0xec, 0x87, 0x46, 0xfa, 0xa3, 0xc5, 0xcc, 0x28, 0xce, 0xa6, 0x47,
-	0xf1, 0xde, 0xfe, 0xf4, 0x53, 0x3d, 0xde, 0x31, 0x33, 0xcc, 0xcf, 0xc2, 0x70, 0x44, 0x9c, 0x38,
-	0x0c, 0xc4, 0x40, 0xab, 0xcf, 0x81, 0x59, 0x2b, 0x16, 0x50, 0xfb, 0xdf, 0x54, 0xb2, 0x83, 0xbd,
-	0xc8, 0x1d, 0x6c, 0x61, 0x84, 0x3c, 0x18, 0x62, 0x2a, 0x3b, 0x17, 0x0d, 0xd7, 0x06, 0x5b, 0x46,
-	0x54, 0xc4, 0x2b, 0xd2, 0xd5, 0x32, 0xfd, 0x6a, 0xb4, 0x09, 0x33, 0x16, 0x68, 0x17, 0xca, 0xae,
-	0xd4, 0xa4, 0x0b, 0x79, 0xf8, 0x9c, 0x84, 0x1e, 0xad, 0x39, 0x8e, 0x51, 0x59, 0xac, 0xd4, 0x6f,
-	0xc5, 0x0d, 0x11, 0x28, 0x36, 0xbc, 0x44, 0x7c, 0xd6, 0x01, 0x6d, 0xa5, 0x45, 0xcf, 0x78, 0xc5,
-	0x11, 0xba, 0x41, 0x2c, 0x7a, 0x09, 0xa6, 0xf4, 0xd1, 0xa7, 0x2d, 0x18, 0x8d, 0xdd, 0xe6, 0x5a,
-	0x14, 0xee, 0x78, 0x35, 0x12, 0x09, 0x4d, 0x69, 0x40, 0xd1, 0xb4, 0x3e, 0xbf, 0x22, 0x09, 0x6a,
-	0xbe, 0xdc, 0x76, 0xd5, 0x10, 0x6c, 0xf2, 0xa5, 0x16, 0xc4, 0xe3, 0xe2, 0xdd, 0x17, 0x88, 0xeb,
-	0xd1, 0xbd, 0x4d, 0x1a, 0x4c, 0x6c, 0xa6, 0x0c, 0xac, 0x39, 0x2e, 0xb4, 0xdd, 0x6d, 0xba, 0xde,
-	0x74, 0x87, 0xde, 0x7e, 0xb0, 0x3f, 0xfd, 0xf8, 0x7c, 0x77, 0x9e, 0xb8, 0x57, 0x67, 0xd8, 0x80,
-	0xb5, 0xda, 0xbe, 0x8f, 0xc9, 0x9d, 0x36, 0x61, 0xee, 0x90, 0x1c, 0x06, 0x6c, 0x4d, 0x13, 0xcc,
-	0x0c, 0x98, 0x01, 0xc1, 0x26, 0x5f, 0x74, 0x07, 0x86, 0x9b, 0x4e, 0x12, 0x79, 0xbb, 0xc2, 0x07,
-	0x32, 0xa0, 0x2e, 0xbf, 0xc2, 0x68, 0x69, 0xe6, 0x6c, 0xeb, 0xe7, 0x8d, 0x58, 0x30, 0x42, 0x4d,
-	0x28, 0x35, 0x49, 0xd4, 0x20, 0x53, 0xe5, 0x3c, 0xfc, 0xbd, 0x2b, 0x94, 0x94, 0x66, 0x58, 0xa1,
-	0x9a, 0x0f, 0x6b, 0xc3, 0x9c, 0x0b, 0x7a, 0x0d, 0xca, 0x31, 0xf1, 0x89, 0x4b, 0x75, 0x97, 0x0a,
-	0xe3, 0xf8, 0x9e, 0x3e, 0xf5, 0x38, 0x67, 0x93, 0xf8, 0xeb, 0xe2, 0x51, 0xbe, 0xc0, 0xe4, 0x3f,
-	0xac, 0x48, 0xd2, 0x01, 0x6c, 0xf9, 0xed, 0x86, 0x17, 0x4c, 0x41, 0x1e, 0x03, 0xb8, 0xc6, 0x68,
-	0x65, 0x06, 0x90, 0x37, 0x62, 0xc1, 0xc8, 0xfe, 0x4f, 0x16, 0xa0, 0xb4, 0x50, 0x7b, 0x00, 0x0a,
-	0xeb, 0x9d, 0xb4, 0xc2, 0xba, 0x9c, 0xa7, 0xd6, 0xd1, 0x43, 0x67, 0xfd, 0xdd, 0x0a, 0x64, 0xb6,
-	0x83, 0xeb, 0x24, 0x4e, 0x48, 0xed, 0x2d, 0x11, 0xfe, 0x96, 0x08, 0x7f, 0x4b, 0x84, 0x2b, 0x11,
-	0xbe, 0x99, 0x11, 0xe1, 0xef, 0x37, 0x56, 0xbd, 0x3e, 0x30, 0x7d, 0x5d, 0x9d, 0xa8, 0x9a, 0x3d,
-	0x30, 0x10, 0xa8, 0x24, 0xb8, 0xba, 0xbe, 0x7a, 0xbd, 0xab, 0xcc, 0x7e, 0x3d, 0x2d, 0xb3, 0x07,
-	0x65, 0xf1, 0xff, 0x83, 0x94, 0xfe, 0x1b, 0x05, 0x78, 0x22, 0x2d, 0xbd, 0x70, 0xe8, 0xfb, 0x61,
-	0x3b, 0xa1, 0xb6, 0x00, 0xfa, 0x35, 0x0b, 0x4e, 0x37, 0xd3, 0x46, 0x78, 0x2c, 0x7c, 0x9d, 0x1f,
-	0xcc, 0x4d, 0xb4, 0x66, 0xac, 0xfc, 0xea, 0x94, 0x10, 0xb3, 0xa7, 0x33, 0x80, 0x18, 0x77, 0xf4,
-	0x05, 0xbd, 0x06, 0x95, 0xa6, 0xb3, 0x7b, 0xa3, 0x55, 0x73, 0x12, 0x69, 0x86, 0xf5, 0xb6, 0x9e,
-	0xdb, 0x89, 0xe7, 0xcf, 0xf0, 0x13, 0xec, 0x99, 0xa5, 0x20, 0x59, 0x8d, 0xd6, 0x93, 0xc8, 0x0b,
-	0x1a, 0xdc, 0xc3, 0xb5, 0x22, 0xc9, 0x60, 0x4d, 0xd1, 0xfe, 0x9a, 0x95, 0x95, 0xed, 0x6a, 0x74,
-	0x22, 0x27, 0x21, 0x8d, 0x3d, 0xf4, 0x31, 0x28, 0x51, 0x7b, 0x49, 0x8e, 0xca, 0xad, 0x3c, 0x37,
-	0x1c, 0xe3, 0x4b, 0xe8, 0xbd, 0x87, 0xfe, 0x8b, 0x31, 0x67, 0x6a, 0x7f, 0x6b, 0x38, 0xbb, 0xc7,
-	0xb2, 0xf3, 0xcc, 0x8b, 0x00, 0x8d, 0x70, 0x83, 0x34, 0x5b, 0x3e, 0x1d, 0x16, 0x8b, 0x39, 0xc5,
-	0x95, 0x8b, 0x60, 0x51, 0x41, 0xb0, 0x81, 0x85, 0xfe, 0xb2, 0x05, 0xd0, 0x90, 0x53, 0x45, 0xee,
-	0x9f, 0x37, 0xf2, 0x7c, 0x1d, 0x3d, 0x11, 0x75, 0x5f, 0x14, 0x43, 0x6c, 0x30, 0x47, 0xbf, 0x60,
-	0x41, 0x39, 0x91, 0xdd, 0xe7, 0x3b, 0xca, 0x46, 0x9e, 0x3d, 0x91, 0x2f, 0xad, 0x55, 0x09, 0x35,
-	0x24, 0x8a, 0x2f, 0xfa, 0x65, 0x0b, 0x20, 0xde, 0x0b, 0xdc, 0xb5, 0xd0, 0xf7, 0xdc, 0x3d, 0xb1,
-	0xd1, 0xdc, 0xcc, 0xd5, 0x8d, 0xa1, 0xa8, 0x57, 0x27, 0xe8, 0x68, 0xe8, 0xff, 0xd8, 0xe0, 0x8c,
-	0x3e, 0x01, 0xe5, 0x58, 0x4c, 0x37, 0xb1, 0xb5, 0x6c, 0xe4, 0xeb, 0x4c, 0xe1, 0xb4, 0x85, 0x54,
-	0x12, 0xff, 0xb0, 0xe2, 0x89, 0x7e, 0xd5, 0x82, 0x53, 0xad, 0xb4, 0xeb, 0x4b, 0xec, 0x22, 0xf9,
-	0xc9, 0x80, 0x8c, 0x6b, 0xad, 0x7a, 0xe6, 0x60, 0x7f, 0xfa, 0x54, 0xa6, 0x11, 0x67, 0x7b, 0x81,
-	0xe6, 0x61, 0x52, 0xcf, 0xe0, 0xd5, 0x16, 0x77, 0xc3, 0x8d, 0x30, 0x37, 0x1c, 0x3b, 0xc5, 0x5c,
-	0xcc, 0x02, 0x71, 0x27, 0xbe, 0xfd, 0x9d, 0x42, 0xca, 0x8b, 0xad, 0xdc, 0x4b, 0x6c, 0x45, 0xb8,
-	0xd2, 0xb2, 0x97, 0x0b, 0x3c, 0xd7, 0x15, 0xa1, 0xfc, 0x06, 0x7a, 0x45, 0xa8, 0xa6, 0x18, 0x1b,
-	0xcc, 0xa9, 0xba, 0x31, 0xe9, 0x64, 0x9d, 0x58, 0x62, 0x91, 0xbe, 0x96, 0x67, 0x97, 0x3a, 0xcf,
-	0x1c, 0x9e, 0x10, 0x5d, 0x9b, 0xec, 0x00, 0xe1, 0xce, 0x2e, 0xd9, 0xdf, 0x49, 0x7b, 0xce, 0x8d,
-	0xf9, 0xd5, 0xc7, 0xa9, 0xc0, 0x17, 0x2c, 0x18, 0x8d, 0x42, 0xdf, 0xf7, 0x82, 0x06, 0x5d, 0x0b,
-	0x42, 0xa0, 0x7f, 0xf8, 0x44, 0x64, 0xaa, 0x98, 0xf4, 0x4c, 0x69, 0xc1, 0x9a, 0x27, 0x36, 0x3b,
-	0x60, 0xff, 0x99, 0x05, 0x53, 0xbd, 0xd6, 0x2c, 0x22, 0xf0, 0x76, 0x39, 0x21, 0xd5, 0x99, 0xf8,
-	0x6a, 0xb0, 0x40, 0x7c, 0xa2, 0x5c, 0x8a, 0xe5, 0xea, 0x33, 0xe2, 0x35, 0xdf, 0xbe, 0xd6, 0x1b,
-	0x15, 0xdf, 0x8f, 0x0e, 0x7a, 0x15, 0x4e, 0x1b, 0xef, 0x15, 0xab, 0x81, 0xa9, 0x54, 0x67, 0xe8,
-	0x26, 0x39, 0x97, 0x81, 0xdd, 0xdb, 0x9f, 0x7e, 0x2c, 0xdb, 0x26, 0x84, 0x4a, 0x07, 0x1d, 0xfb,
-	0xb7, 0x0a, 0xd9, 0xaf, 0xa5, 0xf6, 0x83, 0xaf, 0x58, 0x1d, 0x86, 0xda, 0x07, 0x4f, 0x42, 0x06,
-	0x33, 0x93, 0x4e, 0x1d, 0xbb, 0xf7, 0xc6, 0x79, 0x88, 0xe7, 0x7a, 0xf6, 0xbf, 0x1c, 0x82, 0xfb,
-	0xf4, 0x4c, 0x9d, 0xdc, 0x58, 0xbd, 0x4e, 0x6e, 0x8e, 0x7e, 0x18, 0xf4, 0x39, 0x0b, 0x86, 0x7d,
-	0xaa, 0x33, 0xf2, 0xd3, 0x89, 0xd1, 0x8b, 0xb5, 0x93, 0x1a, 0x7b, 0xae, 0x9a, 0xc6, 0xfc, 0x6c,
-	0x59, 0x39, 0x28, 0x79, 0x23, 0x16, 0x7d, 0x40, 0x5f, 0xb7, 0xd2, 0x47, 0x1d, 0x3c, 0x58, 0xc8,
-	0x3b, 0xb1, 0x3e, 0x19, 0xe7, 0x27, 0xbc, 0x63, 0xda, 0x33, 0xdf, 0xe3, 0x64, 0x05, 0xcd, 0x00,
-	0xd4, 0xbd, 0xc0, 0xf1, 0xbd, 0x37, 0xa8, 0xed, 0x5b, 0x62, 0x9b, 0x00, 0xdb, 0x55, 0x2f, 0xab,
-	0x56, 0x6c, 0x60, 0x9c, 0xff, 0x4b, 0x30, 0x6a, 0xbc, 0x79, 0x97, 0x23, 0xf1, 0xb3, 0xe6, 0x91,
-	0x78, 0xc5, 0x38, 0xc9, 0x3e, 0xff, 0x7e, 0x38, 0x9d, 0xed, 0xe0, 0x51, 0x9e, 0xb7, 0xbf, 0x3a,
-	0x92, 0x3d, 0x9f, 0xd8, 0x20, 0x51, 0x93, 0x76, 0xed, 0x2d, 0x9f, 0xc1, 0x5b, 0x3e, 0x83, 0xb7,
-	0x7c, 0x06, 0xa6, 0xdb, 0x57, 0xd8, 0xc3, 0x23, 0x0f, 0xca, 0x1e, 0x3e, 0x28, 0x41, 0x4a, 0xd1,
-	0xe1, 0x03, 0xf2, 0x4e, 0x18, 0x89, 0x48, 0x2b, 0xbc, 0x81, 0x97, 0x85, 0x90, 0xd7, 0x71, 0xc9,
-	0xbc, 0x19, 0x4b, 0x38, 0xdd, 0x0c, 0x5a, 0x4e, 0xb2, 0x25, 0xa4, 0xbc, 0xda, 0x0c, 0xd6, 0x9c,
-	0x64, 0x0b, 0x33, 0x08, 0x7a, 0x3f, 0x4c, 0x24, 0x4e, 0xd4, 0x20, 0x09, 0x26, 0x3b, 0x6c, 0xdc,
-	0xc5, 0x31, 0xd3, 0x63, 0x02, 0x77, 0x62, 0x23, 0x05, 0xc5, 0x19, 0x6c, 0x74, 0x07, 0x86, 0xb6,
-	0x88, 0xdf, 0x14, 0x63, 0xb2, 0x9e, 0x9f, 0x10, 0x66, 0xef, 0x7a, 0x85, 0xf8, 0x4d, 0x2e, 0x22,
-	0xe8, 0x2f, 0xcc, 0x58, 0xd1, 0x09, 0x51, 0xd9, 0x6e, 0xc7, 0x49, 0xd8, 0xf4, 0xde, 0x90, 0xde,
-	0x95, 0x0f, 0xe6, 0xcc, 0xf8, 0x9a, 0xa4, 0xcf, 0xed, 0x71, 0xf5, 0x17, 0x6b, 0xce, 0xac, 0x1f,
-	0x35, 0x2f, 0x62, 0xde, 0x92, 0x3d, 0xe1, 0x24, 0xc9, 0xbb, 0x1f, 0x0b, 0x92, 0x3e, 0xef, 0x87,
-	0xfa, 0x8b, 0x35, 0x67, 0xb4, 0xa7, 0x26, 0xe6, 0x28, 0xeb, 0xc3, 0x8d, 0x9c, 0xfb, 0xc0, 0x27,
-	0x65, 0xb7, 0x09, 0x8a, 0x9e, 0x81, 0x92, 0xbb, 0xe5, 0x44, 0xc9, 0xd4, 0x18, 0x9b, 0x34, 0xca,
-	0x2f, 0x30, 0x4f, 0x1b, 0x31, 0x87, 0xa1, 0xa7, 0xa0, 0x18, 0x91, 0x3a, 0x0b, 0x87, 0x33, 0x02,
-	0x25, 0x30, 0xa9, 0x63, 0xda, 0x6e, 0xff, 0x7a, 0x21, 0xad, 0xcf, 0xa4, 0xdf, 0x9b, 0xcf, 0x76,
-	0xb7, 0x1d, 0xc5, 0xd2, 0x77, 0x60, 0xcc, 0x76, 0xd6, 0x8c, 0x25, 0x1c, 0x7d, 0xca, 0x82, 0x91,
-	0xdb, 0x71, 0x18, 0x04, 0x24, 0x11, 0x7b, 0xc7, 0xcd, 0x9c, 0x87, 0xe2, 0x2a, 0xa7, 0xae, 0xfb,
-	0x20, 0x1a, 0xb0, 0xe4, 0x4b, 0xbb, 0x4b, 0x76, 0x5d, 0xbf, 0x5d, 0xeb, 0x38, 0x1f, 0xbf, 0xc4,
-	0x9b, 0xb1, 0x84, 0x53, 0x54, 0x2f, 0xe0, 0xa8, 0x43, 0x69, 0xd4, 0xa5, 0x40, 0xa0, 0x0a, 0xb8,
-	0xfd, 0xcd, 0x12, 0x9c, 0xeb, 0xba, 0x38, 0xa8, 0xa6, 0xc1, 0xf6, 0xf2, 0xcb, 0x9e, 0x4f, 0x64,
-	0xd4, 0x07, 0xd3, 0x34, 0x6e, 0xaa, 0x56, 0x6c, 0x60, 0xa0, 0x9f, 0x07, 0x68, 0x39, 0x91, 0xd3,
-	0x24, 0x62, 0x87, 0x2d, 0x0e, 0xbe, 0xa1, 0xd3, 0x7e, 0xac, 0x49, 0x9a, 0xda, 0x78, 0x54, 0x4d,
-	0x31, 0x36, 0x58, 0xa2, 0xf7, 0xc2, 0x68, 0x44, 0x7c, 0xe2, 0xc4, 0x2c, 0x9a, 0x32, 0x1b, 0x1a,
-	0x8e, 0x35, 0x08, 0x9b, 0x78, 0xe8, 0x59, 0x15, 0x20, 0x93, 0x09, 0x26, 0x48, 0x07, 0xc9, 0xa0,
-	0x37, 0x2d, 0x98, 0xa8, 0x7b, 0x3e, 0xd1, 0xdc, 0x45, 0x20, 0xf7, 0xea, 0xe0, 0x2f, 0x79, 0xd9,
-	0xa4, 0xab, 0x25, 0x64, 0xaa, 0x39, 0xc6, 0x19, 0xf6, 0xf4, 0x33, 0xef, 0x90, 0x88, 0x89, 0xd6,
-	0xe1, 0xf4, 0x67, 0xbe, 0xc9, 0x9b, 0xb1, 0x84, 0xa3, 0x39, 0x38, 0xd5, 0x72, 0xe2, 0x78, 0x3e,
-	0x22, 0x35, 0x12, 0x24, 0x9e, 0xe3, 0xf3, 0x30, 0xeb, 0xb2, 0x0e, 0xb3, 0x5c, 0x4b, 0x83, 0x71,
-	0x16, 0x1f, 0x7d, 0x08, 0x1e, 0xf7, 0x1a, 0x41, 0x18, 0x91, 0x15, 0x2f, 0x8e, 0xbd, 0xa0, 0xa1,
-	0xa7, 0x01, 0x93, 0x94, 0xe5, 0xea, 0xb4, 0x20, 0xf5, 0xf8, 0x52, 0x77, 0x34, 0xdc, 0xeb, 0x79,
-	0xf4, 0x3c, 0x94, 0xe3, 0x6d, 0xaf, 0x35, 0x1f, 0xd5, 0x62, 0xe6, 0x6f, 0x2e, 0x6b, 0x8f, 0xd5,
-	0xba, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0x6a, 0x21, 0x6d, 0xad, 0x9a, 0xeb, 0x07, 0xc5, 0x74, 0x95,
-	0x24, 0x37, 0x9d, 0x48, 0x7a, 0x32, 0x06, 0x0c, 0xd4, 0x16, 0x74, 0x6f, 0x3a, 0x91, 0xb9, 0xde,
-	0x18, 0x03, 0x2c, 0x39, 0xa1, 0xdb, 0x30, 0x94, 0xf8, 0x4e, 0x4e, 0x99, 0x1d, 0x06, 0x47, 0xed,
-	0x3c, 0x58, 0x9e, 0x8b, 0x31, 0xe3, 0x81, 0x9e, 0xa4, 0x1a, 0xf3, 0xa6, 0x8c, 0xe6, 0x12, 0x4a,
-	0xee, 0x66, 0x8c, 0x59, 0xab, 0xfd, 0x7f, 0xcb, 0x5d, 0x44, 0x9e, 0xda, 0x63, 0xd0, 0x45, 0x00,
-	0x6a, 0x7c, 0xad, 0x45, 0xa4, 0xee, 0xed, 0x8a, 0x3d, 0x5e, 0x2d, 0xab, 0xeb, 0x0a, 0x82, 0x0d,
-	0x2c, 0xf9, 0xcc, 0x7a, 0xbb, 0x4e, 0x9f, 0x29, 0x74, 0x3e, 0xc3, 0x21, 0xd8, 0xc0, 0x42, 0x2f,
-	0xc2, 0xb0, 0xd7, 0x74, 0x1a, 0x2a, 0xe8, 0xec, 0x49, 0xba, 0x9e, 0x96, 0x58, 0xcb, 0xbd, 0xfd,
-	0xe9, 0x09, 0xd5, 0x21, 0xd6, 0x84, 0x05, 0x2e, 0xfa, 0x2d, 0x0b, 0xc6, 0xdc, 0xb0, 0xd9, 0x0c,
-	0x03, 0x6e, 0xb2, 0x08, 0xfb, 0xeb, 0xf6, 0x49, 0xed, 0xc0, 0x33, 0xf3, 0x06, 0x33, 0x6e, 0x80,
-	0xa9, 0x14, 0x14, 0x13, 0x84, 0x53, 0xbd, 0x32, 0x97, 0x5d, 0xe9, 0x90, 0x65, 0xf7, 0x3b, 0x16,
-	0x4c, 0xf2, 0x67, 0x0d, 0x4b, 0x4a, 0x64, 0x5b, 0x84, 0x27, 0xfc, 0x5a, 0x1d, 0xc6, 0xa5, 0xf2,
-	0x70, 0x75, 0xc0, 0x71, 0x67, 0x27, 0xd1, 0x22, 0x4c, 0xd6, 0xc3, 0xc8, 0x25, 0xe6, 0x40, 0x08,
-	0x99, 0xa1, 0x08, 0x5d, 0xce, 0x22, 0xe0, 0xce, 0x67, 0xd0, 0x4d, 0x78, 0xcc, 0x68, 0x34, 0xc7,
-	0x81, 0x8b, 0x8d, 0xa7, 0x05, 0xb5, 0xc7, 0x2e, 0x77, 0xc5, 0xc2, 0x3d, 0x9e, 0x4e, 0x3b, 0x1b,
-	0x2a, 0x7d, 0x38, 0x1b, 0x5e, 0x87, 0x27, 0xdc, 0xce, 0x91, 0xd9, 0x89, 0xdb, 0x9b, 0x71, 0xc2,
-	0x94, 0xac, 0x72, 0xf5, 0xc7, 0x04, 0x81, 0x27, 0xe6, 0x7b, 0x21, 0xe2, 0xde, 0x34, 0xd0, 0xc7,
-	0xa0, 0x1c, 0x11, 0xf6, 0x55, 0x62, 0x91, 0x7a, 0x30, 0xa0, 0x85, 0xa9, 0x95, 0x43, 0x4e, 0x56,
-	0x8b, 0x45, 0xd1, 0x10, 0x63, 0xc5, 0xf1, 0xfc, 0x07, 0x60, 0xb2, 0x63, 0x3e, 0x1f, 0xc9, 0xde,
-	0x5f, 0x80, 0xc7, 0xba, 0xcf, 0x9c, 0x23, 0x59, 0xfd, 0xff, 0x30, 0x13, 0x51, 0x67, 0x28, 0x7a,
-	0x7d, 0x78, 0x90, 0x1c, 0x28, 0x92, 0x60, 0x47, 0x08, 0xd2, 0xcb, 0x83, 0x8d, 0xde, 0xa5, 0x60,
-	0x87, 0x4f, 0x7c, 0x66, 0x26, 0x5f, 0x0a, 0x76, 0x30, 0xa5, 0x8d, 0xbe, 0x64, 0xa5, 0x14, 0x15,
-	0xee, 0x77, 0xfa, 0xc8, 0x89, 0x68, 0xb6, 0x7d, 0xeb, 0x2e, 0xf6, 0xbf, 0x2a, 0xc0, 0x85, 0xc3,
-	0x88, 0xf4, 0x31, 0x7c, 0xcf, 0xc0, 0x70, 0xcc, 0x0e, 0xfb, 0x84, 0x64, 0x1a, 0xa5, 0x52, 0x89,
-	0x1f, 0xff, 0xbd, 0x8e, 0x05, 0x08, 0xf9, 0x50, 0x6c, 0x3a, 0x2d, 0xe1, 0x8e, 0x58, 0x1a, 0x34,
-	0x7e, 0x9e, 0xfe, 0x77, 0xfc, 0x15, 0xa7, 0xc5, 0x8d, 0x5c, 0xa3, 0x01, 0x53, 0x36, 0x28, 0x81,
-	0x92, 0x13, 0x45, 0x8e, 0x3c, 0x59, 0xba, 0x96, 0x0f, 0xbf, 0x39, 0x4a, 0xb2, 0x3a, 0x79, 0xb0,
-	0x3f, 0x3d, 0x9e, 0x6a, 0xc2, 0x9c, 0x99, 0xfd, 0xb9, 0x91, 0x54, 0x0c, 0x39, 0x3b, 0x2e, 0x8c,
-	0x61, 0x58, 0x78, 0x21, 0xac, 0xbc, 0xd3, 0x16, 0x78, 0x12, 0x10, 0xb3, 0x63, 0x44, 0x2a, 0xa5,
-	0x60, 0x85, 0x3e, 0x6b, 0xb1, 0x84, 0x45, 0x19, 0x57, 0x2f, 0xac, 0x87, 0x93, 0xc9, 0x9f, 0x34,
-	0xd3, 0x20, 0x65, 0x23, 0x36, 0xb9, 0xd3, 0xad, 0xab, 0xc5, 0x53, 0x6f, 0xb2, 0x36, 0x84, 0x4c,
-	0x69, 0x94, 0x70, 0xb4, 0xdb, 0xe5, 0x58, 0x30, 0x87, 0xa4, 0xb7, 0x3e, 0x0e, 0x02, 0xbf, 0x6e,
-	0xc1, 0x24, 0xd7, 0x14, 0x17, 0xbc, 0x7a, 0x9d, 0x44, 0x24, 0x70, 0x89, 0xd4, 0xb5, 0x07, 0x3c,
-	0x78, 0x96, 0xae, 0x9f, 0xa5, 0x2c, 0x79, 0xbd, 0xa7, 0x75, 0x80, 0x70, 0x67, 0x67, 0x50, 0x0d,
-	0x86, 0xbc, 0xa0, 0x1e, 0x8a, 0x9d, 0xbc, 0x3a, 0x58, 0xa7, 0x96, 0x82, 0x7a, 0xa8, 0x57, 0x33,
-	0xfd, 0x87, 0x19, 0x75, 0xb4, 0x0c, 0x67, 0x23, 0xe1, 0x0d, 0xb9, 0xe2, 0xc5, 0xd4, 0x66, 0x5d,
-	0xf6, 0x9a, 0x5e, 0xc2, 0x76, 0xe1, 0x62, 0x75, 0xea, 0x60, 0x7f, 0xfa, 0x2c, 0xee, 0x02, 0xc7,
-	0x5d, 0x9f, 0x42, 0x6f, 0xc0, 0x88, 0xcc, 0xb0, 0x2c, 0xe7, 0x61, 0xb7, 0x74, 0xce, 0x7f, 0x35,
-	0x99, 0xd6, 0x45, 0x32, 0xa5, 0x64, 0x68, 0xbf, 0x39, 0x0a, 0x9d, 0xe7, 0x6a, 0xe8, 0xe3, 0x50,
-	0x89, 0x54, 0xd6, 0xa7, 0x95, 0x47, 0x24, 0x9b, 0xfc, 0xbe, 0xe2, 0x4c, 0x4f, 0xe9, 0x03, 0x3a,
-	0xbf, 0x53, 0x73, 0xa4, 0x5a, 0x7b, 0xac, 0x8f, 0xdf, 0x72, 0x98, 0xdb, 0x82, 0xab, 0x3e, 0x5a,
-	0xd9, 0x0b, 0x5c, 0xcc, 0x78, 0xa0, 0x08, 0x86, 0xb7, 0x88, 0xe3, 0x27, 0x5b, 0xf9, 0x78, 0x81,
-	0xaf, 0x30, 0x5a, 0xd9, 0xfc, 0x00, 0xde, 0x8a, 0x05, 0x27, 0xb4, 0x0b, 0x23, 0x5b, 0x7c, 0x02,
-	0x08, 0x45, 0x7a, 0x65, 0xd0, 0xc1, 0x4d, 0xcd, 0x2a, 0xfd, 0xb9, 0x45, 0x03, 0x96, 0xec, 0x58,
-	0x4c, 0x81, 0x71, 0xa4, 0xcc, 0x97, 0x6e, 0x7e, 0xa9, 0x11, 0xfd, 0x9f, 0x27, 0x7f, 0x14, 0xc6,
-	0x22, 0xe2, 0x86, 0x81, 0xeb, 0xf9, 0xa4, 0x36, 0x27, 0x3d, 0xbc, 0x47, 0x09, 0xa8, 0x3f, 0x4d,
-	0x8d, 0x01, 0x6c, 0xd0, 0xc0, 0x29, 0x8a, 0xe8, 0x33, 0x16, 0x4c, 0xa8, 0x54, 0x31, 0xfa, 0x41,
-	0x88, 0x70, 0x58, 0x2e, 0xe7, 0x94, 0x98, 0xc6, 0x68, 0x56, 0xd1, 0xc1, 0xfe, 0xf4, 0x44, 0xba,
-	0x0d, 0x67, 0xf8, 0xa2, 0x57, 0x01, 0xc2, 0x4d, 0x1e, 0x38, 0x30, 0x97, 0x08, 0xef, 0xe5, 0x51,
-	0x5e, 0x75, 0x82, 0x67, 0xd6, 0x48, 0x0a, 0xd8, 0xa0, 0x86, 0xae, 0x01, 0xf0, 0x65, 0xb3, 0xb1,
-	0xd7, 0x92, 0xda, 0xb6, 0xcc, 0x88, 0x80, 0x75, 0x05, 0xb9, 0xb7, 0x3f, 0xdd, 0xe9, 0x4d, 0x62,
-	0x27, 0xdf, 0xc6, 0xe3, 0xe8, 0xe7, 0x60, 0x24, 0x6e, 0x37, 0x9b, 0x8e, 0xf2, 0x6d, 0xe6, 0x98,
-	0xab, 0xc3, 0xe9, 0x1a, 0xa2, 0x88, 0x37, 0x60, 0xc9, 0x11, 0xdd, 0xa6, 0x42, 0x35, 0x16, 0x6e,
-	0x2e, 0xb6, 0x8a, 0xb8, 0x4e, 0x30, 0xca, 0xde, 0xe9, 0x7d, 0xe2, 0xb9, 0xb3, 0xb8, 0x0b, 0xce,
-	0xbd, 0xfd, 0xe9, 0xc7, 0xd2, 0xed, 0xcb, 0xa1, 0xc8, 0x9e, 0xe9, 0x4a, 0x13, 0x5d, 0x95, 0x05,
-	0x17, 0xe8, 0x6b, 0xcb, 0x3c, 0xe0, 0xe7, 0x74, 0xc1, 0x05, 0xd6, 0xdc, 0x7b, 0xcc, 0xcc, 0x87,
-	0xd1, 0x0a, 0x9c, 0x71, 0xc3, 0x20, 0x89, 0x42, 0xdf, 0xe7, 0x55, 0x44, 0xb8, 0xe1, 0xc3, 0x7d,
-	0x9f, 0x6f, 0x17, 0xdd, 0x3e, 0x33, 0xdf, 0x89, 0x82, 0xbb, 0x3d, 0x67, 0x07, 0xe9, 0x88, 0x2a,
-	0x31, 0x38, 0x2f, 0xc2, 0x18, 0xd9, 0x4d, 0x48, 0x14, 0x38, 0xfe, 0x0d, 0xbc, 0x2c, 0xbd, 0x7e,
-	0x6c, 0x0d, 0x5c, 0x32, 0xda, 0x71, 0x0a, 0x0b, 0xd9, 0xca, 0xda, 0x2f, 0xe8, 0x14, 0x33, 0x6e,
-	0xed, 0x4b, 0xdb, 0xde, 0xfe, 0xdf, 0x85, 0x94, 0x42, 0xb6, 0x11, 0x11, 0x82, 0x42, 0x28, 0x05,
-	0x61, 0x4d, 0xc9, 0xfe, 0xab, 0xf9, 0xc8, 0xfe, 0xeb, 0x61, 0xcd, 0xa8, 0xca, 0x40, 0xff, 0xc5,
-	0x98, 0xf3, 0x61, 0x69, 0xeb, 0x32, 0xbf, 0x9f, 0x01, 0x84, 0xa1, 0x91, 0x27, 0x67, 0x95, 0xb6,
-	0xbe, 0x6a, 0x32, 0xc2, 0x69, 0xbe, 0x68, 0x1b, 0x4a, 0x5b, 0x61, 0x9c, 0x48, 0xf3, 0x63, 0x40,
-	0x4b, 0xe7, 0x4a, 0x18, 0x27, 0x4c, 0x8b, 0x50, 0xaf, 0x4d, 0x5b, 0x62, 0xcc, 0x79, 0xd8, 0xff,
-	0xd9, 0x4a, 0xf9, 0x78, 0x6f, 0xb1, 0xe8, 0xc2, 0x1d, 0x12, 0xd0, 0x65, 0x6d, 0xc6, 0xaa, 0xfc,
-	0xc5, 0x4c, 0x8a, 0xd3, 0x3b, 0x7a, 0xd5, 0xc8, 0xb9, 0x4b, 0x29, 0xcc, 0x30, 0x12, 0x46, 0x58,
-	0xcb, 0x27, 0xad, 0x74, 0xb2, 0x59, 0x21, 0x0f, 0x03, 0xc3, 0x4c, 0xa6, 0x3c, 0x34, 0x6f, 0xcd,
-	0xfe, 0x92, 0x05, 0x23, 0x55, 0xc7, 0xdd, 0x0e, 0xeb, 0x75, 0xf4, 0x3c, 0x94, 0x6b, 0xed, 0xc8,
-	0xcc, 0x7b, 0x53, 0xd6, 0xf3, 0x82, 0x68, 0xc7, 0x0a, 0x83, 0xce, 0xe1, 0xba, 0xe3, 0xca, 0x94,
-	0xca, 0x22, 0x9f, 0xc3, 0x97, 0x59, 0x0b, 0x16, 0x10, 0xf4, 0x5e, 0x18, 0x6d, 0x3a, 0xbb, 0xf2,
-	0xe1, 0xac, 0x83, 0x79, 0x45, 0x83, 0xb0, 0x89, 0x67, 0xff, 0x73, 0x0b, 0xa6, 0xaa, 0x4e, 0xec,
-	0xb9, 0x73, 0xed, 0x64, 0xab, 0xea, 0x25, 0x9b, 0x6d, 0x77, 0x9b, 0x24, 0x3c, 0x8f, 0x96, 0xf6,
-	0xb2, 0x1d, 0xd3, 0xa5, 0xa4, 0xec, 0x3a, 0xd5, 0xcb, 0x1b, 0xa2, 0x1d, 0x2b, 0x0c, 0xf4, 0x06,
-	0x8c, 0xb6, 0x9c, 0x38, 0xbe, 0x1b, 0x46, 0x35, 0x4c, 0xea, 0xf9, 0x64, 0xb1, 0xaf, 0x13, 0x37,
-	0x22, 0x09, 0x26, 0x75, 0x71, 0x4a, 0xa9, 0xe9, 0x63, 0x93, 0x99, 0xfd, 0xd7, 0x2d, 0x18, 0x63,
-	0xa7, 0x2f, 0x0b, 0x24, 0x71, 0x3c, 0xbf, 0xa3, 0x14, 0x8b, 0xd5, 0x67, 0x29, 0x96, 0x0b, 0x30,
-	0xb4, 0x15, 0x36, 0x49, 0xf6, 0xe4, 0xf0, 0x4a, 0x48, 0xad, 0x58, 0x0a, 0x41, 0x2f, 0xd0, 0x71,
-	0xf6, 0x82, 0xc4, 0xa1, 0x33, 0x4e, 0xba, 0x10, 0x4f, 0xf1, 0x31, 0x56, 0xcd, 0xd8, 0xc4, 0xb1,
-	0xbf, 0x55, 0x81, 0x11, 0x71, 0xfe, 0xdb, 0x77, 0xea, 0xb2, 0x34, 0xa7, 0x0b, 0x3d, 0xcd, 0xe9,
-	0x18, 0x86, 0x5d, 0x56, 0xe8, 0x49, 0x68, 0x6d, 0xd7, 0x72, 0x09, 0x18, 0xe0, 0xb5, 0xa3, 0x74,
-	0xb7, 0xf8, 0x7f, 0x2c, 0x58, 0xa1, 0x2f, 0x5a, 0x70, 0xca, 0x0d, 0x83, 0x80, 0xb8, 0x5a, 0xa5,
-	0x18, 0xca, 0xe3, 0x5c, 0x78, 0x3e, 0x4d, 0x54, 0xbb, 0xfe, 0x33, 0x00, 0x9c, 0x65, 0x8f, 0x5e,
-	0x86, 0x71, 0x3e, 0x66, 0x37, 0x53, 0x7e, 0x4f, 0x5d, 0xa1, 0xc3, 0x04, 0xe2, 0x34, 0x2e, 0x9a,
-	0xe1, 0xfe, 0x63, 0x51, 0x0b, 0x63, 0x58, 0x9f, 0x23, 0x19, 0x55, 0x30, 0x0c, 0x0c, 0x14, 0x01,
-	0x8a, 0x48, 0x3d, 0x22, 0xf1, 0x96, 0x38, 0x1f, 0x67, 0xea, 0xcc, 0xc8, 0xf1, 0x52, 0x21, 0x71,
-	0x07, 0x25, 0xdc, 0x85, 0x3a, 0xda, 0x16, 0xf6, 0x5c, 0x39, 0x0f, 0x91, 0x25, 0x3e, 0x73, 0x4f,
-	0xb3, 0x6e, 0x1a, 0x4a, 0xf1, 0x96, 0x13, 0xd5, 0x98, 0x1a, 0x55, 0xe4, 0xe1, 0xf7, 0xeb, 0xb4,
-	0x01, 0xf3, 0x76, 0xb4, 0x00, 0xa7, 0x33, 0xf5, 0x45, 0x62, 0xe1, 0x9f, 0x54, 0x31, 0xe3, 0x99,
-	0xca, 0x24, 0x31, 0xee, 0x78, 0xc2, 0xb4, 0xf5, 0x47, 0x0f, 0xb1, 0xf5, 0xf7, 0x54, 0x14, 0xd6,
-	0x18, 0xdb, 0x8e, 0x5e, 0xc9, 0x65, 0x00, 0xfa, 0x0a, 0xb9, 0xfa, 0x7c, 0x26, 0xe4, 0x6a, 0x9c,
-	0x75, 0xe0, 0x66, 0x3e, 0x1d, 0x38, 0x7a, 0x7c, 0xd5, 0xc3, 0x8c, 0x97, 0xfa, 0x5f, 0x16, 0xc8,
-	0xef, 0x3a, 0xef, 0xb8, 0x5b, 0x84, 0x4e, 0x19, 0xf4, 0x7e, 0x98, 0x50, 0x16, 0xeb, 0x7c, 0xd8,
-	0x0e, 0x78, 0xa8, 0x54, 0x51, 0x9f, 0x11, 0xe2, 0x14, 0x14, 0x67, 0xb0, 0xd1, 0x2c, 0x54, 0xe8,
-	0x38, 0xf1, 0x47, 0xf9, 0xd6, 0xa6, 0xac, 0xe2, 0xb9, 0xb5, 0x25, 0xf1, 0x94, 0xc6, 0x41, 0x21,
-	0x4c, 0xfa, 0x4e, 0x9c, 0xb0, 0x1e, 0x50, 0x03, 0xf6, 0x98, 0x89, 0xc8, 0x2c, 0x30, 0x79, 0x39,
-	0x4b, 0x08, 0x77, 0xd2, 0xb6, 0xbf, 0x37, 0x04, 0xe3, 0x29, 0xc9, 0x78, 0xc4, 0x3d, 0xf1, 0x79,
-	0x28, 0xcb, 0x6d, 0x2a, 0x5b, 0x0e, 0x41, 0xed, 0x65, 0x0a, 0x83, 0x6e, 0x5a, 0x9b, 0xc4, 0x89,
-	0x48, 0xc4, 0x2a, 0xb7, 0x64, 0xf7, 0xf0, 0xaa, 0x06, 0x61, 0x13, 0x8f, 0x09, 0xe5, 0xc4, 0x8f,
-	0xe7, 0x7d, 0x8f, 0x04, 0x09, 0xef, 0x66, 0x3e, 0x42, 0x79, 0x63, 0x79, 0xdd, 0x24, 0xaa, 0x85,
-	0x72, 0x06, 0x80, 0xb3, 0xec, 0xd1, 0x2f, 0x59, 0x30, 0xee, 0xdc, 0x8d, 0x75, 0x35, 0x42, 0x11,
-	0x5c, 0x35, 0xe0, 0x26, 0x95, 0x2a, 0x70, 0xc8, 0x3d, 0xac, 0xa9, 0x26, 0x9c, 0x66, 0x8a, 0xbe,
-	0x62, 0x01, 0x22, 0xbb, 0xc4, 0x95, 0xe1, 0x5f, 0xa2, 0x2f, 0xc3, 0x79, 0x18, 0x76, 0x97, 0x3a,
-	0xe8, 0x72, 0xa9, 0xde, 0xd9, 0x8e, 0xbb, 0xf4, 0xc1, 0xfe, 0x27, 0x45, 0xb5, 0xa0, 0x74, 0xc4,
-	0xa1, 0x63, 0xe4, 0x36, 0x59, 0xc7, 0xcf, 0x6d, 0xd2, 0x07, 0xd4, 0x9d, 0xf9, 0x4d, 0xa9, 0xbc,
-	0x8e, 0xc2, 0x43, 0xca, 0xeb, 0xf8, 0x05, 0x2b, 0x55, 0xf8, 0x63, 0xf4, 0xe2, 0xab, 0xf9, 0x46,
-	0x3b, 0xce, 0xf0, 0xf0, 0x88, 0x8c, 0x74, 0x4f, 0xc7, 0x4c, 0x50, 0x69, 0x6a, 0xa0, 0x1d, 0x49,
-	0x1a, 0xfe, 0xfb, 0x22, 0x8c, 0x1a, 0x3b, 0x69, 0x57, 0xb5, 0xc8, 0x7a, 0xc4, 0xd4, 0xa2, 0xc2,
-	0x11, 0xd4, 0xa2, 0x9f, 0x87, 0x8a, 0x2b, 0xa5, 0x7c, 0x3e, 0xa5, 0x2f, 0xb3, 0x7b, 0x87, 0x16,
-	0xf4, 0xaa, 0x09, 0x6b, 0x9e, 0x68, 0x31, 0x95, 0x6a, 0x21, 0x76, 0x88, 0x21, 0xb6, 0x43, 0x74,
-	0xcb, 0x85, 0x10, 0x3b, 0x45, 0xe7, 0x33, 0xac, 0x3e, 0x4c, 0xcb, 0x13, 0xef, 0x25, 0x63, 0x92,
-	0x79, 0x7d, 0x98, 0xb5, 0x25, 0xd9, 0x8c, 0x4d, 0x1c, 0xfb, 0x7b, 0x96, 0xfa, 0xb8, 0x0f, 0x20,
-	0x5b, 0xfa, 0x76, 0x3a, 0x5b, 0xfa, 0x52, 0x2e, 0xc3, 0xdc, 0x23, 0x4d, 0xfa, 0x3a, 0x8c, 0xcc,
-	0x87, 0xcd, 0xa6, 0x13, 0xd4, 0xd0, 0x8f, 0xc3, 0x88, 0xcb, 0x7f, 0x0a, 0x3f, 0x0a, 0x3b, 0x8d,
-	0x13, 0x50, 0x2c, 0x61, 0xe8, 0x49, 0x18, 0x72, 0xa2, 0x86, 0xf4, 0x9d, 0xb0, 0x80, 0x8e, 0xb9,
-	0xa8, 0x11, 0x63, 0xd6, 0x6a, 0xbf, 0x59, 0x04, 0x98, 0x0f, 0x9b, 0x2d, 0x27, 0x22, 0xb5, 0x8d,
-	0x90, 0x95, 0xde, 0x3a, 0xd1, 0x33, 0x2c, 0x6d, 0x2c, 0x3d, 0xca, 0xe7, 0x58, 0xc6, 0x59, 0x46,
-	0xf1, 0x41, 0x9f, 0x65, 0x7c, 0xce, 0x02, 0x44, 0xbf, 0x48, 0x18, 0x90, 0x20, 0xd1, 0x87, 0xb3,
-	0xb3, 0x50, 0x71, 0x65, 0xab, 0xd0, 0x5a, 0xf4, 0xfa, 0x93, 0x00, 0xac, 0x71, 0xfa, 0x30, 0x3f,
-	0x9f, 0x91, 0xc2, 0xb1, 0x98, 0x8e, 0x81, 0x64, 0x22, 0x55, 0xc8, 0x4a, 0xfb, 0xf7, 0x0b, 0xf0,
-	0x18, 0xdf, 0xef, 0x56, 0x9c, 0xc0, 0x69, 0x90, 0x26, 0xed, 0x55, 0xbf, 0xc7, 0xed, 0x2e, 0xb5,
-	0x7b, 0x3c, 0x19, 0xd3, 0x38, 0xe8, 0xc2, 0xe0, 0x13, 0x9a, 0x4f, 0xe1, 0xa5, 0xc0, 0x4b, 0x30,
-	0x23, 0x8e, 0x62, 0x28, 0xcb, 0x42, 0xca, 0x42, 0xd0, 0xe5, 0xc4, 0x48, 0xad, 0x79, 0xb1, 0x29,
-	0x11, 0xac, 0x18, 0x51, 0xad, 0xd0, 0x0f, 0xdd, 0x6d, 0x4c, 0x5a, 0x21, 0x13, 0x6a, 0x46, 0x48,
-	0xd9, 0xb2, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0x7d, 0x0b, 0xb2, 0xe2, 0xde, 0x28, 0x32, 0x64, 0xdd,
-	0xb7, 0xc8, 0xd0, 0x11, 0xaa, 0xfc, 0xfc, 0x2c, 0x8c, 0x3a, 0x09, 0xdd, 0xa1, 0xb9, 0x4d, 0x5b,
-	0x3c, 0x9e, 0x8b, 0x7e, 0x25, 0xac, 0x79, 0x75, 0x8f, 0xd9, 0xb2, 0x26, 0x39, 0xfb, 0x7f, 0x0c,
-	0xc1, 0x64, 0x47, 0x68, 0x3c, 0x7a, 0x09, 0xc6, 0x5c, 0x31, 0x3d, 0x5a, 0x98, 0xd4, 0xc5, 0xcb,
-	0x18, 0x71, 0x4e, 0x1a, 0x86, 0x53, 0x98, 0x7d, 0x4c, 0xd0, 0x25, 0x38, 0x13, 0x51, 0x2b, 0xba,
-	0x4d, 0xe6, 0xea, 0x09, 0x89, 0xd6, 0x89, 0x1b, 0x06, 0x35, 0x5e, 0x0a, 0xab, 0x58, 0x7d, 0xfc,
-	0x60, 0x7f, 0xfa, 0x0c, 0xee, 0x04, 0xe3, 0x6e, 0xcf, 0xa0, 0x16, 0x8c, 0xfb, 0xa6, 0x82, 0x25,
-	0xb4, 0xeb, 0x63, 0xe9, 0x66, 0x6a, 0x03, 0x4e, 0x35, 0xe3, 0x34, 0x83, 0xb4, 0x96, 0x56, 0x7a,
-	0x48, 0x5a, 0xda, 0x2f, 0x6a, 0x2d, 0x8d, 0x9f, 0x25, 0x7f, 0x38, 0xe7, 0xd4, 0x88, 0x93, 0x56,
-	0xd3, 0x5e, 0x81, 0xb2, 0x8c, 0xb3, 0xe9, 0x2b, 0x3e, 0xc5, 0xa4, 0xd3, 0x43, 0xa2, 0xdd, 0x2b,
-	0x40, 0x17, 0x0d, 0x9f, 0xae, 0x33, 0xbd, 0x9d, 0xa6, 0xd6, 0xd9, 0xd1, 0xb6, 0x54, 0xb4, 0xcb,
-	0x63, 0x8c, 0xf8, 0xc6, 0xf1, 0xa1, 0xbc, 0x2d, 0x14, 0x1d, 0x76, 0xa4, 0x02, 0xd2, 0x55, 0xe8,
-	0xd1, 0x45, 0x00, 0xad, 0x05, 0x89, 0x70, 0x63, 0x75, 0x84, 0xa9, 0x95, 0x25, 0x6c, 0x60, 0x51,
-	0x83, 0xd5, 0x0b, 0xe2, 0xc4, 0xf1, 0xfd, 0x2b, 0x5e, 0x90, 0x08, 0xcf, 0x9b, 0xda, 0x21, 0x97,
-	0x34, 0x08, 0x9b, 0x78, 0xe7, 0xdf, 0x67, 0x7c, 0x97, 0xa3, 0x7c, 0xcf, 0x2d, 0x78, 0x62, 0xd1,
-	0x4b, 0x54, 0x90, 0xbc, 0x9a, 0x47, 0x54, 0xc9, 0x51, 0x49, 0x1f, 0x56, 0xcf, 0xa4, 0x0f, 0x23,
-	0x48, 0xbd, 0x90, 0x8e, 0xa9, 0xcf, 0x06, 0xa9, 0xdb, 0x2f, 0xc1, 0xd9, 0x45, 0x2f, 0xb9, 0xec,
-	0xf9, 0xe4, 0x88, 0x4c, 0xec, 0xdf, 0x1b, 0x86, 0x31, 0x33, 0x0f, 0xea, 0x28, 0x79, 0x2b, 0x5f,
-	0xa0, 0x7a, 0x8c, 0x78, 0x3b, 0x4f, 0x1d, 0x00, 0xdd, 0x1a, 0x38, 0x29, 0xab, 0xfb, 0x88, 0x19,
-	0xaa, 0x8c, 0xe6, 0x89, 0xcd, 0x0e, 0xa0, 0xbb, 0x50, 0xaa, 0xb3, 0x20, 0xea, 0x62, 0x1e, 0xa7,
-	0xe4, 0xdd, 0x46, 0x54, 0x2f, 0x33, 0x1e, 0x86, 0xcd, 0xf9, 0xd1, 0x1d, 0x32, 0x4a, 0x67, 0xe6,
-	0x18, 0xd1, 0x85, 0x22, 0x27, 0x47, 0x61, 0xf4, 0x12, 0xf5, 0xa5, 0x63, 0x88, 0xfa, 0x94, 0xe0,
-	0x1d, 0x7e, 0x48, 0x82, 0x97, 0x05, 0xc4, 0x27, 0x5b, 0x4c, 0x7f, 0x13, 0xe1, 0xd0, 0x23, 0x6c,
-	0x10, 0x8c, 0x80, 0xf8, 0x14, 0x18, 0x67, 0xf1, 0xd1, 0x27, 0x94, 0xe8, 0x2e, 0xe7, 0xe1, 0xb4,
-	0x34, 0x67, 0xf4, 0x49, 0x4b, 0xed, 0xcf, 0x15, 0x60, 0x62, 0x31, 0x68, 0xaf, 0x2d, 0xae, 0xb5,
-	0x37, 0x7d, 0xcf, 0xbd, 0x46, 0xf6, 0xa8, 0x68, 0xde, 0x26, 0x7b, 0x4b, 0x0b, 0x62, 0x05, 0xa9,
-	0x39, 0x73, 0x8d, 0x36, 0x62, 0x0e, 0xa3, 0xc2, 0xa8, 0xee, 0x05, 0x0d, 0x12, 0xb5, 0x22, 0x4f,
-	0xf8, 0x13, 0x0d, 0x61, 0x74, 0x59, 0x83, 0xb0, 0x89, 0x47, 0x69, 0x87, 0x77, 0x03, 0x12, 0x65,
-	0x15, 0xd9, 0x55, 0xda, 0x88, 0x39, 0x8c, 0x22, 0x25, 0x51, 0x3b, 0x4e, 0xc4, 0x64, 0x54, 0x48,
-	0x1b, 0xb4, 0x11, 0x73, 0x18, 0x5d, 0xe9, 0x71, 0x7b, 0x93, 0x05, 0x21, 0x64, 0x62, 0xaf, 0xd7,
-	0x79, 0x33, 0x96, 0x70, 0x8a, 0xba, 0x4d, 0xf6, 0x16, 0xa8, 0x49, 0x99, 0xc9, 0x8e, 0xb8, 0xc6,
-	0x9b, 0xb1, 0x84, 0xb3, 0x1a, 0x5e, 0xe9, 0xe1, 0xf8, 0xa1, 0xab, 0xe1, 0x95, 0xee, 0x7e, 0x0f,
-	0xe3, 0xf4, 0x37, 0x2c, 0x18, 0x33, 0x43, 0x87, 0x50, 0x23, 0xa3, 0xe3, 0xae, 0x76, 0x94, 0x80,
-	0xfc, 0xe9, 0x6e, 0xf7, 0xdd, 0x34, 0xbc, 0x24, 0x6c, 0xc5, 0xef, 0x26, 0x41, 0xc3, 0x0b, 0x08,
-	0x3b, 0x11, 0xe6, 0x21, 0x47, 0xa9, 0xb8, 0xa4, 0xf9, 0xb0, 0x46, 0x8e, 0xa1, 0x24, 0xdb, 0xb7,
-	0x60, 0xb2, 0x23, 0x25, 0xa6, 0x0f, 0xd5, 0xe2, 0xd0, 0x84, 0x44, 0x1b, 0xc3, 0x28, 0x25, 0x2c,
-	0x0a, 0x62, 0xa0, 0x79, 0x98, 0xe4, 0x0b, 0x89, 0x72, 0x5a, 0x77, 0xb7, 0x48, 0x53, 0xa5, 0x39,
-	0x31, 0xe7, 0xf5, 0xcd, 0x2c, 0x10, 0x77, 0xe2, 0xdb, 0x9f, 0xb7, 0x60, 0x3c, 0x95, 0xa5, 0x94,
-	0x93, 0x12, 0xc4, 0x56, 0x5a, 0xc8, 0x22, 0xd9, 0x58, 0x38, 0x6f, 0x91, 0x6d, 0xa6, 0x7a, 0xa5,
-	0x69, 0x10, 0x36, 0xf1, 0xec, 0x2f, 0x15, 0xa0, 0x2c, 0xa3, 0x01, 0xfa, 0xe8, 0xca, 0x67, 0x2d,
-	0x18, 0x57, 0x07, 0x06, 0xcc, 0x13, 0x55, 0xc8, 0x23, 0x6e, 0x9d, 0xf6, 0x40, 0x85, 0x5a, 0x06,
-	0xf5, 0x50, 0x6b, 0xe4, 0xd8, 0x64, 0x86, 0xd3, 0xbc, 0xd1, 0x4d, 0x80, 0x78, 0x2f, 0x4e, 0x48,
-	0xd3, 0xf0, 0x89, 0xd9, 0xc6, 0x8a, 0x9b, 0x71, 0xc3, 0x88, 0xd0, 0xf5, 0x75, 0x3d, 0xac, 0x91,
-	0x75, 0x85, 0xa9, 0x55, 0x28, 0xdd, 0x86, 0x0d, 0x4a, 0xf6, 0xdf, 0x2f, 0xc0, 0xe9, 0x6c, 0x97,
-	0xd0, 0x87, 0x61, 0x4c, 0x72, 0x37, 0xee, 0xee, 0x91, 0x21, 0x10, 0x63, 0xd8, 0x80, 0xdd, 0xdb,
-	0x9f, 0x9e, 0xee, 0xbc, 0x3b, 0x69, 0xc6, 0x44, 0xc1, 0x29, 0x62, 0xfc, 0xd4, 0x46, 0x1c, 0x2f,
-	0x56, 0xf7, 0xe6, 0x5a, 0x2d, 0x71, 0xf4, 0x62, 0x9c, 0xda, 0x98, 0x50, 0x9c, 0xc1, 0x46, 0x6b,
-	0x70, 0xd6, 0x68, 0xb9, 0x4e, 0xbc, 0xc6, 0xd6, 0x66, 0x18, 0x49, 0xcb, 0xea, 0x49, 0x1d, 0xa4,
-	0xd4, 0x89, 0x83, 0xbb, 0x3e, 0x49, 0x77, 0x7b, 0xd7, 0x69, 0x39, 0xae, 0x97, 0xec, 0x09, 0x27,
-	0x9f, 0x92, 0x4d, 0xf3, 0xa2, 0x1d, 0x2b, 0x0c, 0x7b, 0x05, 0x86, 0xfa, 0x9c, 0x41, 0x7d, 0x69,
-	0xf4, 0xaf, 0x40, 0x99, 0x92, 0x93, 0xea, 0x5d, 0x1e, 0x24, 0x43, 0x28, 0xcb, 0xf2, 0xfb, 0xc8,
-	0x86, 0xa2, 0xe7, 0xc8, 0x83, 0x31, 0xf5, 0x5a, 0x4b, 0x71, 0xdc, 0x66, 0x46, 0x32, 0x05, 0xa2,
-	0x67, 0xa0, 0x48, 0x76, 0x5b, 0xd9, 0x13, 0xb0, 0x4b, 0xbb, 0x2d, 0x2f, 0x22, 0x31, 0x45, 0x22,
-	0xbb, 0x2d, 0x74, 0x1e, 0x0a, 0x5e, 0x4d, 0x6c, 0x52, 0x20, 0x70, 0x0a, 0x4b, 0x0b, 0xb8, 0xe0,
-	0xd5, 0xec, 0x5d, 0xa8, 0xa8, 0x7a, 0xff, 0x68, 0x5b, 0xca, 0x6e, 0x2b, 0x8f, 0xf0, 0x1d, 0x49,
-	0xb7, 0x87, 0xd4, 0x6e, 0x03, 0xe8, 0x9c, 0xb0, 0xbc, 0xe4, 0xcb, 0x05, 0x18, 0x72, 0x43, 0x91,
-	0x4a, 0x5a, 0xd6, 0x64, 0x98, 0xd0, 0x66, 0x10, 0xfb, 0x16, 0x4c, 0x5c, 0x0b, 0xc2, 0xbb, 0xac,
-	0xa0, 0x31, 0x2b, 0x44, 0x44, 0x09, 0xd7, 0xe9, 0x8f, 0xac, 0x8a, 0xc0, 0xa0, 0x98, 0xc3, 0x54,
-	0xf9, 0x9b, 0x42, 0xaf, 0xf2, 0x37, 0xf6, 0x27, 0x2d, 0x38, 0xad, 0x32, 0x5b, 0xa4, 0x34, 0x7e,
-	0x09, 0xc6, 0x36, 0xdb, 0x9e, 0x5f, 0x93, 0xe5, 0x8d, 0x32, 0x6e, 0x8a, 0xaa, 0x01, 0xc3, 0x29,
-	0x4c, 0x6a, 0x54, 0x6d, 0x7a, 0x81, 0x13, 0xed, 0xad, 0x69, 0xf1, 0xaf, 0x24, 0x42, 0x55, 0x41,
-	0xb0, 0x81, 0x65, 0x7f, 0xd6, 0xec, 0x82, 0xc8, 0xa5, 0xe9, 0x63, 0x64, 0x6f, 0x40, 0xc9, 0x55,
-	0x07, 0xa9, 0xc7, 0x2a, 0xc1, 0xa6, 0xd2, 0x98, 0x99, 0x33, 0x9d, 0x53, 0xb3, 0xff, 0x69, 0x01,
-	0xc6, 0x53, 0xb5, 0x2b, 0x90, 0x0f, 0x65, 0xe2, 0x33, 0x57, 0x9e, 0x9c, 0x62, 0x83, 0x16, 0xf9,
-	0x53, 0xcb, 0xe2, 0x92, 0xa0, 0x8b, 0x15, 0x87, 0x47, 0xe3, 0xbc, 0xea, 0x25, 0x18, 0x93, 0x1d,
-	0xfa, 0x90, 0xd3, 0xf4, 0xc5, 0x2a, 0x54, 0x13, 0xe0, 0x92, 0x01, 0xc3, 0x29, 0x4c, 0xfb, 0x0f,
-	0x8a, 0x30, 0xc5, 0x7d, 0x9f, 0x35, 0x15, 0x52, 0xb2, 0x22, 0xb5, 0xac, 0xbf, 0xa2, 0x2b, 0xcc,
-	0xf0, 0x81, 0xdc, 0x1c, 0xb4, 0xa6, 0x6e, 0x77, 0x46, 0x7d, 0x05, 0x3b, 0xfc, 0x5a, 0x26, 0xd8,
-	0x81, 0x6f, 0xb6, 0x8d, 0x13, 0xea, 0xd1, 0x0f, 0x57, 0xf4, 0xc3, 0xdf, 0x29, 0xc0, 0xa9, 0x4c,
-	0xc1, 0x62, 0xf4, 0x66, 0xba, 0x58, 0x9f, 0x95, 0x87, 0x87, 0xec, 0xbe, 0x35, 0x6c, 0x8f, 0x56,
-	0xb2, 0xef, 0x21, 0x2d, 0x15, 0xfb, 0x0f, 0x0b, 0x30, 0x91, 0xae, 0xb4, 0xfc, 0x08, 0x8e, 0xd4,
-	0xbb, 0xa0, 0xc2, 0x8a, 0x89, 0xb2, 0xdb, 0xa1, 0xb8, 0x23, 0x8e, 0x17, 0xa0, 0x94, 0x8d, 0x58,
-	0xc3, 0x1f, 0x89, 0x4a, 0x88, 0xf6, 0xdf, 0xb5, 0xe0, 0x1c, 0x7f, 0xcb, 0xec, 0x3c, 0xfc, 0xab,
-	0xdd, 0x46, 0xf7, 0xb5, 0x7c, 0x3b, 0x98, 0xa9, 0x8c, 0x74, 0xd8, 0xf8, 0xb2, 0x5b, 0x69, 0x44,
-	0x6f, 0xd3, 0x53, 0xe1, 0x11, 0xec, 0xec, 0x91, 0x26, 0x83, 0xfd, 0x87, 0x45, 0xd0, 0x17, 0xf1,
-	0x20, 0x4f, 0x64, 0xe9, 0xe4, 0x52, 0x21, 0x6a, 0x7d, 0x2f, 0x70, 0xf5, 0x95, 0x3f, 0xe5, 0x4c,
-	0x92, 0xce, 0xaf, 0x58, 0x30, 0xea, 0x05, 0x5e, 0xe2, 0x39, 0x4c, 0x79, 0xce, 0xe7, 0x22, 0x11,
-	0xc5, 0x6e, 0x89, 0x53, 0x0e, 0x23, 0xd3, 0x7b, 0xab, 0x98, 0x61, 0x93, 0x33, 0xfa, 0xa8, 0x88,
-	0x47, 0x2c, 0xe6, 0x96, 0x5f, 0x56, 0xce, 0x04, 0x21, 0xb6, 0xa0, 0x14, 0x91, 0x24, 0xca, 0x29,
-	0x2d, 0x13, 0x53, 0x52, 0xaa, 0xd8, 0xa0, 0xbe, 0x12, 0x91, 0x36, 0x63, 0xce, 0xc8, 0x8e, 0x01,
-	0x75, 0x8e, 0xc5, 0x11, 0x63, 0xbd, 0x66, 0xa1, 0xe2, 0xb4, 0x93, 0xb0, 0x49, 0x87, 0x49, 0x38,
-	0x98, 0x75, 0x34, 0x9b, 0x04, 0x60, 0x8d, 0x63, 0xbf, 0x59, 0x82, 0x4c, 0xda, 0x0c, 0xda, 0x35,
-	0x2f, 0x91, 0xb2, 0xf2, 0xbd, 0x44, 0x4a, 0x75, 0xa6, 0xdb, 0x45, 0x52, 0xa8, 0x01, 0xa5, 0xd6,
-	0x96, 0x13, 0x4b, 0xdd, 0xf8, 0x15, 0x39, 0x4c, 0x6b, 0xb4, 0xf1, 0xde, 0xfe, 0xf4, 0xcf, 0xf4,
-	0xe7, 0x6b, 0xa1, 0x73, 0x75, 0x96, 0x67, 0xa1, 0x6b, 0xd6, 0x8c, 0x06, 0xe6, 0xf4, 0x8f, 0x72,
-	0x95, 0xca, 0xa7, 0x44, 0xf9, 0x57, 0x4c, 0xe2, 0xb6, 0x9f, 0x88, 0xd9, 0xf0, 0x4a, 0x8e, 0xab,
-	0x8c, 0x13, 0xd6, 0x09, 0x9f, 0xfc, 0x3f, 0x36, 0x98, 0xa2, 0x0f, 0x43, 0x25, 0x4e, 0x9c, 0x28,
-	0x39, 0x66, 0x8a, 0x96, 0x1a, 0xf4, 0x75, 0x49, 0x04, 0x6b, 0x7a, 0xe8, 0x55, 0x56, 0x30, 0xcf,
-	0x8b, 0xb7, 0x8e, 0x19, 0x46, 0x2c, 0x8b, 0xeb, 0x09, 0x0a, 0xd8, 0xa0, 0x46, 0x4d, 0x0f, 0x36,
-	0xb7, 0x79, 0xec, 0x4c, 0x99, 0xd9, 0x96, 0x4a, 0x14, 0x62, 0x05, 0xc1, 0x06, 0x96, 0xfd, 0x13,
-	0x90, 0xce, 0x58, 0x46, 0xd3, 0x32, 0x41, 0x9a, 0xfb, 0x9e, 0x58, 0x38, 0x70, 0x2a, 0x97, 0xf9,
-	0x77, 0x2c, 0x30, 0xd3, 0xaa, 0xd1, 0x1d, 0x9e, 0xbf, 0x6d, 0xe5, 0x71, 0x5e, 0x60, 0xd0, 0x9d,
-	0x59, 0x71, 0x5a, 0x99, 0x83, 0x2b, 0x99, 0xc4, 0x7d, 0xfe, 0x7d, 0x50, 0x96, 0xd0, 0x23, 0x29,
-	0x75, 0x9f, 0x80, 0x33, 0xd9, 0x2b, 0x36, 0x85, 0xaf, 0xb9, 0x11, 0x85, 0xed, 0x56, 0xd6, 0x90,
-	0x64, 0x57, 0x30, 0x62, 0x0e, 0xa3, 0xe6, 0xd8, 0xb6, 0x17, 0xd4, 0xb2, 0x86, 0xe4, 0x35, 0x2f,
-	0xa8, 0x61, 0x06, 0xe9, 0xe3, 0x2a, 0xb1, 0xdf, 0xb5, 0xe0, 0xc2, 0x61, 0x37, 0x81, 0xa2, 0x27,
-	0x61, 0xe8, 0xae, 0x13, 0xc9, 0x4a, 0xa6, 0x4c, 0x50, 0xde, 0x72, 0xa2, 0x00, 0xb3, 0x56, 0xb4,
-	0x07, 0xc3, 0x3c, 0xff, 0x57, 0x68, 0xeb, 0xaf, 0xe4, 0x7b, 0x2f, 0xe9, 0x35, 0x62, 0x98, 0x0b,
-	0x3c, 0xf7, 0x18, 0x0b, 0x86, 0xf6, 0xf7, 0x2d, 0x40, 0xab, 0x3b, 0x24, 0x8a, 0xbc, 0x9a, 0x91,
-	0xb1, 0x8c, 0x5e, 0x84, 0xb1, 0xdb, 0xeb, 0xab, 0xd7, 0xd7, 0x42, 0x2f, 0x60, 0x15, 0x0c, 0x8c,
-	0x24, 0xad, 0xab, 0x46, 0x3b, 0x4e, 0x61, 0xa1, 0x79, 0x98, 0xbc, 0x7d, 0x87, 0x1a, 0xbf, 0x66,
-	0x8d, 0xf3, 0x82, 0x76, 0x77, 0x5e, 0x7d, 0x25, 0x03, 0xc4, 0x9d, 0xf8, 0x68, 0x15, 0xce, 0x35,
-	0xb9, 0xb9, 0xc1, 0x4b, 0x13, 0x73, 0xdb, 0x43, 0xe5, 0x68, 0x3c, 0x71, 0xb0, 0x3f, 0x7d, 0x6e,
-	0xa5, 0x1b, 0x02, 0xee, 0xfe, 0x9c, 0xfd, 0x3e, 0x40, 0x3c, 0x58, 0x65, 0xbe, 0x5b, 0xe4, 0x41,
-	0x4f, 0x4b, 0xdc, 0xfe, 0x5a, 0x09, 0x4e, 0x65, 0xea, 0xdc, 0x51, 0x53, 0xaf, 0x33, 0xd4, 0x61,
-	0xe0, 0xfd, 0xbb, 0xb3, 0x7b, 0x7d, 0x05, 0x4f, 0x04, 0x50, 0xf2, 0x82, 0x56, 0x3b, 0xc9, 0x27,
-	0x0b, 0x8a, 0x77, 0x62, 0x89, 0x12, 0x34, 0x9c, 0x44, 0xf4, 0x2f, 0xe6, 0x6c, 0xf2, 0x0c, 0xc5,
-	0x48, 0x29, 0xe3, 0x43, 0x0f, 0xc9, 0x1d, 0xf0, 0x29, 0x1d, 0x18, 0x51, 0xca, 0xe3, 0xa0, 0x3e,
-	0x33, 0x59, 0x4e, 0xfa, 0x80, 0xed, 0x9b, 0x05, 0x18, 0x35, 0x3e, 0x1a, 0xfa, 0xf5, 0x74, 0xd1,
-	0x11, 0x2b, 0xbf, 0x57, 0x62, 0xf4, 0x67, 0x74, 0x59, 0x11, 0xfe, 0x4a, 0xcf, 0x76, 0xd6, 0x1b,
-	0xb9, 0xb7, 0x3f, 0x7d, 0x3a, 0x53, 0x51, 0x24, 0x55, 0x83, 0xe4, 0xfc, 0xc7, 0xe1, 0x54, 0x86,
-	0x4c, 0x97, 0x57, 0xde, 0x48, 0xdf, 0xa0, 0x3a, 0xa0, 0x5b, 0xca, 0x1c, 0xb2, 0x6f, 0xd0, 0x21,
-	0xd3, 0x17, 0x6b, 0xf7, 0xe1, 0x8e, 0xcb, 0x24, 0xa0, 0x15, 0xfa, 0x4c, 0x40, 0x7b, 0x0e, 0xca,
-	0xad, 0xd0, 0xf7, 0x5c, 0x4f, 0x95, 0xa7, 0x62, 0x85, 0x5b, 0xd7, 0x44, 0x1b, 0x56, 0x50, 0x74,
-	0x17, 0x2a, 0xea, 0xb2, 0x59, 0x91, 0x43, 0x9f, 0x97, 0xab, 0x57, 0x29, 0x2d, 0xfa, 0x12, 0x59,
-	0xcd, 0x0b, 0xd9, 0x30, 0xcc, 0x36, 0x41, 0x19, 0x4d, 0xcb, 0xb2, 0x11, 0xd9, 0xee, 0x18, 0x63,
-	0x01, 0xb1, 0x3f, 0x3d, 0x02, 0x67, 0xbb, 0x15, 0x1b, 0x45, 0x1f, 0x83, 0x61, 0xde, 0xc7, 0x7c,
-	0xea, 0x59, 0x77, 0xe3, 0xb1, 0xc8, 0x08, 0x8a, 0x6e, 0xb1, 0xdf, 0x58, 0xf0, 0x14, 0xdc, 0x7d,
-	0x67, 0x53, 0xcc, 0x90, 0x93, 0xe1, 0xbe, 0xec, 0x68, 0xee, 0xcb, 0x0e, 0xe7, 0xee, 0x3b, 0x9b,
-	0x68, 0x17, 0x4a, 0x0d, 0x2f, 0x21, 0x8e, 0x70, 0x22, 0xdc, 0x3a, 0x11, 0xe6, 0xc4, 0xe1, 0x5a,
-	0x1a, 0xfb, 0x89, 0x39, 0x43, 0xf4, 0x75, 0x0b, 0x4e, 0x6d, 0xa6, 0x93, 0x3b, 0x85, 0xf0, 0x74,
-	0x4e, 0xa0, 0xa0, 0x6c, 0x9a, 0x11, 0xbf, 0x47, 0x20, 0xd3, 0x88, 0xb3, 0xdd, 0x41, 0xbf, 0x68,
-	0xc1, 0x48, 0xdd, 0xf3, 0x8d, 0xd2, 0x85, 0x27, 0xf0, 0x71, 0x2e, 0x33, 0x06, 0xda, 0xe2, 0xe0,
-	0xff, 0x63, 0x2c, 0x39, 0xf7, 0xda, 0xa9, 0x86, 0x07, 0xdd, 0xa9, 0x46, 0x1e, 0x92, 0xdb, 0xe8,
-	0x57, 0x0b, 0xf0, 0x4c, 0x1f, 0xdf, 0xc8, 0xcc, 0xc7, 0xb3, 0x0e, 0xc9, 0xc7, 0xbb, 0x00, 0x43,
-	0x11, 0x69, 0x85, 0x59, 0xd5, 0x97, 0x05, 0xad, 0x32, 0x08, 0x7a, 0x0a, 0x8a, 0x4e, 0xcb, 0x13,
-	0x9a, 0xaf, 0xd2, 0xd7, 0xe7, 0xd6, 0x96, 0x30, 0x6d, 0xa7, 0x5f, 0xba, 0xb2, 0x29, 0x53, 0x8e,
-	0xf3, 0xb9, 0xd3, 0xa3, 0x57, 0x06, 0x33, 0x77, 0xe4, 0x28, 0x28, 0xd6, 0x7c, 0xed, 0xbf, 0x66,
-	0xc1, 0xf9, 0xde, 0x53, 0x04, 0xbd, 0x00, 0xa3, 0x9b, 0x91, 0x13, 0xb8, 0x5b, 0xec, 0x02, 0x1c,
-	0x39, 0x28, 0x2c, 0x0d, 0x4b, 0x37, 0x63, 0x13, 0x87, 0x2a, 0xb1, 0xbc, 0x4a, 0xb0, 0x81, 0x21,
-	0xb3, 0x2e, 0xa8, 0x12, 0xbb, 0x91, 0x05, 0xe2, 0x4e, 0x7c, 0xfb, 0x0f, 0x0a, 0xdd, 0xbb, 0xc5,
-	0x45, 0xc9, 0x51, 0xbe, 0x93, 0xf8, 0x0a, 0x85, 0x1e, 0x5f, 0xe1, 0x0e, 0x94, 0x13, 0x96, 0x4a,
-	0x46, 0xea, 0x42, 0x1e, 0xe5, 0x96, 0xaa, 0xcd, 0x76, 0xac, 0x0d, 0x41, 0x1c, 0x2b, 0x36, 0x74,
-	0xe3, 0xf0, 0x75, 0xed, 0x44, 0xb1, 0x71, 0x64, 0x4e, 0x21, 0x16, 0xe0, 0xb4, 0x51, 0x7d, 0x9a,
-	0x67, 0xd2, 0xf0, 0x60, 0x1a, 0x95, 0x5e, 0xba, 0x96, 0x81, 0xe3, 0x8e, 0x27, 0xec, 0xdf, 0x28,
-	0xc0, 0x13, 0x3d, 0xe5, 0xa3, 0x8e, 0xf8, 0xb1, 0xee, 0x13, 0xf1, 0x33, 0xf0, 0x34, 0x37, 0x07,
-	0x78, 0xe8, 0xc1, 0x0c, 0xf0, 0xf3, 0x50, 0xf6, 0x82, 0x98, 0xb8, 0xed, 0x88, 0x0f, 0x9a, 0x11,
-	0x57, 0xbe, 0x24, 0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xa8, 0xf7, 0x54, 0xa3, 0x7b, 0xe5, 0x8f, 0xec,
-	0x28, 0xbd, 0x0c, 0xe3, 0x4e, 0xab, 0xc5, 0xf1, 0x58, 0x74, 0x45, 0x26, 0x61, 0x7c, 0xce, 0x04,
-	0xe2, 0x34, 0xae, 0x31, 0x87, 0x87, 0x7b, 0xcd, 0x61, 0xfb, 0x4f, 0x2d, 0xa8, 0x60, 0x52, 0xe7,
-	0xeb, 0x1d, 0xdd, 0x16, 0x43, 0x64, 0xe5, 0x51, 0xc9, 0x89, 0x0e, 0x6c, 0xec, 0xb1, 0x0a, 0x47,
-	0xdd, 0x06, 0xbb, 0xb3, 0xac, 0x79, 0xe1, 0x48, 0x65, 0xcd, 0x55, 0x61, 0xeb, 0x62, 0xef, 0xc2,
-	0xd6, 0xf6, 0x37, 0x46, 0xe8, 0xeb, 0xb5, 0xc2, 0xf9, 0x88, 0xd4, 0x62, 0xfa, 0x7d, 0xdb, 0x91,
-	0x9f, 0xbd, 0x0f, 0xfc, 0x06, 0x5e, 0xc6, 0xb4, 0x3d, 0xe5, 0x42, 0x2d, 0x1c, 0x29, 0x5d, 0xb6,
-	0x78, 0x68, 0xba, 0xec, 0xcb, 0x30, 0x1e, 0xc7, 0x5b, 0x6b, 0x91, 0xb7, 0xe3, 0x24, 0xe4, 0x1a,
-	0xd9, 0x13, 0xc1, 0x79, 0x3a, 0xc5, 0x6d, 0xfd, 0x8a, 0x06, 0xe2, 0x34, 0x2e, 0x5a, 0x84, 0x49,
-	0x9d, 0xb4, 0x4a, 0xa2, 0x84, 0xc5, 0xe2, 0xf1, 0x99, 0xa0, 0x32, 0xcc, 0x74, 0x9a, 0xab, 0x40,
-	0xc0, 0x9d, 0xcf, 0x50, 0x89, 0x95, 0x6a, 0xa4, 0x1d, 0x19, 0x4e, 0x4b, 0xac, 0x14, 0x1d, 0xda,
-	0x97, 0x8e, 0x27, 0xd0, 0x0a, 0x9c, 0xe1, 0x13, 0x63, 0xae, 0xd5, 0x32, 0xde, 0x68, 0x24, 0x5d,
-	0x41, 0x67, 0xb1, 0x13, 0x05, 0x77, 0x7b, 0x8e, 0x5a, 0x1f, 0xaa, 0x79, 0x69, 0x41, 0x78, 0xff,
-	0x94, 0xf5, 0xa1, 0xc8, 0x2c, 0xd5, 0xb0, 0x89, 0x87, 0x3e, 0x04, 0x8f, 0xeb, 0xbf, 0x3c, 0x60,
-	0x9b, 0xbb, 0xc4, 0x17, 0x44, 0x3d, 0x00, 0x55, 0x46, 0x79, 0xb1, 0x2b, 0x5a, 0x0d, 0xf7, 0x7a,
-	0x1e, 0x6d, 0xc2, 0x79, 0x05, 0xba, 0x14, 0x24, 0x2c, 0xfa, 0x32, 0x26, 0x55, 0x27, 0x26, 0x37,
-	0x22, 0x9f, 0x55, 0x10, 0xa8, 0xe8, 0x2b, 0x68, 0x16, 0xbd, 0xe4, 0x4a, 0x37, 0x4c, 0xbc, 0x8c,
-	0xef, 0x43, 0x05, 0xcd, 0x42, 0x85, 0x04, 0xce, 0xa6, 0x4f, 0x56, 0xe7, 0x97, 0x58, 0x5d, 0x01,
-	0xc3, 0x03, 0x7f, 0x49, 0x02, 0xb0, 0xc6, 0x51, 0xf1, 0x20, 0x63, 0x3d, 0xaf, 0x43, 0x5a, 0x83,
-	0xb3, 0x0d, 0xb7, 0x45, 0xb5, 0x09, 0xcf, 0x25, 0x73, 0x2e, 0x8b, 0x89, 0xa0, 0x1f, 0x86, 0x97,
-	0x36, 0x52, 0xc1, 0x4e, 0x8b, 0xf3, 0x6b, 0x1d, 0x38, 0xb8, 0xeb, 0x93, 0x74, 0x8d, 0xb5, 0xa2,
-	0x70, 0x77, 0x6f, 0xea, 0x4c, 0x7a, 0x8d, 0xad, 0xd1, 0x46, 0xcc, 0x61, 0xe8, 0x2a, 0x20, 0x16,
-	0x39, 0x77, 0x25, 0x49, 0x5a, 0x4a, 0x7d, 0x99, 0x3a, 0xcb, 0x5e, 0xe9, 0xbc, 0x78, 0x02, 0x5d,
-	0xee, 0xc0, 0xc0, 0x5d, 0x9e, 0xb2, 0xff, 0xc4, 0x82, 0x71, 0xb5, 0x5e, 0x1f, 0x40, 0xec, 0xa8,
-	0x9f, 0x8e, 0x1d, 0x5d, 0x1c, 0x5c, 0xe2, 0xb1, 0x9e, 0xf7, 0x08, 0x40, 0xfa, 0xf4, 0x28, 0x80,
-	0x96, 0x8a, 0x6a, 0x43, 0xb2, 0x7a, 0x6e, 0x48, 0x8f, 0xac, 0x44, 0xea, 0x96, 0x44, 0x5c, 0x7a,
-	0xb8, 0x49, 0xc4, 0xeb, 0x70, 0x4e, 0xaa, 0x0b, 0xdc, 0xc7, 0x7b, 0x25, 0x8c, 0x95, 0x80, 0x2b,
-	0x57, 0x9f, 0x12, 0x84, 0xce, 0x2d, 0x75, 0x43, 0xc2, 0xdd, 0x9f, 0x4d, 0x69, 0x29, 0x23, 0x87,
-	0x69, 0x29, 0x7a, 0x4d, 0x2f, 0xd7, 0x65, 0x51, 0xe6, 0xcc, 0x9a, 0x5e, 0xbe, 0xbc, 0x8e, 0x35,
-	0x4e, 0x77, 0xc1, 0x5e, 0xc9, 0x49, 0xb0, 0xc3, 0x91, 0x05, 0xbb, 0x14, 0x31, 0xa3, 0x3d, 0x45,
-	0x8c, 0xf4, 0x25, 0x8d, 0xf5, 0xf4, 0x25, 0xbd, 0x1f, 0x26, 0xbc, 0x60, 0x8b, 0x44, 0x5e, 0x42,
-	0x6a, 0x6c, 0x2d, 0x30, 0xf1, 0x53, 0xd6, 0xdb, 0xfa, 0x52, 0x0a, 0x8a, 0x33, 0xd8, 0x69, 0xb9,
-	0x38, 0xd1, 0x87, 0x5c, 0xec, 0xb1, 0x1b, 0x9d, 0xca, 0x67, 0x37, 0x3a, 0x3d, 0xf8, 0x6e, 0x34,
-	0x79, 0xa2, 0xbb, 0x11, 0xca, 0x65, 0x37, 0xea, 0x4b, 0xd0, 0x1b, 0x06, 0xdd, 0xd9, 0x43, 0x0c,
-	0xba, 0x5e, 0x5b, 0xd1, 0xb9, 0x63, 0x6f, 0x45, 0xdd, 0x77, 0x99, 0xc7, 0x8e, 0xb5, 0xcb, 0x7c,
-	0xa6, 0x00, 0xe7, 0xb4, 0x1c, 0xa6, 0xb3, 0xdf, 0xab, 0x53, 0x49, 0xc4, 0xea, 0xfa, 0x73, 0x7f,
-	0xab, 0x11, 0xca, 0xac, 0xa3, 0xa2, 0x15, 0x04, 0x1b, 0x58, 0x2c, 0x22, 0x98, 0x44, 0xac, 0x80,
-	0x5b, 0x56, 0x48, 0xcf, 0x8b, 0x76, 0xac, 0x30, 0xe8, 0xfc, 0xa2, 0xbf, 0x45, 0x96, 0x45, 0xb6,
-	0x6e, 0xca, 0xbc, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xc7, 0x99, 0x30, 0x01, 0x41, 0x05, 0xf5, 0x98,
-	0xb8, 0x24, 0x4b, 0xca, 0x04, 0x05, 0x95, 0xdd, 0x61, 0xa1, 0xdf, 0xa5, 0xce, 0xee, 0xb0, 0xd0,
-	0x05, 0x85, 0x61, 0xff, 0x4f, 0x0b, 0x9e, 0xe8, 0x3a, 0x14, 0x0f, 0x60, 0xf3, 0xdd, 0x4d, 0x6f,
-	0xbe, 0xeb, 0x79, 0x99, 0x1b, 0xc6, 0x5b, 0xf4, 0xd8, 0x88, 0xff, 0x9d, 0x05, 0x13, 0x1a, 0xff,
-	0x01, 0xbc, 0xaa, 0x97, 0x7e, 0xd5, 0xfc, 0x2c, 0xab, 0x4a, 0xc7, 0xbb, 0xfd, 0x09, 0x7b, 0x37,
-	0x7e, 0x28, 0x3a, 0xe7, 0xca, 0x4a, 0x71, 0x87, 0x9c, 0x00, 0xec, 0xc1, 0x30, 0x3b, 0xc0, 0x88,
-	0xf3, 0x39, 0x9c, 0x4d, 0xf3, 0x67, 0x87, 0x21, 0xfa, 0x70, 0x88, 0xfd, 0x8d, 0xb1, 0x60, 0xc8,
-	0xca, 0x0b, 0x7a, 0x31, 0x95, 0xe6, 0x35, 0x11, 0x44, 0xad, 0xcb, 0x0b, 0x8a, 0x76, 0xac, 0x30,
-	0xec, 0x26, 0x4c, 0xa5, 0x89, 0x2f, 0x90, 0x3a, 0x0b, 0xf8, 0xe9, 0xeb, 0x35, 0x67, 0xa1, 0xe2,
-	0xb0, 0xa7, 0x96, 0xdb, 0x4e, 0xf6, 0x5e, 0xc5, 0x39, 0x09, 0xc0, 0x1a, 0xc7, 0xfe, 0x6d, 0x0b,
-	0xce, 0x74, 0x79, 0x99, 0x1c, 0x83, 0xc7, 0x13, 0x2d, 0x05, 0xba, 0x6d, 0xb8, 0xef, 0x84, 0x91,
-	0x1a, 0xa9, 0x3b, 0x32, 0xa4, 0xc4, 0x90, 0xb9, 0x0b, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0xdf, 0x2c,
-	0x38, 0x95, 0xee, 0x6b, 0x4c, 0xa5, 0x26, 0x7f, 0x99, 0x05, 0x2f, 0x76, 0xc3, 0x1d, 0x12, 0xed,
-	0xd1, 0x37, 0xe7, 0xbd, 0x56, 0x52, 0x73, 0xae, 0x03, 0x03, 0x77, 0x79, 0x8a, 0x55, 0x18, 0xab,
-	0xa9, 0xd1, 0x96, 0x33, 0xe5, 0x66, 0x9e, 0x33, 0x45, 0x7f, 0x4c, 0xf3, 0xf8, 0x49, 0xb1, 0xc4,
-	0x26, 0x7f, 0xfb, 0xfb, 0x43, 0xa0, 0xb2, 0x4b, 0xd8, 0x79, 0x7e, 0x4e, 0xd1, 0x10, 0xa9, 0xfb,
-	0x30, 0x8a, 0x7d, 0xdc, 0x87, 0x21, 0x27, 0xc3, 0xd0, 0xfd, 0x0e, 0xd8, 0xb8, 0xf7, 0xc2, 0x74,
-	0x12, 0xaa, 0x37, 0xdc, 0xd0, 0x20, 0x6c, 0xe2, 0xd1, 0x9e, 0xf8, 0xde, 0x0e, 0xe1, 0x0f, 0x0d,
-	0xa7, 0x7b, 0xb2, 0x2c, 0x01, 0x58, 0xe3, 0xd0, 0x9e, 0xd4, 0xbc, 0x7a, 0x5d, 0x98, 0xe2, 0xaa,
-	0x27, 0x74, 0x74, 0x30, 0x83, 0xf0, 0xa2, 0x91, 0xe1, 0xb6, 0xd0, 0x4e, 0x8d, 0xa2, 0x91, 0xe1,
-	0x36, 0x66, 0x10, 0xaa, 0x4f, 0x05, 0x61, 0xd4, 0x64, 0xf7, 0x5e, 0xd6, 0x14, 0x17, 0xa1, 0x95,
-	0x2a, 0x7d, 0xea, 0x7a, 0x27, 0x0a, 0xee, 0xf6, 0x1c, 0x9d, 0x81, 0xad, 0x88, 0xd4, 0x3c, 0x37,
-	0x31, 0xa9, 0x41, 0x7a, 0x06, 0xae, 0x75, 0x60, 0xe0, 0x2e, 0x4f, 0xa1, 0x39, 0x38, 0x25, 0xb3,
-	0x83, 0x64, 0xee, 0xf7, 0x68, 0x3a, 0xd7, 0x14, 0xa7, 0xc1, 0x38, 0x8b, 0x4f, 0xa5, 0x4d, 0x53,
-	0x94, 0x7d, 0x60, 0x4a, 0xac, 0x21, 0x6d, 0x64, 0x39, 0x08, 0xac, 0x30, 0xec, 0x4f, 0x15, 0xe9,
-	0xee, 0xd8, 0xa3, 0xd4, 0xfd, 0x03, 0x8b, 0xbe, 0x49, 0xcf, 0xc8, 0xa1, 0x3e, 0x66, 0xe4, 0x8b,
-	0x30, 0x76, 0x3b, 0x0e, 0x03, 0x15, 0xd9, 0x52, 0xea, 0x19, 0xd9, 0x62, 0x60, 0x75, 0x8f, 0x6c,
-	0x19, 0xce, 0x2b, 0xb2, 0x65, 0xe4, 0x98, 0x91, 0x2d, 0xdf, 0x29, 0x81, 0x2a, 0x16, 0x7d, 0x9d,
-	0x24, 0x77, 0xc3, 0x68, 0xdb, 0x0b, 0x1a, 0x2c, 0xab, 0xea, 0xeb, 0x16, 0x8c, 0xf1, 0xf5, 0xb2,
-	0x6c, 0x66, 0x26, 0xd4, 0x73, 0xaa, 0x42, 0x9c, 0x62, 0x36, 0xb3, 0x61, 0x30, 0xca, 0xdc, 0x71,
-	0x64, 0x82, 0x70, 0xaa, 0x47, 0xe8, 0xe3, 0x00, 0xd2, 0x6f, 0x59, 0x97, 0x22, 0x73, 0x29, 0x9f,
-	0xfe, 0x61, 0x52, 0xd7, 0xba, 0xe9, 0x86, 0x62, 0x82, 0x0d, 0x86, 0xe8, 0x33, 0xd9, 0x7b, 0x81,
-	0x3f, 0x7a, 0x22, 0x63, 0xd3, 0x4f, 0xce, 0x06, 0x86, 0x11, 0x2f, 0x68, 0xd0, 0x79, 0x22, 0x22,
-	0x00, 0xde, 0xd1, 0x2d, 0x23, 0x71, 0x39, 0x74, 0x6a, 0x55, 0xc7, 0x77, 0x02, 0x97, 0x44, 0x4b,
-	0x1c, 0xdd, 0xbc, 0x74, 0x8f, 0x35, 0x60, 0x49, 0xa8, 0xa3, 0xcc, 0x76, 0xa9, 0x9f, 0x32, 0xdb,
-	0xe7, 0x3f, 0x00, 0x93, 0x1d, 0x1f, 0xf3, 0x48, 0x29, 0x1a, 0xc7, 0xcf, 0xee, 0xb0, 0xff, 0xd9,
-	0xb0, 0xde, 0xb4, 0xae, 0x87, 0x35, 0x5e, 0xec, 0x39, 0xd2, 0x5f, 0x54, 0xe8, 0x9e, 0x39, 0x4e,
-	0x11, 0xe3, 0xe2, 0x3e, 0xd5, 0x88, 0x4d, 0x96, 0x74, 0x8e, 0xb6, 0x9c, 0x88, 0x04, 0x27, 0x3d,
-	0x47, 0xd7, 0x14, 0x13, 0x6c, 0x30, 0x44, 0x5b, 0xa9, 0x18, 0xed, 0xcb, 0x83, 0xc7, 0x68, 0xb3,
-	0x5a, 0x0d, 0xdd, 0x0a, 0xc6, 0x7e, 0xd1, 0x82, 0x89, 0x20, 0x35, 0x73, 0xf3, 0x09, 0xcb, 0xea,
-	0xbe, 0x2a, 0xf8, 0x5d, 0x03, 0xe9, 0x36, 0x9c, 0xe1, 0xdf, 0x6d, 0x4b, 0x2b, 0x1d, 0x71, 0x4b,
-	0xd3, 0x55, 0xe3, 0x87, 0x7b, 0x55, 0x8d, 0x47, 0x81, 0xba, 0x36, 0x63, 0x24, 0xf7, 0x6b, 0x33,
-	0xa0, 0xcb, 0x95, 0x19, 0xb7, 0xa0, 0xe2, 0x46, 0xc4, 0x49, 0x8e, 0x79, 0x83, 0x02, 0x3b, 0x0a,
-	0x9f, 0x97, 0x04, 0xb0, 0xa6, 0x65, 0xff, 0xdb, 0x22, 0x9c, 0x96, 0x23, 0x22, 0x43, 0x3a, 0xe9,
-	0xfe, 0xc8, 0xf9, 0x6a, 0xe5, 0x56, 0xed, 0x8f, 0x57, 0x24, 0x00, 0x6b, 0x1c, 0xaa, 0x8f, 0xb5,
-	0x63, 0xb2, 0xda, 0x22, 0xc1, 0xb2, 0xb7, 0x19, 0x8b, 0xf3, 0x47, 0xb5, 0x50, 0x6e, 0x68, 0x10,
-	0x36, 0xf1, 0xa8, 0x32, 0xce, 0xf5, 0xe2, 0x38, 0x1b, 0x0e, 0x2e, 0xf4, 0x6d, 0x2c, 0xe1, 0xe8,
-	0xab, 0x5d, 0xef, 0xde, 0xc9, 0x27, 0x11, 0xa2, 0x23, 0x92, 0xf5, 0x88, 0x97, 0xee, 0xbc, 0x69,
-	0xc1, 0xa9, 0xed, 0x54, 0x46, 0xaa, 0x14, 0xc9, 0x03, 0xd6, 0x4e, 0x48, 0xa7, 0xb9, 0xea, 0x29,
-	0x9c, 0x6e, 0x8f, 0x71, 0x96, 0xbb, 0xfd, 0xdf, 0x2d, 0x30, 0xc5, 0x53, 0x7f, 0x9a, 0x95, 0x71,
-	0x7d, 0x60, 0xe1, 0x90, 0xeb, 0x03, 0xa5, 0x12, 0x56, 0xec, 0x4f, 0xe9, 0x1f, 0x3a, 0x82, 0xd2,
-	0x5f, 0xea, 0xa9, 0xb5, 0x3d, 0x05, 0xc5, 0xb6, 0x57, 0x13, 0x7a, 0xbb, 0x3e, 0x6d, 0x5c, 0x5a,
-	0xc0, 0xb4, 0xdd, 0xfe, 0xc7, 0x25, 0x6d, 0xa7, 0x8b, 0xf8, 0xfd, 0x1f, 0x89, 0xd7, 0xae, 0xab,
-	0x52, 0x18, 0xfc, 0xcd, 0xaf, 0x77, 0x94, 0xc2, 0xf8, 0xa9, 0xa3, 0xa7, 0x67, 0xf0, 0x01, 0xea,
-	0x55, 0x09, 0x63, 0xe4, 0x90, 0xdc, 0x8c, 0xdb, 0x50, 0xa6, 0xa6, 0x0d, 0x73, 0xb8, 0x95, 0x53,
-	0x9d, 0x2a, 0x5f, 0x11, 0xed, 0xf7, 0xf6, 0xa7, 0x7f, 0xf2, 0xe8, 0xdd, 0x92, 0x4f, 0x63, 0x45,
-	0x1f, 0xc5, 0x50, 0xa1, 0xbf, 0x59, 0x1a, 0x89, 0x30, 0x9a, 0x6e, 0x28, 0x59, 0x24, 0x01, 0xb9,
-	0xe4, 0xa8, 0x68, 0x3e, 0x28, 0x80, 0x0a, 0xbb, 0xf7, 0x8b, 0x31, 0xe5, 0xb6, 0xd5, 0x9a, 0x4a,
-	0xe6, 0x90, 0x80, 0x7b, 0xfb, 0xd3, 0x2f, 0x1f, 0x9d, 0xa9, 0x7a, 0x1c, 0x6b, 0x16, 0xf6, 0x97,
-	0x86, 0xf4, 0xdc, 0x15, 0x15, 0x50, 0x7e, 0x24, 0xe6, 0xee, 0x4b, 0x99, 0xb9, 0x7b, 0xa1, 0x63,
-	0xee, 0x4e, 0xe8, 0xfb, 0xa9, 0x52, 0xb3, 0xf1, 0x41, 0x6f, 0xb0, 0x87, 0xdb, 0xf1, 0x4c, 0xb3,
-	0xb8, 0xd3, 0xf6, 0x22, 0x12, 0xaf, 0x45, 0xed, 0xc0, 0x0b, 0x1a, 0xe2, 0x4a, 0x60, 0x43, 0xb3,
-	0x48, 0x81, 0x71, 0x16, 0x9f, 0x5d, 0x27, 0xbc, 0x17, 0xb8, 0xb7, 0x9c, 0x1d, 0x3e, 0xab, 0x8c,
-	0xa2, 0x10, 0xeb, 0xa2, 0x1d, 0x2b, 0x0c, 0xfb, 0x1b, 0xec, 0xec, 0xd6, 0xc8, 0x5f, 0xa3, 0x73,
-	0xc2, 0x67, 0x17, 0xad, 0xf1, 0x8a, 0x12, 0x6a, 0x4e, 0xf0, 0xdb, 0xd5, 0x38, 0x0c, 0xdd, 0x85,
-	0x91, 0x4d, 0x7e, 0xd3, 0x48, 0x3e, 0xd5, 0x33, 0xc5, 0xb5, 0x25, 0xac, 0xc0, 0xb5, 0xbc, 0xc3,
-	0xe4, 0x9e, 0xfe, 0x89, 0x25, 0x37, 0xfb, 0xdb, 0x43, 0x70, 0x2a, 0x73, 0x15, 0x57, 0xaa, 0x96,
-	0x57, 0xe1, 0xd0, 0x5a, 0x5e, 0x1f, 0x01, 0xa8, 0x91, 0x96, 0x1f, 0xee, 0x31, 0x35, 0x67, 0xe8,
-	0xc8, 0x6a, 0x8e, 0xd2, 0x8c, 0x17, 0x14, 0x15, 0x6c, 0x50, 0x14, 0x65, 0x34, 0x78, 0x69, 0xb0,
-	0x4c, 0x19, 0x0d, 0xa3, 0x80, 0xed, 0xf0, 0x83, 0x2d, 0x60, 0xeb, 0xc1, 0x29, 0xde, 0x45, 0x95,
-	0x25, 0x76, 0x8c, 0x64, 0x30, 0x16, 0x67, 0xbb, 0x90, 0x26, 0x83, 0xb3, 0x74, 0x1f, 0xe6, 0x4d,
-	0x7b, 0xe8, 0x5d, 0x50, 0x91, 0xdf, 0x39, 0x9e, 0xaa, 0xe8, 0x4c, 0x5b, 0x39, 0x0d, 0xd8, 0x0d,
-	0x78, 0xe2, 0xa7, 0xfd, 0x85, 0x02, 0xd5, 0x4a, 0xf9, 0x3f, 0x55, 0x31, 0xe1, 0x59, 0x18, 0x76,
-	0xda, 0xc9, 0x56, 0xd8, 0x71, 0x7d, 0xca, 0x1c, 0x6b, 0xc5, 0x02, 0x8a, 0x96, 0x61, 0xa8, 0xa6,
-	0xb3, 0xe0, 0x8f, 0x32, 0x8a, 0xda, 0xc1, 0xe7, 0x24, 0x04, 0x33, 0x2a, 0xe8, 0x49, 0x18, 0x4a,
-	0x9c, 0x46, 0xea, 0x5a, 0xeb, 0x0d, 0xa7, 0x11, 0x63, 0xd6, 0x6a, 0x6e, 0x9a, 0x43, 0x87, 0x6c,
-	0x9a, 0x2f, 0xc3, 0x78, 0xec, 0x35, 0x02, 0x27, 0x69, 0x47, 0xc4, 0x38, 0x4c, 0xd2, 0xf1, 0x01,
-	0x26, 0x10, 0xa7, 0x71, 0xed, 0xdf, 0x1b, 0x83, 0xb3, 0xeb, 0xf3, 0x2b, 0xb2, 0xa2, 0xe3, 0x89,
-	0xc5, 0xd4, 0x77, 0xe3, 0xf1, 0xe0, 0x62, 0xea, 0x7b, 0x70, 0xf7, 0x8d, 0x98, 0x7a, 0xdf, 0x88,
-	0xa9, 0xff, 0x8c, 0x05, 0x15, 0x15, 0x4a, 0x2e, 0x02, 0x59, 0x3f, 0x9c, 0x7f, 0x0f, 0x54, 0x5c,
-	0xb1, 0x88, 0x28, 0x96, 0x7f, 0xb1, 0x66, 0x7e, 0x72, 0x41, 0xf6, 0xf7, 0xed, 0xd0, 0x91, 0x82,
-	0xec, 0x55, 0x06, 0x42, 0x29, 0x8f, 0x0c, 0x84, 0x1e, 0x9f, 0xaa, 0x6b, 0x06, 0xc2, 0x17, 0x2d,
-	0x18, 0x75, 0xde, 0x68, 0x47, 0x64, 0x81, 0xec, 0xac, 0xb6, 0x62, 0x21, 0x60, 0x5f, 0xcb, 0xbf,
-	0x03, 0x73, 0x9a, 0x89, 0xa8, 0xf3, 0xae, 0x1b, 0xb0, 0xd9, 0x85, 0x54, 0xc6, 0xc1, 0x48, 0x1e,
-	0x19, 0x07, 0xdd, 0xba, 0x73, 0x68, 0xc6, 0xc1, 0xcb, 0x30, 0xee, 0xfa, 0x61, 0x40, 0xd6, 0xa2,
-	0x30, 0x09, 0xdd, 0xd0, 0x17, 0xca, 0xb4, 0x12, 0x09, 0xf3, 0x26, 0x10, 0xa7, 0x71, 0x7b, 0xa5,
-	0x2b, 0x54, 0x06, 0x4d, 0x57, 0x80, 0x87, 0x94, 0x58, 0xf7, 0xcb, 0x3a, 0xb1, 0x6e, 0x34, 0x8f,
-	0xab, 0xaf, 0xbb, 0x7d, 0x91, 0x7e, 0xb2, 0xeb, 0xd0, 0x57, 0xf8, 0xfd, 0x25, 0x54, 0x1d, 0x9d,
-	0x0f, 0x9b, 0x54, 0xdd, 0x1a, 0x63, 0x43, 0xf2, 0xfa, 0x09, 0x4c, 0xd8, 0x5b, 0xeb, 0x9a, 0x8d,
-	0xba, 0xd3, 0x44, 0x37, 0xe1, 0x74, 0x47, 0x06, 0x49, 0xfc, 0xfb, 0x5a, 0x01, 0x7e, 0xec, 0xd0,
-	0x2e, 0xa0, 0xbb, 0x00, 0x89, 0xd3, 0x10, 0x13, 0x55, 0xb8, 0xff, 0x07, 0x0c, 0xe2, 0xdb, 0x90,
-	0xf4, 0x78, 0xc6, 0xba, 0xfa, 0xcb, 0x1c, 0xeb, 0xf2, 0x37, 0x8b, 0xdd, 0x0b, 0xfd, 0x8e, 0xea,
-	0x5c, 0x38, 0xf4, 0x09, 0x66, 0x10, 0xba, 0xfd, 0x47, 0xa4, 0xa1, 0xef, 0xb7, 0x53, 0x9f, 0x0f,
-	0xb3, 0x56, 0x2c, 0xa0, 0xe8, 0xbd, 0x30, 0xea, 0xf8, 0x3e, 0xcf, 0xab, 0x20, 0xb1, 0xa8, 0xb1,
-	0xae, 0x2b, 0x0c, 0x69, 0x10, 0x36, 0xf1, 0xec, 0x3f, 0x2f, 0xc0, 0xf4, 0x21, 0x32, 0x05, 0xbd,
-	0x04, 0x63, 0x61, 0xd4, 0x70, 0x02, 0xef, 0x0d, 0x5e, 0xa4, 0xa1, 0x94, 0x2e, 0x05, 0xb5, 0x6a,
-	0xc0, 0x70, 0x0a, 0x53, 0x46, 0xc2, 0x0f, 0xf7, 0x88, 0x84, 0x7f, 0x2f, 0x8c, 0x26, 0xc4, 0x69,
-	0x8a, 0xb0, 0x1f, 0x61, 0x7f, 0xeb, 0xf3, 0x4c, 0x0d, 0xc2, 0x26, 0x1e, 0x95, 0x62, 0x13, 0x8e,
-	0xeb, 0x92, 0x38, 0x96, 0xa1, 0xee, 0xc2, 0x37, 0x98, 0x5b, 0x1c, 0x3d, 0x73, 0xb9, 0xce, 0xa5,
-	0x58, 0xe0, 0x0c, 0xcb, 0xec, 0x80, 0x57, 0xfa, 0x1c, 0xf0, 0xdf, 0x2c, 0xc0, 0x53, 0xf7, 0xdd,
-	0xdd, 0xfa, 0xce, 0x42, 0x68, 0xc7, 0x24, 0xca, 0x4e, 0x9c, 0x1b, 0x31, 0x89, 0x30, 0x83, 0xf0,
-	0x51, 0x6a, 0xb5, 0x8c, 0xfb, 0x03, 0xf3, 0x4e, 0x7a, 0xe1, 0xa3, 0x94, 0x62, 0x81, 0x33, 0x2c,
-	0x8f, 0x3b, 0x2d, 0xff, 0x5e, 0x01, 0x9e, 0xe9, 0x43, 0x07, 0xc8, 0x31, 0x39, 0x28, 0x9d, 0xa2,
-	0x55, 0x7c, 0x38, 0x29, 0x5a, 0xc7, 0x1d, 0xae, 0x6f, 0x14, 0xe0, 0x7c, 0xef, 0xad, 0x18, 0xfd,
-	0x34, 0xb5, 0xe1, 0x65, 0xac, 0x8f, 0x99, 0xdd, 0x75, 0x86, 0xdb, 0xef, 0x29, 0x10, 0xce, 0xe2,
-	0xa2, 0x19, 0x80, 0x96, 0x93, 0x6c, 0xc5, 0x97, 0x76, 0xbd, 0x38, 0x11, 0x35, 0x0a, 0x26, 0xf8,
-	0x49, 0x8c, 0x6c, 0xc5, 0x06, 0x06, 0x65, 0xc7, 0xfe, 0x2d, 0x84, 0xd7, 0xc3, 0x84, 0x3f, 0xc4,
-	0xcd, 0x88, 0x33, 0xb2, 0x8e, 0xb3, 0x01, 0xc2, 0x59, 0x5c, 0xca, 0x8e, 0x9d, 0xf5, 0xf1, 0x8e,
-	0x72, 0xfb, 0x82, 0xb1, 0x5b, 0x56, 0xad, 0xd8, 0xc0, 0xc8, 0xe6, 0xad, 0x95, 0x0e, 0xcf, 0x5b,
-	0xb3, 0xff, 0x51, 0x01, 0x9e, 0xe8, 0xa9, 0xca, 0xf5, 0xb7, 0x00, 0x1f, 0xbd, 0x5c, 0xb3, 0xe3,
-	0xcd, 0x9d, 0x23, 0x66, 0x50, 0xfd, 0x69, 0x8f, 0x99, 0x26, 0x32, 0xa8, 0xb2, 0x5b, 0x85, 0x75,
-	0xd4, 0xad, 0xe2, 0x11, 0x1a, 0xcf, 0x8e, 0xa4, 0xa9, 0xa1, 0x23, 0x24, 0x4d, 0x65, 0x3e, 0x46,
-	0xa9, 0xcf, 0x85, 0xfc, 0xdd, 0xde, 0xc3, 0x4b, 0x4d, 0xbf, 0xbe, 0xbc, 0xa3, 0x0b, 0x70, 0xda,
-	0x0b, 0x58, 0x4d, 0xff, 0xf5, 0xf6, 0xa6, 0x48, 0x5b, 0x2f, 0xa4, 0xaf, 0xab, 0x5c, 0xca, 0xc0,
-	0x71, 0xc7, 0x13, 0x8f, 0x60, 0x12, 0xdb, 0x31, 0x87, 0xf4, 0x23, 0x50, 0x51, 0xb4, 0x79, 0x60,
-	0xae, 0xfa, 0xa0, 0x1d, 0x81, 0xb9, 0xea, 0x6b, 0x1a, 0x58, 0x74, 0x24, 0xa8, 0xba, 0x99, 0x99,
-	0x99, 0xd7, 0xc8, 0x1e, 0xd3, 0x3d, 0xed, 0xf7, 0xc0, 0x98, 0xf2, 0x61, 0xf4, 0x5b, 0xb8, 0xdd,
-	0xfe, 0xd2, 0x30, 0x8c, 0xa7, 0xca, 0x32, 0xa5, 0x5c, 0x86, 0xd6, 0xa1, 0x2e, 0x43, 0x16, 0x68,
-	0xdd, 0x0e, 0xe4, 0xad, 0x0e, 0x46, 0xa0, 0x75, 0x3b, 0x20, 0x98, 0xc3, 0xa8, 0xea, 0x58, 0x8b,
-	0xf6, 0x70, 0x3b, 0x10, 0x01, 0x91, 0x4a, 0x75, 0x5c, 0x60, 0xad, 0x58, 0x40, 0xd1, 0x27, 0x2d,
-	0x18, 0x8b, 0x99, 0x3f, 0x9a, 0x3b, 0x5c, 0xc5, 0x07, 0xbd, 0x3a, 0x78, 0xd5, 0x29, 0x55, 0x82,
-	0x8c, 0xc5, 0x52, 0x98, 0x2d, 0x38, 0xc5, 0x11, 0xfd, 0x92, 0x05, 0x15, 0x55, 0x7c, 0x5a, 0x5c,
-	0xbd, 0xb2, 0x9e, 0x6f, 0xd5, 0x2b, 0xee, 0xa9, 0x53, 0xae, 0x7d, 0x7d, 0x55, 0xab, 0x66, 0x8c,
-	0x62, 0xe5, 0x0d, 0x1d, 0x39, 0x19, 0x6f, 0x28, 0x74, 0xf1, 0x84, 0xbe, 0x0b, 0x2a, 0x4d, 0x27,
-	0xf0, 0xea, 0x24, 0x4e, 0xb8, 0x83, 0x52, 0x16, 0xe3, 0x93, 0x8d, 0x58, 0xc3, 0xe9, 0x66, 0x17,
-	0xb3, 0x17, 0x4b, 0x0c, 0x8f, 0x22, 0xdb, 0xec, 0xd6, 0x75, 0x33, 0x36, 0x71, 0x4c, 0xf7, 0x27,
-	0x3c, 0x54, 0xf7, 0xe7, 0xe8, 0x21, 0xee, 0xcf, 0x7f, 0x60, 0xc1, 0xb9, 0xae, 0x5f, 0xed, 0xd1,
-	0x0d, 0x91, 0xb3, 0xbf, 0x5c, 0x82, 0x33, 0x5d, 0xea, 0xab, 0xa1, 0x3d, 0x73, 0x3e, 0x5b, 0x79,
-	0x9c, 0x8a, 0xa7, 0x0f, 0x79, 0xe5, 0x30, 0x76, 0x99, 0xc4, 0x47, 0x3b, 0x7c, 0xd0, 0x07, 0x00,
-	0xc5, 0x07, 0x7b, 0x00, 0x60, 0x4c, 0xcb, 0xa1, 0x87, 0x3a, 0x2d, 0x4b, 0xf7, 0x9f, 0x96, 0xe8,
-	0x9b, 0x16, 0x4c, 0x35, 0x7b, 0x14, 0xf5, 0x15, 0x4e, 0xbd, 0x9b, 0x27, 0x53, 0x32, 0xb8, 0xfa,
-	0xe4, 0xc1, 0xfe, 0x74, 0xcf, 0x5a, 0xca, 0xb8, 0x67, 0xaf, 0xec, 0xef, 0x17, 0x81, 0x15, 0xf7,
-	0x63, 0x35, 0x74, 0xf6, 0xd0, 0x27, 0xcc, 0x32, 0x8d, 0x56, 0x5e, 0x25, 0x05, 0x39, 0x71, 0x55,
-	0xe6, 0x91, 0x8f, 0x60, 0xb7, 0xaa, 0x8f, 0x59, 0xa1, 0x55, 0xe8, 0x43, 0x68, 0xf9, 0xb2, 0x1e,
-	0x66, 0x31, 0xff, 0x7a, 0x98, 0x95, 0x6c, 0x2d, 0xcc, 0xfb, 0x7f, 0xe2, 0xa1, 0x47, 0xf2, 0x13,
-	0xff, 0x4d, 0x8b, 0x0b, 0x9e, 0xcc, 0x57, 0xd0, 0x9a, 0x81, 0x75, 0x1f, 0xcd, 0xe0, 0x79, 0x76,
-	0xe9, 0x6e, 0xfd, 0x0a, 0x71, 0x7c, 0xa1, 0x41, 0x98, 0xf7, 0xe7, 0xb2, 0x76, 0xac, 0x30, 0xd8,
-	0x35, 0x59, 0xbe, 0x1f, 0xde, 0xbd, 0xd4, 0x6c, 0x25, 0x7b, 0x42, 0x97, 0xd0, 0xd7, 0x64, 0x29,
-	0x08, 0x36, 0xb0, 0xec, 0xbf, 0x55, 0xe0, 0x33, 0x50, 0x1c, 0xeb, 0xbf, 0x94, 0xb9, 0xd8, 0xa4,
-	0xff, 0x13, 0xf1, 0x8f, 0x01, 0xb8, 0xea, 0xbe, 0x4d, 0x71, 0xde, 0x72, 0x65, 0xe0, 0xfb, 0x0a,
-	0x05, 0x3d, 0xfd, 0x1a, 0xba, 0x0d, 0x1b, 0xfc, 0x52, 0xb2, 0xb4, 0x78, 0xa8, 0x2c, 0x4d, 0x89,
-	0x95, 0xa1, 0x43, 0x76, 0xbb, 0x3f, 0xb7, 0x20, 0xa5, 0x11, 0xa1, 0x16, 0x94, 0x68, 0x77, 0xf7,
-	0xf2, 0xb9, 0x4a, 0xd4, 0x24, 0x4d, 0x45, 0xa3, 0x98, 0xf6, 0xec, 0x27, 0xe6, 0x8c, 0x90, 0x2f,
-	0x4e, 0xff, 0x0b, 0x79, 0x5c, 0x77, 0x6b, 0x32, 0xbc, 0x12, 0x86, 0xdb, 0xfc, 0xd0, 0x50, 0x47,
-	0x12, 0xd8, 0x2f, 0xc1, 0x64, 0x47, 0xa7, 0xd8, 0x1d, 0x06, 0xa1, 0xbc, 0x3f, 0xd5, 0x98, 0xae,
-	0x2c, 0x05, 0x0f, 0x73, 0x98, 0xfd, 0x0d, 0x0b, 0x4e, 0x67, 0xc9, 0xa3, 0xaf, 0x58, 0x30, 0x19,
-	0x67, 0xe9, 0x9d, 0xd4, 0xd8, 0xa9, 0xc8, 0xb8, 0x0e, 0x10, 0xee, 0xec, 0x84, 0xfd, 0x7f, 0xc4,
-	0xe4, 0xbf, 0xe5, 0x05, 0xb5, 0xf0, 0xae, 0x52, 0x4c, 0xac, 0x9e, 0x8a, 0x09, 0x5d, 0x8f, 0xee,
-	0x16, 0xa9, 0xb5, 0xfd, 0x8e, 0xdc, 0xbf, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0x4b, 0x75, 0x6a, 0x8b,
-	0x82, 0xb9, 0x99, 0x49, 0xb9, 0x20, 0xda, 0xb1, 0xc2, 0x40, 0x2f, 0xc2, 0x98, 0x79, 0x47, 0xb0,
-	0x98, 0x97, 0x4c, 0x21, 0x37, 0xaf, 0x13, 0xc6, 0x29, 0x2c, 0x34, 0x03, 0xa0, 0x94, 0x1c, 0xb9,
-	0x45, 0x32, 0x27, 0x8c, 0x92, 0x44, 0x31, 0x36, 0x30, 0x58, 0x62, 0x21, 0xbf, 0x88, 0x57, 0xc6,
-	0x8f, 0xf2, 0xc4, 0x42, 0xd1, 0x86, 0x15, 0x94, 0x4a, 0x93, 0xa6, 0x13, 0xb4, 0x1d, 0x9f, 0x8e,
-	0x90, 0xc8, 0x86, 0x56, 0xcb, 0x70, 0x45, 0x41, 0xb0, 0x81, 0x45, 0xdf, 0x38, 0xf1, 0x9a, 0xe4,
-	0xd5, 0x30, 0x90, 0x91, 0x57, 0xfa, 0x48, 0x45, 0xb4, 0x63, 0x85, 0x61, 0xff, 0x17, 0x0b, 0xb2,
-	0xd7, 0xb5, 0xa7, 0xbc, 0x1c, 0xd6, 0xa1, 0x19, 0xd8, 0xe9, 0xfc, 0xcd, 0x42, 0x5f, 0xf9, 0x9b,
-	0x66, 0x6a, 0x65, 0xf1, 0xbe, 0xa9, 0x95, 0x3f, 0xae, 0x6f, 0xc2, 0xe2, 0x39, 0x98, 0xa3, 0xdd,
-	0x6e, 0xc1, 0x42, 0x36, 0x0c, 0xbb, 0x8e, 0xaa, 0xd1, 0x31, 0xc6, 0x6d, 0x87, 0xf9, 0x39, 0x86,
-	0x24, 0x20, 0xf6, 0x2a, 0x54, 0xd4, 0xc9, 0x82, 0x34, 0x54, 0xad, 0xee, 0x86, 0x6a, 0x5f, 0xa9,
-	0x64, 0xd5, 0xcd, 0x6f, 0xff, 0xe0, 0xe9, 0xb7, 0x7d, 0xf7, 0x07, 0x4f, 0xbf, 0xed, 0x8f, 0x7f,
-	0xf0, 0xf4, 0xdb, 0x3e, 0x79, 0xf0, 0xb4, 0xf5, 0xed, 0x83, 0xa7, 0xad, 0xef, 0x1e, 0x3c, 0x6d,
-	0xfd, 0xf1, 0xc1, 0xd3, 0xd6, 0xf7, 0x0f, 0x9e, 0xb6, 0xbe, 0xf8, 0x1f, 0x9f, 0x7e, 0xdb, 0xab,
-	0x5d, 0x43, 0xef, 0xe8, 0x8f, 0x77, 0xbb, 0xb5, 0xd9, 0x9d, 0x8b, 0x2c, 0xfa, 0x8b, 0x2e, 0xaf,
-	0x59, 0x63, 0x4e, 0xcd, 0xca, 0xe5, 0xf5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x09, 0x61, 0x3b,
-	0x5d, 0xb4, 0xd1, 0x00, 0x00,
+	// 10988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xd9,
+	0x71, 0x98, 0x66, 0x3f, 0x80, 0xdd, 0x07, 0x10, 0x04, 0x87, 0xe4, 0xdd, 0x1e, 0xa5, 0x3b, 0xd0,
+	0x73, 0x65, 0xe9, 0x6c, 0xe9, 0x00, 0x8b, 0x52, 0xe4, 0x8b, 0x65, 0xcb, 0xc2, 0x02, 0xfc, 0x00,
+	0x09, 0x10, 0xb8, 0x06, 0x8e, 0xd4, 0x87, 0x4f, 0xa7, 0xc1, 0xee, 0xc3, 0x62, 0xc8, 0xd9, 0x99,
+	0xbd, 0x99, 0x59, 0x10, 0x38, 0x4b, 0xb2, 0x94, 0xc4, 0xb6, 0x12, 0x7d, 0x46, 0x4a, 0xca, 0x91,
+	0x1d, 0xd9, 0x92, 0xed, 0x4a, 0x25, 0x95, 0xa8, 0xe2, 0x54, 0x7e, 0x44, 0xb6, 0x93, 0xb8, 0x64,
+	0xe7, 0x87, 0x52, 0x4a, 0x2a, 0xaa, 0x94, 0xcb, 0x76, 0x62, 0x87, 0x91, 0x98, 0xa4, 0x92, 0x4a,
+	0xaa, 0x5c, 0x95, 0x8f, 0x3f, 0x66, 0xa5, 0x92, 0x54, 0xbf, 0xef, 0x99, 0x9d, 0x25, 0x16, 0xc0,
+	0x80, 0xa4, 0x94, 0xfb, 0x05, 0xec, 0xeb, 0x9e, 0xee, 0x37, 0x6f, 0xde, 0xeb, 0xee, 0xd7, 0xaf,
+	0xbb, 0x1f, 0x59, 0xee, 0x78, 0xc9, 0x76, 0x7f, 0x73, 0xb6, 0x15, 0x76, 0xe7, 0xdc, 0xa8, 0x13,
+	0xf6, 0xa2, 0xf0, 0x16, 0xfb, 0xe7, 0xf9, 0x56, 0x7b, 0x6e, 0xe7, 0xc2, 0x5c, 0xef, 0x76, 0x67,
+	0xce, 0xed, 0x79, 0xf1, 0x9c, 0xdb, 0xeb, 0xf9, 0x5e, 0xcb, 0x4d, 0xbc, 0x30, 0x98, 0xdb, 0x79,
+	0xbb, 0xeb, 0xf7, 0xb6, 0xdd, 0xb7, 0xcf, 0x75, 0x68, 0x40, 0x23, 0x37, 0xa1, 0xed, 0xd9, 0x5e,
+	0x14, 0x26, 0xa1, 0xfd, 0xe3, 0x9a, 0xda, 0xac, 0xa4, 0xc6, 0xfe, 0x79, 0xa5, 0xd5, 0x9e, 0xdd,
+	0xb9, 0x30, 0xdb, 0xbb, 0xdd, 0x99, 0x45, 0x6a, 0xb3, 0x06, 0xb5, 0x59, 0x49, 0xed, 0xdc, 0xf3,
+	0x46, 0x5f, 0x3a, 0x61, 0x27, 0x9c, 0x63, 0x44, 0x37, 0xfb, 0x5b, 0xec, 0x17, 0xfb, 0xc1, 0xfe,
+	0xe3, 0xcc, 0xce, 0x39, 0xb7, 0x5f, 0x88, 0x67, 0xbd, 0x10, 0xbb, 0x37, 0xd7, 0x0a, 0x23, 0x3a,
+	0xb7, 0x33, 0xd0, 0xa1, 0x73, 0x57, 0x34, 0x0e, 0xdd, 0x4d, 0x68, 0x10, 0x7b, 0x61, 0x10, 0x3f,
+	0x8f, 0x5d, 0xa0, 0xd1, 0x0e, 0x8d, 0xcc, 0xd7, 0x33, 0x10, 0xf2, 0x28, 0xbd, 0x53, 0x53, 0xea,
+	0xba, 0xad, 0x6d, 0x2f, 0xa0, 0xd1, 0x9e, 0x7e, 0xbc, 0x4b, 0x13, 0x37, 0xef, 0xa9, 0xb9, 0x61,
+	0x4f, 0x45, 0xfd, 0x20, 0xf1, 0xba, 0x74, 0xe0, 0x81, 0x77, 0xed, 0xf7, 0x40, 0xdc, 0xda, 0xa6,
+	0x5d, 0x77, 0xe0, 0xb9, 0x77, 0x0c, 0x7b, 0xae, 0x9f, 0x78, 0xfe, 0x9c, 0x17, 0x24, 0x71, 0x12,
+	0x65, 0x1f, 0x72, 0x5e, 0x25, 0x27, 0xe6, 0x6f, 0xae, 0xcf, 0xf7, 0x93, 0xed, 0x85, 0x30, 0xd8,
+	0xf2, 0x3a, 0xf6, 0x9f, 0x23, 0x13, 0x2d, 0xbf, 0x1f, 0x27, 0x34, 0xba, 0xee, 0x76, 0x69, 0xc3,
+	0x3a, 0x6f, 0x3d, 0x57, 0x6f, 0x9e, 0xfe, 0xe6, 0xdd, 0x99, 0x37, 0xdc, 0xbb, 0x3b, 0x33, 0xb1,
+	0xa0, 0x41, 0x60, 0xe2, 0xd9, 0x3f, 0x44, 0xc6, 0xa3, 0xd0, 0xa7, 0xf3, 0x70, 0xbd, 0x51, 0x62,
+	0x8f, 0x9c, 0x14, 0x8f, 0x8c, 0x03, 0x6f, 0x06, 0x09, 0x77, 0xfe, 0xa0, 0x44, 0xc8, 0x7c, 0xaf,
+	0xb7, 0x16, 0x85, 0xb7, 0x68, 0x2b, 0xb1, 0x3f, 0x4c, 0x6a, 0x38, 0x74, 0x6d, 0x37, 0x71, 0x19,
+	0xb7, 0x89, 0x0b, 0x3f, 0x32, 0xcb, 0xdf, 0x64, 0xd6, 0x7c, 0x13, 0x3d, 0x71, 0x10, 0x7b, 0x76,
+	0xe7, 0xed, 0xb3, 0xab, 0x9b, 0xf8, 0xfc, 0x0a, 0x4d, 0xdc, 0xa6, 0x2d, 0x98, 0x11, 0xdd, 0x06,
+	0x8a, 0xaa, 0x1d, 0x90, 0x4a, 0xdc, 0xa3, 0x2d, 0xd6, 0xb1, 0x89, 0x0b, 0xcb, 0xb3, 0x47, 0x99,
+	0xa1, 0xb3, 0xba, 0xe7, 0xeb, 0x3d, 0xda, 0x6a, 0x4e, 0x0a, 0xce, 0x15, 0xfc, 0x05, 0x8c, 0x8f,
+	0xbd, 0x43, 0xc6, 0xe2, 0xc4, 0x4d, 0xfa, 0x71, 0xa3, 0xcc, 0x38, 0x5e, 0x2f, 0x8c, 0x23, 0xa3,
+	0xda, 0x9c, 0x12, 0x3c, 0xc7, 0xf8, 0x6f, 0x10, 0xdc, 0x9c, 0x7f, 0x67, 0x91, 0x29, 0x8d, 0xbc,
+	0xec, 0xc5, 0x89, 0xfd, 0x53, 0x03, 0x83, 0x3b, 0x3b, 0xda, 0xe0, 0xe2, 0xd3, 0x6c, 0x68, 0xa7,
+	0x05, 0xb3, 0x9a, 0x6c, 0x31, 0x06, 0xb6, 0x4b, 0xaa, 0x5e, 0x42, 0xbb, 0x71, 0xa3, 0x74, 0xbe,
+	0xfc, 0xdc, 0xc4, 0x85, 0x2b, 0x45, 0xbd, 0x67, 0xf3, 0x84, 0x60, 0x5a, 0x5d, 0x42, 0xf2, 0xc0,
+	0xb9, 0x38, 0x5f, 0x9d, 0x32, 0xdf, 0x0f, 0x07, 0xdc, 0x7e, 0x3b, 0x99, 0x88, 0xc3, 0x7e, 0xd4,
+	0xa2, 0x40, 0x7b, 0x61, 0xdc, 0xb0, 0xce, 0x97, 0x71, 0xea, 0xe1, 0x4c, 0x5d, 0xd7, 0xcd, 0x60,
+	0xe2, 0xd8, 0x9f, 0xb5, 0xc8, 0x64, 0x9b, 0xc6, 0x89, 0x17, 0x30, 0xfe, 0xb2, 0xf3, 0x1b, 0x47,
+	0xee, 0xbc, 0x6c, 0x5c, 0xd4, 0xc4, 0x9b, 0x67, 0xc4, 0x8b, 0x4c, 0x1a, 0x8d, 0x31, 0xa4, 0xf8,
+	0xe3, 0x8a, 0x6b, 0xd3, 0xb8, 0x15, 0x79, 0x3d, 0xfc, 0xdd, 0x28, 0xa7, 0x57, 0xdc, 0xa2, 0x06,
+	0x81, 0x89, 0x67, 0x07, 0xa4, 0x8a, 0x2b, 0x2a, 0x6e, 0x54, 0x58, 0xff, 0x97, 0x8e, 0xd6, 0x7f,
+	0x31, 0xa8, 0xb8, 0x58, 0xf5, 0xe8, 0xe3, 0xaf, 0x18, 0x38, 0x1b, 0xfb, 0x33, 0x16, 0x69, 0x88,
+	0x15, 0x0f, 0x94, 0x0f, 0xe8, 0xcd, 0x6d, 0x2f, 0xa1, 0xbe, 0x17, 0x27, 0x8d, 0x2a, 0xeb, 0xc3,
+	0xdc, 0x68, 0x73, 0xeb, 0x72, 0x14, 0xf6, 0x7b, 0xd7, 0xbc, 0xa0, 0xdd, 0x3c, 0x2f, 0x38, 0x35,
+	0x16, 0x86, 0x10, 0x86, 0xa1, 0x2c, 0xed, 0x2f, 0x5a, 0xe4, 0x5c, 0xe0, 0x76, 0x69, 0xdc, 0x73,
+	0x5b, 0x54, 0x82, 0x9b, 0xbe, 0xdb, 0xba, 0xcd, 0x7a, 0x34, 0x76, 0xb8, 0x1e, 0x39, 0xa2, 0x47,
+	0xe7, 0xae, 0x0f, 0x25, 0x0d, 0x0f, 0x60, 0x6b, 0xff, 0x9a, 0x45, 0x4e, 0x85, 0x51, 0x6f, 0xdb,
+	0x0d, 0x68, 0x5b, 0x42, 0xe3, 0xc6, 0x38, 0x5b, 0x7a, 0x1f, 0x3a, 0xda, 0x27, 0x5a, 0xcd, 0x92,
+	0x5d, 0x09, 0x03, 0x2f, 0x09, 0xa3, 0x75, 0x9a, 0x24, 0x5e, 0xd0, 0x89, 0x9b, 0x67, 0xef, 0xdd,
+	0x9d, 0x39, 0x35, 0x80, 0x05, 0x83, 0xfd, 0xb1, 0x7f, 0x9a, 0x4c, 0xc4, 0x7b, 0x41, 0xeb, 0xa6,
+	0x17, 0xb4, 0xc3, 0x3b, 0x71, 0xa3, 0x56, 0xc4, 0xf2, 0x5d, 0x57, 0x04, 0xc5, 0x02, 0xd4, 0x0c,
+	0xc0, 0xe4, 0x96, 0xff, 0xe1, 0xf4, 0x54, 0xaa, 0x17, 0xfd, 0xe1, 0xf4, 0x64, 0x7a, 0x00, 0x5b,
+	0xfb, 0xe7, 0x2d, 0x72, 0x22, 0xf6, 0x3a, 0x81, 0x9b, 0xf4, 0x23, 0x7a, 0x8d, 0xee, 0xc5, 0x0d,
+	0xc2, 0x3a, 0x72, 0xf5, 0x88, 0xa3, 0x62, 0x90, 0x6c, 0x9e, 0x15, 0x7d, 0x3c, 0x61, 0xb6, 0xc6,
+	0x90, 0xe6, 0x9b, 0xb7, 0xd0, 0xf4, 0xb4, 0x9e, 0x28, 0x76, 0xa1, 0xe9, 0x49, 0x3d, 0x94, 0xa5,
+	0xfd, 0x5e, 0x32, 0xcd, 0x9b, 0xd4, 0xc8, 0xc6, 0x8d, 0x49, 0x26, 0x68, 0xcf, 0xdc, 0xbb, 0x3b,
+	0x33, 0xbd, 0x9e, 0x81, 0xc1, 0x00, 0xb6, 0xfd, 0x2a, 0x99, 0xe9, 0xd1, 0xa8, 0xeb, 0x25, 0xab,
+	0x81, 0xbf, 0x27, 0xc5, 0x77, 0x2b, 0xec, 0xd1, 0xb6, 0xe8, 0x4e, 0xdc, 0x38, 0x71, 0xde, 0x7a,
+	0xae, 0xd6, 0x7c, 0x8b, 0xe8, 0xe6, 0xcc, 0xda, 0x83, 0xd1, 0x61, 0x3f, 0x7a, 0xf6, 0xc7, 0x2d,
+	0x32, 0x89, 0x93, 0x6e, 0xbe, 0xd7, 0x8b, 0xc2, 0x1d, 0xd7, 0x6f, 0x4c, 0x9d, 0xb7, 0x0a, 0xf8,
+	0x9a, 0x06, 0xc5, 0xe6, 0x34, 0xca, 0x75, 0xb3, 0x05, 0x52, 0x1c, 0xed, 0x98, 0x8c, 0xb7, 0xa8,
+	0xe7, 0x7b, 0x41, 0xa7, 0x71, 0xb2, 0x08, 0xcb, 0x43, 0xbc, 0xe8, 0x02, 0xa7, 0xd9, 0x9c, 0x40,
+	0xe3, 0x4a, 0xfc, 0x00, 0xc9, 0xc9, 0xf9, 0xe7, 0x25, 0x32, 0x9d, 0x35, 0x18, 0xec, 0xbf, 0x65,
+	0x91, 0x93, 0xb7, 0xee, 0x24, 0x1b, 0xe1, 0x6d, 0x1a, 0xc4, 0xcd, 0x3d, 0x14, 0xeb, 0x4c, 0x55,
+	0x4e, 0x5c, 0x68, 0x15, 0x6b, 0x9a, 0xcc, 0x5e, 0x4d, 0x73, 0xb9, 0x18, 0x24, 0xd1, 0x5e, 0xf3,
+	0x49, 0xf1, 0x55, 0x4f, 0x5e, 0xbd, 0xb9, 0x61, 0x42, 0x21, 0xdb, 0xa9, 0x73, 0x9f, 0xb2, 0xc8,
+	0x99, 0x3c, 0x12, 0xf6, 0x34, 0x29, 0xdf, 0xa6, 0x7b, 0xdc, 0x1a, 0x05, 0xfc, 0xd7, 0x7e, 0x99,
+	0x54, 0x77, 0x5c, 0xbf, 0x4f, 0x85, 0x55, 0x77, 0xf9, 0x68, 0x2f, 0xa2, 0x7a, 0x06, 0x9c, 0xea,
+	0x8f, 0x95, 0x5e, 0xb0, 0x9c, 0x7f, 0x55, 0x26, 0x13, 0x86, 0x5e, 0x7f, 0x08, 0x96, 0x6a, 0x98,
+	0xb2, 0x54, 0x57, 0x0a, 0x33, 0x49, 0x86, 0x9a, 0xaa, 0x77, 0x32, 0xa6, 0xea, 0x6a, 0x71, 0x2c,
+	0x1f, 0x68, 0xab, 0xda, 0x09, 0xa9, 0x87, 0x3d, 0x1a, 0x31, 0xd4, 0x46, 0xa5, 0x88, 0x4f, 0xb8,
+	0x2a, 0xc9, 0x35, 0x4f, 0xdc, 0xbb, 0x3b, 0x53, 0x57, 0x3f, 0x41, 0x33, 0x72, 0xfe, 0xd0, 0x22,
+	0x67, 0x8c, 0x3e, 0x2e, 0x84, 0x41, 0xdb, 0x63, 0x9f, 0xf6, 0x3c, 0xa9, 0x24, 0x7b, 0x3d, 0xb9,
+	0xdd, 0x51, 0x23, 0xb5, 0xb1, 0xd7, 0xa3, 0xc0, 0x20, 0xb8, 0xc1, 0xe9, 0xd2, 0x38, 0x76, 0x3b,
+	0x34, 0xbb, 0xc1, 0x59, 0xe1, 0xcd, 0x20, 0xe1, 0x76, 0x44, 0x6c, 0xdf, 0x8d, 0x93, 0x8d, 0xc8,
+	0x0d, 0x62, 0x46, 0x7e, 0xc3, 0xeb, 0x52, 0x31, 0xc0, 0x3f, 0x3c, 0xda, 0x8c, 0xc1, 0x27, 0x9a,
+	0x4f, 0xdc, 0xbb, 0x3b, 0x63, 0x2f, 0x0f, 0x50, 0x82, 0x1c, 0xea, 0xce, 0x17, 0x2d, 0xf2, 0x44,
+	0xbe, 0x0d, 0x6a, 0xbf, 0x99, 0x8c, 0xf1, 0xad, 0xae, 0x78, 0x3b, 0xfd, 0x49, 0x58, 0x2b, 0x08,
+	0xa8, 0x3d, 0x47, 0xea, 0x4a, 0x3f, 0x8a, 0x77, 0x3c, 0x25, 0x50, 0xeb, 0x5a, 0xa9, 0x6a, 0x1c,
+	0x1c, 0xb4, 0xc0, 0x15, 0x6f, 0x66, 0x0c, 0x1a, 0xe2, 0x02, 0x83, 0x38, 0xff, 0xa7, 0x44, 0x9e,
+	0x34, 0x7a, 0x75, 0x85, 0xba, 0x7e, 0xb2, 0xbd, 0x16, 0xfa, 0x5e, 0x6b, 0xcf, 0xfe, 0x0b, 0x16,
+	0x19, 0xf3, 0x3a, 0x41, 0x18, 0x49, 0x59, 0xf4, 0x81, 0xa3, 0x7d, 0x7f, 0x93, 0xb8, 0x54, 0x62,
+	0xeb, 0xd4, 0xa7, 0xad, 0x24, 0x8c, 0xf4, 0x3b, 0x2f, 0x31, 0x8e, 0x20, 0x38, 0xdb, 0x3f, 0x6b,
+	0x91, 0xf1, 0x88, 0xbe, 0xda, 0xf7, 0x22, 0xda, 0x28, 0x1d, 0x7b, 0x2f, 0xf4, 0x9e, 0x98, 0xb3,
+	0x04, 0xc9, 0xdb, 0xbe, 0x41, 0x9e, 0xe8, 0x45, 0x61, 0x27, 0xa2, 0x71, 0xec, 0x05, 0x9d, 0xcb,
+	0x91, 0xdb, 0xa2, 0x6b, 0x34, 0xf2, 0xc2, 0xb6, 0x18, 0xdc, 0x67, 0xc4, 0x93, 0x4f, 0xac, 0xe5,
+	0x62, 0xc1, 0x90, 0xa7, 0x9d, 0x7f, 0x6f, 0x91, 0x93, 0xc6, 0x07, 0x78, 0x08, 0x7b, 0xc2, 0x20,
+	0xbd, 0x27, 0x5c, 0x2a, 0x4c, 0xa0, 0x0c, 0xd9, 0x14, 0x7e, 0xc6, 0x22, 0xe7, 0x0c, 0xac, 0x15,
+	0x37, 0x69, 0x6d, 0x5f, 0xdc, 0xed, 0xb1, 0xb1, 0x08, 0x03, 0xfb, 0x69, 0x43, 0x71, 0x34, 0x27,
+	0x04, 0x85, 0xf2, 0x35, 0xba, 0xc7, 0xb5, 0xc8, 0xdb, 0x48, 0x8d, 0x4b, 0x87, 0x30, 0x12, 0x53,
+	0x5e, 0xbd, 0xdb, 0xaa, 0x68, 0x07, 0x85, 0x61, 0x3b, 0x64, 0x8c, 0x69, 0x07, 0x94, 0x96, 0x68,
+	0xff, 0x10, 0x9c, 0x51, 0x37, 0x58, 0x0b, 0x08, 0x88, 0xb3, 0x9a, 0xea, 0xce, 0x5a, 0x44, 0xd9,
+	0xea, 0x6a, 0x5f, 0xf2, 0xa8, 0xdf, 0x8e, 0x71, 0xbf, 0xea, 0x06, 0x41, 0x98, 0x88, 0xad, 0xa7,
+	0xb1, 0x5f, 0x9d, 0xd7, 0xcd, 0x60, 0xe2, 0x38, 0xf7, 0x4a, 0x64, 0xca, 0xa0, 0xb8, 0x4e, 0x1f,
+	0x86, 0xcb, 0x24, 0x4a, 0x29, 0xa2, 0xb5, 0xe2, 0xb4, 0x02, 0x1d, 0xee, 0x36, 0x79, 0x2d, 0xa3,
+	0x8b, 0xa0, 0x50, 0xae, 0x0f, 0x76, 0x9d, 0x7c, 0xa3, 0x44, 0x66, 0xd2, 0x0f, 0x0c, 0xa8, 0x32,
+	0xdc, 0xa7, 0x1b, 0x8c, 0xb2, 0x9e, 0x31, 0x03, 0x1f, 0x4c, 0xbc, 0x21, 0xda, 0xa0, 0x74, 0x9c,
+	0xda, 0xc0, 0x54, 0x56, 0xe5, 0x7d, 0x94, 0xd5, 0x9b, 0xd5, 0xa8, 0x57, 0x32, 0xda, 0x21, 0xad,
+	0xb0, 0xcf, 0x93, 0x4a, 0x9c, 0xd0, 0x5e, 0xa3, 0x9a, 0x16, 0xf6, 0xeb, 0x09, 0xed, 0x01, 0x83,
+	0x38, 0xff, 0x35, 0x2d, 0xec, 0xd7, 0x69, 0xa2, 0xf5, 0xeb, 0x4f, 0xa6, 0xf4, 0xeb, 0x5b, 0x4d,
+	0xfd, 0x7a, 0xff, 0xee, 0xcc, 0x1b, 0x87, 0x3c, 0xf6, 0x3d, 0xa3, 0x7e, 0xed, 0xcb, 0x99, 0x51,
+	0x9c, 0x4b, 0x8f, 0xe2, 0xfd, 0xbb, 0x33, 0x4f, 0x0f, 0x79, 0xc7, 0xcc, 0x30, 0xbf, 0x99, 0x8c,
+	0x45, 0xd4, 0x8d, 0xc3, 0xa0, 0x51, 0x4d, 0x7f, 0x0e, 0x60, 0xad, 0x20, 0xa0, 0xce, 0xbf, 0xae,
+	0x67, 0x07, 0xfb, 0x32, 0xf7, 0xec, 0x86, 0x91, 0xed, 0x91, 0x0a, 0xdb, 0x2b, 0x72, 0xd1, 0x70,
+	0xed, 0x68, 0xcb, 0x08, 0x45, 0xbc, 0x22, 0xdd, 0xac, 0xe1, 0x57, 0xc3, 0x26, 0x60, 0x2c, 0xec,
+	0x5d, 0x52, 0x6b, 0xc9, 0x2d, 0x5c, 0xa9, 0x08, 0x67, 0xa7, 0xd8, 0xc0, 0x69, 0x8e, 0x93, 0x28,
+	0x8b, 0xd5, 0xbe, 0x4f, 0x71, 0xb3, 0x29, 0x29, 0x77, 0xbc, 0xa4, 0x51, 0x2e, 0x62, 0x5b, 0x77,
+	0xd9, 0x33, 0x5e, 0x71, 0x1c, 0x15, 0xc4, 0x65, 0x2f, 0x01, 0xa4, 0x8f, 0x06, 0xc2, 0x44, 0xdc,
+	0xea, 0xae, 0x45, 0xe1, 0x8e, 0xd7, 0xa6, 0x51, 0xa3, 0x52, 0x84, 0x68, 0x5a, 0x5f, 0x58, 0x91,
+	0x04, 0x35, 0x5f, 0xee, 0x34, 0xd1, 0x10, 0x30, 0xf9, 0xe2, 0x16, 0xee, 0x49, 0xf1, 0xee, 0x8b,
+	0xb4, 0xe5, 0xa1, 0x6e, 0x93, 0xe6, 0x45, 0xa3, 0x5a, 0x84, 0xe9, 0xbe, 0xd8, 0x6f, 0xdd, 0xc6,
+	0xf5, 0xa6, 0x3b, 0xf4, 0xc6, 0x7b, 0x77, 0x67, 0x9e, 0x5c, 0xc8, 0xe7, 0x09, 0xc3, 0x3a, 0xc3,
+	0x06, 0xac, 0xd7, 0xf7, 0x7d, 0x34, 0x71, 0x28, 0xf3, 0xc3, 0x15, 0x30, 0x60, 0x6b, 0x9a, 0x60,
+	0x66, 0xc0, 0x0c, 0x08, 0x98, 0x7c, 0xed, 0x57, 0xc9, 0x58, 0xd7, 0x4d, 0x22, 0x6f, 0xb7, 0x31,
+	0x5e, 0xc4, 0x66, 0x6a, 0x85, 0xd1, 0xd2, 0xcc, 0x99, 0xea, 0xe7, 0x8d, 0x20, 0x18, 0xa1, 0x3b,
+	0xbc, 0x4b, 0xa3, 0x0e, 0x6d, 0xd4, 0x8a, 0xd8, 0xee, 0xaf, 0x20, 0x29, 0xcd, 0xb0, 0x8e, 0x96,
+	0x0f, 0x6b, 0x03, 0xce, 0xc5, 0x7e, 0x99, 0xd4, 0x62, 0x61, 0x59, 0x36, 0xea, 0x8c, 0xe3, 0x3b,
+	0x46, 0xb4, 0xe3, 0xdc, 0x4d, 0xea, 0x2b, 0xa3, 0x94, 0x2d, 0x30, 0xf9, 0x0b, 0x14, 0x49, 0x1c,
+	0xc0, 0x9e, 0xdf, 0xef, 0x78, 0x41, 0x83, 0x14, 0x31, 0x80, 0x6b, 0x8c, 0x56, 0x66, 0x00, 0x79,
+	0x23, 0x08, 0x46, 0xce, 0x7f, 0xb2, 0x88, 0x9d, 0x16, 0x6a, 0x0f, 0xc1, 0x60, 0x7d, 0x35, 0x6d,
+	0xb0, 0x2e, 0x17, 0x69, 0x75, 0x0c, 0xb1, 0x59, 0x7f, 0xbb, 0x4e, 0x32, 0xea, 0xe0, 0x3a, 0x8d,
+	0x13, 0xda, 0x7e, 0x5d, 0x84, 0xbf, 0x2e, 0xc2, 0x5f, 0x17, 0xe1, 0xf2, 0x87, 0xbd, 0x99, 0x11,
+	0xe1, 0xef, 0x31, 0x56, 0xbd, 0x3e, 0xa9, 0x7f, 0x45, 0x1d, 0xe5, 0x9b, 0x3d, 0x30, 0x10, 0x50,
+	0x12, 0x5c, 0x5d, 0x5f, 0xbd, 0x9e, 0x2b, 0xb3, 0x5f, 0x49, 0xcb, 0xec, 0xa3, 0xb2, 0xf8, 0xff,
+	0x41, 0x4a, 0xff, 0x52, 0x89, 0x3c, 0x95, 0x96, 0x5e, 0x10, 0xfa, 0x7e, 0xd8, 0x4f, 0x70, 0x2f,
+	0x60, 0xff, 0xb2, 0x45, 0xa6, 0xbb, 0xe9, 0x4d, 0x78, 0x2c, 0x1c, 0x3c, 0xef, 0x2b, 0x4c, 0xb4,
+	0x66, 0x76, 0xf9, 0xcd, 0x86, 0x10, 0xb3, 0xd3, 0x19, 0x40, 0x0c, 0x03, 0x7d, 0xb1, 0x5f, 0x26,
+	0xf5, 0xae, 0xbb, 0xfb, 0x52, 0xaf, 0xed, 0x26, 0x72, 0x1b, 0x36, 0x7c, 0xf7, 0xdc, 0x4f, 0x3c,
+	0x7f, 0x96, 0x87, 0x4e, 0xcc, 0x2e, 0x05, 0xc9, 0x6a, 0xb4, 0x9e, 0x44, 0xe8, 0x7c, 0x67, 0x2e,
+	0xc6, 0x15, 0x49, 0x06, 0x34, 0x45, 0xe7, 0xcb, 0x16, 0x79, 0x7a, 0xc8, 0xe8, 0x44, 0x6e, 0x42,
+	0x3b, 0x7b, 0xf6, 0x47, 0x48, 0x15, 0xf7, 0x4b, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x70, 0x8c, 0x2f,
+	0xa1, 0x75, 0x0f, 0xfe, 0x8a, 0x81, 0x33, 0x75, 0xbe, 0x31, 0x96, 0xd5, 0xb1, 0xec, 0x20, 0xfd,
+	0x02, 0x21, 0x9d, 0x70, 0x83, 0x76, 0x7b, 0x3e, 0x0e, 0x8b, 0xc5, 0x4e, 0x63, 0x94, 0x8b, 0xe0,
+	0xb2, 0x82, 0x80, 0x81, 0x65, 0xff, 0x65, 0x8b, 0x90, 0x8e, 0x9c, 0x2a, 0x52, 0x7f, 0xbe, 0x54,
+	0xe4, 0xeb, 0xe8, 0x89, 0xa8, 0xfb, 0xa2, 0x18, 0x82, 0xc1, 0x1c, 0xbd, 0x89, 0xb5, 0x44, 0x76,
+	0x9f, 0x6b, 0x94, 0x8d, 0x22, 0x7b, 0x22, 0x5f, 0x5a, 0x9b, 0x12, 0x6a, 0x48, 0x14, 0x5f, 0xfb,
+	0xe7, 0x2c, 0x42, 0xf0, 0x08, 0x88, 0xbb, 0xff, 0x84, 0xa2, 0xb9, 0x51, 0xa8, 0x1b, 0x43, 0x51,
+	0x6f, 0x4e, 0xe1, 0x68, 0xe8, 0xdf, 0x60, 0x70, 0xb6, 0x3f, 0x46, 0x6a, 0xb1, 0x98, 0x6e, 0x8d,
+	0x6a, 0xf1, 0x83, 0x21, 0xa7, 0xb2, 0x90, 0x4a, 0xe2, 0x17, 0x28, 0x9e, 0xf6, 0x2f, 0x58, 0xe4,
+	0x64, 0x2f, 0xed, 0xfa, 0x12, 0x5a, 0xa4, 0x38, 0x19, 0x90, 0x71, 0xad, 0x35, 0x4f, 0xe3, 0x09,
+	0x53, 0xa6, 0x11, 0xb2, 0xbd, 0xb0, 0x17, 0xc8, 0x29, 0x3d, 0x83, 0x57, 0x7b, 0xdc, 0x0d, 0x37,
+	0xce, 0xdc, 0x70, 0xec, 0xf8, 0xfc, 0x72, 0x16, 0x08, 0x83, 0xf8, 0xce, 0xb7, 0x4a, 0xe4, 0x4c,
+	0x76, 0x44, 0xd8, 0xee, 0x1d, 0x57, 0x44, 0x4b, 0xee, 0xec, 0xe5, 0x02, 0x2f, 0x74, 0x45, 0x28,
+	0xbf, 0x81, 0x5e, 0x11, 0xaa, 0x29, 0x06, 0x83, 0x39, 0x9a, 0x1b, 0xa7, 0xdc, 0xac, 0x13, 0x4b,
+	0x2c, 0xd2, 0x97, 0x8b, 0xec, 0xd2, 0xe0, 0xa1, 0xcf, 0x53, 0xa2, 0x6b, 0xa7, 0x06, 0x40, 0x30,
+	0xd8, 0x25, 0xe7, 0x5b, 0xe9, 0xa3, 0x0b, 0x63, 0x7e, 0x8d, 0x70, 0x2c, 0xf3, 0x59, 0x8b, 0x4c,
+	0x44, 0xa1, 0x8f, 0x67, 0x9f, 0xb8, 0x16, 0x84, 0x40, 0xff, 0xe0, 0xb1, 0xc8, 0x54, 0x31, 0xe9,
+	0x99, 0xd1, 0x02, 0x9a, 0x27, 0x98, 0x1d, 0xc0, 0x20, 0xac, 0xc6, 0xb0, 0x35, 0x6b, 0x53, 0xf2,
+	0x46, 0x39, 0x21, 0x55, 0x30, 0xc6, 0x6a, 0xb0, 0x48, 0x7d, 0xaa, 0x5c, 0x8a, 0xb5, 0xe6, 0xb3,
+	0xe2, 0x35, 0xdf, 0xb8, 0x36, 0x1c, 0x15, 0x1e, 0x44, 0xc7, 0xfe, 0x00, 0x99, 0x36, 0xde, 0x2b,
+	0x56, 0x03, 0x53, 0x6f, 0xce, 0xa2, 0x92, 0x9c, 0xcf, 0xc0, 0xee, 0xdf, 0x9d, 0x79, 0x22, 0xdb,
+	0x26, 0x84, 0xca, 0x00, 0x1d, 0xe7, 0xd7, 0x4b, 0xd9, 0xaf, 0xa5, 0xf4, 0xc1, 0xdf, 0xb0, 0x06,
+	0x36, 0x6a, 0xef, 0x3b, 0x0e, 0x19, 0xcc, 0xb6, 0x74, 0x2a, 0xde, 0x63, 0x38, 0xce, 0x23, 0x3c,
+	0x58, 0x75, 0xfe, 0x45, 0x85, 0x3c, 0xa0, 0x67, 0xea, 0xe8, 0xcc, 0x1a, 0x76, 0x74, 0x76, 0xf0,
+	0xd3, 0xb8, 0x4f, 0x5b, 0x64, 0xcc, 0x47, 0x9b, 0x91, 0x9f, 0x4e, 0x4c, 0x5c, 0x68, 0x1f, 0xd7,
+	0xd8, 0x73, 0xd3, 0x34, 0xe6, 0x87, 0xfb, 0xca, 0x41, 0xc9, 0x1b, 0x41, 0xf4, 0xc1, 0xfe, 0x8a,
+	0x95, 0x3e, 0xea, 0xe0, 0x51, 0x6a, 0xde, 0xb1, 0xf5, 0xc9, 0x38, 0x3f, 0xe1, 0x1d, 0xd3, 0x9e,
+	0xf9, 0x21, 0x27, 0x2b, 0xf6, 0x2c, 0x21, 0x5b, 0x5e, 0xe0, 0xfa, 0xde, 0x6b, 0xb8, 0xf7, 0xad,
+	0x32, 0x25, 0xc0, 0xb4, 0xea, 0x25, 0xd5, 0x0a, 0x06, 0xc6, 0xb9, 0x3f, 0x4f, 0x26, 0x8c, 0x37,
+	0xcf, 0x89, 0x49, 0x38, 0x63, 0xc6, 0x24, 0xd4, 0x8d, 0x50, 0x82, 0x73, 0xef, 0x21, 0xd3, 0xd9,
+	0x0e, 0x1e, 0xe4, 0x79, 0xe7, 0x4b, 0xe3, 0xd9, 0xf3, 0x89, 0x0d, 0x1a, 0x75, 0xb1, 0x6b, 0xaf,
+	0xfb, 0x0c, 0x5e, 0xf7, 0x19, 0xbc, 0xee, 0x33, 0x30, 0xdd, 0xbe, 0x62, 0x3f, 0x3c, 0xfe, 0xb0,
+	0xf6, 0xc3, 0xf7, 0xaa, 0x24, 0x65, 0xe8, 0xf0, 0x01, 0xc1, 0x80, 0x78, 0xda, 0x0b, 0x5f, 0x82,
+	0xe5, 0x86, 0x95, 0x3e, 0xb0, 0x02, 0xde, 0x0c, 0x12, 0x8e, 0xca, 0xa0, 0xe7, 0x26, 0xdb, 0x8d,
+	0x52, 0x5a, 0x19, 0xac, 0xb9, 0xc9, 0x36, 0x30, 0x88, 0xfd, 0x1e, 0x32, 0x95, 0xb8, 0x51, 0x87,
+	0x26, 0x40, 0x77, 0xd8, 0xb8, 0x8b, 0x63, 0xa6, 0x27, 0x04, 0xee, 0xd4, 0x46, 0x0a, 0x0a, 0x19,
+	0x6c, 0xfb, 0x55, 0x52, 0xd9, 0xa6, 0x7e, 0x57, 0x8c, 0xc9, 0x7a, 0x71, 0x42, 0x98, 0xbd, 0xeb,
+	0x15, 0xea, 0x77, 0xb9, 0x88, 0xc0, 0xff, 0x80, 0xb1, 0xc2, 0x09, 0x51, 0xbf, 0xdd, 0x8f, 0x93,
+	0xb0, 0xeb, 0xbd, 0x26, 0xbd, 0x2b, 0xef, 0x2b, 0x98, 0xf1, 0x35, 0x49, 0x9f, 0xef, 0xc7, 0xd5,
+	0x4f, 0xd0, 0x9c, 0x59, 0x3f, 0xda, 0x5e, 0xc4, 0xbc, 0x25, 0x7b, 0x0d, 0x72, 0x2c, 0xfd, 0x58,
+	0x94, 0xf4, 0x79, 0x3f, 0xd4, 0x4f, 0xd0, 0x9c, 0xed, 0x3d, 0x35, 0x31, 0x27, 0xce, 0x5b, 0xc5,
+	0xee, 0x0a, 0x58, 0x1f, 0xf8, 0xa4, 0xcc, 0x9b, 0xa0, 0xf6, 0xb3, 0xa4, 0xda, 0xda, 0x76, 0xa3,
+	0xa4, 0x31, 0xc9, 0x26, 0x8d, 0xf2, 0x0b, 0x2c, 0x60, 0x23, 0x70, 0x18, 0x06, 0x4a, 0x44, 0x74,
+	0xab, 0x71, 0x22, 0x1d, 0x28, 0x01, 0x74, 0x0b, 0xb0, 0xdd, 0xf9, 0x6a, 0x89, 0x9c, 0x1b, 0xe0,
+	0xa9, 0x5e, 0x94, 0xcf, 0xf6, 0x56, 0x3f, 0x8a, 0xa5, 0xef, 0xc0, 0x98, 0xed, 0xac, 0x19, 0x24,
+	0xdc, 0xfe, 0x84, 0x45, 0xc6, 0x6f, 0xc5, 0x61, 0x10, 0xd0, 0xa4, 0x51, 0x2a, 0x7a, 0x87, 0xcc,
+	0xba, 0x75, 0x95, 0x53, 0xd7, 0x7d, 0x10, 0x0d, 0x20, 0xf9, 0x62, 0x77, 0xe9, 0x6e, 0xcb, 0xef,
+	0xb7, 0x07, 0xce, 0xc7, 0x2f, 0xf2, 0x66, 0x90, 0x70, 0x44, 0xf5, 0x02, 0x8e, 0x5a, 0x49, 0xa3,
+	0x2e, 0x05, 0x02, 0x55, 0xc0, 0x9d, 0xbf, 0x3e, 0x46, 0xce, 0xe6, 0x2e, 0x0e, 0xb4, 0x34, 0x98,
+	0x2e, 0xbf, 0xe4, 0xf9, 0x94, 0x6f, 0x0b, 0x85, 0xa5, 0x71, 0x43, 0xb5, 0x82, 0x81, 0x61, 0xff,
+	0x0c, 0x21, 0x3d, 0x37, 0x72, 0xbb, 0x54, 0x68, 0xd8, 0xf2, 0xd1, 0x15, 0x3a, 0xf6, 0x63, 0x4d,
+	0xd2, 0xd4, 0x9b, 0x47, 0xd5, 0x14, 0x83, 0xc1, 0x12, 0x63, 0x1d, 0x22, 0xea, 0x53, 0x37, 0x66,
+	0x61, 0xbc, 0xd9, 0x9c, 0x04, 0xd0, 0x20, 0x30, 0xf1, 0xf0, 0xf4, 0x5a, 0x04, 0xc8, 0x64, 0x82,
+	0x09, 0xd2, 0x41, 0x32, 0xf6, 0xe7, 0x2c, 0x32, 0xb5, 0xe5, 0xf9, 0x54, 0x73, 0x17, 0x19, 0x04,
+	0xab, 0x47, 0x7f, 0xc9, 0x4b, 0x26, 0x5d, 0x2d, 0x21, 0x53, 0xcd, 0x31, 0x64, 0xd8, 0xe3, 0x67,
+	0xde, 0xa1, 0x11, 0x13, 0xad, 0x63, 0xe9, 0xcf, 0x7c, 0x83, 0x37, 0x83, 0x84, 0xdb, 0xf3, 0xe4,
+	0x64, 0xcf, 0x8d, 0xe3, 0x85, 0x88, 0xb6, 0x69, 0x90, 0x78, 0xae, 0xcf, 0xe3, 0xfb, 0x6b, 0x3a,
+	0xce, 0x75, 0x2d, 0x0d, 0x86, 0x2c, 0xbe, 0xfd, 0x7e, 0xf2, 0x24, 0x0f, 0x40, 0x5b, 0xf1, 0x58,
+	0xc8, 0x96, 0x9e, 0x06, 0x4c, 0x52, 0xd6, 0x9a, 0x33, 0x82, 0xd4, 0x93, 0x4b, 0xf9, 0x68, 0x30,
+	0xec, 0x79, 0x8c, 0x68, 0x8a, 0x6f, 0x7b, 0xbd, 0x85, 0xa8, 0x1d, 0x33, 0x7f, 0x73, 0x4d, 0x7b,
+	0xac, 0xd6, 0x45, 0x3b, 0x28, 0x0c, 0xbb, 0x45, 0x26, 0xf9, 0x27, 0xe1, 0x51, 0x40, 0x42, 0x3e,
+	0x3e, 0x3f, 0xd4, 0x1f, 0x2a, 0x52, 0xd0, 0x66, 0xc1, 0xbd, 0x73, 0x51, 0x7a, 0xbf, 0x79, 0x20,
+	0xf4, 0x0d, 0x83, 0x0c, 0xa4, 0x88, 0x3a, 0x5f, 0x2a, 0x91, 0xc6, 0xc0, 0xba, 0x10, 0x6b, 0x12,
+	0xa3, 0xa4, 0xe9, 0x6e, 0x72, 0xc3, 0x8d, 0xa4, 0xbb, 0xe4, 0x88, 0x69, 0x08, 0x82, 0xee, 0x0d,
+	0x37, 0x32, 0x17, 0x35, 0x63, 0x00, 0x92, 0x93, 0x7d, 0x8b, 0x54, 0x12, 0xdf, 0x2d, 0x28, 0x6f,
+	0xc9, 0xe0, 0xa8, 0x3d, 0x14, 0xcb, 0xf3, 0x31, 0x30, 0x1e, 0xf6, 0x9b, 0xd0, 0x2c, 0xdf, 0x94,
+	0x21, 0x63, 0xc2, 0x92, 0xde, 0x8c, 0x81, 0xb5, 0x3a, 0xff, 0xb7, 0x96, 0x23, 0x57, 0x95, 0x22,
+	0x43, 0xb7, 0x2c, 0xee, 0xf0, 0xd6, 0x22, 0xba, 0xe5, 0xed, 0x0a, 0x43, 0x42, 0xad, 0xdd, 0xeb,
+	0x0a, 0x02, 0x06, 0x96, 0x7c, 0x66, 0xbd, 0xbf, 0x85, 0xcf, 0x94, 0x06, 0x9f, 0xe1, 0x10, 0x30,
+	0xb0, 0xec, 0x77, 0x92, 0x31, 0xaf, 0xeb, 0x76, 0x54, 0x64, 0xdb, 0x9b, 0x58, 0xac, 0x24, 0x6b,
+	0xb9, 0x7f, 0x77, 0x66, 0x4a, 0x75, 0x88, 0x35, 0x81, 0xc0, 0xb5, 0x7f, 0xdd, 0x22, 0x93, 0xad,
+	0xb0, 0xdb, 0x0d, 0x03, 0xbe, 0x2f, 0x12, 0x9b, 0xbc, 0x5b, 0xc7, 0xa5, 0xe6, 0x67, 0x17, 0x0c,
+	0x66, 0x7c, 0x97, 0xa7, 0x12, 0xac, 0x4c, 0x10, 0xa4, 0x7a, 0x65, 0xae, 0xed, 0xea, 0x3e, 0x6b,
+	0xfb, 0xeb, 0x16, 0x39, 0xc5, 0x9f, 0x35, 0xb6, 0x6b, 0x22, 0x97, 0x28, 0x3c, 0xe6, 0xd7, 0x1a,
+	0xd8, 0xc1, 0x2a, 0x37, 0xda, 0x00, 0x1c, 0x06, 0x3b, 0x69, 0x5f, 0x26, 0xa7, 0xb6, 0xc2, 0xa8,
+	0x45, 0xcd, 0x81, 0x10, 0x82, 0x49, 0x11, 0xba, 0x94, 0x45, 0x80, 0xc1, 0x67, 0x30, 0x16, 0xd5,
+	0x68, 0x34, 0xc7, 0x81, 0xcb, 0x26, 0x15, 0x8b, 0x7a, 0x29, 0x17, 0x0b, 0x86, 0x3c, 0x9d, 0xf6,
+	0x68, 0xd4, 0x47, 0xf0, 0x68, 0xbc, 0x42, 0x9e, 0x6a, 0x0d, 0x8e, 0xcc, 0x4e, 0xdc, 0xdf, 0x8c,
+	0xb9, 0xa4, 0xaa, 0x35, 0x7f, 0x40, 0x10, 0x78, 0x6a, 0x61, 0x18, 0x22, 0x0c, 0xa7, 0x61, 0x7f,
+	0x84, 0xd4, 0x22, 0xca, 0xbe, 0x4a, 0x2c, 0x12, 0x6b, 0x8e, 0xb8, 0x8d, 0xd5, 0x16, 0x28, 0x27,
+	0xab, 0x65, 0xaf, 0x68, 0x88, 0x41, 0x71, 0x3c, 0xf7, 0x93, 0xe4, 0xd4, 0xc0, 0x7c, 0x3e, 0x90,
+	0x53, 0x61, 0x91, 0x3c, 0x91, 0x3f, 0x73, 0x0e, 0xe4, 0x5a, 0xf8, 0x87, 0x99, 0xb0, 0x3d, 0xc3,
+	0x9a, 0x1c, 0xc1, 0x4d, 0xe5, 0x92, 0x32, 0x0d, 0x76, 0x84, 0x20, 0xbd, 0x74, 0xb4, 0xd1, 0xbb,
+	0x18, 0xec, 0xf0, 0x89, 0xcf, 0xf6, 0xe2, 0x17, 0x83, 0x1d, 0x40, 0xda, 0xf6, 0x17, 0xac, 0x94,
+	0x35, 0xc4, 0x9d, 0x5b, 0x1f, 0x3a, 0x16, 0xf3, 0x79, 0x64, 0x03, 0xc9, 0xf9, 0x97, 0x25, 0x72,
+	0x7e, 0x3f, 0x22, 0x23, 0x0c, 0xdf, 0xb3, 0x18, 0x37, 0x88, 0x27, 0x8a, 0x42, 0x32, 0xb1, 0xa4,
+	0x1e, 0x7e, 0xc6, 0xf8, 0x0a, 0x08, 0x90, 0xed, 0x93, 0x72, 0xd7, 0xed, 0x09, 0x9f, 0xc7, 0xd2,
+	0x51, 0xb3, 0x24, 0xf0, 0xb7, 0xeb, 0xaf, 0xb8, 0x3d, 0xbe, 0x93, 0x36, 0x1a, 0x00, 0xd9, 0xd8,
+	0x09, 0xa9, 0xba, 0x51, 0xe4, 0xca, 0xe3, 0xab, 0x6b, 0xc5, 0xf0, 0x9b, 0x47, 0x92, 0xcd, 0x53,
+	0x98, 0xfc, 0x96, 0x6a, 0x02, 0xce, 0xcc, 0xf9, 0xc5, 0x5a, 0x2a, 0x50, 0x9d, 0x9d, 0x49, 0xc6,
+	0x64, 0x4c, 0xb8, 0x3a, 0xac, 0xa2, 0x93, 0x53, 0x18, 0x59, 0xbe, 0x59, 0xe2, 0xff, 0x83, 0x60,
+	0x65, 0x7f, 0xca, 0x62, 0xe9, 0xb8, 0x32, 0x7b, 0xa2, 0x51, 0x2a, 0xf8, 0xf8, 0xcc, 0xcc, 0x0e,
+	0x36, 0x93, 0x7c, 0x65, 0x23, 0x98, 0xdc, 0x51, 0x75, 0xf5, 0x78, 0x82, 0x55, 0x76, 0xa3, 0x22,
+	0x13, 0x76, 0x25, 0xdc, 0xde, 0xcd, 0x39, 0x7b, 0x2c, 0x20, 0xa5, 0x73, 0x84, 0xd3, 0xc6, 0xaf,
+	0x58, 0xe4, 0x14, 0x37, 0x47, 0x17, 0xbd, 0xad, 0x2d, 0x1a, 0xd1, 0xa0, 0x45, 0xa5, 0x41, 0x7f,
+	0xc4, 0xd3, 0x6d, 0xe9, 0x5f, 0x5a, 0xca, 0x92, 0xd7, 0x3a, 0x6d, 0x00, 0x04, 0x83, 0x9d, 0xb1,
+	0xdb, 0xa4, 0xe2, 0x05, 0x5b, 0xa1, 0xd0, 0xe4, 0xcd, 0xa3, 0x75, 0x6a, 0x29, 0xd8, 0x0a, 0xf5,
+	0x6a, 0xc6, 0x5f, 0xc0, 0xa8, 0xdb, 0xcb, 0xe4, 0x4c, 0x24, 0x5c, 0x2e, 0x57, 0xbc, 0x18, 0x37,
+	0xc6, 0xcb, 0x5e, 0xd7, 0x4b, 0x98, 0x16, 0x2e, 0x37, 0x1b, 0xf7, 0xee, 0xce, 0x9c, 0x81, 0x1c,
+	0x38, 0xe4, 0x3e, 0x65, 0xbf, 0x46, 0xc6, 0x65, 0xfe, 0x70, 0xad, 0x88, 0xcd, 0xd1, 0xe0, 0xfc,
+	0x57, 0x93, 0x89, 0xff, 0x8e, 0x41, 0x32, 0xc4, 0x55, 0x30, 0xb9, 0x6d, 0xa4, 0xb2, 0x34, 0xea,
+	0x05, 0x3b, 0x2d, 0xcc, 0x3c, 0x19, 0xbe, 0x81, 0x30, 0x5b, 0x20, 0xc5, 0xdc, 0xf9, 0xa5, 0x13,
+	0x64, 0xf0, 0x28, 0xd1, 0xfe, 0x28, 0xa9, 0x47, 0x2a, 0xc3, 0xda, 0x2a, 0x22, 0x78, 0x4f, 0x25,
+	0xec, 0xf0, 0x63, 0x4c, 0x65, 0x9d, 0xe8, 0x5c, 0x6a, 0xcd, 0x11, 0xf7, 0x10, 0xb1, 0x3e, 0x71,
+	0x2c, 0x60, 0xa5, 0x09, 0xae, 0xfa, 0x34, 0x09, 0xcf, 0x16, 0x19, 0x0f, 0x3b, 0x22, 0x63, 0x7c,
+	0x40, 0x8a, 0x71, 0x7c, 0xf3, 0xa1, 0xce, 0xa6, 0x44, 0xf0, 0x56, 0x10, 0x9c, 0xec, 0x5d, 0x32,
+	0xbe, 0xcd, 0xa7, 0xa3, 0x30, 0xeb, 0x57, 0x8e, 0x3a, 0xb8, 0xa9, 0x39, 0xae, 0x27, 0x9f, 0x68,
+	0x00, 0xc9, 0x8e, 0x85, 0x51, 0x18, 0xa7, 0xe8, 0x5c, 0x90, 0x14, 0x97, 0x0d, 0x32, 0xfa, 0x11,
+	0xfa, 0x87, 0xc9, 0x64, 0x44, 0x5b, 0x61, 0xd0, 0xf2, 0x7c, 0xda, 0x9e, 0x97, 0x4e, 0xed, 0x83,
+	0xe4, 0x10, 0xb0, 0x99, 0x0d, 0x06, 0x0d, 0x48, 0x51, 0xb4, 0x3f, 0x69, 0x91, 0x29, 0x95, 0x9e,
+	0x88, 0x1f, 0x84, 0x0a, 0x1f, 0xed, 0x72, 0x41, 0xc9, 0x90, 0x8c, 0x66, 0xd3, 0x46, 0x0f, 0x48,
+	0xba, 0x0d, 0x32, 0x7c, 0xed, 0x0f, 0x10, 0x12, 0x6e, 0xf2, 0x58, 0x89, 0xf9, 0xa4, 0x51, 0x3b,
+	0xf0, 0xab, 0x4e, 0xf1, 0x64, 0x22, 0x49, 0x01, 0x0c, 0x6a, 0xf6, 0x35, 0x42, 0xf8, 0xb2, 0xc1,
+	0xa3, 0x86, 0x46, 0x3d, 0x95, 0x04, 0x42, 0xd6, 0x15, 0xe4, 0xfe, 0xdd, 0x99, 0x41, 0x07, 0x1a,
+	0x02, 0xc0, 0x78, 0xdc, 0xfe, 0x69, 0x32, 0x1e, 0xf7, 0xbb, 0x5d, 0x57, 0xb9, 0x73, 0x0b, 0x4c,
+	0x4f, 0xe2, 0x74, 0x0d, 0xc1, 0xc8, 0x1b, 0x40, 0x72, 0xb4, 0x6f, 0xa1, 0x88, 0x8f, 0x85, 0x67,
+	0x8f, 0xad, 0x22, 0xf6, 0x3f, 0x73, 0xea, 0xd6, 0x9b, 0xef, 0x12, 0xcf, 0x9d, 0x81, 0x1c, 0x1c,
+	0x3c, 0x66, 0x4f, 0xb7, 0x2f, 0x87, 0x9c, 0x2d, 0xe4, 0xd2, 0xb4, 0xaf, 0x92, 0x09, 0xfd, 0xda,
+	0x32, 0xe7, 0xfe, 0x39, 0x5d, 0xdc, 0x84, 0x35, 0x0f, 0x1f, 0x33, 0xf3, 0x61, 0x7b, 0x85, 0x9c,
+	0x6e, 0x85, 0x41, 0x12, 0x85, 0xbe, 0xcf, 0x2b, 0xf6, 0xf0, 0x6d, 0x18, 0x77, 0xf7, 0xbe, 0x51,
+	0x74, 0xfb, 0xf4, 0xc2, 0x20, 0x0a, 0xe4, 0x3d, 0x67, 0x7f, 0x84, 0x4c, 0xf4, 0x68, 0xd0, 0x96,
+	0x51, 0x17, 0x53, 0x45, 0x98, 0xa6, 0x6b, 0x9a, 0xa0, 0x38, 0xe4, 0xd1, 0x0d, 0x60, 0xb2, 0x43,
+	0xed, 0x74, 0x82, 0x4b, 0x29, 0x21, 0x3b, 0x1a, 0x27, 0x8b, 0xd8, 0xbd, 0xf1, 0xc1, 0xd7, 0x99,
+	0x3d, 0xba, 0x5e, 0xc3, 0x15, 0x93, 0x19, 0xa4, 0x79, 0x3b, 0x41, 0x3a, 0xa0, 0x4e, 0x4c, 0x94,
+	0x77, 0x92, 0x49, 0x8c, 0x0f, 0x8d, 0x02, 0xd7, 0x7f, 0x09, 0x96, 0xa5, 0xd3, 0x97, 0xc9, 0x83,
+	0x8b, 0x46, 0x3b, 0xa4, 0xb0, 0x30, 0xc3, 0x50, 0xf8, 0x61, 0x4a, 0x3a, 0xc3, 0x90, 0xfb, 0x61,
+	0xa4, 0xd7, 0xc5, 0xf9, 0xb3, 0x52, 0xca, 0x54, 0xde, 0x88, 0x28, 0xb5, 0x43, 0x52, 0x0d, 0xc2,
+	0xb6, 0xd2, 0x83, 0x57, 0x8b, 0xd1, 0x83, 0xd7, 0xc3, 0xb6, 0x51, 0x0d, 0x06, 0x7f, 0xc5, 0xc0,
+	0xf9, 0xb0, 0x72, 0x19, 0xb2, 0xae, 0x08, 0x03, 0x34, 0x4a, 0x85, 0x73, 0x56, 0xc3, 0xbf, 0x6a,
+	0x32, 0x82, 0x34, 0x5f, 0xfb, 0x36, 0xa9, 0x6e, 0x87, 0x71, 0x22, 0x37, 0x86, 0x47, 0xdc, 0x83,
+	0x5e, 0x09, 0xe3, 0x84, 0xd9, 0x77, 0xea, 0xb5, 0xb1, 0x25, 0x06, 0xce, 0xc3, 0xf9, 0xcf, 0x56,
+	0xca, 0xc5, 0x7f, 0x93, 0x05, 0x97, 0xee, 0xd0, 0x00, 0x45, 0x9c, 0x19, 0xaa, 0xf4, 0xa3, 0x99,
+	0x0c, 0xb7, 0xb7, 0x0c, 0xab, 0xcd, 0x75, 0x07, 0x29, 0xcc, 0x32, 0x12, 0x46, 0x54, 0xd3, 0xc7,
+	0xad, 0x74, 0xae, 0x61, 0xa9, 0x88, 0xf5, 0x65, 0xe6, 0xd2, 0xee, 0x9b, 0xb6, 0xe8, 0x7c, 0xc1,
+	0x22, 0xe3, 0x4d, 0xb7, 0x75, 0x3b, 0xdc, 0xda, 0x42, 0x9f, 0x72, 0xbb, 0x1f, 0x99, 0x69, 0x8f,
+	0xca, 0xaf, 0xb1, 0x28, 0xda, 0x41, 0x61, 0xe0, 0x1c, 0xde, 0x72, 0x5b, 0x32, 0xa3, 0xb6, 0xcc,
+	0xe7, 0xf0, 0x25, 0xd6, 0x02, 0x02, 0x82, 0xe7, 0x0b, 0x5d, 0x77, 0x57, 0x3e, 0x9c, 0x3d, 0x5f,
+	0x58, 0xd1, 0x20, 0x30, 0xf1, 0x9c, 0x7f, 0x66, 0x91, 0x46, 0xd3, 0x8d, 0xbd, 0x16, 0x16, 0x2c,
+	0x6b, 0x7a, 0xc9, 0x66, 0xbf, 0x75, 0x9b, 0x26, 0x3c, 0x8f, 0x1d, 0x7b, 0xd9, 0x8f, 0x69, 0x64,
+	0xec, 0xb8, 0x55, 0x2f, 0x5f, 0x12, 0xed, 0xa0, 0x30, 0xec, 0xd7, 0xc8, 0x04, 0x7a, 0xe5, 0xef,
+	0x84, 0x51, 0x1b, 0xe8, 0x56, 0x31, 0x55, 0x24, 0xd6, 0x69, 0x2b, 0xa2, 0x09, 0xd0, 0x2d, 0x21,
+	0xbf, 0x34, 0x7d, 0x30, 0x99, 0x39, 0xbf, 0x68, 0x91, 0x49, 0x76, 0xf8, 0xb6, 0x48, 0x13, 0xd7,
+	0xf3, 0x07, 0x4a, 0x40, 0x59, 0x23, 0x96, 0x80, 0x3a, 0x4f, 0x2a, 0xdb, 0x61, 0x97, 0x66, 0x0f,
+	0x8e, 0xaf, 0x84, 0xe8, 0x5f, 0x40, 0x08, 0xe6, 0x1b, 0x77, 0x5d, 0x2f, 0x48, 0x5c, 0x9c, 0x71,
+	0xd2, 0xb9, 0x7b, 0x92, 0x8f, 0xb1, 0x6a, 0x06, 0x13, 0xc7, 0xf9, 0x46, 0x9d, 0x8c, 0x8b, 0xe3,
+	0xff, 0x91, 0x4b, 0x07, 0x48, 0x47, 0x47, 0x69, 0xa8, 0xa3, 0x23, 0x26, 0x63, 0x2d, 0x56, 0x60,
+	0xae, 0x51, 0x2e, 0xc2, 0xad, 0x20, 0x3a, 0xc8, 0x6b, 0xd6, 0xe9, 0x6e, 0xf1, 0xdf, 0x20, 0x58,
+	0xd9, 0x9f, 0xb7, 0xc8, 0xc9, 0x56, 0x18, 0x04, 0xb4, 0xa5, 0xcd, 0xab, 0x4a, 0x11, 0x61, 0x01,
+	0x0b, 0x69, 0xa2, 0xfa, 0xe4, 0x27, 0x03, 0x80, 0x2c, 0x7b, 0xfb, 0xdd, 0xe4, 0x04, 0x1f, 0xb3,
+	0x1b, 0x29, 0x8f, 0xb4, 0xae, 0x0c, 0x64, 0x02, 0x21, 0x8d, 0x8b, 0xc7, 0x88, 0x81, 0xae, 0xc1,
+	0x33, 0xa6, 0x8f, 0x11, 0x8d, 0xea, 0x3b, 0x06, 0x06, 0x66, 0xc2, 0x46, 0x74, 0x2b, 0xa2, 0xf1,
+	0xb6, 0x08, 0x8f, 0x60, 0xa6, 0xdd, 0xf8, 0xe1, 0x32, 0x61, 0x61, 0x80, 0x12, 0xe4, 0x50, 0xb7,
+	0x6f, 0x8b, 0x9d, 0x76, 0xad, 0x08, 0x91, 0x25, 0x3e, 0xf3, 0xd0, 0x0d, 0xf7, 0x0c, 0xa9, 0xc6,
+	0xdb, 0x6e, 0xd4, 0x66, 0x26, 0x65, 0x99, 0x67, 0x5f, 0xac, 0x63, 0x03, 0xf0, 0x76, 0x7b, 0x91,
+	0x4c, 0x67, 0xea, 0x1a, 0xc5, 0xc2, 0x73, 0xac, 0x52, 0x06, 0x32, 0x15, 0x91, 0x62, 0x18, 0x78,
+	0xc2, 0xf4, 0xc2, 0x4c, 0xec, 0xe3, 0x85, 0xd9, 0x53, 0x41, 0x78, 0x93, 0x4c, 0x1d, 0xbd, 0x58,
+	0xc8, 0x00, 0x8c, 0x14, 0x71, 0xf7, 0x99, 0x4c, 0xc4, 0xdd, 0x89, 0xf3, 0xe5, 0xa3, 0x1f, 0xae,
+	0xcb, 0x0e, 0x1c, 0x3c, 0xbc, 0xee, 0x51, 0x86, 0xcb, 0xfd, 0x2f, 0x8b, 0xc8, 0xef, 0xba, 0xe0,
+	0xb6, 0xb6, 0x29, 0x4e, 0x19, 0x0c, 0xa2, 0x51, 0xbb, 0xf7, 0x85, 0xb0, 0x1f, 0xf0, 0x48, 0xb9,
+	0xb2, 0x3e, 0x22, 0x86, 0x14, 0x14, 0x32, 0xd8, 0x78, 0x7e, 0x81, 0xe3, 0xc4, 0x1f, 0xe5, 0xaa,
+	0x4d, 0x79, 0x08, 0xe6, 0xd7, 0x96, 0xc4, 0x53, 0x1a, 0xc7, 0x0e, 0xc9, 0x29, 0xdf, 0x8d, 0x13,
+	0xd6, 0x03, 0xb4, 0x5b, 0x0f, 0x99, 0x87, 0xce, 0xe2, 0xd2, 0x97, 0xb3, 0x84, 0x60, 0x90, 0xb6,
+	0xf3, 0x87, 0x15, 0x72, 0x22, 0x25, 0x19, 0x0f, 0xa8, 0x13, 0xdf, 0x46, 0x6a, 0x52, 0x4d, 0x65,
+	0xab, 0x61, 0x28, 0x5d, 0xa6, 0x30, 0x50, 0x69, 0x6d, 0x52, 0x37, 0xa2, 0x11, 0xab, 0x9c, 0x94,
+	0xd5, 0xe1, 0x4d, 0x0d, 0x02, 0x13, 0x8f, 0x09, 0xe5, 0xc4, 0x8f, 0x17, 0x7c, 0x8f, 0x06, 0x09,
+	0xef, 0x66, 0x31, 0x42, 0x79, 0x63, 0x79, 0xdd, 0x24, 0xaa, 0x85, 0x72, 0x06, 0x00, 0x59, 0xf6,
+	0xf6, 0x5f, 0xb2, 0xc8, 0x09, 0xf7, 0x4e, 0xac, 0xab, 0xa0, 0x36, 0xaa, 0x45, 0x28, 0xa9, 0x54,
+	0x61, 0x55, 0xee, 0xfb, 0x4e, 0x35, 0x41, 0x9a, 0x29, 0xc6, 0x4f, 0xdb, 0x74, 0x97, 0xb6, 0x64,
+	0xf4, 0x9f, 0xe8, 0xcb, 0x58, 0x11, 0x9b, 0xdc, 0x8b, 0x03, 0x74, 0xb9, 0x54, 0x1f, 0x6c, 0x87,
+	0x9c, 0x3e, 0x38, 0xbf, 0x55, 0x56, 0x0b, 0x4a, 0x07, 0x9c, 0xba, 0x46, 0x6a, 0x9b, 0x75, 0xf8,
+	0xd4, 0x36, 0x1d, 0x9f, 0x30, 0x98, 0xde, 0x96, 0x4a, 0xeb, 0x29, 0x3d, 0xa2, 0xb4, 0x1e, 0xac,
+	0x54, 0x64, 0xd4, 0x7d, 0x39, 0x72, 0x8d, 0xa0, 0xec, 0x40, 0xce, 0xf2, 0xd8, 0x89, 0x8c, 0x74,
+	0x4f, 0x87, 0xcc, 0xa0, 0x34, 0x35, 0xd0, 0x0e, 0x24, 0x0d, 0xff, 0x6d, 0x99, 0x4c, 0x18, 0x9a,
+	0x34, 0xd7, 0x2c, 0xb2, 0x1e, 0x33, 0xb3, 0xa8, 0x74, 0x00, 0xb3, 0xe8, 0x67, 0x48, 0xbd, 0x25,
+	0xa5, 0x7c, 0x31, 0x25, 0x77, 0xb3, 0xba, 0x43, 0x0b, 0x7a, 0xd5, 0x04, 0x9a, 0x27, 0x1e, 0xbd,
+	0x1b, 0x64, 0x84, 0x86, 0xa8, 0x30, 0x0d, 0x91, 0x97, 0x0a, 0x23, 0x34, 0xc5, 0xe0, 0x33, 0xac,
+	0x3c, 0x50, 0xcf, 0x13, 0xef, 0x25, 0x43, 0xd2, 0x79, 0x79, 0xa0, 0xb5, 0x25, 0xd9, 0x0c, 0x26,
+	0x0e, 0x96, 0x34, 0x93, 0x1f, 0xf7, 0x21, 0x24, 0xcb, 0xdf, 0x4a, 0x27, 0xcb, 0x5f, 0x2c, 0x64,
+	0x98, 0x87, 0x64, 0xc9, 0x5f, 0x27, 0xe3, 0x78, 0xbc, 0xed, 0x06, 0x6d, 0xfb, 0x07, 0xc9, 0x78,
+	0x8b, 0xff, 0x2b, 0xfc, 0x28, 0xbc, 0xf8, 0x21, 0x6f, 0x02, 0x09, 0xc3, 0x50, 0x1b, 0x37, 0xea,
+	0x48, 0xdf, 0x09, 0x0b, 0xb5, 0x99, 0x8f, 0x3a, 0x31, 0xb0, 0x56, 0xe7, 0x73, 0x65, 0x42, 0x16,
+	0xc2, 0x6e, 0xcf, 0x8d, 0x68, 0x7b, 0x23, 0x64, 0xa5, 0xef, 0x8e, 0xf5, 0x74, 0x51, 0x6f, 0x96,
+	0x1e, 0xe7, 0x13, 0x46, 0xe3, 0x94, 0xa9, 0xfc, 0x90, 0x4f, 0x99, 0x9c, 0x4f, 0x5b, 0xc4, 0xc6,
+	0x2f, 0x12, 0x06, 0x34, 0x48, 0xf4, 0xb1, 0xf9, 0x1c, 0xa9, 0xb7, 0x64, 0xab, 0xb0, 0x5a, 0xf4,
+	0xfa, 0x93, 0x00, 0xd0, 0x38, 0x23, 0x6c, 0x3f, 0x9f, 0x95, 0xc2, 0xb1, 0x9c, 0x0e, 0x81, 0x65,
+	0x22, 0x55, 0xc8, 0x4a, 0xe7, 0x77, 0x4b, 0xe4, 0x09, 0xae, 0xef, 0x56, 0xdc, 0xc0, 0xed, 0xd0,
+	0x2e, 0xf6, 0x6a, 0xd4, 0x40, 0x88, 0x16, 0xee, 0x7b, 0x3c, 0x19, 0xd2, 0x7a, 0xd4, 0x85, 0xc1,
+	0x27, 0x34, 0x9f, 0xc2, 0x4b, 0x81, 0x97, 0x00, 0x23, 0x6e, 0xc7, 0xa4, 0x26, 0x0b, 0xb8, 0x37,
+	0xca, 0x45, 0x32, 0x52, 0x6b, 0x5e, 0x28, 0x25, 0x0a, 0x8a, 0x11, 0x5a, 0x85, 0x7e, 0xd8, 0xba,
+	0x0d, 0xb4, 0x17, 0x36, 0x2a, 0xe9, 0x88, 0xc2, 0x65, 0xd1, 0x0e, 0x0a, 0xc3, 0xf9, 0x5d, 0x8b,
+	0x64, 0xc5, 0xbd, 0x51, 0x63, 0xca, 0x7a, 0x60, 0x8d, 0xa9, 0x03, 0x14, 0x79, 0xfa, 0x29, 0x32,
+	0xe1, 0x26, 0xa8, 0xa1, 0xf9, 0x9e, 0xb6, 0x7c, 0xb8, 0xe3, 0x8a, 0x95, 0xb0, 0xed, 0x6d, 0x79,
+	0x6c, 0x2f, 0x6b, 0x92, 0x73, 0xfe, 0x47, 0x85, 0x9c, 0x1a, 0xc8, 0x8c, 0xb0, 0x5f, 0xc0, 0x68,
+	0x37, 0x3e, 0x3d, 0x7a, 0xe8, 0x33, 0xe2, 0x2f, 0x63, 0x44, 0xa0, 0x69, 0x18, 0xa4, 0x30, 0x47,
+	0x98, 0xa0, 0x4b, 0xe4, 0x34, 0xd6, 0x02, 0xa4, 0x7d, 0x3a, 0xbf, 0x95, 0xd0, 0x68, 0x9d, 0xe2,
+	0x31, 0x14, 0xaf, 0x84, 0x56, 0x6e, 0x3e, 0x89, 0xbe, 0x79, 0x18, 0x04, 0x43, 0xde, 0x33, 0x76,
+	0x8f, 0x9c, 0xf0, 0x4d, 0x03, 0xab, 0x51, 0x39, 0xbc, 0x6d, 0xa6, 0x14, 0x70, 0xaa, 0x19, 0xd2,
+	0x0c, 0xd2, 0x56, 0x5a, 0xf5, 0x11, 0x59, 0x69, 0x7f, 0x51, 0x5b, 0x69, 0xfc, 0x94, 0xff, 0x83,
+	0x05, 0x67, 0xc6, 0x1c, 0xb7, 0x99, 0xf6, 0x22, 0xa9, 0xc9, 0x08, 0xa8, 0x91, 0x22, 0x87, 0x4c,
+	0x3a, 0x43, 0x24, 0xda, 0xfd, 0x12, 0xc9, 0xb1, 0xf0, 0x71, 0x9d, 0x69, 0x75, 0x9a, 0x5a, 0x67,
+	0x07, 0x53, 0xa9, 0xf6, 0x2e, 0x8f, 0xfe, 0xe2, 0x8a, 0xe3, 0xfd, 0x45, 0xef, 0x50, 0x74, 0x40,
+	0x98, 0xca, 0x47, 0x50, 0x41, 0x61, 0x17, 0x08, 0xd1, 0x56, 0x90, 0x88, 0x36, 0x57, 0xc7, 0xb9,
+	0xda, 0x58, 0x02, 0x03, 0x0b, 0x37, 0xac, 0x5e, 0x10, 0x27, 0xae, 0xef, 0x5f, 0xf1, 0x82, 0x44,
+	0x78, 0xde, 0x94, 0x86, 0x5c, 0xd2, 0x20, 0x30, 0xf1, 0xce, 0xbd, 0xcb, 0xf8, 0x2e, 0x07, 0xf9,
+	0x9e, 0xdb, 0xe4, 0xa9, 0xcb, 0x5e, 0xa2, 0x72, 0x24, 0xd4, 0x3c, 0x42, 0x23, 0x47, 0xe5, 0xfc,
+	0x58, 0x43, 0x73, 0x7e, 0x8c, 0x1c, 0x85, 0x52, 0x3a, 0xa5, 0x22, 0x9b, 0xa3, 0xe0, 0xbc, 0x40,
+	0xce, 0x5c, 0xf6, 0x12, 0x8c, 0xff, 0x3e, 0x20, 0x13, 0xe7, 0x77, 0xc6, 0xc8, 0xa4, 0x99, 0x06,
+	0x77, 0x90, 0xb4, 0x25, 0x4c, 0xbd, 0x96, 0xf9, 0x2d, 0x9e, 0x3a, 0x00, 0xba, 0x79, 0xe4, 0x9c,
+	0xbc, 0xfc, 0x11, 0x33, 0x4c, 0x19, 0xcd, 0x13, 0xcc, 0x0e, 0xd8, 0x77, 0x48, 0x75, 0x8b, 0xc5,
+	0xd0, 0x97, 0x8b, 0x88, 0x18, 0xc8, 0x1b, 0x51, 0xbd, 0xcc, 0x78, 0x14, 0x3e, 0xe7, 0x87, 0x1a,
+	0x32, 0x4a, 0x27, 0x66, 0x19, 0x71, 0x9f, 0xbc, 0x1d, 0x14, 0xc6, 0x30, 0x51, 0x5f, 0x3d, 0x84,
+	0xa8, 0x4f, 0x09, 0xde, 0xb1, 0x47, 0x24, 0x78, 0x59, 0x3e, 0x44, 0xb2, 0xcd, 0xec, 0x37, 0x11,
+	0xa8, 0x3e, 0xce, 0x06, 0xc1, 0xc8, 0x87, 0x48, 0x81, 0x21, 0x8b, 0x6f, 0x7f, 0x4c, 0x89, 0xee,
+	0x5a, 0x11, 0x4e, 0x4b, 0x73, 0x46, 0x1f, 0xb7, 0xd4, 0xfe, 0x74, 0x89, 0x4c, 0x5d, 0x0e, 0xfa,
+	0x6b, 0x97, 0xd7, 0xfa, 0x9b, 0xbe, 0xd7, 0xba, 0x46, 0xf7, 0x50, 0x34, 0xdf, 0xa6, 0x7b, 0x4b,
+	0x8b, 0x62, 0x05, 0xa9, 0x39, 0x73, 0x0d, 0x1b, 0x81, 0xc3, 0x50, 0x18, 0x6d, 0x79, 0x41, 0x87,
+	0x46, 0xbd, 0xc8, 0x13, 0xfe, 0x44, 0x43, 0x18, 0x5d, 0xd2, 0x20, 0x30, 0xf1, 0x90, 0x76, 0x78,
+	0x27, 0xa0, 0x51, 0xd6, 0x90, 0x5d, 0xc5, 0x46, 0xe0, 0x30, 0x44, 0x4a, 0xa2, 0x7e, 0x9c, 0x34,
+	0x2a, 0x69, 0xa4, 0x0d, 0x6c, 0x04, 0x0e, 0xc3, 0x95, 0x1e, 0xf7, 0x37, 0x59, 0x40, 0x46, 0x26,
+	0x2a, 0x7e, 0x9d, 0x37, 0x83, 0x84, 0x23, 0xea, 0x6d, 0xba, 0xb7, 0x88, 0x5b, 0xca, 0x4c, 0x72,
+	0xcc, 0x35, 0xde, 0x0c, 0x12, 0xce, 0x4a, 0xb8, 0xa5, 0x87, 0xe3, 0x7b, 0xae, 0x84, 0x5b, 0xba,
+	0xfb, 0x43, 0x36, 0xa7, 0x5f, 0xb7, 0xc8, 0x9b, 0x1e, 0x54, 0xeb, 0x19, 0xbf, 0x41, 0x27, 0x0a,
+	0xfb, 0xbd, 0xec, 0x24, 0x60, 0x77, 0x32, 0x00, 0x87, 0xa1, 0x80, 0xbe, 0xed, 0x05, 0xed, 0xac,
+	0x5d, 0x88, 0x57, 0x36, 0x00, 0x83, 0xa4, 0x83, 0xe6, 0xcb, 0x07, 0x28, 0xca, 0x5d, 0x19, 0x5a,
+	0x94, 0xfb, 0x57, 0x2d, 0x32, 0x69, 0x46, 0x80, 0xd9, 0x9d, 0x8c, 0x79, 0xbe, 0x3a, 0x50, 0xbc,
+	0xf4, 0x27, 0xf2, 0xae, 0x08, 0xeb, 0x78, 0x49, 0xd8, 0x8b, 0x9f, 0xa7, 0x41, 0xc7, 0x0b, 0x28,
+	0x3b, 0xcc, 0xe6, 0x71, 0x11, 0xa9, 0xf0, 0xb2, 0x85, 0xb0, 0x4d, 0x0f, 0x61, 0xdf, 0xe3, 0x41,
+	0xfb, 0x74, 0x36, 0x1e, 0xe3, 0xe1, 0x75, 0xf4, 0x16, 0x99, 0x4a, 0x14, 0x5b, 0xb6, 0xc1, 0x38,
+	0x44, 0xbd, 0x5e, 0x95, 0x9b, 0x9b, 0xa2, 0x04, 0x19, 0xca, 0xce, 0x4d, 0x72, 0x6a, 0x20, 0x6d,
+	0x6d, 0x04, 0xfb, 0x6f, 0xdf, 0xa4, 0x61, 0x07, 0xc8, 0x04, 0x12, 0x16, 0x45, 0x6b, 0xb0, 0xf2,
+	0x0d, 0x97, 0x76, 0xc8, 0x69, 0x1d, 0xaf, 0x10, 0x53, 0xa9, 0x88, 0xec, 0x84, 0xe1, 0x46, 0x16,
+	0x08, 0x83, 0xf8, 0x58, 0x6d, 0xfb, 0x44, 0x2a, 0x93, 0xb0, 0x20, 0x4b, 0x95, 0x89, 0xc3, 0x10,
+	0xd7, 0x0f, 0x8f, 0x86, 0x2f, 0x33, 0x8b, 0x47, 0x8b, 0x43, 0x0d, 0x02, 0x13, 0xcf, 0xf9, 0x42,
+	0x89, 0xd4, 0x64, 0xc8, 0xc6, 0x08, 0x5d, 0xc1, 0xc0, 0x21, 0x75, 0xaa, 0x83, 0xcf, 0x08, 0x89,
+	0x71, 0xfd, 0xe8, 0x41, 0x23, 0x2a, 0x52, 0x19, 0xdd, 0x85, 0x6a, 0xdb, 0x04, 0x26, 0x33, 0x48,
+	0xf3, 0xb6, 0x6f, 0x60, 0xc4, 0x76, 0x9c, 0xd0, 0xae, 0xe1, 0xb8, 0x74, 0x8c, 0x19, 0x36, 0xdb,
+	0x0a, 0x23, 0x8a, 0xf3, 0x09, 0x03, 0x5d, 0xd6, 0x15, 0xa6, 0xb6, 0x73, 0x75, 0x1b, 0x18, 0x94,
+	0x9c, 0xbf, 0x5f, 0x22, 0xd3, 0xd9, 0x2e, 0xd9, 0x1f, 0xc4, 0x58, 0x46, 0x7d, 0x33, 0x4b, 0x26,
+	0x4e, 0x65, 0x12, 0x0c, 0xd8, 0xfd, 0xbb, 0x33, 0x33, 0x83, 0x17, 0xeb, 0xcd, 0x9a, 0x28, 0x90,
+	0x22, 0xc6, 0x8f, 0xd6, 0xc4, 0x19, 0x70, 0x73, 0x6f, 0xbe, 0xd7, 0x13, 0xe7, 0x63, 0xc6, 0xd1,
+	0x9a, 0x09, 0x85, 0x0c, 0xb6, 0xbd, 0x46, 0xce, 0x18, 0x2d, 0xd7, 0xa9, 0xd7, 0xd9, 0xde, 0x0c,
+	0x23, 0xb9, 0xfd, 0x7d, 0x93, 0x8e, 0xaa, 0x1b, 0xc4, 0x81, 0xdc, 0x27, 0xd1, 0x24, 0x6b, 0xb9,
+	0x3d, 0xb7, 0xe5, 0x25, 0x7b, 0xc2, 0x13, 0xab, 0x14, 0xc8, 0x82, 0x68, 0x07, 0x85, 0xe1, 0xac,
+	0x90, 0xca, 0x88, 0x33, 0x68, 0xa4, 0x6d, 0xd7, 0x8b, 0xa4, 0x86, 0xe4, 0xa4, 0x0d, 0x5e, 0x04,
+	0xc9, 0x90, 0xd4, 0xe4, 0x1d, 0x25, 0xb6, 0x43, 0xca, 0x9e, 0x2b, 0x4f, 0x2f, 0xd5, 0x6b, 0x2d,
+	0xc5, 0x71, 0x9f, 0x09, 0x18, 0x04, 0xda, 0xcf, 0x92, 0x32, 0xdd, 0xed, 0x65, 0x8f, 0x29, 0x2f,
+	0xee, 0xf6, 0xbc, 0x88, 0xc6, 0x88, 0x44, 0x77, 0x7b, 0xf6, 0x39, 0x52, 0xf2, 0xe4, 0x0d, 0x03,
+	0x44, 0xe0, 0x94, 0x96, 0x16, 0xa1, 0xe4, 0xb5, 0x9d, 0x5d, 0x52, 0x97, 0x0c, 0x59, 0x8c, 0x15,
+	0x57, 0xb0, 0x56, 0x11, 0x31, 0x56, 0x92, 0xee, 0x10, 0xd5, 0xda, 0x27, 0x44, 0xa7, 0x54, 0x16,
+	0x25, 0x5f, 0xce, 0x93, 0x4a, 0x2b, 0x14, 0xe9, 0xde, 0x35, 0x4d, 0x86, 0x49, 0x7d, 0x06, 0x71,
+	0x6e, 0x92, 0xa9, 0x6b, 0x41, 0x78, 0x87, 0x15, 0x1d, 0x67, 0xc5, 0xc2, 0x90, 0xf0, 0x16, 0xfe,
+	0x93, 0x55, 0xe1, 0x0c, 0x0a, 0x1c, 0xa6, 0x4a, 0x54, 0x95, 0x86, 0x95, 0xa8, 0x72, 0x3e, 0x6e,
+	0x91, 0x69, 0x95, 0x18, 0x26, 0xa5, 0xf1, 0x0b, 0x64, 0x72, 0xb3, 0xef, 0xf9, 0x6d, 0xf1, 0x3b,
+	0xeb, 0x4b, 0x6a, 0x1a, 0x30, 0x48, 0x61, 0xe2, 0xce, 0x77, 0xd3, 0x0b, 0xdc, 0x68, 0x6f, 0x4d,
+	0x8b, 0x7f, 0x25, 0x11, 0x9a, 0x0a, 0x02, 0x06, 0x96, 0xf3, 0x29, 0xb3, 0x0b, 0x22, 0x15, 0x6d,
+	0x84, 0x91, 0x7d, 0x89, 0x54, 0x5b, 0xea, 0xb4, 0xfb, 0x50, 0x65, 0x12, 0x55, 0xa9, 0x01, 0x24,
+	0x03, 0x9c, 0x9a, 0xf3, 0x8f, 0x4b, 0xe4, 0x44, 0xaa, 0xbe, 0x8c, 0xed, 0x93, 0x1a, 0xf5, 0x99,
+	0xbf, 0x55, 0x4e, 0xb1, 0xa3, 0x16, 0xe2, 0x54, 0xcb, 0xe2, 0xa2, 0xa0, 0x0b, 0x8a, 0xc3, 0xe3,
+	0x71, 0xa8, 0xf8, 0x02, 0x99, 0x94, 0x1d, 0x7a, 0xbf, 0xdb, 0xf5, 0x1b, 0xe5, 0xf4, 0x04, 0xb8,
+	0x68, 0xc0, 0x20, 0x85, 0xe9, 0xfc, 0x5e, 0x99, 0x34, 0xb8, 0x83, 0xba, 0xad, 0x2c, 0xc0, 0x15,
+	0x69, 0x0a, 0xff, 0x15, 0x5d, 0x05, 0x8a, 0x0f, 0xe4, 0xe6, 0x51, 0xeb, 0x5e, 0xe7, 0x33, 0x1a,
+	0x29, 0x22, 0xe5, 0x97, 0x33, 0x11, 0x29, 0x5c, 0xd9, 0x76, 0x8e, 0xa9, 0x47, 0xdf, 0x5b, 0x21,
+	0x2a, 0x7f, 0xbb, 0x44, 0x4e, 0x66, 0x8a, 0x8a, 0x63, 0x59, 0x04, 0xb3, 0xa0, 0xa6, 0x55, 0x84,
+	0x1b, 0xf3, 0x81, 0x75, 0xa6, 0x0f, 0x56, 0x56, 0xf3, 0x11, 0x2d, 0x15, 0xe7, 0xf7, 0x4b, 0x64,
+	0x2a, 0x5d, 0x0d, 0xfd, 0x31, 0x1c, 0xa9, 0xb7, 0x92, 0x3a, 0x2b, 0xf8, 0xcb, 0xae, 0x0e, 0xe4,
+	0xde, 0x52, 0x5e, 0x24, 0x56, 0x36, 0x82, 0x86, 0x3f, 0x16, 0xd5, 0x4a, 0x9d, 0xbf, 0x6b, 0x91,
+	0xb3, 0xfc, 0x2d, 0xb3, 0xf3, 0xf0, 0xaf, 0xe6, 0x8d, 0xee, 0xcb, 0xc5, 0x76, 0x30, 0x53, 0xbd,
+	0x6c, 0xbf, 0xf1, 0x65, 0x57, 0x77, 0x89, 0xde, 0xa6, 0xa7, 0xc2, 0x63, 0xd8, 0xd9, 0x03, 0x4d,
+	0x06, 0xe7, 0xf7, 0xcb, 0x44, 0xdf, 0x56, 0x86, 0x55, 0xdc, 0x58, 0x5a, 0x59, 0x21, 0x55, 0xdc,
+	0x30, 0x32, 0x4c, 0x91, 0xe6, 0xde, 0x7b, 0x23, 0xab, 0xec, 0xe7, 0x2d, 0x74, 0x88, 0x7b, 0x89,
+	0xe7, 0x32, 0xe3, 0xb9, 0x98, 0xcb, 0x7e, 0x14, 0xbb, 0x25, 0x4e, 0x39, 0x8c, 0x4c, 0x17, 0xbb,
+	0x62, 0x06, 0x26, 0x67, 0xfb, 0xc3, 0x22, 0x68, 0xb4, 0x5c, 0x58, 0x7a, 0x66, 0x2d, 0x13, 0x29,
+	0xda, 0x23, 0xd5, 0x88, 0x26, 0x51, 0x41, 0x59, 0xcd, 0x80, 0xa4, 0x54, 0x41, 0x50, 0x7d, 0x5f,
+	0x2e, 0x36, 0x03, 0x67, 0xe4, 0xc4, 0xc4, 0x1e, 0x1c, 0x8b, 0x03, 0x06, 0xe4, 0x61, 0xc8, 0x61,
+	0x3f, 0x09, 0xbb, 0x38, 0x4c, 0xe2, 0x14, 0x40, 0x87, 0x1c, 0x4a, 0x00, 0x68, 0x1c, 0xe7, 0x73,
+	0x55, 0x92, 0xc9, 0xf3, 0xb2, 0x77, 0xcd, 0x9b, 0xf6, 0xac, 0x62, 0x6f, 0xda, 0x53, 0x9d, 0xc9,
+	0xbb, 0x6d, 0xcf, 0xee, 0x90, 0x6a, 0x6f, 0xdb, 0x8d, 0xa5, 0x6d, 0xfc, 0xa2, 0x1c, 0xa6, 0x35,
+	0x6c, 0xbc, 0x7f, 0x77, 0xe6, 0xbd, 0xa3, 0x39, 0x6b, 0x70, 0xae, 0xce, 0xf1, 0x22, 0x0e, 0x9a,
+	0x35, 0xa3, 0x01, 0x9c, 0xfe, 0x41, 0xae, 0x3b, 0xfa, 0x84, 0x28, 0xd1, 0x0c, 0x34, 0xee, 0xfb,
+	0x89, 0x98, 0x0d, 0x2f, 0x16, 0xb8, 0xca, 0x38, 0x61, 0x9d, 0x2f, 0xcd, 0x7f, 0x83, 0xc1, 0xd4,
+	0xfe, 0x20, 0xa9, 0xc7, 0x89, 0x1b, 0x25, 0x87, 0xcc, 0x29, 0x54, 0x83, 0xbe, 0x2e, 0x89, 0x80,
+	0xa6, 0x87, 0x69, 0x7c, 0x5b, 0x5e, 0xe0, 0xc5, 0xdb, 0x87, 0x8c, 0xf5, 0x96, 0x05, 0x30, 0x05,
+	0x05, 0x30, 0xa8, 0xe1, 0xd6, 0x83, 0xcd, 0x6d, 0x1e, 0xe0, 0x54, 0x63, 0x7b, 0x4b, 0x25, 0x0a,
+	0x41, 0x41, 0xc0, 0xc0, 0x72, 0x7e, 0x84, 0xa4, 0x13, 0xfe, 0x31, 0x66, 0x9b, 0xd7, 0x17, 0xe0,
+	0xbe, 0x27, 0x16, 0xb3, 0x9d, 0x2a, 0x05, 0xf0, 0x75, 0x8b, 0x98, 0x55, 0x09, 0xec, 0x57, 0x79,
+	0xf9, 0x03, 0xab, 0x88, 0x43, 0x1d, 0x83, 0xee, 0xec, 0x8a, 0xdb, 0xcb, 0x9c, 0x2e, 0xca, 0x1a,
+	0x08, 0x78, 0xe4, 0x27, 0xa1, 0x07, 0x32, 0xea, 0x3e, 0x46, 0x4e, 0x67, 0xef, 0x5f, 0x16, 0x07,
+	0x02, 0x45, 0xf8, 0x82, 0xf7, 0xbf, 0x6f, 0xf1, 0xb7, 0x2d, 0x72, 0x7e, 0xbf, 0x6b, 0xa2, 0xf1,
+	0x48, 0xf7, 0x8e, 0x1b, 0xc9, 0x6a, 0xc3, 0x4c, 0x50, 0xde, 0x74, 0xa3, 0x00, 0x58, 0x2b, 0x06,
+	0xb0, 0x8b, 0x5b, 0x19, 0x4b, 0x45, 0x04, 0xb0, 0xe7, 0x0c, 0xc7, 0xb0, 0xcb, 0x18, 0x9d, 0xab,
+	0xa4, 0xb1, 0xba, 0x43, 0xa3, 0xc8, 0x6b, 0xd3, 0xf9, 0x4e, 0x27, 0xa2, 0x1d, 0x14, 0x69, 0xdc,
+	0x47, 0x8b, 0xa9, 0x0f, 0xad, 0x6d, 0xcf, 0x6f, 0xe3, 0x70, 0xa4, 0x2a, 0xa8, 0x2d, 0xa8, 0x56,
+	0x30, 0x30, 0x9c, 0xef, 0x58, 0xc4, 0x96, 0xc4, 0x74, 0x85, 0x00, 0xcc, 0xca, 0xbb, 0xb5, 0xbe,
+	0x7a, 0x7d, 0x2d, 0xf4, 0x02, 0x56, 0x4c, 0xc4, 0xc8, 0xca, 0xbb, 0x6a, 0xb4, 0x43, 0x0a, 0x0b,
+	0x5d, 0xa7, 0xb7, 0x5e, 0xc5, 0x8d, 0xb4, 0x79, 0xa7, 0x41, 0x49, 0xbb, 0x4e, 0xaf, 0xbe, 0x98,
+	0x01, 0xc2, 0x20, 0xbe, 0xbd, 0x4a, 0xce, 0x76, 0xf9, 0xd6, 0x85, 0x97, 0x22, 0xe7, 0xfb, 0x18,
+	0x95, 0x94, 0xf3, 0xd4, 0xbd, 0xbb, 0x33, 0x67, 0x57, 0xf2, 0x10, 0x20, 0xff, 0x39, 0xe7, 0x9f,
+	0x56, 0x88, 0x99, 0x22, 0xf9, 0x08, 0x05, 0xfd, 0x1c, 0xa9, 0x4b, 0x27, 0x5c, 0x94, 0xad, 0x55,
+	0x2c, 0x7d, 0x76, 0x11, 0x68, 0x1c, 0xdb, 0x25, 0x13, 0xf2, 0xc7, 0xe1, 0xa2, 0x77, 0x8c, 0x52,
+	0x74, 0x8a, 0x0c, 0x98, 0x34, 0x51, 0xc8, 0x52, 0xe9, 0xed, 0x6a, 0x54, 0x0e, 0xcc, 0x20, 0xc7,
+	0x65, 0xa6, 0xe9, 0xb1, 0x7c, 0x2d, 0x6f, 0x6b, 0x4b, 0xe4, 0x7a, 0x66, 0x23, 0x09, 0x16, 0x35,
+	0x08, 0x4c, 0x3c, 0xfb, 0x47, 0xa5, 0x42, 0xe4, 0xa7, 0x68, 0x3f, 0x90, 0x55, 0x88, 0xd3, 0xc6,
+	0xe7, 0x4c, 0x29, 0xb8, 0x1f, 0x26, 0xd3, 0x6a, 0xf0, 0xd6, 0xfb, 0xbc, 0x54, 0x1b, 0x3b, 0x63,
+	0x85, 0x81, 0x76, 0xfb, 0x39, 0x72, 0x52, 0xb5, 0x31, 0x9f, 0x60, 0xc4, 0x24, 0x75, 0x1d, 0xb2,
+	0xcd, 0xce, 0xbb, 0x88, 0xcd, 0xc3, 0xdb, 0x16, 0xf2, 0x62, 0x95, 0x86, 0xba, 0x85, 0x9c, 0x2f,
+	0x57, 0xc9, 0xc9, 0x4c, 0x61, 0x54, 0xf4, 0x3b, 0x0c, 0x06, 0x47, 0x1d, 0xd9, 0x98, 0x1c, 0xec,
+	0xde, 0x48, 0xe1, 0x56, 0x78, 0x07, 0x69, 0xd0, 0xeb, 0x27, 0xc5, 0xe4, 0x4d, 0xf2, 0x4e, 0x2c,
+	0x21, 0x41, 0xc3, 0x63, 0x89, 0x3f, 0x81, 0xb3, 0x29, 0x32, 0x78, 0x2b, 0xb5, 0x33, 0xac, 0x3c,
+	0x22, 0xdf, 0xd4, 0x27, 0x74, 0x28, 0x55, 0xb5, 0x88, 0xd0, 0x9e, 0xcc, 0x64, 0x39, 0xee, 0x23,
+	0xf9, 0xdf, 0x28, 0x91, 0x09, 0xe3, 0xa3, 0xd9, 0x5f, 0x4d, 0x17, 0x90, 0xb2, 0x8a, 0x7b, 0x25,
+	0x46, 0x7f, 0x56, 0x97, 0x88, 0xe2, 0xaf, 0xf4, 0xe6, 0xc1, 0xda, 0x51, 0x6c, 0x71, 0xa7, 0xab,
+	0x43, 0xa5, 0xea, 0x49, 0x9d, 0xfb, 0x28, 0x39, 0x99, 0x21, 0x93, 0xf3, 0xca, 0x1b, 0xe9, 0x3b,
+	0xcf, 0x8f, 0xe8, 0x23, 0x35, 0x87, 0xec, 0x3f, 0x96, 0xc8, 0x54, 0xfa, 0x7e, 0x79, 0x8c, 0x45,
+	0x17, 0x45, 0x9f, 0x69, 0x2f, 0x4c, 0x5d, 0x55, 0xbb, 0xae, 0x9b, 0xc1, 0xc4, 0xc1, 0x88, 0xa0,
+	0x49, 0x23, 0xb6, 0x58, 0x3a, 0xfc, 0x8e, 0x27, 0xb4, 0x59, 0x09, 0x02, 0xa3, 0x31, 0x86, 0x14,
+	0x7f, 0x4c, 0x89, 0x6b, 0x64, 0xb2, 0xf9, 0x6e, 0x6e, 0x7b, 0x09, 0x65, 0x75, 0xd2, 0xf9, 0x66,
+	0x73, 0x6e, 0x34, 0xa5, 0xc0, 0x4c, 0x35, 0x34, 0x2d, 0x9a, 0xe7, 0x05, 0xdf, 0xc6, 0xc2, 0x10,
+	0xc2, 0x30, 0x94, 0xa5, 0xf3, 0x35, 0x9c, 0x99, 0x22, 0x65, 0x30, 0xf4, 0xe9, 0x08, 0x2e, 0xf8,
+	0x4c, 0x66, 0x70, 0x69, 0xc4, 0xcc, 0xe0, 0xe7, 0x48, 0xad, 0x17, 0xfa, 0x5e, 0xcb, 0x53, 0x15,
+	0x1d, 0x59, 0x41, 0xf5, 0x35, 0xd1, 0x06, 0x0a, 0x6a, 0xdf, 0x21, 0x75, 0x75, 0x0b, 0x7f, 0xa3,
+	0x52, 0xe8, 0xf1, 0x8e, 0xd2, 0xa1, 0xfa, 0x76, 0x7d, 0xcd, 0x0b, 0xd3, 0xc4, 0x99, 0xe1, 0x2b,
+	0xd3, 0x1c, 0x58, 0x9a, 0x38, 0x1b, 0xe6, 0x18, 0x04, 0xc4, 0xf9, 0xd9, 0x71, 0x72, 0x26, 0xaf,
+	0x08, 0xb8, 0xfd, 0x11, 0x32, 0xc6, 0xfb, 0x58, 0xcc, 0x3d, 0x13, 0x79, 0x3c, 0x2e, 0x33, 0x82,
+	0xa2, 0x5b, 0xec, 0x7f, 0x10, 0x3c, 0x05, 0x77, 0xdf, 0xdd, 0x6c, 0x94, 0x8e, 0x91, 0xfb, 0xb2,
+	0xab, 0xb9, 0x2f, 0xbb, 0x9c, 0xbb, 0xef, 0x6e, 0xda, 0xbb, 0xa4, 0xda, 0xf1, 0x12, 0xea, 0x0a,
+	0xb3, 0xe9, 0xe6, 0xb1, 0x30, 0xa7, 0x2e, 0xdf, 0x99, 0xb1, 0x7f, 0x81, 0x33, 0xc4, 0x42, 0x5f,
+	0x27, 0x37, 0xd3, 0x59, 0xf7, 0x42, 0x47, 0xb9, 0xc5, 0x77, 0x22, 0x93, 0xde, 0xcf, 0xef, 0xf7,
+	0xc9, 0x34, 0x42, 0xb6, 0x3b, 0x18, 0x05, 0x3c, 0xbe, 0xe5, 0xf9, 0x46, 0x49, 0xe1, 0x63, 0xf8,
+	0x38, 0x97, 0x18, 0x03, 0xed, 0x65, 0xe0, 0xbf, 0x63, 0x90, 0x9c, 0x87, 0x19, 0x04, 0x63, 0x47,
+	0x35, 0x08, 0xc6, 0x1f, 0x91, 0xab, 0xf8, 0x17, 0x4a, 0xe4, 0xd9, 0x11, 0xbe, 0x91, 0x99, 0x28,
+	0x6d, 0xed, 0x93, 0x28, 0x7d, 0x9e, 0x54, 0x22, 0xcc, 0x28, 0xc8, 0x6c, 0x77, 0x59, 0x36, 0x01,
+	0x83, 0x60, 0x45, 0x72, 0xb7, 0xe7, 0x89, 0xdd, 0xae, 0xda, 0xa3, 0xcf, 0xaf, 0x2d, 0x01, 0xb6,
+	0xe3, 0x97, 0xae, 0x6f, 0xca, 0x5a, 0x10, 0xc5, 0xdc, 0xb5, 0x35, 0xac, 0xb4, 0x04, 0x77, 0xde,
+	0x2a, 0x28, 0x68, 0xbe, 0xce, 0x5f, 0xb3, 0xc8, 0xb9, 0xe1, 0x53, 0x04, 0x95, 0xe8, 0x66, 0xe4,
+	0x06, 0xad, 0x6d, 0x76, 0x31, 0x9d, 0x1c, 0x14, 0x96, 0x1f, 0xab, 0x9b, 0xc1, 0xc4, 0xc1, 0xcd,
+	0x26, 0xaf, 0xde, 0x6f, 0x60, 0xc8, 0x74, 0x38, 0xdc, 0x6c, 0x6e, 0x64, 0x81, 0x30, 0x88, 0xef,
+	0xfc, 0x5e, 0x29, 0xbf, 0x5b, 0x5c, 0x94, 0x1c, 0xe4, 0x3b, 0x89, 0xaf, 0x50, 0x1a, 0xf2, 0x15,
+	0x5e, 0x25, 0xb5, 0x84, 0xe5, 0xf8, 0xd2, 0x2d, 0x21, 0x8f, 0x0a, 0xab, 0xa1, 0xc1, 0x34, 0xd6,
+	0x86, 0x20, 0x0e, 0x8a, 0x0d, 0x2a, 0x0e, 0x5f, 0x97, 0x1b, 0x16, 0x8a, 0x23, 0x73, 0xf2, 0xb8,
+	0x48, 0xa6, 0x8d, 0x5b, 0x21, 0x78, 0x8a, 0x23, 0xdf, 0xa5, 0xa9, 0xbc, 0xff, 0xb5, 0x0c, 0x1c,
+	0x06, 0x9e, 0x70, 0x7e, 0xb5, 0x44, 0x9e, 0x1a, 0x2a, 0x1f, 0x75, 0x28, 0xa6, 0xf5, 0x80, 0x50,
+	0xcc, 0x23, 0x4f, 0x73, 0x73, 0x80, 0x2b, 0x0f, 0x67, 0x80, 0xdf, 0x46, 0x6a, 0x5e, 0x10, 0xd3,
+	0x56, 0x3f, 0xe2, 0x83, 0x66, 0x24, 0xfc, 0x2c, 0x89, 0x76, 0x50, 0x18, 0xce, 0x1f, 0x0c, 0x9f,
+	0x6a, 0xa8, 0x2b, 0xbf, 0x6f, 0x47, 0xe9, 0xdd, 0xe4, 0x84, 0xdb, 0xeb, 0x71, 0x3c, 0x16, 0x51,
+	0x95, 0xa9, 0xe4, 0x31, 0x6f, 0x02, 0x21, 0x8d, 0x6b, 0xcc, 0xe1, 0xb1, 0x61, 0x73, 0xd8, 0xf9,
+	0x13, 0x8b, 0xd4, 0x81, 0x6e, 0xf1, 0xf5, 0x8e, 0xe5, 0x06, 0xd9, 0x10, 0x59, 0x45, 0x94, 0x1b,
+	0x64, 0xe6, 0xba, 0xc7, 0xca, 0xf0, 0xe5, 0x0d, 0xf6, 0xe0, 0x75, 0x23, 0xa5, 0x03, 0x5d, 0x37,
+	0xa2, 0x2e, 0x9c, 0x28, 0x0f, 0xbf, 0x70, 0xc2, 0xf9, 0xb3, 0x71, 0x7c, 0xbd, 0x5e, 0x88, 0x75,
+	0xf1, 0x63, 0xfc, 0xbe, 0xfd, 0xc8, 0x6f, 0x58, 0xe9, 0xef, 0x8b, 0xf9, 0x06, 0xd8, 0x9e, 0x3a,
+	0x36, 0x29, 0x1d, 0xa8, 0x8e, 0x41, 0x79, 0xdf, 0x3a, 0x06, 0x98, 0x7b, 0x1c, 0x6f, 0xaf, 0x45,
+	0xde, 0x8e, 0x9b, 0xa0, 0x7f, 0xb2, 0x51, 0x49, 0x7f, 0xc8, 0xf5, 0xf5, 0x2b, 0x1a, 0x08, 0x69,
+	0x5c, 0x4c, 0xfd, 0xd5, 0xd5, 0x04, 0x68, 0x94, 0xb0, 0x20, 0x69, 0x3e, 0x13, 0x54, 0xea, 0xaf,
+	0xae, 0x3f, 0x20, 0x10, 0x60, 0xf0, 0x19, 0x94, 0x58, 0xa9, 0x46, 0xec, 0xc8, 0x58, 0x5a, 0x62,
+	0xa5, 0xe8, 0x60, 0x5f, 0x06, 0x9e, 0xc0, 0x32, 0x6f, 0x7c, 0x62, 0xcc, 0xf7, 0x7a, 0xc6, 0x1b,
+	0x8d, 0xa7, 0xcb, 0xbc, 0x5d, 0x1e, 0x44, 0x81, 0xbc, 0xe7, 0x70, 0xf7, 0xa1, 0x9a, 0x97, 0x16,
+	0x85, 0xc7, 0x5f, 0xed, 0x3e, 0x14, 0x99, 0xa5, 0x36, 0x98, 0x78, 0x78, 0xbd, 0x81, 0xfe, 0xc9,
+	0x33, 0x69, 0xf8, 0x31, 0xd8, 0xa2, 0x28, 0xd4, 0xa2, 0xae, 0x37, 0xb8, 0x9c, 0x8b, 0xd6, 0x86,
+	0x61, 0xcf, 0xdb, 0x9b, 0xe4, 0x9c, 0x02, 0x5d, 0x0c, 0x12, 0x16, 0x16, 0x1f, 0xd3, 0xa6, 0x1b,
+	0xd3, 0x97, 0x22, 0x9f, 0x95, 0x76, 0xa9, 0xeb, 0xab, 0xe1, 0x2e, 0x7b, 0xc9, 0x95, 0x3c, 0x4c,
+	0x58, 0x86, 0x07, 0x50, 0x41, 0x77, 0x26, 0x0d, 0xdc, 0x4d, 0x9f, 0xae, 0x2e, 0x2c, 0x35, 0x26,
+	0xd2, 0xa7, 0x6e, 0x17, 0x25, 0x00, 0x34, 0x8e, 0x8a, 0x01, 0x9b, 0x1c, 0x7a, 0x4d, 0xe1, 0x1a,
+	0x39, 0xd3, 0x69, 0xf5, 0xd0, 0x9a, 0xf0, 0x5a, 0x74, 0xbe, 0xc5, 0xe2, 0xa0, 0xf0, 0xc3, 0xf0,
+	0xfa, 0x7b, 0x2a, 0xc0, 0xf1, 0xf2, 0xc2, 0xda, 0x00, 0x0e, 0xe4, 0x3e, 0x89, 0x6b, 0xac, 0x17,
+	0x85, 0xbb, 0x7b, 0x8d, 0xd3, 0xe9, 0x35, 0xb6, 0x86, 0x8d, 0xc0, 0x61, 0xf6, 0x55, 0x62, 0xb3,
+	0x68, 0xd9, 0x2b, 0x49, 0xd2, 0x53, 0xe6, 0x4b, 0xe3, 0x0c, 0x7b, 0xa5, 0x73, 0xe2, 0x09, 0xfb,
+	0xd2, 0x00, 0x06, 0xe4, 0x3c, 0x85, 0xb4, 0x5a, 0xea, 0x0a, 0x0b, 0x75, 0x31, 0xd6, 0x59, 0xc6,
+	0x5d, 0xd1, 0x5a, 0x18, 0xc0, 0x80, 0x9c, 0xa7, 0x9c, 0x3f, 0xb6, 0xc8, 0x09, 0xb5, 0xf6, 0x1f,
+	0x42, 0x82, 0x80, 0x9f, 0x4e, 0x10, 0xb8, 0x7c, 0x74, 0xe9, 0xc9, 0x7a, 0x3e, 0x24, 0x80, 0xf1,
+	0xb7, 0x4e, 0x10, 0xa2, 0x25, 0xac, 0x52, 0x6e, 0xd6, 0x50, 0xe5, 0xf6, 0xd8, 0x4a, 0xb7, 0xbc,
+	0x4a, 0x11, 0xd5, 0x47, 0x5b, 0x29, 0x62, 0x9d, 0x9c, 0x95, 0xa6, 0x07, 0x3f, 0xd7, 0xc1, 0x48,
+	0x67, 0x29, 0x2c, 0x6b, 0xcd, 0xa7, 0x05, 0xa1, 0xb3, 0x4b, 0x79, 0x48, 0x90, 0xff, 0x6c, 0xca,
+	0xe2, 0x19, 0xdf, 0xcf, 0xe2, 0xd1, 0xf2, 0x61, 0x79, 0x4b, 0xde, 0x89, 0x90, 0x91, 0x0f, 0xcb,
+	0x97, 0xd6, 0x41, 0xe3, 0xe4, 0x2b, 0x89, 0x7a, 0x41, 0x4a, 0x82, 0x1c, 0x58, 0x49, 0x48, 0x71,
+	0x35, 0x31, 0x54, 0x5c, 0x49, 0xbf, 0xd4, 0xe4, 0x50, 0xbf, 0xd4, 0x7b, 0xc8, 0x94, 0x17, 0x6c,
+	0xd3, 0xc8, 0x4b, 0x68, 0x9b, 0xad, 0x05, 0x26, 0xca, 0x6a, 0xda, 0x44, 0x58, 0x4a, 0x41, 0x21,
+	0x83, 0x9d, 0x96, 0xb1, 0x53, 0x23, 0xc8, 0xd8, 0x21, 0x9a, 0xed, 0x64, 0x31, 0x9a, 0x6d, 0xfa,
+	0xe8, 0x9a, 0xed, 0xd4, 0xb1, 0x6a, 0x36, 0xbb, 0x10, 0xcd, 0x36, 0x92, 0xd2, 0x30, 0x36, 0x87,
+	0x67, 0xf6, 0xd9, 0x1c, 0x0e, 0x53, 0x6b, 0x67, 0x0f, 0xad, 0xd6, 0xf2, 0x35, 0xd6, 0x13, 0x05,
+	0x6a, 0xac, 0x27, 0x0f, 0xa3, 0xb1, 0xec, 0xf7, 0x92, 0x69, 0x3e, 0x17, 0xd7, 0xfb, 0x9b, 0xdd,
+	0xb0, 0xdd, 0xc7, 0x34, 0xd3, 0x06, 0xeb, 0xd5, 0x19, 0x5c, 0x75, 0x17, 0x33, 0x30, 0x18, 0xc0,
+	0xc6, 0xcb, 0x72, 0xce, 0xc4, 0xf2, 0xa7, 0x79, 0x79, 0xd4, 0x53, 0x45, 0x04, 0x36, 0xac, 0xe7,
+	0x50, 0xd6, 0x1f, 0x20, 0x0f, 0x0a, 0xb9, 0xbd, 0x71, 0x3e, 0x59, 0x22, 0x67, 0xb5, 0xf2, 0x42,
+	0x91, 0xe1, 0x6d, 0x21, 0x37, 0x76, 0x17, 0x11, 0x3f, 0x57, 0x30, 0xf2, 0x47, 0x74, 0x2a, 0x8a,
+	0x82, 0x80, 0x81, 0xc5, 0xd2, 0x30, 0x68, 0xc4, 0x4a, 0x9b, 0x66, 0x35, 0xdb, 0x82, 0x68, 0x07,
+	0x85, 0x81, 0x8b, 0x12, 0xff, 0x17, 0xf9, 0x87, 0xd9, 0x8a, 0x62, 0x0b, 0x1a, 0x04, 0x26, 0x1e,
+	0x3a, 0xbb, 0x5b, 0x52, 0xaa, 0xa2, 0x76, 0x9b, 0x14, 0xb7, 0x87, 0x8a, 0x36, 0x50, 0x50, 0xd9,
+	0x1d, 0x96, 0x6f, 0x53, 0x1d, 0xec, 0x0e, 0xb6, 0x83, 0xc2, 0x70, 0xfe, 0xa7, 0x45, 0x9e, 0xca,
+	0x1d, 0x8a, 0x87, 0x60, 0xb1, 0xec, 0xa6, 0x2d, 0x96, 0xf5, 0xa2, 0xf6, 0x7b, 0xc6, 0x5b, 0x0c,
+	0xb1, 0x5e, 0xfe, 0x8d, 0x45, 0xa6, 0x34, 0xfe, 0x43, 0x78, 0x55, 0x2f, 0xfd, 0xaa, 0xc5, 0x6d,
+	0x6d, 0xeb, 0x03, 0xef, 0xf6, 0xc7, 0xec, 0xdd, 0xf8, 0xa9, 0xcc, 0x7c, 0x4b, 0xd6, 0x50, 0xdd,
+	0xe7, 0x08, 0x06, 0x6f, 0x6e, 0xc4, 0xa3, 0xb9, 0xb8, 0x98, 0x88, 0x98, 0x34, 0x7f, 0x76, 0xe8,
+	0xa7, 0x0f, 0x41, 0xd9, 0xcf, 0x18, 0x04, 0x43, 0x56, 0x78, 0xd7, 0x8b, 0x51, 0x90, 0xb4, 0x45,
+	0xe6, 0x8a, 0x2e, 0xbc, 0x2b, 0xda, 0x41, 0x61, 0x38, 0x5d, 0xd2, 0x48, 0x13, 0x5f, 0xa4, 0x5b,
+	0x2c, 0xca, 0x72, 0xa4, 0xd7, 0xc4, 0x58, 0x43, 0xf6, 0xd4, 0x72, 0xdf, 0xcd, 0x06, 0x71, 0xcc,
+	0x4b, 0x00, 0x68, 0x1c, 0xe7, 0xef, 0x58, 0xe4, 0x74, 0xce, 0xcb, 0x14, 0x98, 0xb1, 0x93, 0x68,
+	0x29, 0x90, 0x67, 0xa5, 0xfc, 0x10, 0x19, 0x6f, 0xd3, 0x2d, 0x57, 0xc6, 0xf1, 0x19, 0x8a, 0x6a,
+	0x91, 0x37, 0x83, 0x84, 0x3b, 0xbf, 0x59, 0x22, 0x27, 0xd3, 0x7d, 0x8d, 0x51, 0x3d, 0xf0, 0x97,
+	0x59, 0xf4, 0xe2, 0x56, 0xb8, 0x43, 0xa3, 0x3d, 0x7c, 0x73, 0x2b, 0xad, 0x1e, 0xe6, 0x07, 0x30,
+	0x20, 0xe7, 0x29, 0x56, 0x7b, 0xb3, 0xad, 0x46, 0x5b, 0xce, 0x94, 0x1b, 0x45, 0xce, 0x14, 0xfd,
+	0x31, 0xcd, 0xf3, 0x3f, 0xc5, 0x12, 0x4c, 0xfe, 0x39, 0xef, 0xb6, 0x70, 0x71, 0xb9, 0x51, 0x7e,
+	0xe0, 0xbb, 0x2d, 0x5c, 0x5c, 0x86, 0x9c, 0xa7, 0x9c, 0xef, 0x54, 0x88, 0x4a, 0x0f, 0x64, 0x41,
+	0x54, 0x8f, 0x6d, 0x6a, 0x33, 0x2a, 0x10, 0xee, 0x8a, 0x32, 0x3d, 0xbe, 0x6a, 0xb4, 0x36, 0x34,
+	0x08, 0x4c, 0x3c, 0xec, 0x89, 0xef, 0xed, 0x50, 0xfe, 0xd0, 0x58, 0xba, 0x27, 0xcb, 0x12, 0x00,
+	0x1a, 0x07, 0x7b, 0x82, 0x71, 0x3d, 0x8d, 0xf1, 0x74, 0x4f, 0x70, 0x74, 0x80, 0x41, 0x78, 0x69,
+	0xe6, 0xf0, 0xb6, 0xd8, 0x1e, 0x18, 0xa5, 0x99, 0xc3, 0xdb, 0xc0, 0x20, 0x68, 0xd0, 0x06, 0x61,
+	0xd4, 0x65, 0x97, 0x8b, 0xb7, 0x15, 0x97, 0x46, 0x3d, 0x6d, 0xd0, 0x5e, 0x1f, 0x44, 0x81, 0xbc,
+	0xe7, 0xf0, 0x8b, 0xf7, 0x22, 0xda, 0xf6, 0x5a, 0x89, 0x49, 0x8d, 0xa4, 0xbf, 0xf8, 0xda, 0x00,
+	0x06, 0xe4, 0x3c, 0x85, 0x15, 0x1d, 0x64, 0x7a, 0xa7, 0xac, 0xb0, 0x32, 0x91, 0xae, 0xe8, 0x00,
+	0x69, 0x30, 0x64, 0xf1, 0x51, 0x72, 0x75, 0x45, 0x71, 0xa5, 0xc6, 0x64, 0x5a, 0x72, 0xc9, 0xa2,
+	0x4b, 0xa0, 0x30, 0x9c, 0x4f, 0x94, 0x51, 0xd3, 0x0e, 0xb9, 0xea, 0xe7, 0xa1, 0x85, 0x4f, 0xa6,
+	0x67, 0x64, 0x65, 0x84, 0x19, 0x89, 0xe1, 0x84, 0x71, 0x18, 0xa8, 0x70, 0xc2, 0xea, 0xd0, 0x70,
+	0x42, 0x03, 0x2b, 0x3f, 0x9c, 0x70, 0xac, 0xa8, 0x70, 0xc2, 0xf1, 0x43, 0x86, 0x13, 0x7e, 0xab,
+	0x4a, 0xd4, 0xf5, 0x14, 0xd7, 0x69, 0x72, 0x27, 0x8c, 0x6e, 0x7b, 0x41, 0x87, 0xa5, 0xc5, 0x7e,
+	0xc5, 0x22, 0x93, 0x7c, 0xbd, 0x2c, 0x9b, 0xa9, 0x65, 0x5b, 0x05, 0xd5, 0xfa, 0x4f, 0x31, 0x9b,
+	0xdd, 0x30, 0x18, 0x65, 0xee, 0x78, 0x34, 0x41, 0x90, 0xea, 0x91, 0xfd, 0x51, 0x42, 0xa4, 0x13,
+	0x7a, 0x4b, 0x8a, 0xdf, 0xa5, 0x62, 0xfa, 0x87, 0x87, 0x00, 0xca, 0xce, 0xdd, 0x50, 0x4c, 0xc0,
+	0x60, 0x88, 0xf7, 0xb8, 0x48, 0x87, 0x3e, 0x0f, 0x2b, 0xf9, 0xf0, 0xb1, 0x8c, 0xcd, 0x28, 0x49,
+	0x77, 0x80, 0x17, 0x16, 0x77, 0x70, 0x9e, 0x88, 0x70, 0x8e, 0xb7, 0xe4, 0xa5, 0x94, 0x2f, 0x87,
+	0x6e, 0xbb, 0xe9, 0xfa, 0x6e, 0xd0, 0xc2, 0xa2, 0xa0, 0x0c, 0xdd, 0xbc, 0xd9, 0x98, 0x35, 0x80,
+	0x24, 0x34, 0x70, 0x99, 0x45, 0x75, 0x94, 0xcb, 0x2c, 0xf0, 0x82, 0xc3, 0x81, 0x8f, 0x79, 0xa0,
+	0x1c, 0xbb, 0xc3, 0xa7, 0xe7, 0x39, 0xff, 0x64, 0x4c, 0x2b, 0x2d, 0x4c, 0x9f, 0x67, 0x57, 0x2a,
+	0x44, 0xfa, 0x8b, 0x0a, 0x3b, 0xb6, 0xc0, 0x29, 0x62, 0x84, 0xa4, 0xaa, 0x46, 0x30, 0x59, 0xe2,
+	0x1c, 0xed, 0xb9, 0x11, 0x0d, 0x8e, 0x7b, 0x8e, 0xae, 0x29, 0x26, 0x60, 0x30, 0xb4, 0xb7, 0x53,
+	0x49, 0x36, 0x97, 0x8e, 0x9e, 0x64, 0xc3, 0x2a, 0x22, 0xe5, 0x95, 0x65, 0xff, 0xbc, 0x45, 0xa6,
+	0x82, 0xd4, 0xcc, 0x2d, 0x26, 0x94, 0x31, 0x7f, 0x55, 0xf0, 0xdb, 0x8d, 0xd2, 0x6d, 0x90, 0xe1,
+	0x9f, 0xa7, 0xd2, 0xaa, 0x07, 0x54, 0x69, 0xfa, 0x6e, 0x96, 0xb1, 0x61, 0x77, 0xb3, 0xd8, 0x81,
+	0xba, 0xa8, 0x6b, 0xbc, 0xf0, 0x8b, 0xba, 0x48, 0xce, 0x25, 0x5d, 0x37, 0x49, 0xbd, 0x15, 0x51,
+	0x37, 0x39, 0xe4, 0x9d, 0x4d, 0x2c, 0xae, 0x61, 0x41, 0x12, 0x00, 0x4d, 0xcb, 0xf9, 0x95, 0x2a,
+	0x99, 0x96, 0x23, 0x22, 0xe3, 0xe8, 0x51, 0x3f, 0x72, 0xbe, 0xda, 0x50, 0x56, 0xfa, 0xf1, 0x8a,
+	0x04, 0x80, 0xc6, 0x41, 0x7b, 0xac, 0x1f, 0xd3, 0xd5, 0x1e, 0x0d, 0xf0, 0xca, 0x63, 0x71, 0x98,
+	0xac, 0x16, 0xca, 0x4b, 0x1a, 0x04, 0x26, 0x1e, 0x1a, 0xf6, 0xdc, 0x0e, 0x8d, 0xb3, 0xf9, 0x3c,
+	0xc2, 0x76, 0x07, 0x09, 0xb7, 0xbf, 0x94, 0x7b, 0xf7, 0x60, 0x31, 0x99, 0x6c, 0x03, 0xe9, 0x03,
+	0x07, 0xbc, 0x74, 0xf0, 0x73, 0x16, 0x39, 0x79, 0x3b, 0x55, 0x52, 0x40, 0x8a, 0xe4, 0x23, 0x56,
+	0x28, 0x4a, 0xd7, 0x29, 0xd0, 0x53, 0x38, 0xdd, 0x1e, 0x43, 0x96, 0xbb, 0xfe, 0x80, 0xb8, 0x1b,
+	0x18, 0xcb, 0xfb, 0x80, 0xb8, 0x09, 0xd0, 0x38, 0x58, 0x92, 0x7c, 0xda, 0xcd, 0xe4, 0x62, 0x88,
+	0xa9, 0x7d, 0xa3, 0x98, 0xd1, 0xcd, 0x66, 0x7a, 0x70, 0x7f, 0x5a, 0xb6, 0x15, 0x06, 0x7a, 0xe1,
+	0xfc, 0x77, 0x8b, 0x98, 0xa2, 0x76, 0x34, 0x2b, 0xd1, 0xb8, 0x0a, 0xba, 0xb4, 0xcf, 0x55, 0xd0,
+	0xd2, 0xa0, 0x2c, 0x8f, 0xb6, 0x81, 0xa9, 0x1c, 0x60, 0x03, 0x53, 0x1d, 0x6a, 0x81, 0xe2, 0x31,
+	0xb8, 0xd7, 0x6e, 0x8c, 0x65, 0x8e, 0xc1, 0x97, 0x16, 0x01, 0xdb, 0x9d, 0xdf, 0xac, 0x6a, 0xff,
+	0x85, 0x48, 0x26, 0xfb, 0xbe, 0x78, 0xed, 0x2d, 0x55, 0xd8, 0x89, 0xbf, 0xf9, 0xf5, 0x81, 0xc2,
+	0x4e, 0x3f, 0x7e, 0xf0, 0x5c, 0x41, 0x3e, 0x40, 0xc3, 0x0a, 0x50, 0x8d, 0xef, 0x93, 0x28, 0x78,
+	0x8b, 0xd4, 0x70, 0x9b, 0xc6, 0x1c, 0x91, 0xb5, 0x54, 0xa7, 0x6a, 0x57, 0x44, 0xfb, 0xfd, 0xbb,
+	0x33, 0x3f, 0x76, 0xf0, 0x6e, 0xc9, 0xa7, 0x41, 0xd1, 0xb7, 0x63, 0x52, 0xc7, 0xff, 0x59, 0xca,
+	0x87, 0xd8, 0x00, 0xbe, 0xa4, 0x96, 0xa5, 0x04, 0x14, 0x92, 0x30, 0xa9, 0xf9, 0xd8, 0x01, 0xa9,
+	0xc7, 0x32, 0xcf, 0x44, 0xec, 0x13, 0xd7, 0x24, 0x53, 0x95, 0x80, 0x72, 0xff, 0xee, 0xcc, 0xbb,
+	0x0f, 0xce, 0x54, 0x3d, 0x0e, 0x9a, 0x85, 0xf3, 0x85, 0x8a, 0x9e, 0xbb, 0xfc, 0xb3, 0x7e, 0x7f,
+	0xcc, 0xdd, 0x17, 0x32, 0x73, 0xf7, 0xfc, 0xc0, 0xdc, 0x9d, 0xd2, 0xb7, 0x7b, 0xa6, 0x66, 0xe3,
+	0xc3, 0x36, 0x16, 0xf6, 0xf7, 0x49, 0xcc, 0xf3, 0xdc, 0x21, 0x2f, 0xa2, 0xf1, 0x5a, 0xd4, 0x0f,
+	0xb0, 0x12, 0x57, 0x9d, 0x21, 0x1b, 0x56, 0x52, 0x0a, 0x0c, 0x59, 0x7c, 0xdc, 0xf8, 0xe3, 0x37,
+	0xbf, 0xe9, 0xee, 0xf0, 0x59, 0x65, 0x54, 0x28, 0x5a, 0x17, 0xed, 0xa0, 0x30, 0x9c, 0xaf, 0xb1,
+	0x40, 0x00, 0x23, 0x99, 0x1a, 0xe7, 0x84, 0xcf, 0x2e, 0xcd, 0xe5, 0xe5, 0x8d, 0xd4, 0x9c, 0xe0,
+	0x37, 0xe5, 0x72, 0x98, 0x7d, 0x87, 0x8c, 0x6f, 0xf2, 0xbb, 0xc9, 0x8a, 0xa9, 0xb7, 0x2d, 0x2e,
+	0x3a, 0x63, 0x57, 0x62, 0xc8, 0x5b, 0xcf, 0xee, 0xeb, 0x7f, 0x41, 0x72, 0x73, 0xbe, 0x59, 0x21,
+	0x27, 0x65, 0x98, 0x93, 0xb8, 0xff, 0x2f, 0x55, 0xfd, 0xb3, 0xb4, 0x6f, 0xf5, 0xcf, 0x0f, 0x11,
+	0xd2, 0xa6, 0x3d, 0x3f, 0xdc, 0x63, 0x26, 0xdb, 0xc1, 0x13, 0xd3, 0x94, 0x95, 0xbf, 0xa8, 0xa8,
+	0x80, 0x41, 0x51, 0xd4, 0x74, 0xe2, 0xc5, 0x44, 0x33, 0x35, 0x9d, 0x8c, 0x92, 0xf7, 0x63, 0x0f,
+	0xb7, 0xe4, 0xbd, 0x47, 0x4e, 0xf2, 0x2e, 0xaa, 0x94, 0xe5, 0x43, 0x64, 0x26, 0xb3, 0x00, 0xf0,
+	0xc5, 0x34, 0x19, 0xc8, 0xd2, 0x7d, 0xa4, 0xb7, 0x26, 0xbf, 0x95, 0xd4, 0xe5, 0x77, 0x8e, 0x1b,
+	0x75, 0x5d, 0xf6, 0x41, 0x4e, 0x03, 0x76, 0x7f, 0xb0, 0xf8, 0xd7, 0xf9, 0x6c, 0x09, 0x2d, 0x6c,
+	0xfe, 0x4b, 0x95, 0xef, 0x79, 0x33, 0x19, 0x73, 0xfb, 0xc9, 0x76, 0x38, 0x70, 0xe1, 0xda, 0x3c,
+	0x6b, 0x05, 0x01, 0xb5, 0x97, 0x49, 0xa5, 0xad, 0x4b, 0xb2, 0x1c, 0x64, 0x14, 0xb5, 0xb3, 0xd2,
+	0x4d, 0x28, 0x30, 0x2a, 0x98, 0x11, 0x9c, 0xb8, 0x1d, 0x99, 0x29, 0xc2, 0x32, 0x82, 0x37, 0x5c,
+	0x2c, 0xf2, 0x8c, 0xad, 0xa6, 0xd2, 0xac, 0xec, 0xa3, 0x34, 0x31, 0xd8, 0xc4, 0xeb, 0x04, 0x6e,
+	0x82, 0x11, 0x16, 0xfa, 0x90, 0x4d, 0x07, 0x9b, 0x98, 0x40, 0x48, 0xe3, 0x3a, 0xbf, 0x33, 0x49,
+	0xce, 0xac, 0x2f, 0xac, 0xc8, 0x23, 0xd7, 0x63, 0x4b, 0xf6, 0xc8, 0xe3, 0xf1, 0xf0, 0x92, 0x3d,
+	0x86, 0x70, 0xf7, 0x8d, 0x64, 0x0f, 0xdf, 0x48, 0xf6, 0xf8, 0x24, 0x46, 0xb9, 0xcb, 0x68, 0x74,
+	0x11, 0x61, 0xfd, 0xc1, 0xe2, 0x7b, 0xa0, 0x02, 0xde, 0x45, 0xa8, 0xbb, 0xfc, 0x09, 0x9a, 0xf9,
+	0xf1, 0x65, 0x7f, 0x3c, 0xb0, 0x43, 0x07, 0xca, 0xfe, 0x50, 0xa9, 0x31, 0xd5, 0x22, 0x52, 0x63,
+	0x86, 0x7c, 0xaa, 0xdc, 0xd4, 0x98, 0xcf, 0x63, 0xa9, 0xab, 0xd7, 0xfa, 0x11, 0x5d, 0xa4, 0x3b,
+	0xab, 0xbd, 0x58, 0x08, 0xd8, 0x97, 0x8b, 0xef, 0xc0, 0xbc, 0x66, 0x22, 0x6e, 0x86, 0xd1, 0x0d,
+	0x60, 0x76, 0x21, 0x95, 0x0a, 0x33, 0x5e, 0x44, 0x2a, 0x4c, 0x5e, 0x77, 0xf6, 0x4d, 0x85, 0x79,
+	0x37, 0x39, 0xd1, 0xf2, 0xc3, 0x80, 0xae, 0x45, 0x61, 0x12, 0xb6, 0x42, 0xbf, 0x51, 0x4b, 0x8b,
+	0x84, 0x05, 0x13, 0x08, 0x69, 0xdc, 0x61, 0x79, 0x34, 0xf5, 0xa3, 0xe6, 0xd1, 0x90, 0x47, 0x94,
+	0x58, 0xfb, 0x73, 0x3a, 0xb1, 0x76, 0x82, 0x7d, 0x91, 0x0f, 0x15, 0xff, 0x45, 0x46, 0xc9, 0xae,
+	0xc5, 0x7d, 0x3d, 0x5e, 0x3e, 0x86, 0xe6, 0x28, 0x96, 0xfc, 0xf7, 0x12, 0x76, 0x48, 0x33, 0x71,
+	0xe1, 0x95, 0x63, 0x98, 0xb0, 0x37, 0xd7, 0x35, 0x1b, 0x75, 0x0b, 0x9a, 0x6e, 0x82, 0x74, 0x47,
+	0x8e, 0x92, 0xf8, 0xfb, 0xe5, 0x12, 0xf9, 0x81, 0x7d, 0xbb, 0x60, 0xdf, 0xc1, 0xa3, 0x82, 0x8e,
+	0x98, 0xa8, 0x0d, 0xab, 0x88, 0x88, 0xd0, 0x0d, 0x49, 0x8f, 0xd7, 0xa4, 0x50, 0x3f, 0xd9, 0x21,
+	0x81, 0xfc, 0x9f, 0x05, 0x82, 0x86, 0xfe, 0x40, 0xa9, 0x48, 0x08, 0x7d, 0x0a, 0x0c, 0x82, 0xea,
+	0x3f, 0xa2, 0x1d, 0x7d, 0x23, 0xae, 0xfa, 0x7c, 0xc0, 0x5a, 0x41, 0x40, 0xd1, 0xaf, 0xe6, 0xfa,
+	0x3e, 0x4f, 0xf8, 0xa1, 0x71, 0xa3, 0x92, 0xf6, 0xab, 0xcd, 0x6b, 0x10, 0x98, 0x78, 0xce, 0x9f,
+	0x96, 0xc8, 0xcc, 0x3e, 0x32, 0x05, 0xeb, 0x12, 0x86, 0x51, 0xc7, 0x0d, 0xbc, 0xd7, 0xd8, 0x3b,
+	0x0a, 0x0d, 0xae, 0x8e, 0x60, 0x56, 0x0d, 0x18, 0xa4, 0x30, 0x65, 0x8a, 0xc6, 0xd8, 0x90, 0x14,
+	0x0d, 0x3c, 0x9b, 0xa5, 0x58, 0xf1, 0x3d, 0xd4, 0xe5, 0x0b, 0x8c, 0xb3, 0x59, 0x0d, 0x02, 0x13,
+	0x0f, 0xa5, 0xd8, 0x94, 0xdb, 0x6a, 0xd1, 0x38, 0x96, 0x39, 0x18, 0xc2, 0xcf, 0x59, 0x58, 0x82,
+	0x07, 0x73, 0x1f, 0xcf, 0xa7, 0x58, 0x40, 0x86, 0x65, 0x76, 0xc0, 0xeb, 0x23, 0x0e, 0xf8, 0xaf,
+	0x95, 0xc8, 0xd3, 0x0f, 0xd4, 0x6e, 0x23, 0xa7, 0xc7, 0x60, 0xf4, 0x6f, 0x76, 0xe2, 0x60, 0x6c,
+	0x30, 0x30, 0x08, 0x1f, 0xa5, 0x5e, 0xcf, 0xb8, 0x71, 0xb8, 0x51, 0x3e, 0x8e, 0x51, 0x4a, 0xb1,
+	0x80, 0x0c, 0xcb, 0xc3, 0x4e, 0xcb, 0xbf, 0x57, 0x22, 0xcf, 0x8e, 0x60, 0x03, 0x14, 0x98, 0xb5,
+	0x96, 0xce, 0x1d, 0x2c, 0x3f, 0x9a, 0xdc, 0xc1, 0xc3, 0x0e, 0xd7, 0xd7, 0x4a, 0xe4, 0xdc, 0x70,
+	0x55, 0x6c, 0xff, 0x04, 0xee, 0xe1, 0x65, 0x0c, 0x94, 0x99, 0x76, 0x78, 0x9a, 0xef, 0xdf, 0x53,
+	0x20, 0xc8, 0xe2, 0x62, 0xa1, 0x9d, 0x9e, 0x9b, 0x6c, 0xc7, 0x17, 0x77, 0x31, 0x47, 0xbe, 0xa4,
+	0x0b, 0xed, 0xac, 0xa9, 0x56, 0x30, 0x30, 0x90, 0x1d, 0xfb, 0xb5, 0x18, 0x5e, 0x0f, 0x13, 0xfe,
+	0x10, 0xdf, 0x46, 0x9c, 0x96, 0x37, 0x3f, 0x18, 0x20, 0xc8, 0xe2, 0x22, 0x3b, 0x76, 0x6e, 0xc9,
+	0x3b, 0xca, 0xf7, 0x17, 0x8c, 0xdd, 0xb2, 0x6a, 0x05, 0x03, 0x23, 0x9b, 0x50, 0x59, 0xdd, 0x3f,
+	0xa1, 0xd2, 0xf9, 0x47, 0x25, 0xf2, 0xd4, 0x50, 0x53, 0x6e, 0xb4, 0x05, 0xf8, 0xf8, 0x25, 0x41,
+	0x1e, 0x6e, 0xee, 0x1c, 0x30, 0xb5, 0xef, 0x4f, 0x86, 0xcc, 0x34, 0x91, 0xda, 0x97, 0x55, 0x15,
+	0xd6, 0x41, 0x55, 0xc5, 0x63, 0x34, 0x9e, 0x03, 0xd9, 0x7c, 0x95, 0x03, 0x64, 0xf3, 0x65, 0x3e,
+	0x46, 0x75, 0xc4, 0x85, 0xfc, 0xed, 0xe1, 0xc3, 0x8b, 0x5b, 0xbf, 0x91, 0xbc, 0xa3, 0x8b, 0x64,
+	0xda, 0x0b, 0xd8, 0x2d, 0x40, 0xeb, 0xfd, 0x4d, 0x51, 0x4f, 0xa1, 0x94, 0xbe, 0xe0, 0x7a, 0x29,
+	0x03, 0x87, 0x81, 0x27, 0x1e, 0xc3, 0xec, 0xca, 0x43, 0x0e, 0xe9, 0x87, 0x48, 0x5d, 0xd1, 0xe6,
+	0x01, 0xcb, 0xea, 0x83, 0x0e, 0x04, 0x2c, 0xab, 0xaf, 0x69, 0x60, 0xd9, 0x4f, 0x73, 0x73, 0x33,
+	0x33, 0x33, 0x31, 0x5e, 0x1d, 0xdb, 0x9d, 0x77, 0x90, 0x49, 0xe5, 0xc3, 0x18, 0xf5, 0xaa, 0x17,
+	0xe7, 0xbf, 0x59, 0x24, 0x37, 0x02, 0x7b, 0xbf, 0xa4, 0xc7, 0x54, 0xfc, 0xfa, 0x86, 0x59, 0x85,
+	0x37, 0x37, 0x7e, 0x5d, 0x62, 0x40, 0xce, 0x53, 0xf6, 0x36, 0xa9, 0xb6, 0x58, 0x9a, 0x46, 0x21,
+	0xeb, 0x49, 0x67, 0x40, 0xb1, 0x9d, 0x30, 0xfb, 0x17, 0x38, 0x03, 0xa7, 0x4d, 0x26, 0xd1, 0xd1,
+	0x3b, 0xdf, 0xeb, 0x45, 0xe1, 0x8e, 0xeb, 0xa3, 0xd6, 0xe6, 0xb1, 0xf0, 0x6d, 0x51, 0x71, 0x4e,
+	0xdf, 0x63, 0xc5, 0x9b, 0x41, 0xc2, 0x11, 0x35, 0xf1, 0xba, 0x34, 0xec, 0x27, 0x59, 0x57, 0xff,
+	0x06, 0x6f, 0x06, 0x09, 0x77, 0xbe, 0x30, 0x46, 0x4e, 0xa4, 0xea, 0x2e, 0xa6, 0xdc, 0xb0, 0xd6,
+	0xbe, 0x6e, 0x58, 0x96, 0x09, 0xd1, 0x0f, 0xe4, 0xdd, 0x5a, 0x46, 0x26, 0x44, 0x3f, 0xc0, 0xb2,
+	0x5b, 0xf8, 0x07, 0xcd, 0xf1, 0x76, 0xb4, 0x07, 0xfd, 0x40, 0x04, 0xdf, 0x2a, 0x73, 0x7c, 0x91,
+	0xb5, 0x82, 0x80, 0x62, 0x6c, 0xc9, 0x64, 0xcc, 0x7c, 0xfc, 0xdc, 0x89, 0xdd, 0xa8, 0x14, 0xe1,
+	0xcf, 0x5f, 0x37, 0x28, 0xf2, 0x58, 0x1b, 0xb3, 0x05, 0x52, 0x1c, 0xf1, 0x0a, 0xeb, 0xba, 0xba,
+	0x5d, 0xa2, 0x31, 0x56, 0x44, 0xd0, 0x78, 0xb6, 0xac, 0x25, 0xf7, 0x7e, 0x1a, 0x85, 0xdd, 0x04,
+	0x37, 0xd0, 0x8c, 0xed, 0x58, 0x79, 0x98, 0xc7, 0x8f, 0xc7, 0xc3, 0x4c, 0x72, 0xbc, 0xcb, 0x58,
+	0x6d, 0xd7, 0x0d, 0xbc, 0x2d, 0x1a, 0x27, 0xdc, 0xe9, 0x2b, 0xab, 0xed, 0xca, 0x46, 0xd0, 0x70,
+	0x56, 0xd6, 0x88, 0xbd, 0x58, 0x62, 0x78, 0x69, 0x79, 0x59, 0x23, 0xdd, 0x0c, 0x26, 0x8e, 0xe9,
+	0x52, 0x26, 0x8f, 0xd4, 0xa5, 0x3c, 0xb1, 0x8f, 0x4b, 0xf9, 0x1f, 0x58, 0xe4, 0x6c, 0xee, 0x57,
+	0x7b, 0x7c, 0x43, 0x28, 0x9d, 0x2f, 0x56, 0xc9, 0xe9, 0x9c, 0x02, 0xaa, 0xf6, 0x9e, 0x39, 0x9f,
+	0xad, 0x22, 0xa2, 0x26, 0xd2, 0x07, 0xe7, 0x72, 0x18, 0x73, 0x26, 0xf1, 0xc1, 0x0e, 0x74, 0xf4,
+	0xa1, 0x4a, 0xf9, 0xe1, 0x1e, 0xaa, 0x18, 0xd3, 0xb2, 0xf2, 0x48, 0xa7, 0x65, 0xf5, 0xc1, 0xd3,
+	0xd2, 0xfe, 0x0d, 0x8b, 0x34, 0xba, 0x43, 0xaa, 0xf6, 0x37, 0xc6, 0x8a, 0xd8, 0x7c, 0x0d, 0xbb,
+	0x13, 0xa0, 0xf9, 0x26, 0x2c, 0xd4, 0x35, 0x0c, 0x0a, 0x43, 0x7b, 0xe5, 0x7c, 0xa7, 0x4c, 0x58,
+	0xf5, 0x5e, 0x7e, 0xb9, 0x97, 0xfd, 0x31, 0xb3, 0x0e, 0xb3, 0x55, 0x54, 0xcd, 0x60, 0x4e, 0x5c,
+	0xd5, 0x71, 0xe6, 0x23, 0x98, 0x57, 0xd6, 0x39, 0x2b, 0xb4, 0x4a, 0x23, 0x08, 0x2d, 0x5f, 0x16,
+	0xbc, 0x2e, 0x17, 0x5f, 0xf0, 0xba, 0x9e, 0x2d, 0x76, 0xfd, 0xe0, 0x4f, 0x5c, 0x79, 0x2c, 0x3f,
+	0xf1, 0xdf, 0xb4, 0xc8, 0xe9, 0x9c, 0xaf, 0xa0, 0x2d, 0x03, 0xeb, 0x01, 0x96, 0x01, 0x9e, 0x72,
+	0x53, 0x7f, 0x0b, 0x0f, 0xd8, 0x85, 0x05, 0xa1, 0x4f, 0xb9, 0x45, 0x3b, 0x28, 0x0c, 0x76, 0x59,
+	0xa9, 0xef, 0x87, 0x77, 0x2e, 0x76, 0x7b, 0xc9, 0x9e, 0xb0, 0x25, 0xf4, 0x65, 0xa5, 0x0a, 0x02,
+	0x06, 0x96, 0xf3, 0x2b, 0x25, 0x3e, 0x03, 0x45, 0xa8, 0xc4, 0x0b, 0x99, 0xab, 0xcf, 0x46, 0x8f,
+	0x32, 0xf8, 0x08, 0x21, 0x2d, 0x75, 0xeb, 0xb9, 0x38, 0xc3, 0xba, 0x72, 0xe4, 0x5b, 0xa3, 0x05,
+	0x3d, 0xfd, 0x1a, 0xba, 0x0d, 0x0c, 0x7e, 0x29, 0x59, 0x5a, 0xde, 0x57, 0x96, 0xa6, 0xc4, 0x4a,
+	0x65, 0x1f, 0x6d, 0xf7, 0xa7, 0x16, 0x49, 0x59, 0x44, 0x58, 0xe3, 0x1d, 0xbb, 0xbb, 0x57, 0xcc,
+	0x85, 0xee, 0x26, 0x69, 0x14, 0x8d, 0x62, 0xda, 0xb3, 0x7f, 0x81, 0x33, 0xb2, 0x7d, 0x11, 0x51,
+	0xc1, 0x47, 0xf5, 0x7a, 0x71, 0x0c, 0x31, 0x26, 0x83, 0x1f, 0xc4, 0xea, 0xe8, 0x0c, 0xe7, 0x05,
+	0x72, 0x6a, 0xa0, 0x53, 0xec, 0x92, 0xa2, 0x30, 0x6a, 0x0d, 0x4c, 0x57, 0x96, 0x23, 0x0b, 0x1c,
+	0x86, 0x61, 0x16, 0xd3, 0x59, 0xf2, 0x78, 0x06, 0x70, 0x2a, 0xce, 0xd2, 0x3b, 0xae, 0xb1, 0x53,
+	0x91, 0x93, 0x03, 0x20, 0x18, 0xec, 0x84, 0xf3, 0xbf, 0xc5, 0xe4, 0xbf, 0xe9, 0x05, 0xed, 0xf0,
+	0x8e, 0x32, 0x4c, 0xac, 0xa1, 0x86, 0x09, 0xae, 0xc7, 0xd6, 0x36, 0xc5, 0x0d, 0x56, 0x56, 0x65,
+	0xaf, 0x8b, 0x76, 0x50, 0x18, 0x88, 0xdd, 0xee, 0x8b, 0x42, 0xc9, 0x99, 0x49, 0xb9, 0x28, 0xda,
+	0x41, 0x61, 0x60, 0xf0, 0xbb, 0xf1, 0x92, 0x72, 0x5e, 0x32, 0x83, 0xdc, 0x50, 0x99, 0x31, 0xa4,
+	0xb0, 0xd0, 0xb1, 0xa5, 0x8c, 0x1c, 0xa9, 0x22, 0x99, 0x63, 0x4b, 0x49, 0xa2, 0x18, 0x0c, 0x0c,
+	0x96, 0xc4, 0xca, 0xcb, 0x46, 0xca, 0xf8, 0x62, 0x9e, 0xc4, 0x2a, 0xda, 0x40, 0x41, 0x51, 0x9a,
+	0x74, 0xdd, 0xa0, 0xef, 0xfa, 0x38, 0x42, 0xa2, 0x5c, 0x81, 0x5a, 0x86, 0x2b, 0x0a, 0x02, 0x06,
+	0x16, 0xbe, 0x31, 0xee, 0x9c, 0x3e, 0x10, 0x06, 0x32, 0x9a, 0x4d, 0x1f, 0x53, 0x89, 0x76, 0x50,
+	0x18, 0xce, 0x7f, 0xb1, 0xc8, 0x49, 0x5d, 0x47, 0x80, 0xdf, 0x19, 0x6d, 0x7a, 0x8e, 0xac, 0x7d,
+	0x4b, 0x24, 0xa4, 0x73, 0x85, 0x4b, 0x23, 0xe5, 0x0a, 0x9b, 0x69, 0xbc, 0xe5, 0x07, 0xa6, 0xf1,
+	0xfe, 0xa0, 0xbe, 0x8f, 0x94, 0xe7, 0xfb, 0x4e, 0xe4, 0xdd, 0x45, 0x8a, 0x01, 0xdb, 0x2d, 0x57,
+	0x15, 0xe4, 0x99, 0xe4, 0x7b, 0x87, 0x85, 0x79, 0x86, 0x24, 0x20, 0xce, 0x2a, 0xa9, 0xab, 0xd3,
+	0x1a, 0xb9, 0xf9, 0xb7, 0xf2, 0x37, 0xff, 0x23, 0xa5, 0x2d, 0x36, 0x37, 0xbf, 0xf9, 0xdd, 0x67,
+	0xde, 0xf0, 0xed, 0xef, 0x3e, 0xf3, 0x86, 0x3f, 0xfa, 0xee, 0x33, 0x6f, 0xf8, 0xf8, 0xbd, 0x67,
+	0xac, 0x6f, 0xde, 0x7b, 0xc6, 0xfa, 0xf6, 0xbd, 0x67, 0xac, 0x3f, 0xba, 0xf7, 0x8c, 0xf5, 0x9d,
+	0x7b, 0xcf, 0x58, 0x9f, 0xff, 0x0f, 0xcf, 0xbc, 0xe1, 0x03, 0xb9, 0xe1, 0x8c, 0xf8, 0xcf, 0xf3,
+	0xad, 0xf6, 0xdc, 0xce, 0x05, 0x16, 0x51, 0x87, 0xcb, 0x6b, 0xce, 0x98, 0x53, 0x73, 0x72, 0x79,
+	0xfd, 0xbf, 0x01, 0x00, 0x10, 0x42, 0x70, 0xd8, 0xb2, 0xdf, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5015,6 +5288,30 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Ceiling != nil {
+		{
+			size, err := m.Ceiling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SyncApproval != nil {
+		{
+			size, err := m.SyncApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	i--
 	if m.PermitOnlyProjectScopedClusters {
 		dAtA[i] = 1
@@ -5373,6 +5670,62 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationHealthPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationHealthPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationHealthPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ProgressingGracePeriod)
+	copy(dAtA[i:], m.ProgressingGracePeriod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingGracePeriod)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Require) > 0 {
+		for iNdEx := len(m.Require) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Require[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Ignore) > 0 {
+		for iNdEx := len(m.Ignore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ignore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6712,6 +7065,18 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValuesObject != nil {
+		{
+			size, err := m.ValuesObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i--
 	if m.SkipCrds {
 		dAtA[i] = 1
@@ -7124,6 +7489,18 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HealthPolicy != nil {
+		{
+			size, err := m.HealthPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Sources) > 0 {
 		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7233,6 +7610,32 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HealthHistory) > 0 {
+		for iNdEx := len(m.HealthHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HealthHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.PendingSync != nil {
+		{
+			size, err := m.PendingSync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	i -= len(m.ControllerNamespace)
 	copy(dAtA[i:], m.ControllerNamespace)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ControllerNamespace)))
@@ -8768,6 +9171,49 @@ func (m *GnuPGPublicKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HealthPolicyResourceSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthPolicyResourceSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthPolicyResourceSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8801,6 +9247,44 @@ func (m *HealthStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HealthTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransitionedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmFileParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9936,6 +10420,38 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *OverrideAggregatedHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverrideAggregatedHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverrideAggregatedHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChildKinds) > 0 {
+		for iNdEx := len(m.ChildKinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChildKinds[iNdEx])
+			copy(dAtA[i:], m.ChildKinds[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChildKinds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9986,6 +10502,84 @@ func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RequesterIssuer)
+	copy(dAtA[i:], m.RequesterIssuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequesterIssuer)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.RequesterSubject)
+	copy(dAtA[i:], m.RequesterSubject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequesterSubject)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.DiffSummary)
+	copy(dAtA[i:], m.DiffSummary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DiffSummary)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RequestedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Requester)
+	copy(dAtA[i:], m.Requester)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Requester)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PluginConfigMapRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10148,6 +10742,66 @@ func (m *PluginInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterResourceWhitelist) > 0 {
+		for iNdEx := len(m.ClusterResourceWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClusterResourceWhitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceRepos) > 0 {
+		for iNdEx := len(m.SourceRepos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceRepos[iNdEx])
+			copy(dAtA[i:], m.SourceRepos[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceRepos[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10644,6 +11298,13 @@ func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CredentialProvider)
+	copy(dAtA[i:], m.CredentialProvider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CredentialProvider)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	i--
 	if m.ForceHttpBasicAuth {
 		dAtA[i] = 1
@@ -10795,6 +11456,41 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubmoduleCredentials) > 0 {
+		for iNdEx := len(m.SubmoduleCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmoduleCredentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.EnableSubmodules != nil {
+		i--
+		if *m.EnableSubmodules {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	i -= len(m.CredentialProvider)
+	copy(dAtA[i:], m.CredentialProvider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CredentialProvider)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
 	i--
 	if m.ForceHttpBasicAuth {
 		dAtA[i] = 1
@@ -11231,6 +11927,11 @@ func (m *ResourceActions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ActionDiscoveryCEL)
+	copy(dAtA[i:], m.ActionDiscoveryCEL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionDiscoveryCEL)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Definitions) > 0 {
 		for iNdEx := len(m.Definitions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -11651,6 +12352,23 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggregatedHealth != nil {
+		{
+			size, err := m.AggregatedHealth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.HealthCEL)
+	copy(dAtA[i:], m.HealthCEL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthCEL)))
+	i--
+	dAtA[i] = 0x32
 	i--
 	if m.UseOpenLibs {
 		dAtA[i] = 1
@@ -12774,6 +13492,87 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubmoduleCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmoduleCredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmoduleCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Creds != nil {
+		{
+			size, err := m.Creds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.CredentialTemplate)
+	copy(dAtA[i:], m.CredentialTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CredentialTemplate)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13602,6 +14401,14 @@ func (m *AppProjectSpec) Size() (n int) {
 		}
 	}
 	n += 2
+	if m.SyncApproval != nil {
+		l = m.SyncApproval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Ceiling != nil {
+		l = m.Ceiling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13674,6 +14481,29 @@ func (m *ApplicationDestination) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationHealthPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ignore) > 0 {
+		for _, e := range m.Ignore {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Require) > 0 {
+		for _, e := range m.Require {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ProgressingGracePeriod)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14186,6 +15016,10 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	if m.ValuesObject != nil {
+		l = m.ValuesObject.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14349,6 +15183,10 @@ func (m *ApplicationSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.HealthPolicy != nil {
+		l = m.HealthPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14406,6 +15244,16 @@ func (m *ApplicationStatus) Size() (n int) {
 	}
 	l = len(m.ControllerNamespace)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PendingSync != nil {
+		l = m.PendingSync.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.HealthHistory) > 0 {
+		for _, e := range m.HealthHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -14934,6 +15782,23 @@ func (m *GnuPGPublicKeyList) Size() (n int) {
 	return n
 }
 
+func (m *HealthPolicyResourceSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14947,6 +15812,19 @@ func (m *HealthStatus) Size() (n int) {
 	return n
 }
 
+func (m *HealthTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TransitionedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HelmFileParameter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15375,6 +16253,21 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	return n
 }
 
+func (m *OverrideAggregatedHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChildKinds) > 0 {
+		for _, s := range m.ChildKinds {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *OverrideIgnoreDiff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15402,6 +16295,31 @@ func (m *OverrideIgnoreDiff) Size() (n int) {
 	return n
 }
 
+func (m *PendingSync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Requester)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RequestedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DiffSummary)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequesterSubject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequesterIssuer)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PluginConfigMapRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15457,6 +16375,33 @@ func (m *PluginInput) Size() (n int) {
 	return n
 }
 
+func (m *ProjectCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceRepos) > 0 {
+		for _, s := range m.SourceRepos {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ClusterResourceWhitelist) > 0 {
+		for _, e := range m.ClusterResourceWhitelist {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ProjectRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15679,6 +16624,8 @@ func (m *RepoCreds) Size() (n int) {
 	l = len(m.Proxy)
 	n += 2 + l + sovGenerated(uint64(l))
 	n += 3
+	l = len(m.CredentialProvider)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15741,6 +16688,17 @@ func (m *Repository) Size() (n int) {
 	l = len(m.GCPServiceAccountKey)
 	n += 2 + l + sovGenerated(uint64(l))
 	n += 3
+	l = len(m.CredentialProvider)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.EnableSubmodules != nil {
+		n += 3
+	}
+	if len(m.SubmoduleCredentials) > 0 {
+		for _, e := range m.SubmoduleCredentials {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -15861,6 +16819,8 @@ func (m *ResourceActions) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ActionDiscoveryCEL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16035,6 +16995,12 @@ func (m *ResourceOverride) Size() (n int) {
 		}
 	}
 	n += 2
+	l = len(m.HealthCEL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AggregatedHealth != nil {
+		l = m.AggregatedHealth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16434,6 +17400,35 @@ func (m *SignatureKey) Size() (n int) {
 	return n
 }
 
+func (m *SubmoduleCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CredentialTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Creds != nil {
+		l = m.Creds.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncOperation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16811,6 +17806,8 @@ func (this *AppProjectSpec) String() string {
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
+		`SyncApproval:` + strings.Replace(this.SyncApproval.String(), "SyncApproval", "SyncApproval", 1) + `,`,
+		`Ceiling:` + strings.Replace(this.Ceiling.String(), "ProjectCeiling", "ProjectCeiling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16872,6 +17869,28 @@ func (this *ApplicationDestination) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationHealthPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIgnore := "[]HealthPolicyResourceSelector{"
+	for _, f := range this.Ignore {
+		repeatedStringForIgnore += strings.Replace(strings.Replace(f.String(), "HealthPolicyResourceSelector", "HealthPolicyResourceSelector", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIgnore += "}"
+	repeatedStringForRequire := "[]HealthPolicyResourceSelector{"
+	for _, f := range this.Require {
+		repeatedStringForRequire += strings.Replace(strings.Replace(f.String(), "HealthPolicyResourceSelector", "HealthPolicyResourceSelector", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRequire += "}"
+	s := strings.Join([]string{`&ApplicationHealthPolicy{`,
+		`Ignore:` + repeatedStringForIgnore + `,`,
+		`Require:` + repeatedStringForRequire + `,`,
+		`ProgressingGracePeriod:` + fmt.Sprintf("%v", this.ProgressingGracePeriod) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -17215,6 +18234,7 @@ func (this *ApplicationSourceHelm) String() string {
 		`PassCredentials:` + fmt.Sprintf("%v", this.PassCredentials) + `,`,
 		`IgnoreMissingValueFiles:` + fmt.Sprintf("%v", this.IgnoreMissingValueFiles) + `,`,
 		`SkipCrds:` + fmt.Sprintf("%v", this.SkipCrds) + `,`,
+		`ValuesObject:` + strings.Replace(fmt.Sprintf("%v", this.ValuesObject), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17349,6 +18369,7 @@ func (this *ApplicationSpec) String() string {
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
+		`HealthPolicy:` + strings.Replace(this.HealthPolicy.String(), "ApplicationHealthPolicy", "ApplicationHealthPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17372,6 +18393,11 @@ func (this *ApplicationStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForHealthHistory := "[]HealthTransition{"
+	for _, f := range this.HealthHistory {
+		repeatedStringForHealthHistory += strings.Replace(strings.Replace(f.String(), "HealthTransition", "HealthTransition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHealthHistory += "}"
 	s := strings.Join([]string{`&ApplicationStatus{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
@@ -17386,6 +18412,8 @@ func (this *ApplicationStatus) String() string {
 		`ResourceHealthSource:` + fmt.Sprintf("%v", this.ResourceHealthSource) + `,`,
 		`SourceTypes:` + fmt.Sprintf("%v", this.SourceTypes) + `,`,
 		`ControllerNamespace:` + fmt.Sprintf("%v", this.ControllerNamespace) + `,`,
+		`PendingSync:` + strings.Replace(this.PendingSync.String(), "PendingSync", "PendingSync", 1) + `,`,
+		`HealthHistory:` + repeatedStringForHealthHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17809,6 +18837,19 @@ func (this *GnuPGPublicKeyList) String() string {
 	}, "")
 	return s
 }
+func (this *HealthPolicyResourceSelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthPolicyResourceSelector{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HealthStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -17820,6 +18861,17 @@ func (this *HealthStatus) String() string {
 	}, "")
 	return s
 }
+func (this *HealthTransition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthTransition{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`TransitionedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TransitionedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HelmFileParameter) String() string {
 	if this == nil {
 		return "nil"
@@ -18192,6 +19244,16 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	}, "")
 	return s
 }
+func (this *OverrideAggregatedHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverrideAggregatedHealth{`,
+		`ChildKinds:` + fmt.Sprintf("%v", this.ChildKinds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OverrideIgnoreDiff) String() string {
 	if this == nil {
 		return "nil"
@@ -18204,6 +19266,23 @@ func (this *OverrideIgnoreDiff) String() string {
 	}, "")
 	return s
 }
+func (this *PendingSync) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingSync{`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`Requester:` + fmt.Sprintf("%v", this.Requester) + `,`,
+		`RequestedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RequestedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DiffSummary:` + fmt.Sprintf("%v", this.DiffSummary) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`RequesterSubject:` + fmt.Sprintf("%v", this.RequesterSubject) + `,`,
+		`RequesterIssuer:` + fmt.Sprintf("%v", this.RequesterIssuer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PluginConfigMapRef) String() string {
 	if this == nil {
 		return "nil"
@@ -18258,6 +19337,28 @@ func (this *PluginInput) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectCeiling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDestinations := "[]ApplicationDestination{"
+	for _, f := range this.Destinations {
+		repeatedStringForDestinations += strings.Replace(strings.Replace(f.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinations += "}"
+	repeatedStringForClusterResourceWhitelist := "[]GroupKind{"
+	for _, f := range this.ClusterResourceWhitelist {
+		repeatedStringForClusterResourceWhitelist += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForClusterResourceWhitelist += "}"
+	s := strings.Join([]string{`&ProjectCeiling{`,
+		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
+		`Destinations:` + repeatedStringForDestinations + `,`,
+		`ClusterResourceWhitelist:` + repeatedStringForClusterResourceWhitelist + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectRole) String() string {
 	if this == nil {
 		return "nil"
@@ -18397,6 +19498,7 @@ func (this *RepoCreds) String() string {
 		`GCPServiceAccountKey:` + fmt.Sprintf("%v", this.GCPServiceAccountKey) + `,`,
 		`Proxy:` + fmt.Sprintf("%v", this.Proxy) + `,`,
 		`ForceHttpBasicAuth:` + fmt.Sprintf("%v", this.ForceHttpBasicAuth) + `,`,
+		`CredentialProvider:` + fmt.Sprintf("%v", this.CredentialProvider) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18421,6 +19523,11 @@ func (this *Repository) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSubmoduleCredentials := "[]SubmoduleCredentials{"
+	for _, f := range this.SubmoduleCredentials {
+		repeatedStringForSubmoduleCredentials += strings.Replace(strings.Replace(f.String(), "SubmoduleCredentials", "SubmoduleCredentials", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubmoduleCredentials += "}"
 	s := strings.Join([]string{`&Repository{`,
 		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
@@ -18444,6 +19551,9 @@ func (this *Repository) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`GCPServiceAccountKey:` + fmt.Sprintf("%v", this.GCPServiceAccountKey) + `,`,
 		`ForceHttpBasicAuth:` + fmt.Sprintf("%v", this.ForceHttpBasicAuth) + `,`,
+		`CredentialProvider:` + fmt.Sprintf("%v", this.CredentialProvider) + `,`,
+		`EnableSubmodules:` + valueToStringGenerated(this.EnableSubmodules) + `,`,
+		`SubmoduleCredentials:` + repeatedStringForSubmoduleCredentials + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18547,6 +19657,7 @@ func (this *ResourceActions) String() string {
 	s := strings.Join([]string{`&ResourceActions{`,
 		`ActionDiscoveryLua:` + fmt.Sprintf("%v", this.ActionDiscoveryLua) + `,`,
 		`Definitions:` + repeatedStringForDefinitions + `,`,
+		`ActionDiscoveryCEL:` + fmt.Sprintf("%v", this.ActionDiscoveryCEL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18674,6 +19785,8 @@ func (this *ResourceOverride) String() string {
 		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
+		`HealthCEL:` + fmt.Sprintf("%v", this.HealthCEL) + `,`,
+		`AggregatedHealth:` + strings.Replace(this.AggregatedHealth.String(), "OverrideAggregatedHealth", "OverrideAggregatedHealth", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18948,6 +20061,29 @@ func (this *SignatureKey) String() string {
 	}, "")
 	return s
 }
+func (this *SubmoduleCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubmoduleCredentials{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`CredentialTemplate:` + fmt.Sprintf("%v", this.CredentialTemplate) + `,`,
+		`Creds:` + strings.Replace(this.Creds.String(), "RepoCreds", "RepoCreds", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncApproval{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -19967,59 +21103,131 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermitOnlyProjectScopedClusters = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncApproval == nil {
+				m.SyncApproval = &SyncApproval{}
+			}
+			if err := m.SyncApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceiling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ceiling == nil {
+				m.Ceiling = &ProjectCeiling{}
+			}
+			if err := m.Ceiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20648,7 +21856,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationHealthPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20671,15 +21879,15 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationHealthPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationHealthPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20706,13 +21914,14 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ignore = append(m.Ignore, HealthPolicyResourceSelector{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Require", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20739,11 +21948,43 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Require = append(m.Require, HealthPolicyResourceSelector{})
+			if err := m.Require[len(m.Require)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingGracePeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingGracePeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20765,7 +22006,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20788,17 +22029,17 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20808,29 +22049,30 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20840,55 +22082,25 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20911,7 +22123,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20934,15 +22146,161 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24951,6 +26309,42 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipCrds = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &runtime.RawExtension{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26305,6 +27699,42 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthPolicy == nil {
+				m.HealthPolicy = &ApplicationHealthPolicy{}
+			}
+			if err := m.HealthPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26792,61 +28222,11 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26856,29 +28236,33 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			if m.PendingSync == nil {
+				m.PendingSync = &PendingSync{}
+			}
+			if err := m.PendingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26888,23 +28272,139 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.HealthHistory = append(m.HealthHistory, HealthTransition{})
+			if err := m.HealthHistory[len(m.HealthHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31579,7 +33079,7 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *HealthPolicyResourceSelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31602,15 +33102,15 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthPolicyResourceSelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthPolicyResourceSelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31638,11 +33138,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31670,61 +33170,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31752,11 +33202,11 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31784,7 +33234,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31807,7 +33257,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31830,15 +33280,15 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31866,7 +33316,39 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31889,7 +33371,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *HealthTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31912,47 +33394,15 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31980,13 +33430,13 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionedAt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31996,12 +33446,25 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransitionedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32023,7 +33486,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32046,10 +33509,10 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32086,43 +33549,9 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32132,24 +33561,23 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32172,7 +33600,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32195,15 +33623,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32231,65 +33659,8 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
-			}
-			m.RequestedByApp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
-			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
-			}
-			m.Capacity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32311,7 +33682,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32334,10 +33705,10 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32404,6 +33775,26 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32425,7 +33816,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32448,10 +33839,10 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32488,9 +33879,9 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32500,23 +33891,58 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32539,7 +33965,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32562,53 +33988,15 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
-			}
-			m.IssuedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32636,8 +34024,65 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			}
+			m.RequestedByApp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByApp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			}
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32659,7 +34104,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32682,17 +34127,17 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32702,25 +34147,55 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32743,7 +34218,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32766,10 +34241,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32836,26 +34311,6 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32877,7 +34332,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32900,17 +34355,17 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32920,27 +34375,33 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32968,7 +34429,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32991,7 +34452,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33014,17 +34475,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33034,55 +34495,25 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33105,7 +34536,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33128,10 +34559,10 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33168,9 +34599,9 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33180,24 +34611,386 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35208,6 +37001,88 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OverrideAggregatedHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverrideAggregatedHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverrideAggregatedHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildKinds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildKinds = append(m.ChildKinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35354,6 +37229,315 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingSync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffSummary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiffSummary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = PendingSyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterSubject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequesterSubject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterIssuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequesterIssuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35911,6 +38095,156 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepos = append(m.SourceRepos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ApplicationDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResourceWhitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterResourceWhitelist = append(m.ClusterResourceWhitelist, v1.GroupKind{})
+			if err := m.ClusterResourceWhitelist[len(m.ClusterResourceWhitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37762,49 +40096,133 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
+			}
+			m.GithubAppId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
+			}
+			m.GithubAppInstallationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOCI = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
-			}
-			m.GithubAppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
-			}
-			m.GithubAppInstallationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppInstallationId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37832,31 +40250,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableOCI = bool(v != 0)
-		case 12:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37884,13 +40282,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37900,27 +40298,15 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
+			m.ForceHttpBasicAuth = bool(v != 0)
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialProvider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37948,28 +40334,8 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.CredentialProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceHttpBasicAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38626,13 +40992,161 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceHttpBasicAuth = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSubmodules", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38642,29 +41156,18 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
+			b := bool(v != 0)
+			m.EnableSubmodules = &b
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmoduleCredentials", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38674,76 +41177,26 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SubmoduleCredentials = append(m.SubmoduleCredentials, SubmoduleCredentials{})
+			if err := m.SubmoduleCredentials[len(m.SubmoduleCredentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ForceHttpBasicAuth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39484,15 +41937,193 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39520,77 +42151,13 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39600,77 +42167,29 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryCEL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39698,41 +42217,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActionDiscoveryCEL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41321,31 +43806,99 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseOpenLibs = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCEL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthCEL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedHealth == nil {
+				m.AggregatedHealth = &OverrideAggregatedHealth{}
+			}
+			if err := m.AggregatedHealth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseOpenLibs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45150,6 +47703,258 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SubmoduleCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmoduleCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmoduleCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Creds == nil {
+				m.Creds = &RepoCreds{}
+			}
+			if err := m.Creds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/argoproj/argo-cd/v2/util/cert"
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -44,6 +45,9 @@ type RepoCreds struct {
 	Proxy string `json:"proxy,omitempty" protobuf:"bytes,19,opt,name=proxy"`
 	// ForceHttpBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,20,opt,name=forceHttpBasicAuth"`
+	// CredentialProvider is the name of a credential provider defined in the argocd-cm ConfigMap, which provides
+	// short-lived tokens used to authenticate at the repo server. It can only be set declaratively.
+	CredentialProvider string `json:"credentialProvider,omitempty" protobuf:"bytes,21,opt,name=credentialProvider"`
 }
 
 // Repository is a repository holding application configurations
@@ -93,6 +97,54 @@ type Repository struct {
 	GCPServiceAccountKey string `json:"gcpServiceAccountKey,omitempty" protobuf:"bytes,21,opt,name=gcpServiceAccountKey"`
 	// ForceHttpBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,22,opt,name=forceHttpBasicAuth"`
+	// CredentialProvider is the name of a credential provider defined in the argocd-cm ConfigMap, which provides
+	// short-lived tokens used to authenticate at the remote repository. It can only be set declaratively.
+	CredentialProvider string `json:"credentialProvider,omitempty" protobuf:"bytes,23,opt,name=credentialProvider"`
+	// EnableSubmodules specifies whether submodules of this repo are checked out. If not set, the repo server
+	// default is used. Only valid for Git repositories.
+	EnableSubmodules *bool `json:"enableSubmodules,omitempty" protobuf:"bytes,24,opt,name=enableSubmodules"`
+	// SubmoduleCredentials maps URL prefixes of submodules to repository credential templates. By default, submodules
+	// are fetched with the credentials of this repo. Only valid for Git repositories.
+	SubmoduleCredentials []SubmoduleCredentials `json:"submoduleCredentials,omitempty" protobuf:"bytes,25,rep,name=submoduleCredentials"`
+}
+
+// SubmoduleCredentials selects the repository credential template used for submodules with a given URL prefix
+type SubmoduleCredentials struct {
+	// URL is the URL prefix of the submodules the credentials are used for, e.g. https://github.com/other-org/
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// CredentialTemplate is the URL of the repository credential template whose credentials are used. If empty will
+	// default to the best matching credential template for URL.
+	CredentialTemplate string `json:"credentialTemplate,omitempty" protobuf:"bytes,2,opt,name=credentialTemplate"`
+	// Creds are the credentials of the template. They are resolved by Argo CD and never stored with the repository.
+	Creds *RepoCreds `json:"creds,omitempty" protobuf:"bytes,3,opt,name=creds"`
+}
+
+// GetCredentialTemplate returns the URL of the credential template used for the submodules
+func (c *SubmoduleCredentials) GetCredentialTemplate() string {
+	if c.CredentialTemplate == "" {
+		return c.URL
+	}
+	return c.CredentialTemplate
+}
+
+// MatchesCredentialTemplate returns true if the URL prefix of the submodules is covered by the credential template
+// with the given URL, so that the credentials are only sent to URLs they are meant for
+func (c *SubmoduleCredentials) MatchesCredentialTemplate(templateURL string) bool {
+	return templateURL != "" && strings.HasPrefix(c.URL, templateURL)
+}
+
+// ValidateSubmoduleCredentials returns an error if a submodule credential mapping refers to a credential template
+// whose URL is not a prefix of the URL prefix of the submodules
+func (repo *Repository) ValidateSubmoduleCredentials() error {
+	for _, mapping := range repo.SubmoduleCredentials {
+		if mapping.URL == "" {
+			return fmt.Errorf("URL prefix of submodule credentials must not be empty")
+		}
+		if mapping.CredentialTemplate != "" && !mapping.MatchesCredentialTemplate(mapping.CredentialTemplate) {
+			return fmt.Errorf("submodule URL prefix %s does not start with the URL of credential template %s", mapping.URL, mapping.CredentialTemplate)
+		}
+	}
+	return nil
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification
@@ -105,9 +157,18 @@ func (repo *Repository) IsLFSEnabled() bool {
 	return repo.EnableLFS
 }
 
+// IsSubmoduleEnabled returns true if submodules of the repository are checked out. defaultEnabled is used if the
+// repository does not configure it.
+func (repo *Repository) IsSubmoduleEnabled(defaultEnabled bool) bool {
+	if repo == nil || repo.EnableSubmodules == nil {
+		return defaultEnabled
+	}
+	return *repo.EnableSubmodules
+}
+
 // HasCredentials returns true when the repository has been configured with any credentials
 func (m *Repository) HasCredentials() bool {
-	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != ""
+	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != "" || m.CredentialProvider != ""
 }
 
 // CopyCredentialsFromRepo copies all credential information from source repository to receiving repository
@@ -143,6 +204,9 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
+		if repo.CredentialProvider == "" {
+			repo.CredentialProvider = source.CredentialProvider
+		}
 		repo.ForceHttpBasicAuth = source.ForceHttpBasicAuth
 	}
 }
@@ -180,6 +244,9 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
+		if repo.CredentialProvider == "" {
+			repo.CredentialProvider = source.CredentialProvider
+		}
 		if repo.Proxy == "" {
 			repo.Proxy = source.Proxy
 		}
@@ -204,12 +271,33 @@ func (repo *Repository) GetGitCreds(store git.CredsStore) git.Creds {
 	if repo.GCPServiceAccountKey != "" {
 		return git.NewGoogleCloudCreds(repo.GCPServiceAccountKey, store)
 	}
+	if repo.CredentialProvider != "" {
+		return git.NewTokenProviderCreds(repo.Username, repo.getTokenProvider(), repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure(), repo.Proxy, store, repo.ForceHttpBasicAuth)
+	}
 	return git.NopCreds{}
 }
 
+// GetSubmoduleGitCreds returns the credentials used for submodules, ordered by the submodule URL prefixes they apply
+// to. Mappings whose credential template could not be resolved are skipped.
+func (repo *Repository) GetSubmoduleGitCreds(store git.CredsStore) []git.SubmoduleCreds {
+	if repo == nil {
+		return nil
+	}
+	var res []git.SubmoduleCreds
+	for _, mapping := range repo.SubmoduleCredentials {
+		if mapping.Creds == nil {
+			continue
+		}
+		submoduleRepo := &Repository{Repo: mapping.URL}
+		submoduleRepo.CopyCredentialsFrom(mapping.Creds)
+		res = append(res, git.SubmoduleCreds{URLPrefix: mapping.URL, Creds: submoduleRepo.GetGitCreds(store)})
+	}
+	return res
+}
+
 // GetHelmCreds returns the credentials from a repository configuration used to authenticate at a Helm repository
 func (repo *Repository) GetHelmCreds() helm.Creds {
-	return helm.Creds{
+	creds := helm.Creds{
 		Username:           repo.Username,
 		Password:           repo.Password,
 		CAPath:             getCAPath(repo.Repo),
@@ -217,6 +305,19 @@ func (repo *Repository) GetHelmCreds() helm.Creds {
 		KeyData:            []byte(repo.TLSClientCertKey),
 		InsecureSkipVerify: repo.Insecure,
 	}
+	if repo.Password == "" && repo.CredentialProvider != "" {
+		creds.TokenProvider = repo.getTokenProvider()
+		if creds.Username == "" {
+			creds.Username = git.TokenProviderDefaultUsername
+		}
+	}
+	return creds
+}
+
+// getTokenProvider returns the token provider configured for the repository. Token providers are cached per
+// repository URL, so that tokens are reused until they are about to expire.
+func (repo *Repository) getTokenProvider() git.TokenProvider {
+	return git.GetNamedTokenProvider(repo.Repo, repo.CredentialProvider)
 }
 
 func getCAPath(repoURL string) string {
@@ -260,6 +361,8 @@ func getCAPath(repoURL string) string {
 func (m *Repository) CopySettingsFrom(source *Repository) {
 	if source != nil {
 		m.EnableLFS = source.EnableLFS
+		m.EnableSubmodules = source.EnableSubmodules
+		m.SubmoduleCredentials = source.SubmoduleCredentials
 		m.InsecureIgnoreHostKey = source.InsecureIgnoreHostKey
 		m.Insecure = source.Insecure
 		m.InheritedCreds = source.InheritedCreds
@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
@@ -81,6 +82,52 @@ type ApplicationSpec struct {
 
 	// Sources is a reference to the location of the application's manifests or chart
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+
+	// HealthPolicy customizes how the health of the application is assessed from the health of its resources
+	HealthPolicy *ApplicationHealthPolicy `json:"healthPolicy,omitempty" protobuf:"bytes,9,opt,name=healthPolicy"`
+}
+
+// ApplicationHealthPolicy customizes how the health of an application is assessed from the health of its resources
+type ApplicationHealthPolicy struct {
+	// Ignore selects resources whose health does not affect the health of the application
+	Ignore []HealthPolicyResourceSelector `json:"ignore,omitempty" protobuf:"bytes,1,rep,name=ignore"`
+	// Require selects resources which must exist and be Healthy for the application to be Healthy
+	Require []HealthPolicyResourceSelector `json:"require,omitempty" protobuf:"bytes,2,rep,name=require"`
+	// ProgressingGracePeriod is the duration after the start of the last sync, e.g. 10m, during which Progressing
+	// resources do not count as Degraded. If not set, Progressing resources never count as Degraded.
+	ProgressingGracePeriod string `json:"progressingGracePeriod,omitempty" protobuf:"bytes,3,opt,name=progressingGracePeriod"`
+}
+
+// HealthPolicyResourceSelector selects resources of an application. Each field is a glob pattern, an empty field
+// matches any value.
+type HealthPolicyResourceSelector struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+}
+
+// Matches returns whether the selector matches the resource
+func (s HealthPolicyResourceSelector) Matches(group, kind, namespace, name string) bool {
+	return matchesPattern(s.Group, group) && matchesPattern(s.Kind, kind) &&
+		matchesPattern(s.Namespace, namespace) && matchesPattern(s.Name, name)
+}
+
+func matchesPattern(pattern, value string) bool {
+	return pattern == "" || globMatch(pattern, value, false)
+}
+
+// GetProgressingGracePeriod returns the grace period during which Progressing resources do not count as Degraded, and
+// false if Progressing resources never count as Degraded
+func (p *ApplicationHealthPolicy) GetProgressingGracePeriod() (time.Duration, bool, error) {
+	if p == nil || p.ProgressingGracePeriod == "" {
+		return 0, false, nil
+	}
+	gracePeriod, err := time.ParseDuration(p.ProgressingGracePeriod)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid progressing grace period %q: %w", p.ProgressingGracePeriod, err)
+	}
+	return gracePeriod, true, nil
 }
 
 type TrackingMethod string
@@ -311,6 +358,9 @@ type ApplicationSourceHelm struct {
 	IgnoreMissingValueFiles bool `json:"ignoreMissingValueFiles,omitempty" protobuf:"bytes,8,opt,name=ignoreMissingValueFiles"`
 	// SkipCrds skips custom resource definition installation step (Helm's --skip-crds)
 	SkipCrds bool `json:"skipCrds,omitempty" protobuf:"bytes,9,opt,name=skipCrds"`
+	// ValuesObject specifies Helm values to be passed to helm template, defined as a map. This takes precedence over Values.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ValuesObject *runtime.RawExtension `json:"valuesObject,omitempty" protobuf:"bytes,10,opt,name=valuesObject"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -390,9 +440,61 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 	}
 }
 
+// SetValuesString sets the Helm values from a YAML string, which must be a map. The map is stored in ValuesObject, so
+// values set as a string can be merged and patched like values set as an object, and Values is cleared. An empty
+// string unsets both fields.
+func (in *ApplicationSourceHelm) SetValuesString(value string) error {
+	if value == "" {
+		in.ValuesObject = nil
+		in.Values = ""
+		return nil
+	}
+	data, err := yaml.YAMLToJSON([]byte(value))
+	if err != nil {
+		return fmt.Errorf("failed converting yaml to json: %w", err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+	if _, ok := v.(map[string]interface{}); !ok {
+		return fmt.Errorf("helm values must be a map, got %T", v)
+	}
+	in.ValuesObject = &runtime.RawExtension{Raw: data}
+	in.Values = ""
+	return nil
+}
+
+// ValuesYAML returns the Helm values as YAML. ValuesObject takes precedence over Values if both are set. A null
+// ValuesObject yields no values.
+func (h *ApplicationSourceHelm) ValuesYAML() []byte {
+	if h.ValuesObject == nil || h.ValuesObject.Raw == nil {
+		return []byte(h.Values)
+	}
+	if strings.TrimSpace(string(h.ValuesObject.Raw)) == "null" {
+		return []byte{}
+	}
+	b, err := yaml.JSONToYAML(h.ValuesObject.Raw)
+	if err != nil {
+		// This should be impossible, since the raw value always comes from a JSON document.
+		return []byte{}
+	}
+	return b
+}
+
+// ValuesIsEmpty returns true if neither Values nor ValuesObject hold any Helm values
+func (h *ApplicationSourceHelm) ValuesIsEmpty() bool {
+	return len(h.ValuesYAML()) == 0
+}
+
+// ValuesString returns the Helm values as a YAML string
+func (h *ApplicationSourceHelm) ValuesString() string {
+	return string(h.ValuesYAML())
+}
+
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.ValuesIsEmpty() && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -896,6 +998,10 @@ type ApplicationStatus struct {
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
 	// ControllerNamespace indicates the namespace in which the application controller is located
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
+	// PendingSync is a manual sync which awaits approval, see AppProjectSpec.SyncApproval
+	PendingSync *PendingSync `json:"pendingSync,omitempty" protobuf:"bytes,14,opt,name=pendingSync"`
+	// HealthHistory contains the latest transitions of the application's health status
+	HealthHistory HealthHistory `json:"healthHistory,omitempty" protobuf:"bytes,15,opt,name=healthHistory"`
 }
 
 // JWTTokens represents a list of JWT tokens
@@ -931,6 +1037,54 @@ func (o *Operation) DryRun() bool {
 	return false
 }
 
+// PendingSyncPhase is the phase of a pending sync
+type PendingSyncPhase string
+
+const (
+	// PendingSyncPhasePending indicates that the sync awaits approval
+	PendingSyncPhasePending PendingSyncPhase = "Pending"
+	// PendingSyncPhaseExpired indicates that the sync was not approved before it expired
+	PendingSyncPhaseExpired PendingSyncPhase = "Expired"
+)
+
+// PendingSync is a manual sync of an application which only starts once it is approved by a different user
+type PendingSync struct {
+	// Operation is the sync operation which is started once the sync is approved
+	Operation Operation `json:"operation" protobuf:"bytes,1,opt,name=operation"`
+	// Requester is the name of the user who requested the sync
+	Requester string `json:"requester" protobuf:"bytes,2,opt,name=requester"`
+	// RequestedAt is the time the sync was requested at
+	RequestedAt metav1.Time `json:"requestedAt" protobuf:"bytes,3,opt,name=requestedAt"`
+	// ExpiresAt is the time after which the sync can no longer be approved
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,4,opt,name=expiresAt"`
+	// DiffSummary summarizes the changes the sync applies, as known when it was requested
+	DiffSummary string `json:"diffSummary,omitempty" protobuf:"bytes,5,opt,name=diffSummary"`
+	// Phase is either Pending or Expired
+	Phase PendingSyncPhase `json:"phase" protobuf:"bytes,6,opt,name=phase,casttype=PendingSyncPhase"`
+	// RequesterSubject is the subject (sub claim) of the user who requested the sync
+	RequesterSubject string `json:"requesterSubject,omitempty" protobuf:"bytes,7,opt,name=requesterSubject"`
+	// RequesterIssuer is the issuer (iss claim) of the token of the user who requested the sync
+	RequesterIssuer string `json:"requesterIssuer,omitempty" protobuf:"bytes,8,opt,name=requesterIssuer"`
+}
+
+// Revision returns the revision the pending sync syncs to
+func (p *PendingSync) Revision() string {
+	if p == nil || p.Operation.Sync == nil {
+		return ""
+	}
+	return p.Operation.Sync.Revision
+}
+
+// IsPending returns whether the sync awaits approval at the given time
+func (p *PendingSync) IsPending(now time.Time) bool {
+	return p != nil && p.Phase == PendingSyncPhasePending && now.Before(p.ExpiresAt.Time)
+}
+
+// IsExpired returns whether the sync was not approved before the given time, but is not yet marked as expired
+func (p *PendingSync) IsExpired(now time.Time) bool {
+	return p != nil && p.Phase == PendingSyncPhasePending && !now.Before(p.ExpiresAt.Time)
+}
+
 // SyncOperationResource contains resources to sync.
 type SyncOperationResource struct {
 	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
@@ -1423,6 +1577,31 @@ type HealthStatus struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 }
 
+// HealthTransition records the health status of an application changing
+type HealthTransition struct {
+	// Status is the health status the application transitioned to
+	Status health.HealthStatusCode `json:"status" protobuf:"bytes,1,opt,name=status"`
+	// TransitionedAt is the time the application transitioned to the health status
+	TransitionedAt metav1.Time `json:"transitionedAt" protobuf:"bytes,2,opt,name=transitionedAt"`
+}
+
+// HealthHistory is a list of health transitions of an application, the latest one last
+type HealthHistory []HealthTransition
+
+// LastTransition returns the latest transition of the health history
+func (in HealthHistory) LastTransition() HealthTransition {
+	return in[len(in)-1]
+}
+
+// Trunc truncates the health history to the latest n transitions
+func (in HealthHistory) Trunc(n int) HealthHistory {
+	i := len(in) - n
+	if i > 0 {
+		in = in[i:]
+	}
+	return in
+}
+
 // InfoItem contains arbitrary, human readable information about an application
 type InfoItem struct {
 	// Name is a human readable title for this piece of information.
@@ -1848,11 +2027,13 @@ type OverrideIgnoreDiff struct {
 }
 
 type rawResourceOverride struct {
-	HealthLua         string           `json:"health.lua,omitempty"`
-	UseOpenLibs       bool             `json:"health.lua.useOpenLibs,omitempty"`
-	Actions           string           `json:"actions,omitempty"`
-	IgnoreDifferences string           `json:"ignoreDifferences,omitempty"`
-	KnownTypeFields   []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthLua         string                    `json:"health.lua,omitempty"`
+	UseOpenLibs       bool                      `json:"health.lua.useOpenLibs,omitempty"`
+	HealthCEL         string                    `json:"health.cel,omitempty"`
+	AggregatedHealth  *OverrideAggregatedHealth `json:"aggregatedHealth,omitempty"`
+	Actions           string                    `json:"actions,omitempty"`
+	IgnoreDifferences string                    `json:"ignoreDifferences,omitempty"`
+	KnownTypeFields   []KnownTypeField          `json:"knownTypeFields,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -1863,6 +2044,18 @@ type ResourceOverride struct {
 	Actions           string             `protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
 	KnownTypeFields   []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	// HealthCEL is a CEL expression assessing the health of the resource, which is used instead of HealthLua if set
+	HealthCEL string `protobuf:"bytes,6,opt,name=healthCEL"`
+	// AggregatedHealth derives the health of the resource from the worst health of its children, if it has no health
+	// check of its own
+	AggregatedHealth *OverrideAggregatedHealth `protobuf:"bytes,7,opt,name=aggregatedHealth"`
+}
+
+// OverrideAggregatedHealth configures deriving the health of a resource from the health of its children
+type OverrideAggregatedHealth struct {
+	// ChildKinds are the kinds of the children which are considered, in the format <group>/<kind>, or <kind> for the
+	// core group. Wildcards are supported. All children are considered if empty.
+	ChildKinds []string `json:"childKinds,omitempty" protobuf:"bytes,1,rep,name=childKinds"`
 }
 
 // TODO: describe this method
@@ -1874,6 +2067,8 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.KnownTypeFields = raw.KnownTypeFields
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
+	s.HealthCEL = raw.HealthCEL
+	s.AggregatedHealth = raw.AggregatedHealth
 	s.Actions = raw.Actions
 	return yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 }
@@ -1884,7 +2079,7 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.HealthCEL, s.AggregatedHealth, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -1903,6 +2098,8 @@ func (o *ResourceOverride) GetActions() (ResourceActions, error) {
 type ResourceActions struct {
 	ActionDiscoveryLua string                     `json:"discovery.lua,omitempty" yaml:"discovery.lua,omitempty" protobuf:"bytes,1,opt,name=actionDiscoveryLua"`
 	Definitions        []ResourceActionDefinition `json:"definitions,omitempty" protobuf:"bytes,2,rep,name=definitions"`
+	// ActionDiscoveryCEL is a CEL expression discovering the available actions, which is used instead of ActionDiscoveryLua if set
+	ActionDiscoveryCEL string `json:"discovery.cel,omitempty" yaml:"discovery.cel,omitempty" protobuf:"bytes,3,opt,name=actionDiscoveryCEL"`
 }
 
 // TODO: describe this type
@@ -1982,6 +2179,9 @@ func validatePolicy(proj string, role string, policy string) error {
 	}
 	// resource
 	resource := strings.Trim(policyComponents[2], " ")
+	if resource == "projects" {
+		return validateProjectOwnerPolicy(proj, policy, policyComponents)
+	}
 	if !isValidResource(resource) {
 		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': project resource must be: 'applications', 'repositories' or 'clusters', not '%s'", policy, resource)
 	}
@@ -1992,9 +2192,10 @@ func validatePolicy(proj string, role string, policy string) error {
 	}
 	// object
 	object := strings.Trim(policyComponents[4], " ")
-	objectRegexp, err := regexp.Compile(fmt.Sprintf(`^%s/[*\w-.]+$`, regexp.QuoteMeta(proj)))
+	// the application may be followed by a resource selector of the form <GROUP>/<KIND>/<NAMESPACE>/<NAME>
+	objectRegexp, err := regexp.Compile(fmt.Sprintf(`^%s/[*\w-.]+(/[*\w-.]*/[*\w-.]+/[*\w-.]*/[*\w-.]+)?$`, regexp.QuoteMeta(proj)))
 	if err != nil || !objectRegexp.MatchString(object) {
-		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': object must be of form '%s/*' or '%s/<APPNAME>', not '%s'", policy, proj, proj, object)
+		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': object must be of form '%s/*' or '%s/<APPNAME>', optionally followed by '/<GROUP>/<KIND>/<NAMESPACE>/<NAME>', not '%s'", policy, proj, proj, object)
 	}
 	// effect
 	effect := strings.Trim(policyComponents[5], " ")
@@ -2004,6 +2205,24 @@ func validatePolicy(proj string, role string, policy string) error {
 	return nil
 }
 
+// validateProjectOwnerPolicy validates a policy of a project role on the project itself. Only the own action may
+// be granted, which lets the members of the role edit the project within the bounds of its ceiling.
+func validateProjectOwnerPolicy(proj string, policy string, policyComponents []string) error {
+	action := strings.Trim(policyComponents[3], " ")
+	if action != "own" {
+		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': the only action of project roles on projects is 'own', not '%s'", policy, action)
+	}
+	object := strings.Trim(policyComponents[4], " ")
+	if object != proj {
+		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': object must be '%s', not '%s'", policy, proj, object)
+	}
+	effect := strings.Trim(policyComponents[5], " ")
+	if effect != "allow" && effect != "deny" {
+		return status.Errorf(codes.InvalidArgument, "invalid policy rule '%s': effect must be: 'allow' or 'deny'", policy)
+	}
+	return nil
+}
+
 var roleNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([-_a-zA-Z0-9]*[a-zA-Z0-9])?$`)
 
 func validateRoleName(name string) error {
@@ -2093,6 +2312,53 @@ type AppProjectSpec struct {
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
 	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
+	// SyncApproval requires manual syncs of the project's applications to be approved by a second user
+	SyncApproval *SyncApproval `json:"syncApproval,omitempty" protobuf:"bytes,14,opt,name=syncApproval"`
+	// Ceiling bounds the source repositories, destinations and cluster resources project owners can permit in the project
+	Ceiling *ProjectCeiling `json:"ceiling,omitempty" protobuf:"bytes,15,opt,name=ceiling"`
+}
+
+// ProjectCeiling is set by global admins and bounds the changes project owners can make to a project. Project owners
+// may only add source repositories, destinations and cluster resources which are matched by the ceiling.
+type ProjectCeiling struct {
+	// SourceRepos are the patterns of the repository URLs project owners may permit
+	SourceRepos []string `json:"sourceRepos,omitempty" protobuf:"bytes,1,rep,name=sourceRepos"`
+	// Destinations are the patterns of the destinations project owners may permit
+	Destinations []ApplicationDestination `json:"destinations,omitempty" protobuf:"bytes,2,rep,name=destinations"`
+	// ClusterResourceWhitelist are the patterns of the cluster-scoped resources project owners may permit
+	ClusterResourceWhitelist []metav1.GroupKind `json:"clusterResourceWhitelist,omitempty" protobuf:"bytes,3,rep,name=clusterResourceWhitelist"`
+}
+
+// DefaultSyncApprovalTimeout is the duration after which pending syncs expire if SyncApproval.Timeout is not set
+const DefaultSyncApprovalTimeout = 24 * time.Hour
+
+// SyncApproval controls the approval of manual syncs
+type SyncApproval struct {
+	// Enabled turns manual syncs and rollbacks into pending syncs, which only start once a different user with the
+	// approve permission approves them. Automated syncs are not affected.
+	Enabled bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
+	// Timeout is the duration after which pending syncs expire, e.g. 2h. Defaults to 24h.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// IsRequired returns whether manual syncs have to be approved
+func (a *SyncApproval) IsRequired() bool {
+	return a != nil && a.Enabled
+}
+
+// GetTimeout returns the duration after which pending syncs expire
+func (a *SyncApproval) GetTimeout() (time.Duration, error) {
+	if a == nil || a.Timeout == "" {
+		return DefaultSyncApprovalTimeout, nil
+	}
+	timeout, err := time.ParseDuration(a.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sync approval timeout %q: %w", a.Timeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid sync approval timeout %q: must be positive", a.Timeout)
+	}
+	return timeout, nil
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2385,6 +2651,17 @@ func (w SyncWindow) Active() bool {
 }
 
 func (w SyncWindow) active(currentTime time.Time) bool {
+	_, active := w.openedAt(currentTime)
+	return active
+}
+
+// OpenedAt returns the time at which the current occurrence of the sync window opened, and whether the sync window is
+// currently active
+func (w SyncWindow) OpenedAt() (time.Time, bool) {
+	return w.openedAt(time.Now())
+}
+
+func (w SyncWindow) openedAt(currentTime time.Time) (time.Time, bool) {
 
 	// If SyncWindow.Active() is called outside of a UTC locale, it should be
 	// first converted to UTC before search
@@ -2398,7 +2675,7 @@ func (w SyncWindow) active(currentTime time.Time) bool {
 	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
 	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
 
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration))
+	return nextWindow.Add(-timeZoneOffsetDuration), nextWindow.Before(currentTime.Add(timeZoneOffsetDuration))
 }
 
 // Update updates a sync window's settings with the given parameter
@@ -2998,3 +3275,8 @@ func (a *Application) QualifiedName() string {
 func (a *Application) RBACName(defaultNS string) string {
 	return security.AppRBACName(defaultNS, a.Spec.GetProject(), a.Namespace, a.Name)
 }
+
+// ResourceRBACName returns the full qualified RBAC resource name for a resource of the application
+func (a *Application) ResourceRBACName(defaultNS string, group, kind, namespace, name string) string {
+	return security.AppResourceRBACName(a.RBACName(defaultNS), group, kind, namespace, name)
+}
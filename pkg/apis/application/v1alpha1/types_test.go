@@ -15,10 +15,13 @@ import (
 	"k8s.io/utils/pointer"
 
 	argocdcommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/git"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -753,6 +756,96 @@ func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	})
 }
 
+func TestAppProject_ValidateSyncApproval(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SyncApproval = &SyncApproval{Enabled: true}
+	assert.NoError(t, p.ValidateProject())
+	timeout, err := p.Spec.SyncApproval.GetTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultSyncApprovalTimeout, timeout)
+
+	p.Spec.SyncApproval.Timeout = "2h"
+	assert.NoError(t, p.ValidateProject())
+	timeout, err = p.Spec.SyncApproval.GetTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, timeout)
+
+	for _, invalid := range []string{"2 days", "-1h", "0s"} {
+		p.Spec.SyncApproval.Timeout = invalid
+		assert.Error(t, p.ValidateProject(), invalid)
+	}
+}
+
+func TestPendingSync(t *testing.T) {
+	now := time.Now()
+	var nilSync *PendingSync
+	assert.False(t, nilSync.IsPending(now))
+	assert.False(t, nilSync.IsExpired(now))
+	assert.Empty(t, nilSync.Revision())
+
+	pending := &PendingSync{
+		Operation: Operation{Sync: &SyncOperation{Revision: "abc"}},
+		ExpiresAt: metav1.NewTime(now.Add(time.Minute)),
+		Phase:     PendingSyncPhasePending,
+	}
+	assert.Equal(t, "abc", pending.Revision())
+	assert.True(t, pending.IsPending(now))
+	assert.False(t, pending.IsExpired(now))
+	assert.False(t, pending.IsPending(now.Add(time.Minute)))
+	assert.True(t, pending.IsExpired(now.Add(time.Minute)))
+
+	pending.Phase = PendingSyncPhaseExpired
+	assert.False(t, pending.IsPending(now))
+	assert.False(t, pending.IsExpired(now.Add(time.Minute)))
+}
+
+func TestProjectCeiling(t *testing.T) {
+	var nilCeiling *ProjectCeiling
+	assert.False(t, nilCeiling.IsSourceRepoPermitted("https://github.com/my-org/app"))
+	assert.True(t, nilCeiling.IsSourceRepoPermitted("!https://github.com/my-org/app"))
+	assert.False(t, nilCeiling.IsDestinationPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"}))
+	assert.True(t, nilCeiling.IsDestinationPermitted(ApplicationDestination{Server: "!https://kubernetes.default.svc", Namespace: "!kube-system"}))
+	assert.False(t, nilCeiling.IsDestinationPermitted(ApplicationDestination{Server: "*", Namespace: "!kube-system"}))
+	assert.False(t, nilCeiling.IsClusterResourcePermitted(metav1.GroupKind{Kind: "Namespace"}))
+
+	ceiling := &ProjectCeiling{
+		SourceRepos:              []string{"https://github.com/my-org/*"},
+		Destinations:             []ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "team-*"}, {Name: "dev-*", Namespace: "*"}},
+		ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}, {Group: "rbac.authorization.k8s.io", Kind: "*"}},
+	}
+	assert.True(t, ceiling.IsSourceRepoPermitted("https://github.com/my-org/app"))
+	assert.True(t, ceiling.IsSourceRepoPermitted("https://github.com/my-org/*"))
+	assert.False(t, ceiling.IsSourceRepoPermitted("https://github.com/other-org/app"))
+	assert.False(t, ceiling.IsSourceRepoPermitted("*"))
+
+	assert.True(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"}))
+	assert.True(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a-*"}))
+	assert.True(t, ceiling.IsDestinationPermitted(ApplicationDestination{Name: "dev-1", Namespace: "kube-system"}))
+	assert.False(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "*"}))
+	assert.False(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "*", Namespace: "team-a"}))
+	// deny patterns match everything but the denied value, so the rest of the destination must still be permitted
+	assert.False(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "*", Namespace: "!kube-system"}))
+	assert.False(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "!kube-system"}))
+	assert.True(t, ceiling.IsDestinationPermitted(ApplicationDestination{Name: "dev-1", Namespace: "!kube-system"}))
+	assert.False(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "!https://kubernetes.default.svc", Namespace: "team-a"}))
+	assert.True(t, ceiling.IsDestinationPermitted(ApplicationDestination{Server: "!https://kubernetes.default.svc", Name: "dev-1", Namespace: "team-a"}))
+
+	// removing deny patterns permits what they denied
+	assert.True(t, nilCeiling.IsSourceRepoRemovalPermitted("https://github.com/my-org/app"))
+	assert.False(t, nilCeiling.IsSourceRepoRemovalPermitted("!https://github.com/my-org/app"))
+	assert.True(t, ceiling.IsSourceRepoRemovalPermitted("!https://github.com/my-org/secret"))
+	assert.False(t, ceiling.IsSourceRepoRemovalPermitted("!https://github.com/other-org/secret"))
+	assert.True(t, nilCeiling.IsDestinationRemovalPermitted(ApplicationDestination{Server: "*", Namespace: "*"}))
+	assert.False(t, nilCeiling.IsDestinationRemovalPermitted(ApplicationDestination{Server: "*", Namespace: "!kube-system"}))
+	assert.False(t, ceiling.IsDestinationRemovalPermitted(ApplicationDestination{Server: "*", Namespace: "!kube-system"}))
+	assert.True(t, ceiling.IsDestinationRemovalPermitted(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "!team-a"}))
+	assert.True(t, ceiling.IsDestinationRemovalPermitted(ApplicationDestination{Name: "!dev-1", Namespace: "!team-a"}))
+
+	assert.True(t, ceiling.IsClusterResourcePermitted(metav1.GroupKind{Group: "", Kind: "Namespace"}))
+	assert.True(t, ceiling.IsClusterResourcePermitted(metav1.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}))
+	assert.False(t, ceiling.IsClusterResourcePermitted(metav1.GroupKind{Group: "*", Kind: "*"}))
+}
+
 // TestInvalidPolicyRules checks various errors in policy rules
 func TestAppProject_InvalidPolicyRules(t *testing.T) {
 	p := newTestProject()
@@ -776,7 +869,8 @@ func TestAppProject_InvalidPolicyRules(t *testing.T) {
 		// invalid resource
 		{"p, proj:my-proj:my-role, , get, my-proj/*, allow", "resource must be: 'applications'"},
 		{"p, proj:my-proj:my-role, applicationz, get, my-proj/*, allow", "resource must be: 'applications'"},
-		{"p, proj:my-proj:my-role, projects, get, my-proj, allow", "resource must be: 'applications'"},
+		{"p, proj:my-proj:my-role, projects, get, my-proj, allow", "the only action of project roles on projects is 'own'"},
+		{"p, proj:my-proj:my-role, projects, own, other-proj, allow", "object must be 'my-proj'"},
 		// invalid action
 		{"p, proj:my-proj:my-role, applications, , my-proj/*, allow", "invalid action"},
 		{"p, proj:my-proj:my-role, applications, foo, my-proj/*, allow", "invalid action"},
@@ -784,6 +878,8 @@ func TestAppProject_InvalidPolicyRules(t *testing.T) {
 		{"p, proj:my-proj:my-role, applications, get, my-proj/, allow", "object must be of form"},
 		{"p, proj:my-proj:my-role, applications, get, /, allow", "object must be of form"},
 		{"p, proj:my-proj:my-role, applications, get, different-my-proj/*, allow", "object must be of form"},
+		{"p, proj:my-proj:my-role, applications, get, my-proj/foo/apps/Deployment, allow", "object must be of form"},
+		{"p, proj:my-proj:my-role, applications, get, my-proj/foo/apps//ns/name, allow", "object must be of form"},
 		// invalid effect
 		{"p, proj:my-proj:my-role, applications, get, my-proj/*, ", "effect must be: 'allow' or 'deny'"},
 		{"p, proj:my-proj:my-role, applications, get, my-proj/*, foo", "effect must be: 'allow' or 'deny'"},
@@ -810,6 +906,7 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 		"p, proj:my-proj:my-role, applications, get, my-proj/*-foo, allow",
 		"p, proj:my-proj:my-role, applications, get, my-proj/foo-*, allow",
 		"p, proj:my-proj:my-role, applications, get, my-proj/*-*, allow",
+		"p, proj:my-proj:my-role, projects, own, my-proj, allow",
 		"p, proj:my-proj:my-role, applications, get, my-proj/*.*, allow",
 		"p, proj:my-proj:my-role, applications, *, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, create, my-proj/foo, allow",
@@ -818,6 +915,9 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 		"p, proj:my-proj:my-role, applications, delete, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/*, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/apps/Deployment/restart, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, delete, my-proj/foo/apps/Deployment/*/*, allow",
+		"p, proj:my-proj:my-role, applications, delete, my-proj/*//Secret/*/*, deny",
+		"p, proj:my-proj:my-role, applications, update, my-proj/foo//Namespace//prod, allow",
 	}
 	for _, good := range goodPolicies {
 		p.Spec.Roles[0].Policies = []string{good}
@@ -960,6 +1060,11 @@ func TestRepository_HasCredentials(t *testing.T) {
 			repo: Repository{InsecureIgnoreHostKey: true},
 			want: false,
 		},
+		{
+			name: "TestHasCredentialProvider",
+			repo: Repository{CredentialProvider: "foo"},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1109,6 +1214,7 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 		{"SourceSSHPrivateKey", &Repository{}, &RepoCreds{SSHPrivateKey: "foo"}, Repository{SSHPrivateKey: "foo"}},
 		{"SourceTLSClientCertData", &Repository{}, &RepoCreds{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &RepoCreds{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
+		{"SourceCredentialProvider", &Repository{}, &RepoCreds{CredentialProvider: "foo"}, Repository{CredentialProvider: "foo"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1130,6 +1236,8 @@ func TestRepository_CopySettingsFrom(t *testing.T) {
 		{"TestHasEnableLFS", &Repository{EnableLFS: true}, Repository{EnableLFS: true}},
 		{"TestHasInsecure", &Repository{Insecure: true}, Repository{Insecure: true}},
 		{"TestHasInsecureIgnoreHostKey", &Repository{InsecureIgnoreHostKey: true}, Repository{InsecureIgnoreHostKey: true}},
+		{"TestHasEnableSubmodules", &Repository{EnableSubmodules: pointer.Bool(false)}, Repository{EnableSubmodules: pointer.Bool(false)}},
+		{"TestHasSubmoduleCredentials", &Repository{SubmoduleCredentials: []SubmoduleCredentials{{URL: "foo"}}}, Repository{SubmoduleCredentials: []SubmoduleCredentials{{URL: "foo"}}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1140,6 +1248,41 @@ func TestRepository_CopySettingsFrom(t *testing.T) {
 	}
 }
 
+func TestRepository_IsSubmoduleEnabled(t *testing.T) {
+	var nilRepo *Repository
+	assert.True(t, nilRepo.IsSubmoduleEnabled(true))
+	assert.True(t, (&Repository{}).IsSubmoduleEnabled(true))
+	assert.False(t, (&Repository{}).IsSubmoduleEnabled(false))
+	assert.False(t, (&Repository{EnableSubmodules: pointer.Bool(false)}).IsSubmoduleEnabled(true))
+	assert.True(t, (&Repository{EnableSubmodules: pointer.Bool(true)}).IsSubmoduleEnabled(false))
+}
+
+func TestRepository_GetSubmoduleGitCreds(t *testing.T) {
+	repo := &Repository{
+		Repo: "https://git.example.com/repo",
+		SubmoduleCredentials: []SubmoduleCredentials{
+			{URL: "https://other.example.com/org/", Creds: &RepoCreds{URL: "https://other.example.com/", Username: "user", Password: "password"}},
+			{URL: "https://unresolved.example.com/"},
+		},
+	}
+	store := git.NoopCredsStore{}
+	assert.Equal(t, []git.SubmoduleCreds{{
+		URLPrefix: "https://other.example.com/org/",
+		Creds:     git.NewHTTPSCreds("user", "password", "", "", false, "", store, false),
+	}}, repo.GetSubmoduleGitCreds(store))
+}
+
+func TestRepository_ValidateSubmoduleCredentials(t *testing.T) {
+	assert.NoError(t, (&Repository{SubmoduleCredentials: []SubmoduleCredentials{
+		{URL: "https://other.example.com/org/"},
+		{URL: "https://other.example.com/org/", CredentialTemplate: "https://other.example.com/"},
+	}}).ValidateSubmoduleCredentials())
+	assert.ErrorContains(t, (&Repository{SubmoduleCredentials: []SubmoduleCredentials{
+		{URL: "https://mirror.example.com/", CredentialTemplate: "https://other.example.com/"},
+	}}).ValidateSubmoduleCredentials(), "does not start with the URL of credential template")
+	assert.ErrorContains(t, (&Repository{SubmoduleCredentials: []SubmoduleCredentials{{}}}).ValidateSubmoduleCredentials(), "must not be empty")
+}
+
 func TestSyncStrategy_Force(t *testing.T) {
 	type fields struct {
 		Apply *SyncStrategyApply
@@ -1419,6 +1562,8 @@ func TestApplicationSourceHelm_IsZero(t *testing.T) {
 		{"Parameters", &ApplicationSourceHelm{Parameters: []HelmParameter{{}}}, false},
 		{"ReleaseName", &ApplicationSourceHelm{ReleaseName: "foa"}, false},
 		{"FileParameters", &ApplicationSourceHelm{FileParameters: []HelmFileParameter{{}}}, false},
+		{"Values", &ApplicationSourceHelm{Values: "foo: bar"}, false},
+		{"ValuesObject", &ApplicationSourceHelm{ValuesObject: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1427,6 +1572,60 @@ func TestApplicationSourceHelm_IsZero(t *testing.T) {
 	}
 }
 
+func TestApplicationSourceHelm_SetValuesString(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		src := ApplicationSourceHelm{ValuesObject: &runtime.RawExtension{Raw: []byte(`{"old":"value"}`)}}
+		err := src.SetValuesString("# comment\nfoo:\n  bar: baz\n")
+		require.NoError(t, err)
+		assert.Equal(t, "", src.Values)
+		require.NotNil(t, src.ValuesObject)
+		assert.JSONEq(t, `{"foo":{"bar":"baz"}}`, string(src.ValuesObject.Raw))
+		assert.Equal(t, "foo:\n  bar: baz\n", src.ValuesString())
+	})
+	t.Run("ReplacesValues", func(t *testing.T) {
+		src := ApplicationSourceHelm{Values: "old: value\n"}
+		err := src.SetValuesString("foo: bar\n")
+		require.NoError(t, err)
+		assert.Equal(t, "", src.Values)
+		assert.Equal(t, "foo: bar\n", src.ValuesString())
+	})
+	t.Run("Empty", func(t *testing.T) {
+		src := ApplicationSourceHelm{Values: "old: value", ValuesObject: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}}
+		err := src.SetValuesString("")
+		require.NoError(t, err)
+		assert.Nil(t, src.ValuesObject)
+		assert.True(t, src.ValuesIsEmpty())
+	})
+	t.Run("NotAMap", func(t *testing.T) {
+		src := ApplicationSourceHelm{}
+		err := src.SetValuesString("- foo\n- bar\n")
+		assert.Error(t, err)
+		assert.Nil(t, src.ValuesObject)
+		assert.Equal(t, "", src.Values)
+	})
+	t.Run("InvalidYAML", func(t *testing.T) {
+		src := ApplicationSourceHelm{}
+		err := src.SetValuesString("foo: [bar")
+		assert.Error(t, err)
+	})
+}
+
+func TestApplicationSourceHelm_ValuesYAML(t *testing.T) {
+	t.Run("Values", func(t *testing.T) {
+		src := ApplicationSourceHelm{Values: "foo: bar\n"}
+		assert.Equal(t, "foo: bar\n", string(src.ValuesYAML()))
+	})
+	t.Run("ValuesObjectTakesPrecedence", func(t *testing.T) {
+		src := ApplicationSourceHelm{Values: "foo: bar\n", ValuesObject: &runtime.RawExtension{Raw: []byte(`{"foo":"baz"}`)}}
+		assert.Equal(t, "foo: baz\n", string(src.ValuesYAML()))
+	})
+	t.Run("NullValuesObject", func(t *testing.T) {
+		src := ApplicationSourceHelm{ValuesObject: &runtime.RawExtension{Raw: []byte(`null`)}}
+		assert.Equal(t, "", string(src.ValuesYAML()))
+		assert.True(t, src.ValuesIsEmpty())
+	})
+}
+
 func TestApplicationSourceKustomize_IsZero(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -2503,6 +2702,18 @@ func TestSyncWindow_Active(t *testing.T) {
 
 }
 
+func TestSyncWindow_OpenedAt(t *testing.T) {
+	now := time.Now().UTC()
+	window := SyncWindow{Kind: "allow", Schedule: "0 10 * * *", Duration: "2h"}
+
+	openedAt, active := window.openedAt(time.Date(now.Year(), now.Month(), now.Day(), 11, 30, 0, 0, time.UTC))
+	assert.True(t, active)
+	assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 10, 0, 0, 0, time.UTC), openedAt)
+
+	_, active = window.openedAt(time.Date(now.Year(), now.Month(), now.Day(), 12, 30, 0, 0, time.UTC))
+	assert.False(t, active)
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
@@ -2867,6 +3078,34 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Equal(t, RevisionHistories{{Revision: "my-revision"}}, RevisionHistories{{}, {}, {Revision: "my-revision"}}.Trunc(1))
 }
 
+func TestHealthHistory_Trunc(t *testing.T) {
+	assert.Len(t, HealthHistory{}.Trunc(1), 0)
+	assert.Len(t, HealthHistory{{}, {}}.Trunc(1), 1)
+	// keep the latest transitions
+	assert.Equal(t, HealthHistory{{Status: health.HealthStatusHealthy}}, HealthHistory{{}, {Status: health.HealthStatusHealthy}}.Trunc(1))
+	assert.Equal(t, health.HealthStatusHealthy, HealthHistory{{}, {Status: health.HealthStatusHealthy}}.LastTransition().Status)
+}
+
+func TestHealthPolicyResourceSelector_Matches(t *testing.T) {
+	assert.True(t, HealthPolicyResourceSelector{}.Matches("apps", "Deployment", "default", "guestbook"))
+	assert.True(t, HealthPolicyResourceSelector{Group: "apps", Kind: "Deployment", Name: "guest*"}.Matches("apps", "Deployment", "default", "guestbook"))
+	assert.False(t, HealthPolicyResourceSelector{Kind: "Deployment", Namespace: "prod"}.Matches("apps", "Deployment", "default", "guestbook"))
+}
+
+func TestApplicationHealthPolicy_GetProgressingGracePeriod(t *testing.T) {
+	_, ok, err := (*ApplicationHealthPolicy)(nil).GetProgressingGracePeriod()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	gracePeriod, ok, err := (&ApplicationHealthPolicy{ProgressingGracePeriod: "10m"}).GetProgressingGracePeriod()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Minute, gracePeriod)
+
+	_, _, err = (&ApplicationHealthPolicy{ProgressingGracePeriod: "10"}).GetProgressingGracePeriod()
+	assert.Error(t, err)
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -156,6 +156,16 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SyncApproval != nil {
+		in, out := &in.SyncApproval, &out.SyncApproval
+		*out = new(SyncApproval)
+		**out = **in
+	}
+	if in.Ceiling != nil {
+		in, out := &in.Ceiling, &out.Ceiling
+		*out = new(ProjectCeiling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -261,6 +271,32 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationHealthPolicy) DeepCopyInto(out *ApplicationHealthPolicy) {
+	*out = *in
+	if in.Ignore != nil {
+		in, out := &in.Ignore, &out.Ignore
+		*out = make([]HealthPolicyResourceSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Require != nil {
+		in, out := &in.Require, &out.Require
+		*out = make([]HealthPolicyResourceSelector, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationHealthPolicy.
+func (in *ApplicationHealthPolicy) DeepCopy() *ApplicationHealthPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationHealthPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -955,6 +991,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]HelmFileParameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesObject != nil {
+		in, out := &in.ValuesObject, &out.ValuesObject
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1186,6 +1227,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HealthPolicy != nil {
+		in, out := &in.HealthPolicy, &out.HealthPolicy
+		*out = new(ApplicationHealthPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1244,6 +1290,18 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = make([]ApplicationSourceType, len(*in))
 		copy(*out, *in)
 	}
+	if in.PendingSync != nil {
+		in, out := &in.PendingSync, &out.PendingSync
+		*out = new(PendingSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthHistory != nil {
+		in, out := &in.HealthHistory, &out.HealthHistory
+		*out = make(HealthHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1884,6 +1942,44 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in HealthHistory) DeepCopyInto(out *HealthHistory) {
+	{
+		in := &in
+		*out = make(HealthHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthHistory.
+func (in HealthHistory) DeepCopy() HealthHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthHistory)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthPolicyResourceSelector) DeepCopyInto(out *HealthPolicyResourceSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthPolicyResourceSelector.
+func (in *HealthPolicyResourceSelector) DeepCopy() *HealthPolicyResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthPolicyResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -1900,6 +1996,23 @@ func (in *HealthStatus) DeepCopy() *HealthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthTransition) DeepCopyInto(out *HealthTransition) {
+	*out = *in
+	in.TransitionedAt.DeepCopyInto(&out.TransitionedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthTransition.
+func (in *HealthTransition) DeepCopy() *HealthTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmFileParameter) DeepCopyInto(out *HelmFileParameter) {
 	*out = *in
@@ -2486,6 +2599,27 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopy() *OrphanedResourcesMonitor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideAggregatedHealth) DeepCopyInto(out *OverrideAggregatedHealth) {
+	*out = *in
+	if in.ChildKinds != nil {
+		in, out := &in.ChildKinds, &out.ChildKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideAggregatedHealth.
+func (in *OverrideAggregatedHealth) DeepCopy() *OverrideAggregatedHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideAggregatedHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideIgnoreDiff) DeepCopyInto(out *OverrideIgnoreDiff) {
 	*out = *in
@@ -2517,6 +2651,25 @@ func (in *OverrideIgnoreDiff) DeepCopy() *OverrideIgnoreDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingSync) DeepCopyInto(out *PendingSync) {
+	*out = *in
+	in.Operation.DeepCopyInto(&out.Operation)
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingSync.
+func (in *PendingSync) DeepCopy() *PendingSync {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfigMapRef) DeepCopyInto(out *PluginConfigMapRef) {
 	*out = *in
@@ -2609,6 +2762,37 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCeiling) DeepCopyInto(out *ProjectCeiling) {
+	*out = *in
+	if in.SourceRepos != nil {
+		in, out := &in.SourceRepos, &out.SourceRepos
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ApplicationDestination, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterResourceWhitelist != nil {
+		in, out := &in.ClusterResourceWhitelist, &out.ClusterResourceWhitelist
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCeiling.
+func (in *ProjectCeiling) DeepCopy() *ProjectCeiling {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCeiling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -2924,6 +3108,18 @@ func (in Repositories) DeepCopy() Repositories {
 func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
 	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.EnableSubmodules != nil {
+		in, out := &in.EnableSubmodules, &out.EnableSubmodules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubmoduleCredentials != nil {
+		in, out := &in.SubmoduleCredentials, &out.SubmoduleCredentials
+		*out = make([]SubmoduleCredentials, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3233,6 +3429,11 @@ func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 		*out = make([]KnownTypeField, len(*in))
 		copy(*out, *in)
 	}
+	if in.AggregatedHealth != nil {
+		in, out := &in.AggregatedHealth, &out.AggregatedHealth
+		*out = new(OverrideAggregatedHealth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3721,6 +3922,43 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubmoduleCredentials) DeepCopyInto(out *SubmoduleCredentials) {
+	*out = *in
+	if in.Creds != nil {
+		in, out := &in.Creds, &out.Creds
+		*out = new(RepoCreds)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubmoduleCredentials.
+func (in *SubmoduleCredentials) DeepCopy() *SubmoduleCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(SubmoduleCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncApproval) DeepCopyInto(out *SyncApproval) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncApproval.
+func (in *SyncApproval) DeepCopy() *SyncApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -681,7 +681,11 @@ type ManifestResponse struct {
 	Revision   string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	SourceType string `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
 	// Raw response of git verify-commit operation (always the empty string for Helm)
-	VerifyResult         string   `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	// Memory limit in bytes applied to each config management tool invocation, zero if no limit was applied
+	ExecMemoryLimit int64 `protobuf:"varint,8,opt,name=execMemoryLimit,proto3" json:"execMemoryLimit,omitempty"`
+	// CPU limit in millicores applied to each config management tool invocation, zero if no limit was applied
+	ExecCpuLimitMillis   int64    `protobuf:"varint,9,opt,name=execCpuLimitMillis,proto3" json:"execCpuLimitMillis,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -762,6 +766,20 @@ func (m *ManifestResponse) GetVerifyResult() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetExecMemoryLimit() int64 {
+	if m != nil {
+		return m.ExecMemoryLimit
+	}
+	return 0
+}
+
+func (m *ManifestResponse) GetExecCpuLimitMillis() int64 {
+	if m != nil {
+		return m.ExecCpuLimitMillis
+	}
+	return 0
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -2189,140 +2207,142 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xe6, 0x92, 0xba, 0x90, 0x47, 0xb2, 0x44, 0x8d, 0x75, 0x59, 0x31, 0x8e, 0xa0, 0x6c, 0x6b,
-	0x43, 0xb5, 0x13, 0x12, 0x92, 0x91, 0xb8, 0x70, 0xd2, 0x14, 0x8a, 0x62, 0x4b, 0x8e, 0x2d, 0x5b,
-	0x5d, 0xbb, 0x2d, 0xd2, 0xba, 0x2d, 0x86, 0xcb, 0x21, 0xb9, 0xe1, 0x5e, 0xc6, 0xbb, 0xb3, 0x0a,
-	0x64, 0xa0, 0x0f, 0x45, 0x8b, 0x02, 0xfd, 0x03, 0x7d, 0xe8, 0xff, 0x28, 0xfa, 0x54, 0xf4, 0xa9,
-	0x97, 0xc7, 0xa0, 0x7f, 0xa0, 0x85, 0x1f, 0xfb, 0x2b, 0x8a, 0xb9, 0xec, 0x95, 0x2b, 0xd9, 0x29,
-	0x65, 0x19, 0xcd, 0x8b, 0xbd, 0x73, 0xe6, 0xcc, 0x39, 0x67, 0xce, 0x9c, 0xcb, 0x37, 0x43, 0xc1,
-	0xb5, 0x80, 0x50, 0x3f, 0x24, 0xc1, 0x31, 0x09, 0x3a, 0xe2, 0xd3, 0x66, 0x7e, 0x70, 0x92, 0xf9,
-	0x6c, 0xd3, 0xc0, 0x67, 0x3e, 0x82, 0x94, 0xd2, 0x7a, 0x30, 0xb0, 0xd9, 0x30, 0xea, 0xb6, 0x2d,
-	0xdf, 0xed, 0xe0, 0x60, 0xe0, 0xd3, 0xc0, 0xff, 0x42, 0x7c, 0xbc, 0x67, 0xf5, 0x3a, 0xc7, 0x3b,
-	0x1d, 0x3a, 0x1a, 0x74, 0x30, 0xb5, 0xc3, 0x0e, 0xa6, 0xd4, 0xb1, 0x2d, 0xcc, 0x6c, 0xdf, 0xeb,
-	0x1c, 0x6f, 0x63, 0x87, 0x0e, 0xf1, 0x76, 0x67, 0x40, 0x3c, 0x12, 0x60, 0x46, 0x7a, 0x52, 0x72,
-	0xeb, 0xad, 0x81, 0xef, 0x0f, 0x1c, 0xd2, 0x11, 0xa3, 0x6e, 0xd4, 0xef, 0x10, 0x97, 0x32, 0xa5,
-	0xd6, 0xf8, 0xcf, 0x3c, 0x2c, 0x1e, 0x62, 0xcf, 0xee, 0x93, 0x90, 0x99, 0xe4, 0x59, 0x44, 0x42,
-	0x86, 0x9e, 0xc2, 0x14, 0x37, 0x46, 0xd7, 0x36, 0xb5, 0xad, 0xb9, 0x9d, 0x83, 0x76, 0x6a, 0x4d,
-	0x3b, 0xb6, 0x46, 0x7c, 0xfc, 0xc2, 0xea, 0xb5, 0x8f, 0x77, 0xda, 0x74, 0x34, 0x68, 0x73, 0x6b,
-	0xda, 0x19, 0x6b, 0xda, 0xb1, 0x35, 0x6d, 0x33, 0xd9, 0x96, 0x29, 0xa4, 0xa2, 0x16, 0xd4, 0x03,
-	0x72, 0x6c, 0x87, 0xb6, 0xef, 0xe9, 0xd5, 0x4d, 0x6d, 0xab, 0x61, 0x26, 0x63, 0xa4, 0xc3, 0xac,
-	0xe7, 0xef, 0x61, 0x6b, 0x48, 0xf4, 0xda, 0xa6, 0xb6, 0x55, 0x37, 0xe3, 0x21, 0xda, 0x84, 0x39,
-	0x4c, 0xe9, 0x03, 0xdc, 0x25, 0xce, 0x7d, 0x72, 0xa2, 0x4f, 0x89, 0x85, 0x59, 0x12, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x4f, 0x8b, 0xd9, 0x78, 0x88, 0xae, 0x40, 0xc3, 0xc3, 0x2e, 0x09,
-	0x29, 0xb6, 0x88, 0x5e, 0x17, 0x73, 0x29, 0x01, 0xfd, 0x12, 0x96, 0x32, 0x86, 0x3f, 0xf6, 0xa3,
-	0xc0, 0x22, 0x3a, 0x88, 0xad, 0x3f, 0x9a, 0x6c, 0xeb, 0xbb, 0x45, 0xb1, 0xe6, 0xb8, 0x26, 0xf4,
-	0x73, 0x98, 0x16, 0x27, 0xaf, 0xcf, 0x6d, 0xd6, 0xce, 0xd5, 0xdb, 0x52, 0x2c, 0xf2, 0x60, 0x96,
-	0x3a, 0xd1, 0xc0, 0xf6, 0x42, 0x7d, 0x5e, 0x68, 0x78, 0x32, 0x99, 0x86, 0x3d, 0xdf, 0xeb, 0xdb,
-	0x83, 0x43, 0xec, 0xe1, 0x01, 0x71, 0x89, 0xc7, 0x8e, 0x84, 0x70, 0x33, 0x56, 0x82, 0x9e, 0x43,
-	0x73, 0x14, 0x85, 0xcc, 0x77, 0xed, 0xe7, 0xe4, 0x11, 0xe5, 0x6b, 0x43, 0xfd, 0x92, 0xf0, 0xe6,
-	0xc3, 0xc9, 0x14, 0xdf, 0x2f, 0x48, 0x35, 0xc7, 0xf4, 0xf0, 0x20, 0x19, 0x45, 0x5d, 0xf2, 0x23,
-	0x12, 0x88, 0xe8, 0x5a, 0x90, 0x41, 0x92, 0x21, 0xc9, 0x30, 0xb2, 0xd5, 0x28, 0xd4, 0x17, 0x37,
-	0x6b, 0x32, 0x8c, 0x12, 0x12, 0xda, 0x82, 0xc5, 0x63, 0x12, 0xd8, 0xfd, 0x93, 0xc7, 0xf6, 0xc0,
-	0xc3, 0x2c, 0x0a, 0x88, 0xde, 0x14, 0xa1, 0x58, 0x24, 0x23, 0x17, 0x2e, 0x0d, 0x89, 0xe3, 0x72,
-	0x97, 0xef, 0x05, 0xa4, 0x17, 0xea, 0x4b, 0xc2, 0xbf, 0xfb, 0x93, 0x9f, 0xa0, 0x10, 0x67, 0xe6,
-	0xa5, 0x73, 0xc3, 0x3c, 0xdf, 0x54, 0x99, 0x22, 0x73, 0x04, 0x49, 0xc3, 0x0a, 0x64, 0x74, 0x0d,
-	0x16, 0x58, 0x80, 0xad, 0x91, 0xed, 0x0d, 0x0e, 0x09, 0x1b, 0xfa, 0x3d, 0xfd, 0xb2, 0xf0, 0x44,
-	0x81, 0x8a, 0x2c, 0x40, 0xc4, 0xc3, 0x5d, 0x87, 0xf4, 0x64, 0x2c, 0x3e, 0x39, 0xa1, 0x24, 0xd4,
-	0x97, 0xc5, 0x2e, 0x6e, 0xb6, 0x33, 0x15, 0xaa, 0x50, 0x20, 0xda, 0x77, 0xc6, 0x56, 0xdd, 0xf1,
-	0x58, 0x70, 0x62, 0x96, 0x88, 0x43, 0x23, 0x98, 0xe3, 0xfb, 0x88, 0x43, 0x61, 0x45, 0x84, 0xc2,
-	0xbd, 0xc9, 0x7c, 0x74, 0x90, 0x0a, 0x34, 0xb3, 0xd2, 0x51, 0x1b, 0xd0, 0x10, 0x87, 0x87, 0x91,
-	0xc3, 0x6c, 0xea, 0x10, 0x69, 0x46, 0xa8, 0xaf, 0x0a, 0x37, 0x95, 0xcc, 0xa0, 0xfb, 0x00, 0x01,
-	0xe9, 0xc7, 0x7c, 0x6b, 0x62, 0xe7, 0x37, 0xce, 0xda, 0xb9, 0x99, 0x70, 0xcb, 0x1d, 0x67, 0x96,
-	0x73, 0xe5, 0x7c, 0x1b, 0xc4, 0x62, 0x2a, 0xdb, 0x45, 0x5a, 0xeb, 0x22, 0xc4, 0x4a, 0x66, 0x78,
-	0x2c, 0x2a, 0xaa, 0x28, 0x5a, 0xeb, 0x32, 0x5a, 0x33, 0xa4, 0xd6, 0x1d, 0x58, 0x3b, 0xc5, 0xd5,
-	0xa8, 0x09, 0xb5, 0x11, 0x39, 0x11, 0x25, 0xba, 0x61, 0xf2, 0x4f, 0xb4, 0x0c, 0xd3, 0xc7, 0xd8,
-	0x89, 0x88, 0x28, 0xaa, 0x75, 0x53, 0x0e, 0x6e, 0x57, 0xbf, 0xab, 0xb5, 0x7e, 0xab, 0xc1, 0x62,
-	0xc1, 0xf0, 0x92, 0xf5, 0x3f, 0xcb, 0xae, 0x3f, 0x87, 0x30, 0xee, 0x3f, 0xc1, 0xc1, 0x80, 0xb0,
-	0x8c, 0x21, 0xc6, 0x3f, 0x35, 0xd0, 0x0b, 0x1e, 0xfd, 0xb1, 0xcd, 0x86, 0x77, 0x6d, 0x87, 0x84,
-	0xe8, 0x16, 0xcc, 0x06, 0x92, 0xa6, 0x1a, 0xcf, 0x5b, 0x67, 0x1c, 0xc4, 0x41, 0xc5, 0x8c, 0xb9,
-	0xd1, 0xc7, 0x50, 0x77, 0x09, 0xc3, 0x3d, 0xcc, 0xb0, 0xb2, 0x7d, 0xb3, 0x6c, 0x25, 0xd7, 0x72,
-	0xa8, 0xf8, 0x0e, 0x2a, 0x66, 0xb2, 0x06, 0xbd, 0x0f, 0xd3, 0xd6, 0x30, 0xf2, 0x46, 0xa2, 0xe5,
-	0xcc, 0xed, 0xbc, 0x7d, 0xda, 0xe2, 0x3d, 0xce, 0x74, 0x50, 0x31, 0x25, 0xf7, 0x27, 0x33, 0x30,
-	0x45, 0x71, 0xc0, 0x8c, 0xbb, 0xb0, 0x5c, 0xa6, 0x82, 0xf7, 0x39, 0x6b, 0x48, 0xac, 0x51, 0x18,
-	0xb9, 0xca, 0xcd, 0xc9, 0x18, 0x21, 0x98, 0x0a, 0xed, 0xe7, 0xd2, 0xd5, 0x35, 0x53, 0x7c, 0x1b,
-	0xdf, 0x81, 0xa5, 0x31, 0x6d, 0xfc, 0x50, 0xa5, 0x6d, 0x5c, 0xc2, 0xbc, 0x52, 0x6d, 0x44, 0xb0,
-	0xf2, 0x44, 0xf8, 0x22, 0x29, 0xf6, 0x17, 0xd1, 0xb9, 0x8d, 0x03, 0x58, 0x2d, 0xaa, 0x0d, 0xa9,
-	0xef, 0x85, 0x84, 0x87, 0xbe, 0xa8, 0x8e, 0x36, 0xe9, 0xa5, 0xb3, 0xc2, 0x8a, 0xba, 0x59, 0x32,
-	0x63, 0xfc, 0xaa, 0x0a, 0xab, 0x26, 0x09, 0x7d, 0xe7, 0x98, 0xc4, 0xa5, 0xeb, 0x62, 0xc0, 0xc7,
-	0x4f, 0xa1, 0x86, 0x29, 0x55, 0x61, 0x72, 0xef, 0xdc, 0xda, 0xbb, 0xc9, 0xa5, 0xa2, 0x77, 0x61,
-	0x09, 0xbb, 0x5d, 0x7b, 0x10, 0xf9, 0x51, 0x18, 0x6f, 0x4b, 0x04, 0x55, 0xc3, 0x1c, 0x9f, 0x30,
-	0x2c, 0x58, 0x1b, 0x73, 0x81, 0x72, 0x67, 0x16, 0x22, 0x69, 0x05, 0x88, 0x54, 0xaa, 0xa4, 0x7a,
-	0x9a, 0x92, 0xbf, 0x69, 0xd0, 0x4c, 0x53, 0x47, 0x89, 0xbf, 0x02, 0x0d, 0x57, 0xd1, 0x42, 0x5d,
-	0x13, 0xf5, 0x29, 0x25, 0xe4, 0xd1, 0x52, 0xb5, 0x88, 0x96, 0x56, 0x61, 0x46, 0x82, 0x59, 0xb5,
-	0x31, 0x35, 0xca, 0x99, 0x3c, 0x55, 0x30, 0x79, 0x03, 0x20, 0x4c, 0xea, 0x97, 0x3e, 0x23, 0x66,
-	0x33, 0x14, 0x64, 0xc0, 0xbc, 0xec, 0xad, 0x26, 0x09, 0x23, 0x87, 0xe9, 0xb3, 0x82, 0x23, 0x47,
-	0x33, 0x7c, 0x58, 0x7c, 0x60, 0xf3, 0x3d, 0xf4, 0xc3, 0x8b, 0x09, 0xf6, 0x0f, 0x60, 0x8a, 0x2b,
-	0xe3, 0x1b, 0xeb, 0x06, 0xd8, 0xb3, 0x86, 0x24, 0xf6, 0x55, 0x32, 0xe6, 0x69, 0xcc, 0xf0, 0x20,
-	0xd4, 0xab, 0x82, 0x2e, 0xbe, 0x8d, 0x3f, 0x55, 0xa5, 0xa5, 0xbb, 0x94, 0x86, 0x6f, 0x1e, 0x50,
-	0x97, 0xb7, 0xf8, 0xda, 0x78, 0x8b, 0x2f, 0x98, 0xfc, 0x75, 0x5a, 0xfc, 0x39, 0xb5, 0x29, 0x23,
-	0x82, 0xd9, 0x5d, 0x4a, 0xb9, 0x21, 0x68, 0x1b, 0xa6, 0x30, 0xa5, 0xd2, 0xe1, 0x85, 0x8a, 0xac,
-	0x58, 0xf8, 0xff, 0xca, 0x24, 0xc1, 0xda, 0xba, 0x05, 0x8d, 0x84, 0xf4, 0x32, 0xb5, 0x8d, 0xac,
-	0xda, 0x4d, 0x00, 0x89, 0x61, 0xef, 0x79, 0x7d, 0x9f, 0x1f, 0x29, 0x0f, 0x76, 0xb5, 0x54, 0x7c,
-	0x1b, 0xb7, 0x63, 0x0e, 0x61, 0xdb, 0xbb, 0x30, 0x6d, 0x33, 0xe2, 0xc6, 0xc6, 0xad, 0x66, 0x8d,
-	0x4b, 0x05, 0x99, 0x92, 0xc9, 0xf8, 0x7b, 0x1d, 0xd6, 0xf9, 0x89, 0x3d, 0x16, 0x69, 0xb2, 0x4b,
-	0xe9, 0xa7, 0x84, 0x61, 0xdb, 0x09, 0x7f, 0x10, 0x91, 0xe0, 0xe4, 0x35, 0x07, 0xc6, 0x00, 0x66,
-	0x64, 0x96, 0xa9, 0x7a, 0x77, 0xee, 0xd7, 0x19, 0x25, 0x3e, 0xbd, 0xc3, 0xd4, 0x5e, 0xcf, 0x1d,
-	0xa6, 0xec, 0x4e, 0x31, 0x75, 0x41, 0x77, 0x8a, 0xd3, 0xaf, 0x95, 0x99, 0xcb, 0xea, 0x4c, 0xfe,
-	0xb2, 0x5a, 0x02, 0xd5, 0x67, 0x5f, 0x15, 0xaa, 0xd7, 0x4b, 0xa1, 0xba, 0x5b, 0x9a, 0xc7, 0x0d,
-	0xe1, 0xee, 0xef, 0x65, 0x23, 0xf0, 0xd4, 0x58, 0x9b, 0x04, 0xb4, 0xc3, 0x6b, 0x05, 0xed, 0x3f,
-	0xcc, 0x81, 0x70, 0x79, 0x0d, 0x7e, 0xff, 0xd5, 0xf6, 0x74, 0x06, 0x1c, 0xff, 0xc6, 0x81, 0xe7,
-	0xdf, 0x08, 0xcc, 0x44, 0xfd, 0xd4, 0x07, 0x49, 0x43, 0xe7, 0x7d, 0x88, 0xb7, 0x56, 0x55, 0xb4,
-	0xf8, 0x37, 0xba, 0x01, 0x53, 0xdc, 0xc9, 0x0a, 0xd4, 0xae, 0x65, 0xfd, 0xc9, 0x4f, 0x62, 0x97,
-	0xd2, 0xc7, 0x94, 0x58, 0xa6, 0x60, 0x42, 0xb7, 0xa1, 0x91, 0x04, 0xbe, 0xca, 0xac, 0x2b, 0xd9,
-	0x15, 0x49, 0x9e, 0xc4, 0xcb, 0x52, 0x76, 0xbe, 0xb6, 0x67, 0x07, 0xc4, 0x12, 0x90, 0x6f, 0x7a,
-	0x7c, 0xed, 0xa7, 0xf1, 0x64, 0xb2, 0x36, 0x61, 0x47, 0xdb, 0x30, 0x23, 0xdf, 0x0d, 0x44, 0x06,
-	0xcd, 0xed, 0xac, 0x8f, 0x17, 0xd3, 0x78, 0x95, 0x62, 0x34, 0xfe, 0xaa, 0xc1, 0x3b, 0x69, 0x40,
-	0xc4, 0xd9, 0x14, 0xa3, 0xee, 0x37, 0xdf, 0x71, 0xaf, 0xc1, 0x82, 0x80, 0xf9, 0xe9, 0xf3, 0x81,
-	0x7c, 0xc9, 0x2a, 0x50, 0x8d, 0x3f, 0x6a, 0x70, 0x75, 0x7c, 0x1f, 0x7b, 0x43, 0x1c, 0xb0, 0xe4,
-	0x78, 0x2f, 0x62, 0x2f, 0x71, 0xc3, 0xab, 0xa6, 0x0d, 0x2f, 0xb7, 0xbf, 0x5a, 0x7e, 0x7f, 0xc6,
-	0x5f, 0xaa, 0x30, 0x97, 0x09, 0xa0, 0xb2, 0x86, 0xc9, 0x01, 0x9f, 0x88, 0x5b, 0x71, 0xb1, 0x13,
-	0x4d, 0xa1, 0x61, 0x66, 0x28, 0x68, 0x04, 0x40, 0x71, 0x80, 0x5d, 0xc2, 0x48, 0xc0, 0x2b, 0x39,
-	0xcf, 0xf8, 0xfb, 0x93, 0x57, 0x97, 0xa3, 0x58, 0xa6, 0x99, 0x11, 0xcf, 0x11, 0xab, 0x50, 0x1d,
-	0xaa, 0xfa, 0xad, 0x46, 0xe8, 0x4b, 0x58, 0xe8, 0xdb, 0x0e, 0x39, 0x4a, 0x0d, 0x99, 0x11, 0x86,
-	0x3c, 0x9a, 0xdc, 0x90, 0xbb, 0x59, 0xb9, 0x66, 0x41, 0x8d, 0x71, 0x1d, 0x9a, 0xc5, 0x7c, 0xe2,
-	0x46, 0xda, 0x2e, 0x1e, 0x24, 0xde, 0x52, 0x23, 0x03, 0x41, 0xb3, 0x98, 0x3f, 0xc6, 0xbf, 0xaa,
-	0xb0, 0x92, 0x88, 0xdb, 0xf5, 0x3c, 0x3f, 0xf2, 0x2c, 0xf1, 0x14, 0x57, 0x7a, 0x16, 0xcb, 0x30,
-	0xcd, 0x6c, 0xe6, 0x24, 0xc0, 0x47, 0x0c, 0x78, 0xef, 0x62, 0xbe, 0xef, 0x30, 0x9b, 0xaa, 0x03,
-	0x8e, 0x87, 0xf2, 0xec, 0x9f, 0x45, 0x76, 0x40, 0x7a, 0xa2, 0x12, 0xd4, 0xcd, 0x64, 0xcc, 0xe7,
-	0x38, 0xaa, 0x11, 0x30, 0x5e, 0x3a, 0x33, 0x19, 0x8b, 0xb8, 0xf7, 0x1d, 0x87, 0x58, 0xdc, 0x1d,
-	0x19, 0xa0, 0x5f, 0xa0, 0x8a, 0x0b, 0x04, 0x0b, 0x6c, 0x6f, 0xa0, 0x60, 0xbe, 0x1a, 0x71, 0x3b,
-	0x71, 0x10, 0xe0, 0x13, 0xbd, 0x2e, 0x1c, 0x20, 0x07, 0xe8, 0x23, 0xa8, 0xb9, 0x98, 0xaa, 0x46,
-	0x77, 0x3d, 0x57, 0x1d, 0xca, 0x3c, 0xd0, 0x3e, 0xc4, 0x54, 0x76, 0x02, 0xbe, 0xac, 0xf5, 0x01,
-	0xd4, 0x63, 0xc2, 0xd7, 0x82, 0x84, 0x5f, 0xc0, 0xa5, 0x5c, 0xf1, 0x41, 0x9f, 0xc3, 0x6a, 0x1a,
-	0x51, 0x59, 0x85, 0x0a, 0x04, 0xbe, 0xf3, 0x52, 0xcb, 0xcc, 0x53, 0x04, 0x18, 0xcf, 0x60, 0x89,
-	0x87, 0x8c, 0x48, 0xfc, 0x0b, 0xba, 0xda, 0x7c, 0x08, 0x8d, 0x44, 0x65, 0x69, 0xcc, 0xb4, 0xa0,
-	0x7e, 0x1c, 0x3f, 0x91, 0xca, 0xbb, 0x4d, 0x32, 0x36, 0x76, 0x01, 0x65, 0xed, 0x55, 0x1d, 0xe8,
-	0x46, 0x1e, 0x14, 0xaf, 0x14, 0xdb, 0x8d, 0x60, 0x8f, 0x31, 0xf1, 0xef, 0xaa, 0xb0, 0xb8, 0x6f,
-	0x8b, 0x57, 0x8e, 0x0b, 0x2a, 0x72, 0xd7, 0xa1, 0x19, 0x46, 0x5d, 0xd7, 0xef, 0x45, 0x0e, 0x51,
-	0xa0, 0x40, 0x75, 0xfa, 0x31, 0xfa, 0x59, 0xc5, 0x8f, 0x3b, 0x8b, 0x62, 0x36, 0x54, 0x37, 0x5c,
-	0xf1, 0x8d, 0x3e, 0x82, 0xf5, 0x87, 0xe4, 0x4b, 0xb5, 0x9f, 0x7d, 0xc7, 0xef, 0x76, 0x6d, 0x6f,
-	0x10, 0x2b, 0x99, 0x16, 0x4a, 0x4e, 0x67, 0x30, 0x7e, 0xad, 0x41, 0x33, 0xf5, 0x85, 0xf2, 0xe6,
-	0x2d, 0x19, 0xf5, 0xd2, 0x97, 0x57, 0xb3, 0xbe, 0x2c, 0xb2, 0xfe, 0xef, 0x01, 0x3f, 0x9f, 0x0d,
-	0xf8, 0x3f, 0x6b, 0xb0, 0xb2, 0x6f, 0xb3, 0xb8, 0xd4, 0xd8, 0xff, 0x67, 0xe7, 0x62, 0xb4, 0x61,
-	0xb5, 0x68, 0xbe, 0x72, 0xe5, 0x32, 0x4c, 0xf3, 0x53, 0x8a, 0xef, 0xee, 0x72, 0xb0, 0xf3, 0x55,
-	0x03, 0x96, 0xd2, 0xe6, 0xcb, 0xff, 0xb5, 0x2d, 0x82, 0x1e, 0x41, 0x73, 0x5f, 0xfd, 0x76, 0x16,
-	0xbf, 0x99, 0xa0, 0xb3, 0x1e, 0x21, 0x5b, 0x57, 0xca, 0x27, 0xa5, 0x6a, 0xa3, 0x82, 0x2c, 0x58,
-	0x2f, 0x0a, 0x4c, 0xdf, 0x3b, 0xbf, 0x7d, 0x86, 0xe4, 0x84, 0xeb, 0x65, 0x2a, 0xb6, 0x34, 0xf4,
-	0x39, 0x2c, 0xe4, 0x5f, 0xe5, 0x50, 0xae, 0x1a, 0x95, 0x3e, 0x14, 0xb6, 0x8c, 0xb3, 0x58, 0x12,
-	0xfb, 0x9f, 0x72, 0xe8, 0x9b, 0x7b, 0xa2, 0x42, 0x46, 0x1e, 0x98, 0x97, 0x3d, 0xe1, 0xb5, 0xbe,
-	0x75, 0x26, 0x4f, 0x22, 0xfd, 0x43, 0xa8, 0xc7, 0x4f, 0x3a, 0x79, 0x37, 0x17, 0x1e, 0x7a, 0x5a,
-	0xcd, 0xbc, 0xbc, 0x7e, 0x68, 0x54, 0xd0, 0xc7, 0x72, 0x31, 0xbf, 0xf2, 0x8f, 0x2f, 0xce, 0x3c,
-	0x64, 0xb4, 0x2e, 0x97, 0x3c, 0x1e, 0x18, 0x15, 0xf4, 0x7d, 0x98, 0xe3, 0x5f, 0x47, 0xea, 0x57,
-	0xab, 0xd5, 0xb6, 0xfc, 0x91, 0xb4, 0x1d, 0xff, 0x48, 0xda, 0xbe, 0xe3, 0x52, 0x76, 0xd2, 0x2a,
-	0xb9, 0xdd, 0x2b, 0x01, 0x4f, 0xe1, 0xd2, 0x3e, 0x61, 0x29, 0x18, 0x47, 0x57, 0x5f, 0xe9, 0xca,
-	0xd2, 0x32, 0x8a, 0x6c, 0xe3, 0x78, 0xde, 0xa8, 0xa0, 0xdf, 0x6b, 0x70, 0x79, 0x9f, 0xb0, 0x22,
-	0xbc, 0x45, 0xef, 0x95, 0x2b, 0x39, 0x05, 0x06, 0xb7, 0x1e, 0x4e, 0x9a, 0xaf, 0x79, 0xb1, 0x46,
-	0x05, 0xfd, 0x41, 0x83, 0xb5, 0x8c, 0x61, 0x59, 0xbc, 0x8a, 0xb6, 0xcf, 0x36, 0xae, 0x04, 0xdb,
-	0xb6, 0x3e, 0x9b, 0xf0, 0xc7, 0xc8, 0x8c, 0x48, 0xa3, 0x82, 0x8e, 0xc4, 0x99, 0xa4, 0xed, 0x09,
-	0xbd, 0x5d, 0xda, 0x87, 0x12, 0xed, 0x1b, 0xa7, 0x4d, 0x27, 0xe7, 0xf0, 0x19, 0xcc, 0xed, 0x13,
-	0x16, 0x57, 0xdd, 0x7c, 0xa4, 0x15, 0x5a, 0x58, 0x3e, 0x55, 0x8b, 0x85, 0x5a, 0x44, 0xcc, 0x92,
-	0x94, 0x95, 0xa9, 0x53, 0xf9, 0x5c, 0x2d, 0x2d, 0xc1, 0xf9, 0x88, 0x29, 0x2f, 0x73, 0x46, 0xe5,
-	0x93, 0xdd, 0x7f, 0xbc, 0xd8, 0xd0, 0xbe, 0x7a, 0xb1, 0xa1, 0xfd, 0xfb, 0xc5, 0x86, 0xf6, 0x93,
-	0x9b, 0x2f, 0xf9, 0x0b, 0x82, 0xcc, 0x1f, 0x25, 0x60, 0x6a, 0x5b, 0x8e, 0x4d, 0x3c, 0xd6, 0x9d,
-	0x11, 0xc1, 0x7f, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x86, 0xe4, 0x0d, 0xb3, 0x20,
-	0x00, 0x00,
+	// 2147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x5d, 0x6f, 0x5c, 0x47,
+	0xd5, 0xbb, 0xeb, 0x8f, 0xdd, 0xe3, 0x24, 0x5e, 0x4f, 0x62, 0xfb, 0x7a, 0xeb, 0x5a, 0xee, 0x85,
+	0x44, 0x26, 0x69, 0x77, 0x65, 0x47, 0x6d, 0x50, 0x5a, 0x40, 0xae, 0x9b, 0xd8, 0x6d, 0xe2, 0xc4,
+	0xdc, 0x04, 0x50, 0x21, 0x80, 0x66, 0xef, 0xce, 0xee, 0x4e, 0xf7, 0x7e, 0x4c, 0xee, 0x9d, 0xeb,
+	0xe2, 0x48, 0x3c, 0x20, 0x10, 0x12, 0x7f, 0x80, 0x07, 0x9e, 0xf8, 0x13, 0x88, 0x27, 0xc4, 0x13,
+	0x82, 0xc7, 0x8a, 0x3f, 0x00, 0xca, 0x23, 0xbf, 0x02, 0xcd, 0xc7, 0xfd, 0xdc, 0xeb, 0x4d, 0xca,
+	0x26, 0xae, 0xe8, 0x8b, 0x3d, 0x73, 0xe6, 0xcc, 0x39, 0x67, 0xce, 0x9c, 0xcf, 0xb9, 0x0b, 0xd7,
+	0x02, 0xc2, 0xfc, 0x90, 0x04, 0x27, 0x24, 0xe8, 0xc8, 0x21, 0xe5, 0x7e, 0x70, 0x9a, 0x19, 0xb6,
+	0x59, 0xe0, 0x73, 0x1f, 0x41, 0x0a, 0x69, 0xdd, 0x1f, 0x50, 0x3e, 0x8c, 0xba, 0x6d, 0xdb, 0x77,
+	0x3b, 0x38, 0x18, 0xf8, 0x2c, 0xf0, 0x3f, 0x93, 0x83, 0x77, 0xec, 0x5e, 0xe7, 0x64, 0xb7, 0xc3,
+	0x46, 0x83, 0x0e, 0x66, 0x34, 0xec, 0x60, 0xc6, 0x1c, 0x6a, 0x63, 0x4e, 0x7d, 0xaf, 0x73, 0xb2,
+	0x83, 0x1d, 0x36, 0xc4, 0x3b, 0x9d, 0x01, 0xf1, 0x48, 0x80, 0x39, 0xe9, 0x29, 0xca, 0xad, 0x37,
+	0x06, 0xbe, 0x3f, 0x70, 0x48, 0x47, 0xce, 0xba, 0x51, 0xbf, 0x43, 0x5c, 0xc6, 0x35, 0x5b, 0xf3,
+	0x3f, 0x17, 0x60, 0xe9, 0x08, 0x7b, 0xb4, 0x4f, 0x42, 0x6e, 0x91, 0xa7, 0x11, 0x09, 0x39, 0x7a,
+	0x02, 0xb3, 0x42, 0x18, 0xa3, 0xb2, 0x55, 0xd9, 0x5e, 0xdc, 0x3d, 0x6c, 0xa7, 0xd2, 0xb4, 0x63,
+	0x69, 0xe4, 0xe0, 0xe7, 0x76, 0xaf, 0x7d, 0xb2, 0xdb, 0x66, 0xa3, 0x41, 0x5b, 0x48, 0xd3, 0xce,
+	0x48, 0xd3, 0x8e, 0xa5, 0x69, 0x5b, 0xc9, 0xb1, 0x2c, 0x49, 0x15, 0xb5, 0xa0, 0x1e, 0x90, 0x13,
+	0x1a, 0x52, 0xdf, 0x33, 0xaa, 0x5b, 0x95, 0xed, 0x86, 0x95, 0xcc, 0x91, 0x01, 0x0b, 0x9e, 0xbf,
+	0x8f, 0xed, 0x21, 0x31, 0x6a, 0x5b, 0x95, 0xed, 0xba, 0x15, 0x4f, 0xd1, 0x16, 0x2c, 0x62, 0xc6,
+	0xee, 0xe3, 0x2e, 0x71, 0xee, 0x91, 0x53, 0x63, 0x56, 0x6e, 0xcc, 0x82, 0xc4, 0x5e, 0xcc, 0xd8,
+	0x03, 0xec, 0x12, 0x63, 0x4e, 0xae, 0xc6, 0x53, 0xb4, 0x01, 0x0d, 0x0f, 0xbb, 0x24, 0x64, 0xd8,
+	0x26, 0x46, 0x5d, 0xae, 0xa5, 0x00, 0xf4, 0x4b, 0x58, 0xce, 0x08, 0xfe, 0xc8, 0x8f, 0x02, 0x9b,
+	0x18, 0x20, 0x8f, 0xfe, 0x70, 0xba, 0xa3, 0xef, 0x15, 0xc9, 0x5a, 0xe3, 0x9c, 0xd0, 0xcf, 0x60,
+	0x4e, 0xde, 0xbc, 0xb1, 0xb8, 0x55, 0x7b, 0xa5, 0xda, 0x56, 0x64, 0x91, 0x07, 0x0b, 0xcc, 0x89,
+	0x06, 0xd4, 0x0b, 0x8d, 0x0b, 0x92, 0xc3, 0xe3, 0xe9, 0x38, 0xec, 0xfb, 0x5e, 0x9f, 0x0e, 0x8e,
+	0xb0, 0x87, 0x07, 0xc4, 0x25, 0x1e, 0x3f, 0x96, 0xc4, 0xad, 0x98, 0x09, 0x7a, 0x06, 0xcd, 0x51,
+	0x14, 0x72, 0xdf, 0xa5, 0xcf, 0xc8, 0x43, 0x26, 0xf6, 0x86, 0xc6, 0x45, 0xa9, 0xcd, 0x07, 0xd3,
+	0x31, 0xbe, 0x57, 0xa0, 0x6a, 0x8d, 0xf1, 0x11, 0x46, 0x32, 0x8a, 0xba, 0xe4, 0x87, 0x24, 0x90,
+	0xd6, 0x75, 0x49, 0x19, 0x49, 0x06, 0xa4, 0xcc, 0x88, 0xea, 0x59, 0x68, 0x2c, 0x6d, 0xd5, 0x94,
+	0x19, 0x25, 0x20, 0xb4, 0x0d, 0x4b, 0x27, 0x24, 0xa0, 0xfd, 0xd3, 0x47, 0x74, 0xe0, 0x61, 0x1e,
+	0x05, 0xc4, 0x68, 0x4a, 0x53, 0x2c, 0x82, 0x91, 0x0b, 0x17, 0x87, 0xc4, 0x71, 0x85, 0xca, 0xf7,
+	0x03, 0xd2, 0x0b, 0x8d, 0x65, 0xa9, 0xdf, 0x83, 0xe9, 0x6f, 0x50, 0x92, 0xb3, 0xf2, 0xd4, 0x85,
+	0x60, 0x9e, 0x6f, 0x69, 0x4f, 0x51, 0x3e, 0x82, 0x94, 0x60, 0x05, 0x30, 0xba, 0x06, 0x97, 0x78,
+	0x80, 0xed, 0x11, 0xf5, 0x06, 0x47, 0x84, 0x0f, 0xfd, 0x9e, 0x71, 0x59, 0x6a, 0xa2, 0x00, 0x45,
+	0x36, 0x20, 0xe2, 0xe1, 0xae, 0x43, 0x7a, 0xca, 0x16, 0x1f, 0x9f, 0x32, 0x12, 0x1a, 0x57, 0xe4,
+	0x29, 0x6e, 0xb6, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xda, 0x77, 0xc6, 0x76, 0xdd, 0xf1, 0x78, 0x70,
+	0x6a, 0x95, 0x90, 0x43, 0x23, 0x58, 0x14, 0xe7, 0x88, 0x4d, 0x61, 0x45, 0x9a, 0xc2, 0xc7, 0xd3,
+	0xe9, 0xe8, 0x30, 0x25, 0x68, 0x65, 0xa9, 0xa3, 0x36, 0xa0, 0x21, 0x0e, 0x8f, 0x22, 0x87, 0x53,
+	0xe6, 0x10, 0x25, 0x46, 0x68, 0xac, 0x4a, 0x35, 0x95, 0xac, 0xa0, 0x7b, 0x00, 0x01, 0xe9, 0xc7,
+	0x78, 0x6b, 0xf2, 0xe4, 0x37, 0x26, 0x9d, 0xdc, 0x4a, 0xb0, 0xd5, 0x89, 0x33, 0xdb, 0x05, 0x73,
+	0x71, 0x0c, 0x62, 0x73, 0x05, 0x91, 0xbe, 0x68, 0x18, 0xd2, 0xc4, 0x4a, 0x56, 0x84, 0x2d, 0x6a,
+	0xa8, 0x0c, 0x5a, 0xeb, 0xca, 0x5a, 0x33, 0xa0, 0xd6, 0x1d, 0x58, 0x3b, 0x43, 0xd5, 0xa8, 0x09,
+	0xb5, 0x11, 0x39, 0x95, 0x21, 0xba, 0x61, 0x89, 0x21, 0xba, 0x02, 0x73, 0x27, 0xd8, 0x89, 0x88,
+	0x0c, 0xaa, 0x75, 0x4b, 0x4d, 0x6e, 0x57, 0xbf, 0x5d, 0x69, 0xfd, 0xb6, 0x02, 0x4b, 0x05, 0xc1,
+	0x4b, 0xf6, 0xff, 0x34, 0xbb, 0xff, 0x15, 0x98, 0x71, 0xff, 0x31, 0x0e, 0x06, 0x84, 0x67, 0x04,
+	0x31, 0xff, 0x59, 0x01, 0xa3, 0xa0, 0xd1, 0x1f, 0x51, 0x3e, 0xbc, 0x4b, 0x1d, 0x12, 0xa2, 0x5b,
+	0xb0, 0x10, 0x28, 0x98, 0x4e, 0x3c, 0x6f, 0x4c, 0xb8, 0x88, 0xc3, 0x19, 0x2b, 0xc6, 0x46, 0xdf,
+	0x85, 0xba, 0x4b, 0x38, 0xee, 0x61, 0x8e, 0xb5, 0xec, 0x5b, 0x65, 0x3b, 0x05, 0x97, 0x23, 0x8d,
+	0x77, 0x38, 0x63, 0x25, 0x7b, 0xd0, 0xbb, 0x30, 0x67, 0x0f, 0x23, 0x6f, 0x24, 0x53, 0xce, 0xe2,
+	0xee, 0x9b, 0x67, 0x6d, 0xde, 0x17, 0x48, 0x87, 0x33, 0x96, 0xc2, 0xfe, 0x70, 0x1e, 0x66, 0x19,
+	0x0e, 0xb8, 0x79, 0x17, 0xae, 0x94, 0xb1, 0x10, 0x79, 0xce, 0x1e, 0x12, 0x7b, 0x14, 0x46, 0xae,
+	0x56, 0x73, 0x32, 0x47, 0x08, 0x66, 0x43, 0xfa, 0x4c, 0xa9, 0xba, 0x66, 0xc9, 0xb1, 0xf9, 0x2d,
+	0x58, 0x1e, 0xe3, 0x26, 0x2e, 0x55, 0xc9, 0x26, 0x28, 0x5c, 0xd0, 0xac, 0xcd, 0x08, 0x56, 0x1e,
+	0x4b, 0x5d, 0x24, 0xc1, 0xfe, 0x3c, 0x32, 0xb7, 0x79, 0x08, 0xab, 0x45, 0xb6, 0x21, 0xf3, 0xbd,
+	0x90, 0x08, 0xd3, 0x97, 0xd1, 0x91, 0x92, 0x5e, 0xba, 0x2a, 0xa5, 0xa8, 0x5b, 0x25, 0x2b, 0xe6,
+	0xaf, 0xaa, 0xb0, 0x6a, 0x91, 0xd0, 0x77, 0x4e, 0x48, 0x1c, 0xba, 0xce, 0xa7, 0xf8, 0xf8, 0x09,
+	0xd4, 0x30, 0x63, 0x46, 0xf5, 0x55, 0x44, 0xa1, 0x4c, 0x7a, 0xb7, 0x04, 0x55, 0xf4, 0x36, 0x2c,
+	0x63, 0xb7, 0x4b, 0x07, 0x91, 0x1f, 0x85, 0xf1, 0xb1, 0xa4, 0x51, 0x35, 0xac, 0xf1, 0x05, 0xd3,
+	0x86, 0xb5, 0x31, 0x15, 0x68, 0x75, 0x66, 0x4b, 0xa4, 0x4a, 0xa1, 0x44, 0x2a, 0x65, 0x52, 0x3d,
+	0x8b, 0xc9, 0x1f, 0xab, 0xd0, 0x4c, 0x5d, 0x47, 0x93, 0xdf, 0x80, 0x86, 0xab, 0x61, 0xa1, 0x51,
+	0x91, 0xf1, 0x29, 0x05, 0xe4, 0xab, 0xa5, 0x6a, 0xb1, 0x5a, 0x5a, 0x85, 0x79, 0x55, 0xcc, 0xea,
+	0x83, 0xe9, 0x59, 0x4e, 0xe4, 0xd9, 0x82, 0xc8, 0x9b, 0x00, 0x61, 0x12, 0xbf, 0x8c, 0x79, 0xb9,
+	0x9a, 0x81, 0x20, 0x13, 0x2e, 0xa8, 0xdc, 0x6a, 0x91, 0x30, 0x72, 0xb8, 0xb1, 0x20, 0x31, 0x72,
+	0x30, 0x91, 0xfd, 0xc8, 0x2f, 0x88, 0x7d, 0x44, 0x5c, 0x3f, 0x38, 0xbd, 0x4f, 0x5d, 0xca, 0x65,
+	0x25, 0x57, 0xb3, 0x8a, 0x60, 0x61, 0x8b, 0x02, 0xb4, 0xcf, 0x22, 0x39, 0x3f, 0xa2, 0x8e, 0x43,
+	0x43, 0xa3, 0x21, 0x91, 0x4b, 0x56, 0x4c, 0x1f, 0x96, 0xee, 0x53, 0xa1, 0x9d, 0x7e, 0x78, 0x3e,
+	0x6e, 0xf4, 0x1e, 0xcc, 0x0a, 0x66, 0x42, 0x65, 0xdd, 0x00, 0x7b, 0xf6, 0x90, 0xc4, 0xb7, 0x90,
+	0xcc, 0x45, 0x80, 0xe0, 0x78, 0x10, 0x1a, 0x55, 0x09, 0x97, 0x63, 0xf3, 0xcf, 0x55, 0x25, 0xe9,
+	0x1e, 0x63, 0xe1, 0x57, 0x5f, 0xaa, 0x97, 0x17, 0x0f, 0xb5, 0xf1, 0xe2, 0xa1, 0x20, 0xf2, 0x97,
+	0x29, 0x1e, 0x5e, 0x51, 0x02, 0x34, 0x23, 0x58, 0xd8, 0x63, 0x4c, 0x08, 0x82, 0x76, 0x60, 0x16,
+	0x33, 0xa6, 0x14, 0x5e, 0x88, 0xf5, 0x1a, 0x45, 0xfc, 0xd7, 0x22, 0x49, 0xd4, 0xd6, 0x2d, 0x68,
+	0x24, 0xa0, 0x17, 0xb1, 0x6d, 0x64, 0xd9, 0x6e, 0x01, 0xa8, 0xea, 0xf8, 0x63, 0xaf, 0xef, 0x8b,
+	0x2b, 0x15, 0x6e, 0xa4, 0xb7, 0xca, 0xb1, 0x79, 0x3b, 0xc6, 0x90, 0xb2, 0xbd, 0x0d, 0x73, 0x94,
+	0x13, 0x37, 0x16, 0x6e, 0x35, 0x2b, 0x5c, 0x4a, 0xc8, 0x52, 0x48, 0xe6, 0xdf, 0xeb, 0xb0, 0x2e,
+	0x6e, 0xec, 0x91, 0x74, 0xc0, 0x3d, 0xc6, 0x3e, 0x22, 0x1c, 0x53, 0x27, 0xfc, 0x7e, 0x44, 0x82,
+	0xd3, 0xd7, 0x6c, 0x18, 0x03, 0x98, 0x57, 0xfe, 0x6b, 0x54, 0x5f, 0x4f, 0xa3, 0x34, 0x1f, 0x16,
+	0xba, 0xa3, 0xda, 0xeb, 0xe9, 0x8e, 0xca, 0xba, 0x95, 0xd9, 0x73, 0xea, 0x56, 0xce, 0x6e, 0x58,
+	0x33, 0x6d, 0xf0, 0x7c, 0xbe, 0x0d, 0x2e, 0x69, 0x02, 0x16, 0x5e, 0xb6, 0x09, 0xa8, 0x97, 0x36,
+	0x01, 0x6e, 0xa9, 0x1f, 0x37, 0xa4, 0xba, 0xbf, 0x93, 0xb5, 0xc0, 0x33, 0x6d, 0x6d, 0x9a, 0x76,
+	0x00, 0x5e, 0x6b, 0x3b, 0xf0, 0x83, 0x5c, 0x79, 0xaf, 0x1a, 0xec, 0x77, 0x5f, 0xee, 0x4c, 0x13,
+	0x0a, 0xfd, 0xaf, 0x5d, 0x59, 0xfe, 0x1b, 0x59, 0x8d, 0x31, 0x3f, 0xd5, 0x41, 0x52, 0x2a, 0x88,
+	0x3c, 0x24, 0x92, 0xb6, 0x0e, 0x5a, 0x62, 0x8c, 0x6e, 0xc0, 0xac, 0x50, 0xb2, 0x2e, 0x97, 0xd7,
+	0xb2, 0xfa, 0x14, 0x37, 0xb1, 0xc7, 0xd8, 0x23, 0x46, 0x6c, 0x4b, 0x22, 0xa1, 0xdb, 0xd0, 0x48,
+	0x0c, 0x5f, 0x7b, 0xd6, 0x46, 0x76, 0x47, 0xe2, 0x27, 0xf1, 0xb6, 0x14, 0x5d, 0xec, 0xed, 0xd1,
+	0x80, 0xd8, 0x02, 0xd1, 0x98, 0x1b, 0xdf, 0xfb, 0x51, 0xbc, 0x98, 0xec, 0x4d, 0xd0, 0xd1, 0x0e,
+	0xcc, 0xab, 0x17, 0x09, 0xe9, 0x41, 0x8b, 0xbb, 0xeb, 0xe3, 0xc1, 0x34, 0xde, 0xa5, 0x11, 0xcd,
+	0xbf, 0x55, 0xe0, 0xad, 0xd4, 0x20, 0x62, 0x6f, 0x8a, 0xeb, 0xf9, 0xaf, 0x3e, 0xe3, 0x5e, 0x83,
+	0x4b, 0xb2, 0x81, 0x48, 0x1f, 0x26, 0xd4, 0x1b, 0x59, 0x01, 0x6a, 0xfe, 0xa9, 0x02, 0x57, 0xc7,
+	0xcf, 0xb1, 0x3f, 0xc4, 0x01, 0x4f, 0xae, 0xf7, 0x3c, 0xce, 0x12, 0x27, 0xbc, 0x6a, 0x9a, 0xf0,
+	0x72, 0xe7, 0xab, 0xe5, 0xcf, 0x67, 0xfe, 0xb5, 0x0a, 0x8b, 0x19, 0x03, 0x2a, 0x4b, 0x98, 0xa2,
+	0x94, 0x94, 0x76, 0x2b, 0x5b, 0x46, 0x99, 0x14, 0x1a, 0x56, 0x06, 0x82, 0x46, 0x00, 0x0c, 0x07,
+	0xd8, 0x25, 0x9c, 0x04, 0x22, 0x92, 0x0b, 0x8f, 0xbf, 0x37, 0x7d, 0x74, 0x39, 0x8e, 0x69, 0x5a,
+	0x19, 0xf2, 0xa2, 0x16, 0x96, 0xac, 0x43, 0x1d, 0xbf, 0xf5, 0x0c, 0x7d, 0x0e, 0x97, 0xfa, 0xd4,
+	0x21, 0xc7, 0xa9, 0x20, 0xf3, 0x5b, 0xb5, 0xe9, 0xb3, 0xa4, 0x10, 0xe4, 0x6e, 0x96, 0xae, 0x55,
+	0x60, 0x63, 0x5e, 0x87, 0x66, 0xd1, 0x9f, 0x84, 0x90, 0xd4, 0xc5, 0x83, 0x44, 0x5b, 0x7a, 0x66,
+	0x22, 0x68, 0x16, 0xfd, 0xc7, 0xfc, 0x57, 0x15, 0x56, 0x12, 0x72, 0x7b, 0x9e, 0xe7, 0x47, 0x9e,
+	0x2d, 0x1f, 0xf9, 0x4a, 0xef, 0xe2, 0x0a, 0xcc, 0x71, 0xca, 0x9d, 0xa4, 0xf0, 0x91, 0x13, 0x91,
+	0xbb, 0xb8, 0xef, 0x3b, 0x9c, 0x32, 0x7d, 0xc1, 0xf1, 0x54, 0xdd, 0xfd, 0xd3, 0x88, 0x06, 0xa4,
+	0x27, 0x23, 0x41, 0xdd, 0x4a, 0xe6, 0x62, 0x4d, 0x54, 0x35, 0xb2, 0x41, 0x50, 0xca, 0x4c, 0xe6,
+	0xd2, 0xee, 0x7d, 0xc7, 0x21, 0xb6, 0x50, 0x47, 0xa6, 0x85, 0x28, 0x40, 0xc5, 0x49, 0x43, 0x1e,
+	0x50, 0x6f, 0xa0, 0x1b, 0x08, 0x3d, 0x13, 0x72, 0xe2, 0x20, 0xc0, 0xa7, 0x46, 0x5d, 0x2a, 0x40,
+	0x4d, 0xd0, 0x07, 0x50, 0x73, 0x31, 0xd3, 0x89, 0xee, 0x7a, 0x2e, 0x3a, 0x94, 0x69, 0xa0, 0x7d,
+	0x84, 0x99, 0xca, 0x04, 0x62, 0x5b, 0xeb, 0x3d, 0xa8, 0xc7, 0x80, 0x2f, 0x55, 0x12, 0x7e, 0x06,
+	0x17, 0x73, 0xc1, 0x07, 0x7d, 0x0a, 0xab, 0xa9, 0x45, 0x65, 0x19, 0xea, 0x22, 0xf0, 0xad, 0x17,
+	0x4a, 0x66, 0x9d, 0x41, 0xc0, 0x7c, 0x0a, 0xcb, 0xc2, 0x64, 0xa4, 0xe3, 0x9f, 0x53, 0x6b, 0xf3,
+	0x3e, 0x34, 0x12, 0x96, 0xa5, 0x36, 0xd3, 0x82, 0xfa, 0x49, 0xfc, 0xf8, 0xaa, 0x7a, 0x9b, 0x64,
+	0x6e, 0xee, 0x01, 0xca, 0xca, 0xab, 0x33, 0xd0, 0x8d, 0x7c, 0x51, 0xbc, 0x52, 0x4c, 0x37, 0x12,
+	0x3d, 0xae, 0x89, 0x7f, 0x57, 0x85, 0xa5, 0x03, 0x2a, 0xdf, 0x4f, 0xce, 0x29, 0xc8, 0x5d, 0x87,
+	0x66, 0x18, 0x75, 0x5d, 0xbf, 0x17, 0x39, 0x44, 0x17, 0x05, 0x3a, 0xd3, 0x8f, 0xc1, 0x27, 0x05,
+	0x3f, 0xa1, 0x2c, 0x86, 0xf9, 0x50, 0xf7, 0xce, 0x72, 0x8c, 0x3e, 0x80, 0xf5, 0x07, 0xe4, 0x73,
+	0x7d, 0x9e, 0x03, 0xc7, 0xef, 0x76, 0xa9, 0x37, 0x88, 0x99, 0xcc, 0x49, 0x26, 0x67, 0x23, 0x98,
+	0xbf, 0xae, 0x40, 0x33, 0xd5, 0x85, 0xd6, 0xe6, 0x2d, 0x65, 0xf5, 0x4a, 0x97, 0x57, 0xb3, 0xba,
+	0x2c, 0xa2, 0xfe, 0xef, 0x06, 0x7f, 0x21, 0x6b, 0xf0, 0x7f, 0xa9, 0xc0, 0xca, 0x01, 0xe5, 0x71,
+	0xa8, 0xa1, 0xff, 0x67, 0xf7, 0x62, 0xb6, 0x61, 0xb5, 0x28, 0xbe, 0x56, 0xe5, 0x15, 0x98, 0x13,
+	0xb7, 0x14, 0xf7, 0xee, 0x6a, 0xb2, 0xfb, 0x45, 0x03, 0x96, 0xd3, 0xe4, 0x2b, 0xfe, 0x52, 0x9b,
+	0xa0, 0x87, 0xd0, 0x3c, 0xd0, 0x5f, 0xe5, 0xe2, 0xd7, 0x18, 0x34, 0xe9, 0x79, 0xb3, 0xb5, 0x51,
+	0xbe, 0xa8, 0x58, 0x9b, 0x33, 0xc8, 0x86, 0xf5, 0x22, 0xc1, 0xf4, 0x25, 0xf5, 0x9b, 0x13, 0x28,
+	0x27, 0x58, 0x2f, 0x62, 0xb1, 0x5d, 0x41, 0x9f, 0xc2, 0xa5, 0xfc, 0x7b, 0x1f, 0xca, 0x45, 0xa3,
+	0xd2, 0x27, 0xc8, 0x96, 0x39, 0x09, 0x25, 0x91, 0xff, 0x09, 0x2c, 0x15, 0x1e, 0xbf, 0x90, 0x99,
+	0x2f, 0xcc, 0xcb, 0x1e, 0x07, 0x5b, 0xdf, 0x98, 0x88, 0x93, 0x50, 0x7f, 0x1f, 0xea, 0xf1, 0x93,
+	0x4e, 0x5e, 0xcd, 0x85, 0x87, 0x9e, 0x56, 0x33, 0x4f, 0xaf, 0x1f, 0x9a, 0x33, 0xe2, 0x39, 0x39,
+	0x7e, 0xb2, 0x18, 0xdf, 0x9c, 0x79, 0xc8, 0x68, 0x5d, 0x2e, 0x79, 0x3c, 0x30, 0x67, 0xd0, 0xf7,
+	0x60, 0x51, 0x8c, 0x8e, 0xf5, 0xf7, 0xb0, 0xd5, 0xb6, 0xfa, 0xfc, 0xda, 0x8e, 0x3f, 0xbf, 0xb6,
+	0xef, 0x88, 0xcf, 0xaf, 0xad, 0x92, 0xee, 0x5e, 0x13, 0x78, 0x02, 0x17, 0x0f, 0x08, 0x4f, 0x8b,
+	0x71, 0x74, 0xf5, 0xa5, 0x5a, 0x96, 0x96, 0x59, 0x44, 0x1b, 0xaf, 0xe7, 0xcd, 0x19, 0xf4, 0xfb,
+	0x0a, 0x5c, 0x3e, 0x20, 0xbc, 0x58, 0xde, 0xa2, 0x77, 0xca, 0x99, 0x9c, 0x51, 0x06, 0xb7, 0x1e,
+	0x4c, 0xeb, 0xaf, 0x79, 0xb2, 0xe6, 0x0c, 0xfa, 0x43, 0x05, 0xd6, 0x32, 0x82, 0x65, 0xeb, 0x55,
+	0xb4, 0x33, 0x59, 0xb8, 0x92, 0xda, 0xb6, 0xf5, 0xc9, 0x94, 0x9f, 0x39, 0x33, 0x24, 0xcd, 0x19,
+	0x74, 0x2c, 0xef, 0x24, 0x4d, 0x4f, 0xe8, 0xcd, 0xd2, 0x3c, 0x94, 0x70, 0xdf, 0x3c, 0x6b, 0x39,
+	0xb9, 0x87, 0x4f, 0x60, 0xf1, 0x80, 0xf0, 0x38, 0xea, 0xe6, 0x2d, 0xad, 0x90, 0xc2, 0x5a, 0x1b,
+	0xe5, 0x8b, 0x19, 0x6f, 0x5a, 0x56, 0xb4, 0x32, 0x71, 0x2a, 0xef, 0xab, 0xa5, 0x21, 0xb8, 0x65,
+	0x4e, 0x42, 0x89, 0xa9, 0x7f, 0xb8, 0xf7, 0x8f, 0xe7, 0x9b, 0x95, 0x2f, 0x9e, 0x6f, 0x56, 0xfe,
+	0xfd, 0x7c, 0xb3, 0xf2, 0xe3, 0x9b, 0x2f, 0xf8, 0x6d, 0x42, 0xe6, 0xe7, 0x0e, 0x98, 0x51, 0xdb,
+	0xa1, 0xc4, 0xe3, 0xdd, 0x79, 0x69, 0xfc, 0x37, 0xff, 0x3b, 0x00, 0x80, 0x48, 0x73, 0x16, 0x0d,
+	0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3561,6 +3581,16 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExecCpuLimitMillis != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.ExecCpuLimitMillis))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecMemoryLimit != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.ExecMemoryLimit))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.VerifyResult) > 0 {
 		i -= len(m.VerifyResult)
 		copy(dAtA[i:], m.VerifyResult)
@@ -5191,6 +5221,12 @@ func (m *ManifestResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	if m.ExecMemoryLimit != 0 {
+		n += 1 + sovRepository(uint64(m.ExecMemoryLimit))
+	}
+	if m.ExecCpuLimitMillis != 0 {
+		n += 1 + sovRepository(uint64(m.ExecCpuLimitMillis))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7664,6 +7700,44 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.VerifyResult = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecMemoryLimit", wireType)
+			}
+			m.ExecMemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecMemoryLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecCpuLimitMillis", wireType)
+			}
+			m.ExecCpuLimitMillis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecCpuLimitMillis |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8,6 +8,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 )
 
 type MetricsServer struct {
@@ -79,6 +81,19 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	registry.MustRegister(prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_server_exec_resource_limits_error",
+			Help: "Whether the configured resource limits of config management tools cannot be applied.",
+		},
+		func() float64 {
+			if executil.ResourceLimitsError() != nil {
+				return 1
+			}
+			return 0
+		},
+	))
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitRequestCounter:        gitRequestCounter,
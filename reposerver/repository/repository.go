@@ -55,6 +55,7 @@ import (
 	argopath "github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/cmp"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
@@ -75,7 +76,11 @@ const (
 	ociPrefix                      = "oci://"
 )
 
-var ErrExceededMaxCombinedManifestFileSize = errors.New("exceeded max combined manifest file size")
+var (
+	ErrExceededMaxCombinedManifestFileSize = errors.New("exceeded max combined manifest file size")
+	ErrExceededMaxManifestFiles            = errors.New("exceeded max number of manifest files")
+	ErrExceededMaxManifestsOutputSize      = errors.New("exceeded max size of generated manifests")
+)
 
 // Service implements ManifestService interface
 type Service struct {
@@ -103,6 +108,8 @@ type RepoServerInitConstants struct {
 	PauseGenerationOnFailureForRequests          int
 	SubmoduleEnabled                             bool
 	MaxCombinedDirectoryManifestsSize            resource.Quantity
+	MaxDirectoryManifestFiles                    int
+	MaxDirectoryManifestsOutputSize              resource.Quantity
 	CMPTarExcludedGlobs                          []string
 	AllowOutOfBoundsSymlinks                     bool
 	StreamedManifestMaxExtractedSize             int64
@@ -211,7 +218,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(gitClient, commitSHA, q.Repo.IsSubmoduleEnabled(s.initConstants.SubmoduleEnabled))
 	})
 
 	if err != nil {
@@ -373,7 +380,7 @@ func (s *Service) runRepoOperation(
 		})
 	} else {
 		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+			return s.checkoutRevision(gitClient, revision, repo.IsSubmoduleEnabled(s.initConstants.SubmoduleEnabled))
 		})
 
 		if err != nil {
@@ -724,7 +731,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 								return
 							}
 							closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
-								return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled)
+								return s.checkoutRevision(gitClient, referencedCommitSHA, refSourceMapping.Repo.IsSubmoduleEnabled(s.initConstants.SubmoduleEnabled))
 							})
 							if err != nil {
 								log.Errorf("failed to acquire lock for referenced source %s", normalizedRepoURL)
@@ -766,7 +773,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithMaxDirectoryManifestFiles(s.initConstants.MaxDirectoryManifestFiles), WithMaxDirectoryManifestsOutputSize(s.initConstants.MaxDirectoryManifestsOutputSize))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -956,6 +963,7 @@ func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoC
 				repo.SSHPrivateKey = repositoryCredential.SSHPrivateKey
 				repo.TLSClientCertData = repositoryCredential.TLSClientCertData
 				repo.TLSClientCertKey = repositoryCredential.TLSClientCertKey
+				repo.CredentialProvider = repositoryCredential.CredentialProvider
 			}
 		}
 		repos = append(repos, helm.HelmRepository{Name: repo.Name, Repo: repo.Repo, Creds: repo.GetHelmCreds(), EnableOci: repo.EnableOCI})
@@ -1087,7 +1095,8 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 
 		templateOpts.Values = resolvedValueFiles
 
-		if appHelm.Values != "" {
+		// Inline values are passed as the last values file, so that helm deep-merges them on top of the value files
+		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
 			if err != nil {
 				return nil, err
@@ -1099,7 +1108,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 					_ = os.RemoveAll(p)
 				}
 			}()
-			err = os.WriteFile(p, []byte(appHelm.Values), 0644)
+			err = os.WriteFile(p, appHelm.ValuesYAML(), 0644)
 			if err != nil {
 				return nil, err
 			}
@@ -1281,8 +1290,10 @@ func getRepoCredential(repoCredentials []*v1alpha1.RepoCreds, repoURL string) *v
 
 type GenerateManifestOpt func(*generateManifestOpt)
 type generateManifestOpt struct {
-	cmpTarDoneCh        chan<- bool
-	cmpTarExcludedGlobs []string
+	cmpTarDoneCh               chan<- bool
+	cmpTarExcludedGlobs        []string
+	maxManifestFiles           int
+	maxManifestsOutputQuantity resource.Quantity
 }
 
 func newGenerateManifestOpt(opts ...GenerateManifestOpt) *generateManifestOpt {
@@ -1310,6 +1321,22 @@ func WithCMPTarExcludedGlobs(excludedGlobs []string) GenerateManifestOpt {
 	}
 }
 
+// WithMaxDirectoryManifestFiles limits the number of manifest files in a directory-type Application. Zero means
+// no limit.
+func WithMaxDirectoryManifestFiles(maxFiles int) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.maxManifestFiles = maxFiles
+	}
+}
+
+// WithMaxDirectoryManifestsOutputSize limits the combined size of the manifests generated for a directory-type
+// Application, including the output of jsonnet files. Zero means no limit.
+func WithMaxDirectoryManifestsOutputSize(maxOutputQuantity resource.Quantity) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.maxManifestsOutputQuantity = maxOutputQuantity
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths io.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
@@ -1353,7 +1380,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity, opt.maxManifestFiles, opt.maxManifestsOutputQuantity)
 	}
 	if err != nil {
 		return nil, err
@@ -1399,10 +1426,17 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		}
 	}
 
-	return &apiclient.ManifestResponse{
+	res := &apiclient.ManifestResponse{
 		Manifests:  manifests,
 		SourceType: string(appSourceType),
-	}, nil
+	}
+	// Only the tools run by the repo server itself are limited, plugins run in their own sidecars
+	if appSourceType == v1alpha1.ApplicationSourceTypeHelm || appSourceType == v1alpha1.ApplicationSourceTypeKustomize {
+		limits := executil.GetResourceLimits()
+		res.ExecMemoryLimit = limits.Memory
+		res.ExecCpuLimitMillis = limits.CPUMillis
+	}
+	return res, nil
 }
 
 func newEnv(q *apiclient.ManifestRequest, revision string) *v1alpha1.Env {
@@ -1522,14 +1556,17 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity, maxManifestFiles int, maxOutputQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
 	// Validate the directory before loading any manifests to save memory.
-	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
+	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity, maxManifestFiles)
 	if err != nil {
 		logCtx.Errorf("failed to get potentially valid manifests: %s", err)
 		return nil, fmt.Errorf("failed to get potentially valid manifests: %w", err)
 	}
 
+	maxOutputSize := maxOutputQuantity.Value()
+	var currentOutputSize = int64(0)
+
 	var objs []*unstructured.Unstructured
 	for _, potentiallyValidManifest := range potentiallyValidManifests {
 		manifestPath := potentiallyValidManifest.path
@@ -1548,6 +1585,11 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 				return nil, status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", manifestFileInfo.Name(), err)
 			}
 
+			currentOutputSize += int64(len(jsonStr))
+			if maxOutputSize != 0 && currentOutputSize > maxOutputSize {
+				return nil, fmt.Errorf("%w: output of jsonnet %q exceeds the limit of %s", ErrExceededMaxManifestsOutputSize, manifestFileInfo.Name(), maxOutputQuantity.String())
+			}
+
 			// attempt to unmarshal either array or single object
 			var jsonObjs []*unstructured.Unstructured
 			err = json.Unmarshal([]byte(jsonStr), &jsonObjs)
@@ -1562,6 +1604,10 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 				objs = append(objs, &jsonObj)
 			}
 		} else {
+			currentOutputSize += manifestFileInfo.Size()
+			if maxOutputSize != 0 && currentOutputSize > maxOutputSize {
+				return nil, fmt.Errorf("%w: manifest %q exceeds the limit of %s", ErrExceededMaxManifestsOutputSize, manifestFileInfo.Name(), maxOutputQuantity.String())
+			}
 			err := getObjsFromYAMLOrJson(logCtx, manifestPath, manifestFileInfo.Name(), &objs)
 			if err != nil {
 				return nil, err
@@ -1711,9 +1757,10 @@ type potentiallyValidManifest struct {
 	fileInfo os.FileInfo
 }
 
-// getPotentiallyValidManifests ensures that 1) there are no errors while checking for potential manifest files in the given dir
-// and 2) the combined file size of the potentially-valid manifest files does not exceed the limit.
-func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot string, recurse bool, include string, exclude string, maxCombinedManifestQuantity resource.Quantity) ([]potentiallyValidManifest, error) {
+// getPotentiallyValidManifests ensures that 1) there are no errors while checking for potential manifest files in the given dir,
+// 2) the combined file size of the potentially-valid manifest files does not exceed the limit and 3) the number of
+// potentially-valid manifest files does not exceed the limit.
+func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot string, recurse bool, include string, exclude string, maxCombinedManifestQuantity resource.Quantity, maxManifestFiles int) ([]potentiallyValidManifest, error) {
 	maxCombinedManifestFileSize := maxCombinedManifestQuantity.Value()
 	var currentCombinedManifestFileSize = int64(0)
 
@@ -1749,6 +1796,9 @@ func getPotentiallyValidManifests(logCtx *log.Entry, appPath string, repoRoot st
 				return ErrExceededMaxCombinedManifestFileSize
 			}
 		}
+		if maxManifestFiles != 0 && len(potentiallyValidManifests) >= maxManifestFiles {
+			return fmt.Errorf("%w: found more than %d manifest files", ErrExceededMaxManifestFiles, maxManifestFiles)
+		}
 		potentiallyValidManifests = append(potentiallyValidManifests, potentiallyValidManifest{path: path, fileInfo: f})
 		return nil
 	})
@@ -2174,7 +2224,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, q.Revision, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(gitClient, q.Revision, q.Repo.IsSubmoduleEnabled(s.initConstants.SubmoduleEnabled))
 	})
 
 	if err != nil {
@@ -2266,6 +2316,9 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 		return nil, err
 	}
 	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	if submoduleCreds := repo.GetSubmoduleGitCreds(s.gitCredsStore); len(submoduleCreds) > 0 {
+		opts = append(opts, git.WithSubmoduleCreds(submoduleCreds))
+	}
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, opts...)
 }
 
@@ -2499,7 +2552,7 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 
 	// cache miss, generate the results
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
+		return s.checkoutRevision(gitClient, revision, repo.IsSubmoduleEnabled(request.GetSubmoduleEnabled()))
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s pattern %s: %v", repo.Repo, revision, gitPath, err)
@@ -2557,7 +2610,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 
 	// cache miss, generate the results
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled())
+		return s.checkoutRevision(gitClient, revision, repo.IsSubmoduleEnabled(request.GetSubmoduleEnabled()))
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "unable to checkout git repo %s with revision %s: %v", repo.Repo, revision, err)
@@ -0,0 +1,30 @@
+local job = {}
+job.apiVersion = "batch/v1"
+job.kind = "Job"
+
+job.metadata = {}
+job.metadata.generateName = obj.metadata.name .. "-"
+job.metadata.namespace = obj.metadata.namespace
+if obj.spec.jobTemplate.metadata ~= nil then
+    job.metadata.labels = obj.spec.jobTemplate.metadata.labels
+    job.metadata.annotations = obj.spec.jobTemplate.metadata.annotations
+end
+if job.metadata.annotations == nil then
+    job.metadata.annotations = {}
+end
+-- mark the job as created manually, like "kubectl create job --from=cronjob/<name>" does
+job.metadata.annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+
+local ownerRef = {}
+ownerRef.apiVersion = obj.apiVersion
+ownerRef.kind = obj.kind
+ownerRef.name = obj.metadata.name
+ownerRef.uid = obj.metadata.uid
+ownerRef.controller = true
+ownerRef.blockOwnerDeletion = true
+job.metadata.ownerReferences = {}
+job.metadata.ownerReferences[1] = ownerRef
+
+job.spec = obj.spec.jobTemplate.spec
+
+return {{operation = "create", resource = job}}
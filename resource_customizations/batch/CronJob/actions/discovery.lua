@@ -0,0 +1,3 @@
+actions = {}
+actions["create-job"] = {}
+return actions
@@ -6,17 +6,22 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/kubectl/pkg/util/slice"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
+	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
@@ -28,11 +33,13 @@ type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
 	enf         *rbac.Enforcer
+	policyEnf   *rbacpolicy.RBACPolicyEnforcer
+	projLister  applister.AppProjectNamespaceLister
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
-	return &Server{sessionMgr, settingsMgr, enf}
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, policyEnf *rbacpolicy.RBACPolicyEnforcer, projLister applister.AppProjectNamespaceLister) *Server {
+	return &Server{sessionMgr, settingsMgr, enf, policyEnf, projLister}
 }
 
 // UpdatePassword updates the password of the currently authenticated account or the account specified in the request.
@@ -277,3 +284,253 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+func toApiPersonalAccessToken(t settings.PersonalAccessToken) *account.PersonalAccessToken {
+	return &account.PersonalAccessToken{
+		Id:          t.ID,
+		Subject:     t.Subject,
+		Description: t.Description,
+		Scopes:      t.Scopes,
+		IssuedAt:    t.IssuedAt,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+	}
+}
+
+// personalAccessTokenSubject returns the subject of the current user, if it may own personal access tokens
+func personalAccessTokenSubject(ctx context.Context) (string, error) {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return "", err
+	}
+	subject := jwtutil.StringField(mapClaims, "sub")
+	if subject == "" {
+		return "", status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	if rbacpolicy.IsProjectSubject(subject) {
+		return "", status.Errorf(codes.InvalidArgument, "personal access tokens cannot be used by project role %q", subject)
+	}
+	return subject, nil
+}
+
+// ListPersonalAccessTokens returns the personal access tokens of the current user
+func (s *Server) ListPersonalAccessTokens(ctx context.Context, r *account.ListPersonalAccessTokensRequest) (*account.PersonalAccessTokenList, error) {
+	subject, err := personalAccessTokenSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.settingsMgr.GetPersonalAccessTokens()
+	if err != nil {
+		return nil, err
+	}
+	resp := account.PersonalAccessTokenList{Items: make([]*account.PersonalAccessToken, 0)}
+	for _, t := range tokens {
+		if t.Subject == subject {
+			resp.Items = append(resp.Items, toApiPersonalAccessToken(t))
+		}
+	}
+	sort.Slice(resp.Items, func(i, j int) bool {
+		return resp.Items[i].IssuedAt > resp.Items[j].IssuedAt
+	})
+	return &resp, nil
+}
+
+// validateTokenScope checks that a token scope is of the form <resource>:<action>:<object>
+func validateTokenScope(scope string) error {
+	parts := strings.SplitN(scope, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return status.Errorf(codes.InvalidArgument, "scope %q must be of the form <resource>:<action>:<object>", scope)
+	}
+	return nil
+}
+
+// CreatePersonalAccessToken creates a personal access token for the current user
+func (s *Server) CreatePersonalAccessToken(ctx context.Context, r *account.CreatePersonalAccessTokenRequest) (*account.CreatePersonalAccessTokenResponse, error) {
+	subject, err := personalAccessTokenSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := jwtutil.MapClaims(ctx.Value("claims").(jwt.Claims))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := identity[rbac.TokenScopesClaim]; ok {
+		return nil, status.Errorf(codes.PermissionDenied, "personal access tokens cannot be created using a personal access token")
+	}
+	if r.ExpiresIn <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "personal access tokens must expire")
+	}
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if maxDuration := argoCDSettings.PersonalAccessTokenMaxDuration; maxDuration > 0 && time.Duration(r.ExpiresIn)*time.Second > maxDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "personal access tokens must expire within %v", maxDuration)
+	}
+	if len(r.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "personal access tokens must have at least one scope")
+	}
+	for _, scope := range r.Scopes {
+		if err := validateTokenScope(scope); err != nil {
+			return nil, err
+		}
+	}
+
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	pat := settings.PersonalAccessToken{
+		ID:          uniqueId.String(),
+		Subject:     subject,
+		Description: r.Description,
+		Scopes:      r.Scopes,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix(),
+	}
+	tokenString, err := s.sessionMgr.CreatePersonalAccessToken(subject, identity, s.policyEnf.GetScopes(), pat.Scopes, time.Unix(pat.ExpiresAt, 0), pat.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.settingsMgr.UpdatePersonalAccessTokens(func(tokens []settings.PersonalAccessToken) ([]settings.PersonalAccessToken, error) {
+		return append(tokens, pat), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' created personal access token '%s'", subject, pat.ID)
+	return &account.CreatePersonalAccessTokenResponse{Token: tokenString, Item: toApiPersonalAccessToken(pat)}, nil
+}
+
+// DeletePersonalAccessToken revokes and deletes a personal access token. Users may delete their own tokens, deleting
+// the tokens of other users requires the permission to update their account.
+func (s *Server) DeletePersonalAccessToken(ctx context.Context, r *account.DeletePersonalAccessTokenRequest) (*account.EmptyResponse, error) {
+	subject, err := personalAccessTokenSubject(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pat, err := s.settingsMgr.GetPersonalAccessToken(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	if pat.Subject != subject {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, pat.Subject); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.sessionMgr.RevokeToken(ctx, pat.ID, time.Until(time.Unix(pat.ExpiresAt, 0))); err != nil {
+		return nil, fmt.Errorf("error revoking token: %w", err)
+	}
+	err = s.settingsMgr.UpdatePersonalAccessTokens(func(tokens []settings.PersonalAccessToken) ([]settings.PersonalAccessToken, error) {
+		for i := range tokens {
+			if tokens[i].ID == r.Id {
+				return append(tokens[:i], tokens[i+1:]...), nil
+			}
+		}
+		return tokens, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' deleted personal access token '%s' of user '%s'", subject, pat.ID, pat.Subject)
+	return &account.EmptyResponse{}, nil
+}
+
+// projectPolicies returns the policies of the roles of all projects. Project role policies may only refer to objects
+// of their own project, so they can be evaluated alongside each other.
+func (s *Server) projectPolicies() (string, error) {
+	projects, err := s.projLister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("error listing projects: %w", err)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Name < projects[j].Name
+	})
+	var policies []string
+	for _, proj := range projects {
+		if policy := proj.ProjectPoliciesString(); policy != "" {
+			policies = append(policies, policy)
+		}
+	}
+	return strings.Join(policies, "\n"), nil
+}
+
+func toApiPolicyMatch(m rbac.PolicyMatch, allowed bool) *account.PolicyMatch {
+	project, _, _ := rbacpolicy.GetProjectRoleFromSubject(m.Rule.Subject)
+	effect := "deny"
+	if allowed {
+		effect = "allow"
+	}
+	return &account.PolicyMatch{
+		Subject: m.Subject,
+		Rule: &account.PolicyRule{
+			Subject:  m.Rule.Subject,
+			Resource: m.Rule.Resource,
+			Action:   m.Rule.Action,
+			Object:   m.Rule.Object,
+			Effect:   m.Rule.Effect,
+			Project:  project,
+		},
+		Effect: effect,
+	}
+}
+
+// ListPermissions returns the RBAC rules, including project roles, which apply to a subject and its groups. Every rule
+// is labeled with the result of enforcing its request for the subject and its groups, so that rules which are denied
+// or overridden by a deny rule are told apart from the permissions which are effectively granted.
+func (s *Server) ListPermissions(ctx context.Context, r *account.ListPermissionsRequest) (*account.PolicyMatchList, error) {
+	if r.Subject == "" && len(r.Groups) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "subject or groups must be specified")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionGet, "*"); err != nil {
+		return nil, err
+	}
+	policies, err := s.projectPolicies()
+	if err != nil {
+		return nil, err
+	}
+	var subjects []string
+	if r.Subject != "" {
+		subjects = append(subjects, r.Subject)
+	}
+	matches, err := s.enf.SubjectRules(policies, append(subjects, r.Groups...)...)
+	if err != nil {
+		return nil, err
+	}
+	list := &account.PolicyMatchList{Items: make([]*account.PolicyMatch, 0, len(matches))}
+	for _, m := range matches {
+		allowed := s.policyEnf.EnforceSubject(r.Subject, r.Groups, m.Rule.Resource, m.Rule.Action, m.Rule.Object)
+		list.Items = append(list.Items, toApiPolicyMatch(m, allowed))
+	}
+	list.Warnings = s.enf.GroupWarnings(s.sessionMgr.RBACIdentities(ctx))
+	return list, nil
+}
+
+// ListSubjects returns the subjects, groups and roles which are allowed to perform an action, along with the matching rule.
+// Only the roles of the project the request belongs to are considered, the same as during enforcement.
+func (s *Server) ListSubjects(ctx context.Context, r *account.ListSubjectsRequest) (*account.PolicyMatchList, error) {
+	if r.Resource == "" || r.Action == "" || r.Subresource == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource, action and subresource must be specified")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionGet, "*"); err != nil {
+		return nil, err
+	}
+	policy := s.policyEnf.GetProjectPolicy(r.Resource, r.Action, r.Subresource)
+	matches, err := s.enf.AllowedSubjects(policy, r.Resource, r.Action, r.Subresource)
+	if err != nil {
+		return nil, err
+	}
+	list := &account.PolicyMatchList{Items: make([]*account.PolicyMatch, 0, len(matches))}
+	for _, m := range matches {
+		if s.policyEnf.EnforceSubject(m.Subject, nil, r.Resource, r.Action, r.Subresource) {
+			list.Items = append(list.Items, toApiPolicyMatch(m, true))
+		}
+	}
+	list.Warnings = s.enf.GroupWarnings(s.sessionMgr.RBACIdentities(ctx))
+	return list, nil
+}
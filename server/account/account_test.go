@@ -16,8 +16,11 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -64,11 +67,15 @@ func newTestAccountServerExt(ctx context.Context, enforceFn rbac.ClaimsEnforcerF
 	}
 	kubeclientset := fake.NewSimpleClientset(cm, secret)
 	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
-	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(nil))
+	// the in-memory redis records the login sessions, and is released when the test binary exits
+	redisClient, _ := test.NewInMemoryRedis()
+	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(redisClient))
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	projLister := test.NewFakeProjLister()
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enforcer, projLister)
+	return NewServer(sessionMgr, settingsMgr, enforcer, policyEnf, projLister), session.NewServer(sessionMgr, settingsMgr, nil, nil, enforcer, nil)
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -361,3 +368,181 @@ func TestCanI_GetLogsAllowSwitchOff(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, "yes", resp.Value)
 }
+
+func newTestRBACSimulationServer(t *testing.T) *Server {
+	accountServer, _ := newTestAccountServer(context.Background())
+	accountServer.projLister = test.NewFakeProjLister(&appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: test.FakeArgoCDNamespace},
+		Spec: appsv1.AppProjectSpec{
+			Roles: []appsv1.ProjectRole{{
+				Name:     "deployer",
+				Policies: []string{"p, proj:demo:deployer, applications, sync, demo/*, allow"},
+				Groups:   []string{"my-org:ci"},
+			}},
+		},
+	}, &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: test.FakeArgoCDNamespace},
+		Spec: appsv1.AppProjectSpec{
+			Roles: []appsv1.ProjectRole{{
+				Name: "deployer",
+				// roles of a project never grant access to the applications of another project
+				Policies: []string{"p, proj:other:deployer, applications, sync, demo/*, allow"},
+				Groups:   []string{"my-org:other"},
+			}},
+		},
+	})
+	accountServer.policyEnf = rbacpolicy.NewRBACPolicyEnforcer(accountServer.enf, accountServer.projLister)
+	accountServer.enf.SetClaimsEnforcerFunc(accountServer.policyEnf.EnforceClaims)
+	assert.NoError(t, accountServer.enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	assert.NoError(t, accountServer.enf.SetUserPolicy(`
+p, role:deployer, applications, sync, default/*, allow
+p, role:deployer, applications, sync, default/prod-*, deny
+g, my-org:team, role:deployer
+`))
+	return accountServer
+}
+
+func TestListPermissions(t *testing.T) {
+	accountServer := newTestRBACSimulationServer(t)
+	ctx := adminContext(context.Background())
+
+	resp, err := accountServer.ListPermissions(ctx, &account.ListPermissionsRequest{Subject: "bob", Groups: []string{"my-org:team", "my-org:ci"}})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Items, &account.PolicyMatch{
+		Subject: "my-org:team",
+		Rule:    &account.PolicyRule{Subject: "role:deployer", Resource: "applications", Action: "sync", Object: "default/*", Effect: "allow"},
+		Effect:  "allow",
+	})
+	assert.Contains(t, resp.Items, &account.PolicyMatch{
+		Subject: "my-org:team",
+		Rule:    &account.PolicyRule{Subject: "role:deployer", Resource: "applications", Action: "sync", Object: "default/prod-*", Effect: "deny"},
+		Effect:  "deny",
+	})
+	assert.Contains(t, resp.Items, &account.PolicyMatch{
+		Subject: "my-org:ci",
+		Rule:    &account.PolicyRule{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow", Project: "demo"},
+		Effect:  "allow",
+	})
+
+	// the rule of the other project is not applied to the applications of the demo project
+	resp, err = accountServer.ListPermissions(ctx, &account.ListPermissionsRequest{Groups: []string{"my-org:other"}})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Items, &account.PolicyMatch{
+		Subject: "my-org:other",
+		Rule:    &account.PolicyRule{Subject: "proj:other:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow", Project: "other"},
+		Effect:  "deny",
+	})
+
+	_, err = accountServer.ListPermissions(ctx, &account.ListPermissionsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListSubjects(t *testing.T) {
+	accountServer := newTestRBACSimulationServer(t)
+	ctx := adminContext(context.Background())
+
+	resp, err := accountServer.ListSubjects(ctx, &account.ListSubjectsRequest{Resource: "applications", Action: "sync", Subresource: "demo/guestbook"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*account.PolicyMatch{
+		{Subject: "admin", Rule: &account.PolicyRule{Subject: "role:admin", Resource: "applications", Action: "sync", Object: "*/*", Effect: "allow"}, Effect: "allow"},
+		{Subject: "my-org:ci", Rule: &account.PolicyRule{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow", Project: "demo"}, Effect: "allow"},
+		{Subject: "proj:demo:deployer", Rule: &account.PolicyRule{Subject: "proj:demo:deployer", Resource: "applications", Action: "sync", Object: "demo/*", Effect: "allow", Project: "demo"}, Effect: "allow"},
+		{Subject: "role:admin", Rule: &account.PolicyRule{Subject: "role:admin", Resource: "applications", Action: "sync", Object: "*/*", Effect: "allow"}, Effect: "allow"},
+	}, resp.Items)
+
+	resp, err = accountServer.ListSubjects(ctx, &account.ListSubjectsRequest{Resource: "applications", Action: "sync", Subresource: "default/guestbook"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*account.PolicyMatch{
+		{Subject: "admin", Rule: &account.PolicyRule{Subject: "role:admin", Resource: "applications", Action: "sync", Object: "*/*", Effect: "allow"}, Effect: "allow"},
+		{Subject: "my-org:team", Rule: &account.PolicyRule{Subject: "role:deployer", Resource: "applications", Action: "sync", Object: "default/*", Effect: "allow"}, Effect: "allow"},
+		{Subject: "role:admin", Rule: &account.PolicyRule{Subject: "role:admin", Resource: "applications", Action: "sync", Object: "*/*", Effect: "allow"}, Effect: "allow"},
+		{Subject: "role:deployer", Rule: &account.PolicyRule{Subject: "role:deployer", Resource: "applications", Action: "sync", Object: "default/*", Effect: "allow"}, Effect: "allow"},
+	}, resp.Items)
+}
+
+func TestListSubjects_DoesNotHavePermissions(t *testing.T) {
+	accountServer, _ := newTestAccountServerExt(context.Background(), func(claims jwt.Claims, rvals ...interface{}) bool {
+		return false
+	})
+	ctx := adminContext(context.Background())
+
+	_, err := accountServer.ListSubjects(ctx, &account.ListSubjectsRequest{Resource: "applications", Action: "sync", Subresource: "default/guestbook"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func ssoUserContext(ctx context.Context) context.Context {
+	// nolint:staticcheck
+	return context.WithValue(ctx, "claims", jwt.MapClaims{
+		"sub":    "sso-user",
+		"iss":    "https://myargocdhost.com/api/dex",
+		"groups": []interface{}{"my-org:team"},
+	})
+}
+
+func TestPersonalAccessTokens(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	accountServer, _ := newTestAccountServer(context.Background())
+	accountServer.sessionMgr = sessionutil.NewSessionManager(accountServer.settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(redisClient))
+	ctx := ssoUserContext(context.Background())
+
+	_, err := accountServer.CreatePersonalAccessToken(ctx, &account.CreatePersonalAccessTokenRequest{Scopes: []string{"applications:get:*"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.CreatePersonalAccessToken(ctx, &account.CreatePersonalAccessTokenRequest{ExpiresIn: 3600})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.CreatePersonalAccessToken(ctx, &account.CreatePersonalAccessTokenRequest{ExpiresIn: 3600, Scopes: []string{"applications:get"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	// tokens must not outlive the configured maximum duration of 90 days
+	_, err = accountServer.CreatePersonalAccessToken(ctx, &account.CreatePersonalAccessTokenRequest{ExpiresIn: 91 * 24 * 3600, Scopes: []string{"applications:get:*"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := accountServer.CreatePersonalAccessToken(ctx, &account.CreatePersonalAccessTokenRequest{ExpiresIn: 3600, Scopes: []string{"applications:get:*"}, Description: "ci"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sso-user", resp.Item.Subject)
+
+	claims, _, err := accountServer.sessionMgr.Parse(resp.Token)
+	assert.NoError(t, err)
+	mapClaims := *(claims.(*jwt.MapClaims))
+	assert.Equal(t, []interface{}{"my-org:team"}, mapClaims["groups"])
+
+	// personal access tokens cannot be used to create further tokens
+	// nolint:staticcheck
+	_, err = accountServer.CreatePersonalAccessToken(context.WithValue(context.Background(), "claims", claims), &account.CreatePersonalAccessTokenRequest{ExpiresIn: 3600, Scopes: []string{"*:*:*"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	list, err := accountServer.ListPersonalAccessTokens(ctx, &account.ListPersonalAccessTokensRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Items, 1) {
+		assert.Equal(t, resp.Item.Id, list.Items[0].Id)
+		assert.Equal(t, "ci", list.Items[0].Description)
+	}
+	// the usage is recorded in the background
+	assert.Eventually(t, func() bool {
+		list, err := accountServer.ListPersonalAccessTokens(ctx, &account.ListPersonalAccessTokensRequest{})
+		return err == nil && len(list.Items) == 1 && list.Items[0].LastUsedAt != 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	list, err = accountServer.ListPersonalAccessTokens(adminContext(context.Background()), &account.ListPersonalAccessTokensRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	_, err = accountServer.DeletePersonalAccessToken(ctx, &account.DeletePersonalAccessTokenRequest{Id: resp.Item.Id})
+	assert.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(resp.Token)
+	assert.Error(t, err)
+	list, err = accountServer.ListPersonalAccessTokens(ctx, &account.ListPersonalAccessTokensRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
+
+func TestDeletePersonalAccessToken_OtherUser(t *testing.T) {
+	accountServer, _ := newTestAccountServerExt(context.Background(), func(claims jwt.Claims, rvals ...interface{}) bool {
+		return false
+	})
+	resp, err := accountServer.CreatePersonalAccessToken(ssoUserContext(context.Background()), &account.CreatePersonalAccessTokenRequest{ExpiresIn: 3600, Scopes: []string{"*:*:*"}})
+	assert.NoError(t, err)
+
+	_, err = accountServer.DeletePersonalAccessToken(adminContext(context.Background()), &account.DeletePersonalAccessTokenRequest{Id: resp.Item.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
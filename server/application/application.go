@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/audit"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -147,6 +148,14 @@ func NewServer(
 // So to prevent a malicious user from inferring the existence or absense of the Application or namespace, we respond
 // "permission denied" if the Application does not exist.
 func (s *Server) getAppEnforceRBAC(ctx context.Context, action, namespace, name string, getApp func() (*appv1.Application, error)) (*appv1.Application, error) {
+	return s.getAppEnforceRBACObject(ctx, action, namespace, name, func(a *appv1.Application) string {
+		return a.RBACName(s.ns)
+	}, getApp)
+}
+
+// getAppEnforceRBACObject gets the Application like getAppEnforceRBAC, but enforces the action on the RBAC object
+// returned by rbacObject.
+func (s *Server) getAppEnforceRBACObject(ctx context.Context, action, namespace, name string, rbacObject func(a *appv1.Application) string, getApp func() (*appv1.Application, error)) (*appv1.Application, error) {
 	logCtx := log.WithFields(map[string]interface{}{
 		"application": name,
 		"namespace":   namespace,
@@ -160,7 +169,7 @@ func (s *Server) getAppEnforceRBAC(ctx context.Context, action, namespace, name
 		logCtx.Errorf("failed to get application: %s", err)
 		return nil, permissionDeniedErr
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, a.RBACName(s.ns)); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, rbacObject(a)); err != nil {
 		logCtx.WithFields(map[string]interface{}{
 			"project":                a.Spec.Project,
 			argocommon.SecurityField: argocommon.SecurityMedium,
@@ -192,6 +201,18 @@ func (s *Server) getApplicationEnforceRBACClient(ctx context.Context, action, na
 	})
 }
 
+// getAppResourceEnforceRBAC uses an informer to get an Application like getApplicationEnforceRBACInformer, but enforces
+// the action on the requested resource of the Application. Policies without a resource selector apply to all resources
+// of an Application.
+func (s *Server) getAppResourceEnforceRBAC(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.Application, error) {
+	namespaceOrDefault := s.appNamespaceOrDefault(q.GetAppNamespace())
+	return s.getAppEnforceRBACObject(ctx, action, namespaceOrDefault, q.GetName(), func(a *appv1.Application) string {
+		return a.ResourceRBACName(s.ns, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
+	}, func() (*appv1.Application, error) {
+		return s.appLister.Applications(namespaceOrDefault).Get(q.GetName())
+	})
+}
+
 // List returns list of applications
 func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*appv1.ApplicationList, error) {
 	selector, err := labels.Parse(q.GetSelector())
@@ -472,6 +493,224 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	return manifestInfo, nil
 }
 
+// RevisionDiff returns the difference between the manifests of an application rendered at two revisions
+func (s *Server) RevisionDiff(ctx context.Context, q *application.ApplicationRevisionDiffQuery) (*application.ApplicationRevisionDiffResponse, error) {
+	if q.Name == nil || *q.Name == "" {
+		return nil, fmt.Errorf("invalid request: application name is missing")
+	}
+	a, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	sources := a.Spec.GetSources()
+	if len(q.FromRevisions) > len(sources) || len(q.ToRevisions) > len(sources) {
+		return nil, status.Errorf(codes.InvalidArgument, "application has %d sources, but more revisions were given", len(sources))
+	}
+
+	fromObjs, err := s.generateManifestsAtRevisions(ctx, a, q.FromRevisions)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests of from revisions: %w", err)
+	}
+	toObjs, err := s.generateManifestsAtRevisions(ctx, a, q.ToRevisions)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests of to revisions: %w", err)
+	}
+	items, err := getResourceRevisionDiffs(fromObjs, toObjs)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationRevisionDiffResponse{Items: items}, nil
+}
+
+// generateManifestsAtRevisions renders the manifests of all sources of the application at the given revisions, one per
+// source. Sources without a revision are rendered at their target revision. Ref sources resolve to the given revisions
+// as well.
+func (s *Server) generateManifestsAtRevisions(ctx context.Context, a *appv1.Application, revisions []string) ([]*unstructured.Unstructured, error) {
+	spec := a.Spec.DeepCopy()
+	for i, revision := range revisions {
+		if revision == "" {
+			continue
+		}
+		if spec.HasMultipleSources() {
+			spec.Sources[i].TargetRevision = revision
+		} else {
+			spec.Source.TargetRevision = revision
+		}
+	}
+
+	refSources, err := argo.GetRefSources(ctx, *spec, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ref sources: %w", err)
+	}
+	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+
+	var objs []*unstructured.Unstructured
+	err = s.queryRepoServer(ctx, a, func(
+		client apiclient.RepoServerServiceClient, _ *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, _ *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
+		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+		if err != nil {
+			return fmt.Errorf("error getting app instance label key from settings: %w", err)
+		}
+
+		config, err := s.getApplicationClusterConfig(ctx, a)
+		if err != nil {
+			return fmt.Errorf("error getting application cluster config: %w", err)
+		}
+
+		serverVersion, err := s.kubectl.GetServerVersion(config)
+		if err != nil {
+			return fmt.Errorf("error getting server version: %w", err)
+		}
+
+		apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+		if err != nil {
+			return fmt.Errorf("error getting API resources: %w", err)
+		}
+
+		proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+		if err != nil {
+			return fmt.Errorf("error getting app project: %w", err)
+		}
+
+		for _, source := range spec.GetSources() {
+			source := source
+			repo, err := s.db.GetRepository(ctx, source.RepoURL)
+			if err != nil {
+				return fmt.Errorf("error getting repository: %w", err)
+			}
+			kustomizeOptions, err := kustomizeSettings.GetOptions(source)
+			if err != nil {
+				return fmt.Errorf("error getting kustomize settings options: %w", err)
+			}
+			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repo,
+				Revision:           source.TargetRevision,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &source,
+				Repos:              helmRepos,
+				KustomizeOptions:   kustomizeOptions,
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HelmRepoCreds:      helmCreds,
+				HelmOptions:        helmOptions,
+				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes: enableGenerateManifests,
+				HasMultipleSources: spec.HasMultipleSources(),
+				RefSources:         refSources,
+				ProjectName:        proj.Name,
+				ProjectSourceRepos: proj.Spec.SourceRepos,
+			})
+			if err != nil {
+				return fmt.Errorf("error generating manifests of revision %s of %s: %w", source.TargetRevision, source.RepoURL, err)
+			}
+			for _, manifest := range manifestInfo.Manifests {
+				obj, err := appv1.UnmarshalToUnstructured(manifest)
+				if err != nil {
+					return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+				}
+				if obj != nil {
+					objs = append(objs, obj)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// getResourceRevisionDiffs pairs the resources rendered at two revisions by their keys and returns the difference of
+// each pair, sorted by key. The data of secrets is masked.
+func getResourceRevisionDiffs(fromObjs, toObjs []*unstructured.Unstructured) ([]*application.ResourceRevisionDiff, error) {
+	type revisionPair struct {
+		from *unstructured.Unstructured
+		to   *unstructured.Unstructured
+	}
+	pairs := make(map[kube.ResourceKey]*revisionPair)
+	getPair := func(key kube.ResourceKey) *revisionPair {
+		if _, ok := pairs[key]; !ok {
+			pairs[key] = &revisionPair{}
+		}
+		return pairs[key]
+	}
+	for _, obj := range fromObjs {
+		getPair(kube.GetResourceKey(obj)).from = obj
+	}
+	for _, obj := range toObjs {
+		getPair(kube.GetResourceKey(obj)).to = obj
+	}
+
+	keys := make([]kube.ResourceKey, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	items := make([]*application.ResourceRevisionDiff, 0, len(keys))
+	for _, key := range keys {
+		from, to := pairs[key].from, pairs[key].to
+		if key.Kind == kube.SecretKind && key.Group == "" {
+			var err error
+			to, from, err = diff.HideSecretData(to, from)
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+		}
+		fromState, err := marshalRevisionState(from)
+		if err != nil {
+			return nil, err
+		}
+		toState, err := marshalRevisionState(to)
+		if err != nil {
+			return nil, err
+		}
+		var patch string
+		if from != nil && to != nil {
+			diffBytes, err := jsonpatch.CreateMergePatch([]byte(fromState), []byte(toState))
+			if err != nil {
+				return nil, fmt.Errorf("error creating merge patch: %w", err)
+			}
+			patch = string(diffBytes)
+		}
+		items = append(items, &application.ResourceRevisionDiff{
+			Group:     pointer.String(key.Group),
+			Kind:      pointer.String(key.Kind),
+			Namespace: pointer.String(key.Namespace),
+			Name:      pointer.String(key.Name),
+			FromState: pointer.String(fromState),
+			ToState:   pointer.String(toState),
+			Diff:      pointer.String(patch),
+			Modified:  pointer.Bool(patch != "{}"),
+		})
+	}
+	return items, nil
+}
+
+func marshalRevisionState(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	return string(data), nil
+}
+
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
 	ctx := stream.Context()
 	query, err := manifeststream.ReceiveApplicationManifestQueryWithFiles(stream)
@@ -807,6 +1046,7 @@ func (s *Server) waitSync(app *appv1.Application) {
 
 func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ctx context.Context, merge bool) (*appv1.Application, error) {
 	for i := 0; i < 10; i++ {
+		before := app.DeepCopy()
 		app.Spec = newApp.Spec
 		if merge {
 			app.Labels = mergeStringMaps(app.Labels, newApp.Labels)
@@ -821,6 +1061,7 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 		res, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, app, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppEvent(app, ctx, argo.EventReasonResourceUpdated, "updated application spec")
+			audit.RecordChange(ctx, before, res)
 			s.waitSync(res)
 			return res, nil
 		}
@@ -1106,6 +1347,11 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
+	// automated syncs would bypass the approval of syncs required by the project
+	if proj.Spec.SyncApproval.IsRequired() && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: automated sync is not allowed since project %s requires approval of syncs", app.Name, proj.Name)
+	}
+
 	var conditions []appv1.ApplicationCondition
 
 	if validate {
@@ -1180,7 +1426,7 @@ func (s *Server) getAppResources(ctx context.Context, a *appv1.Application) (*ap
 }
 
 func (s *Server) getAppLiveResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.ResourceNode, *rest.Config, *appv1.Application, error) {
-	a, err := s.getApplicationEnforceRBACInformer(ctx, action, q.GetAppNamespace(), q.GetName())
+	a, err := s.getAppResourceEnforceRBAC(ctx, action, q)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -1477,17 +1723,20 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		return fmt.Errorf("error getting RBAC log enforce enable: %w", err)
 	}
 
-	if serverRBACLogEnforceEnable {
-		if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
-			return err
-		}
-	}
-
 	tree, err := s.getAppResources(ws.Context(), a)
 	if err != nil {
 		return fmt.Errorf("error getting app resource tree: %w", err)
 	}
 
+	if serverRBACLogEnforceEnable {
+		for _, key := range getLogsRBACResources(tree.Nodes, q) {
+			rbacObject := a.ResourceRBACName(s.ns, key.Group, key.Kind, key.Namespace, key.Name)
+			if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceLogs, rbacpolicy.ActionGet, rbacObject); err != nil {
+				return err
+			}
+		}
+	}
+
 	config, err := s.getApplicationClusterConfig(ws.Context(), a)
 	if err != nil {
 		return fmt.Errorf("error getting application cluster config: %w", err)
@@ -1599,6 +1848,30 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	}
 }
 
+// getLogsRBACResources returns the resources whose logs are requested by the query. Resources named by the query
+// are resolved from the treeNodes, so that a query which only sets the pod name is enforced against the group,
+// kind and namespace of the pod. Queries which do not name a resource, or whose resource is not part of the tree,
+// are enforced against the query values.
+func getLogsRBACResources(treeNodes []appv1.ResourceNode, q *application.ApplicationPodLogsQuery) []kube.ResourceKey {
+	query := kube.ResourceKey{Group: q.GetGroup(), Kind: q.GetKind(), Namespace: q.GetNamespace(), Name: q.GetResourceName()}
+	if query.Name == "" {
+		return []kube.ResourceKey{query}
+	}
+	var keys []kube.ResourceKey
+	for _, treeNode := range treeNodes {
+		if treeNode.Name == query.Name &&
+			(query.Kind == "" || treeNode.Kind == query.Kind) &&
+			(query.Group == "" || treeNode.Group == query.Group) &&
+			(query.Namespace == "" || treeNode.Namespace == query.Namespace) {
+			keys = append(keys, kube.ResourceKey{Group: treeNode.Group, Kind: treeNode.Kind, Namespace: treeNode.Namespace, Name: treeNode.Name})
+		}
+	}
+	if len(keys) == 0 {
+		return []kube.ResourceKey{query}
+	}
+	return keys
+}
+
 // from all of the treeNodes, get the pod who meets the criteria or whose parents meets the criteria
 func getSelectedPods(treeNodes []appv1.ResourceNode, q *application.ApplicationPodLogsQuery) []appv1.ResourceNode {
 	var pods []appv1.ResourceNode
@@ -1744,6 +2017,18 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		op.Retry = *retry
 	}
 
+	partial := ""
+	if len(syncReq.Resources) > 0 {
+		partial = "partial "
+	}
+	target := fmt.Sprintf("%ssync to %s", partial, displayRevision)
+	if syncReq.Manifests != nil {
+		target = fmt.Sprintf("%ssync locally", partial)
+	}
+	if proj.Spec.SyncApproval.IsRequired() && !op.DryRun() {
+		return s.requestSyncApproval(ctx, a, proj, &op, target)
+	}
+
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
@@ -1751,18 +2036,176 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
-	partial := ""
-	if len(syncReq.Resources) > 0 {
-		partial = "partial "
+	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, "initiated "+target)
+	return a, nil
+}
+
+// requestSyncApproval stores the given sync operation as the pending sync of the application. The operation is only
+// started once a different user approves it.
+func (s *Server) requestSyncApproval(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, op *appv1.Operation, target string) (*appv1.Application, error) {
+	timeout, err := proj.Spec.SyncApproval.GetTimeout()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	now := metav1.Now()
+	pending := &appv1.PendingSync{
+		Operation:        *op,
+		Requester:        op.InitiatedBy.Username,
+		RequesterSubject: session.Sub(ctx),
+		RequesterIssuer:  session.Iss(ctx),
+		RequestedAt:      now,
+		ExpiresAt:        metav1.NewTime(now.Add(timeout)),
+		DiffSummary:      syncDiffSummary(a, op.Sync),
+		Phase:            appv1.PendingSyncPhasePending,
+	}
+	a, err = s.updatePendingSync(ctx, a.Namespace, a.Name, func(a *appv1.Application) error {
+		if a.Status.PendingSync.IsPending(now.Time) {
+			return status.Errorf(codes.FailedPrecondition, "a sync requested by %s is already pending approval", a.Status.PendingSync.Requester)
+		}
+		a.Status.PendingSync = pending
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	reason := fmt.Sprintf("initiated %ssync to %s", partial, displayRevision)
-	if syncReq.Manifests != nil {
-		reason = fmt.Sprintf("initiated %ssync locally", partial)
+	s.logAppEvent(a, ctx, argo.EventReasonSyncApprovalRequested, "requested approval of "+target)
+	return a, nil
+}
+
+// SyncApprove approves or rejects the pending sync of an application
+func (s *Server) SyncApprove(ctx context.Context, req *application.ApplicationSyncApprovalRequest) (*appv1.Application, error) {
+	a, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionGet, req.GetAppNamespace(), req.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	pending := a.Status.PendingSync
+	if pending == nil || pending.Phase != appv1.PendingSyncPhasePending {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s has no sync pending approval", a.QualifiedName())
+	}
+
+	isRequester := isSyncRequester(ctx, pending)
+	// requesters can always withdraw their own syncs
+	if !req.GetReject() || !isRequester {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionApprove, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+	if !req.GetReject() {
+		if session.Sub(ctx) == "" || isRequester {
+			return nil, status.Errorf(codes.PermissionDenied, "the sync requested by %s must be approved by a different user", pending.Requester)
+		}
+		if !pending.IsPending(time.Now()) {
+			return nil, status.Errorf(codes.FailedPrecondition, "the sync requested by %s expired at %s", pending.Requester, pending.ExpiresAt.Format(time.RFC3339))
+		}
+		proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting app project: %w", err)
+		}
+		if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
+			return nil, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+		}
+	}
+
+	a, err = s.updatePendingSync(ctx, a.Namespace, a.Name, func(a *appv1.Application) error {
+		if a.Status.PendingSync == nil || !a.Status.PendingSync.RequestedAt.Equal(&pending.RequestedAt) {
+			return status.Errorf(codes.Aborted, "the pending sync of application %s has changed", a.QualifiedName())
+		}
+		a.Status.PendingSync = nil
+		if req.GetReject() {
+			return nil
+		}
+		if a.Operation != nil {
+			return status.Errorf(codes.FailedPrecondition, "another operation is already in progress")
+		}
+		op := pending.Operation.DeepCopy()
+		op.Info = append(op.Info, &appv1.Info{Name: "Approved by", Value: session.Username(ctx)})
+		a.Operation = op
+		a.Status.OperationState = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.GetReject() {
+		s.logAppEvent(a, ctx, argo.EventReasonSyncApprovalRejected, fmt.Sprintf("rejected sync to %s requested by %s", pending.Revision(), pending.Requester))
+	} else {
+		s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("approved sync to %s requested by %s", pending.Revision(), pending.Requester))
 	}
-	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
 
+// isSyncRequester returns whether the user of the context requested the given pending sync. Users are identified by
+// their subject and the issuer of their token, since usernames (e.g. the email claim of SSO users) are not unique.
+func isSyncRequester(ctx context.Context, pending *appv1.PendingSync) bool {
+	sub := session.Sub(ctx)
+	if sub == "" {
+		return false
+	}
+	if pending.RequesterSubject == "" {
+		// the sync was requested before the subject of requesters was stored
+		return session.Username(ctx) == pending.Requester
+	}
+	return sub == pending.RequesterSubject && session.Iss(ctx) == pending.RequesterIssuer
+}
+
+// updatePendingSync updates the pending sync of an application using the given function, retrying on conflicts
+func (s *Server) updatePendingSync(ctx context.Context, appNs string, appName string, update func(a *appv1.Application) error) (*appv1.Application, error) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	for i := 0; i < 10; i++ {
+		a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		if err := update(a); err != nil {
+			return nil, err
+		}
+		a, err = appIf.Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to update pending sync of app '%s' due to update conflict. Retrying again...", appName)
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update pending sync of application. Too many conflicts")
+}
+
+// maxDiffSummaryResources is the maximum number of resources listed in the diff summary of a pending sync
+const maxDiffSummaryResources = 10
+
+// syncDiffSummary summarizes the resources the given sync operation changes according to the current application
+// status
+func syncDiffSummary(a *appv1.Application, op *appv1.SyncOperation) string {
+	var changed []string
+	total := 0
+	for _, res := range a.Status.Resources {
+		if len(op.Resources) > 0 && !argo.ContainsSyncResource(res.Name, res.Namespace, res.GroupVersionKind(), op.Resources) {
+			continue
+		}
+		total++
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + "/" + name
+		}
+		switch {
+		case res.RequiresPruning:
+			if op.Prune {
+				changed = append(changed, fmt.Sprintf("%s %s (prune)", res.Kind, name))
+			}
+		case res.Status != appv1.SyncStatusCodeSynced:
+			changed = append(changed, fmt.Sprintf("%s %s", res.Kind, name))
+		}
+	}
+	if len(changed) == 0 {
+		return fmt.Sprintf("0 of %d resources out of sync", total)
+	}
+	if len(changed) > maxDiffSummaryResources {
+		return fmt.Sprintf("%d of %d resources out of sync: %s and %d more", len(changed), total, strings.Join(changed[:maxDiffSummaryResources], ", "), len(changed)-maxDiffSummaryResources)
+	}
+	return fmt.Sprintf("%d of %d resources out of sync: %s", len(changed), total, strings.Join(changed, ", "))
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*appv1.Application, error) {
 	a, err := s.getApplicationEnforceRBACClient(ctx, rbacpolicy.ActionSync, rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
 	if err != nil {
@@ -1811,6 +2254,14 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 	}
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	if proj.Spec.SyncApproval.IsRequired() && !op.DryRun() {
+		return s.requestSyncApproval(ctx, a, proj, &op, fmt.Sprintf("rollback to %d", rollbackReq.GetId()))
+	}
+
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
@@ -2080,6 +2531,18 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]appv1.Resource
 		ResourceOverrides: resourceOverrides,
 	}
 
+	discoveryExpression, err := luaVM.GetResourceActionDiscoveryCEL(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting CEL discovery expression: %w", err)
+	}
+	if discoveryExpression != "" {
+		availableActions, err := luaVM.ExecuteResourceActionDiscoveryCEL(obj, discoveryExpression)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating CEL discovery expression: %w", err)
+		}
+		return availableActions, nil
+	}
+
 	discoveryScript, err := luaVM.GetResourceActionDiscovery(obj)
 	if err != nil {
 		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
@@ -2124,27 +2587,68 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
 	}
 
-	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
 	if err != nil {
 		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
 	}
 
+	// verify all operations before running any of them, so an action is not applied partially because of missing
+	// permissions
+	created := make([]*kube.APIResourceInfo, len(newObjects))
+	for i, impactedResource := range newObjects {
+		newObj := impactedResource.UnstructuredObj
+		switch impactedResource.K8SOperation {
+		case lua.PatchOperation:
+			if newObj.GroupVersionKind().GroupKind() != liveObj.GroupVersionKind().GroupKind() || newObj.GetName() != liveObj.GetName() || newObj.GetNamespace() != liveObj.GetNamespace() {
+				return nil, status.Errorf(codes.InvalidArgument, "resource action can only patch the resource it is run on, not %s %s/%s", newObj.GetKind(), newObj.GetNamespace(), newObj.GetName())
+			}
+		case lua.CreateOperation:
+			created[i], err = s.verifyResourceCreation(ctx, config, a, liveObj, newObj)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, impactedResource := range newObjects {
+		switch impactedResource.K8SOperation {
+		case lua.PatchOperation:
+			err = s.patchResource(ctx, config, liveObj, impactedResource.UnstructuredObj)
+		case lua.CreateOperation:
+			err = s.createResource(ctx, config, created[i], impactedResource.UnstructuredObj)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res == nil {
+		s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
+	} else {
+		s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
+		s.logResourceEvent(res, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
+	}
+	return &application.ApplicationResponse{}, nil
+}
+
+// patchResource patches the live object with the changes of the new object
+func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObj, newObj *unstructured.Unstructured) error {
 	newObjBytes, err := json.Marshal(newObj)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling new object: %w", err)
+		return fmt.Errorf("error marshaling new object: %w", err)
 	}
 
 	liveObjBytes, err := json.Marshal(liveObj)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling live object: %w", err)
+		return fmt.Errorf("error marshaling live object: %w", err)
 	}
 
 	diffBytes, err := jsonpatch.CreateMergePatch(liveObjBytes, newObjBytes)
 	if err != nil {
-		return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		return fmt.Errorf("error calculating merge patch: %w", err)
 	}
 	if string(diffBytes) == "{}" {
-		return &application.ApplicationResponse{}, nil
+		return nil
 	}
 
 	// The following logic detects if the resource action makes a modification to status and/or spec.
@@ -2156,13 +2660,13 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	// * the other to update only status.
 	nonStatusPatch, statusPatch, err := splitStatusPatch(diffBytes)
 	if err != nil {
-		return nil, fmt.Errorf("error splitting status patch: %w", err)
+		return fmt.Errorf("error splitting status patch: %w", err)
 	}
 	if statusPatch != nil {
 		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes, "status")
 		if err != nil {
 			if !apierr.IsNotFound(err) {
-				return nil, fmt.Errorf("error patching resource: %w", err)
+				return fmt.Errorf("error patching resource: %w", err)
 			}
 			// K8s API server returns 404 NotFound when the CRD does not support the status subresource
 			// if we get here, the CRD does not use the status subresource. We will fall back to a normal patch
@@ -2175,17 +2679,78 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	if diffBytes != nil {
 		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes)
 		if err != nil {
-			return nil, fmt.Errorf("error patching resource: %w", err)
+			return fmt.Errorf("error patching resource: %w", err)
 		}
 	}
+	return nil
+}
 
-	if res == nil {
-		s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
+// verifyResourceCreation verifies that the project of the application permits to create the new object, which is
+// created in the namespace of the live object if it has none. Returns the API resource of the new object.
+func (s *Server) verifyResourceCreation(ctx context.Context, config *rest.Config, a *appv1.Application, liveObj, newObj *unstructured.Unstructured) (*kube.APIResourceInfo, error) {
+	gvk := newObj.GroupVersionKind()
+	resourcesFilter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources filter: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, resourcesFilter)
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+	var apiResource *kube.APIResourceInfo
+	for i := range apiResources {
+		if apiResources[i].GroupKind == gvk.GroupKind() && apiResources[i].GroupVersionResource.Version == gvk.Version {
+			apiResource = &apiResources[i]
+			break
+		}
+	}
+	if apiResource == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource action cannot create %s: unknown or excluded resource type", gvk)
+	}
+	if apiResource.Meta.Namespaced {
+		if newObj.GetNamespace() == "" {
+			newObj.SetNamespace(liveObj.GetNamespace())
+		}
 	} else {
-		s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
-		s.logResourceEvent(res, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
+		newObj.SetNamespace("")
 	}
-	return &application.ApplicationResponse{}, nil
+
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	if !proj.IsGroupKindPermitted(gvk.GroupKind(), apiResource.Meta.Namespaced) {
+		return nil, status.Errorf(codes.PermissionDenied, "resource action cannot create %s: resource kind is not permitted in project %s", gvk.Kind, proj.Name)
+	}
+	dest := a.Spec.Destination
+	if err := argo.ValidateDestination(ctx, &dest, s.db); err != nil {
+		return nil, fmt.Errorf("error validating destination: %w", err)
+	}
+	dest.Namespace = newObj.GetNamespace()
+	permitted, err := proj.IsDestinationPermitted(dest, func(project string) ([]*appv1.Cluster, error) {
+		return s.db.GetProjectClusters(ctx, project)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error checking destination: %w", err)
+	}
+	if !permitted {
+		return nil, status.Errorf(codes.PermissionDenied, "resource action cannot create %s in namespace %s: destination is not permitted in project %s", gvk.Kind, dest.Namespace, proj.Name)
+	}
+	return apiResource, nil
+}
+
+// createResource creates the new object of the given API resource
+func (s *Server) createResource(ctx context.Context, config *rest.Config, apiResource *kube.APIResourceInfo, newObj *unstructured.Unstructured) error {
+	dynamicIf, err := s.kubectl.NewDynamicClient(config)
+	if err != nil {
+		return fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	resourceIf := kube.ToResourceInterface(dynamicIf, &apiResource.Meta, apiResource.GroupVersionResource, newObj.GetNamespace())
+	_, err = resourceIf.Create(ctx, newObj, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating resource: %w", err)
+	}
+	return nil
 }
 
 // splitStatusPatch splits a patch into two: one for a non-status patch, and the status-only patch.
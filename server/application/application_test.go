@@ -23,12 +23,14 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	k8sappsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
@@ -56,6 +58,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/grpc"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -748,6 +751,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
 	})
 
+	t.Run("RevisionDiff", func(t *testing.T) {
+		_, err := appServer.RevisionDiff(adminCtx, &application.ApplicationRevisionDiffQuery{Name: pointer.String("test"), FromRevisions: []string{"v1"}, ToRevisions: []string{"v2"}})
+		assert.NoError(t, err)
+		_, err = appServer.RevisionDiff(adminCtx, &application.ApplicationRevisionDiffQuery{Name: pointer.String("test"), FromRevisions: []string{"v1", "v2"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = appServer.RevisionDiff(noRoleCtx, &application.ApplicationRevisionDiffQuery{Name: pointer.String("test")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.RevisionDiff(adminCtx, &application.ApplicationRevisionDiffQuery{Name: pointer.String("doest-not-exist")})
+		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+	})
+
 	t.Run("ListResourceEvents", func(t *testing.T) {
 		_, err := appServer.ListResourceEvents(adminCtx, &application.ApplicationResourceEventsQuery{Name: pointer.String("test")})
 		assert.NoError(t, err)
@@ -958,6 +972,33 @@ func TestNoAppEnumeration(t *testing.T) {
 	})
 }
 
+func TestAppResourceRBAC(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy(`
+p, role:oncall, applications, delete, default/*, allow
+p, role:oncall, applications, delete, default/*//Secret/*/*, deny
+g, oncall, role:oncall
+`)
+		enf.SetDefaultRole("role:none")
+	}
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "test"
+		app.Status.Resources = []appsv1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Version: "v1", Name: "test", Namespace: "test"},
+			{Kind: "Secret", Version: "v1", Name: "test", Namespace: "test"},
+		}
+	})
+	appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp)
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"oncall"}})
+
+	_, err := appServer.DeleteResource(ctx, &application.ApplicationResourceDeleteRequest{Name: pointer.String("test"), ResourceName: pointer.String("test"), Group: pointer.String("apps"), Kind: pointer.String("Deployment"), Namespace: pointer.String("test")})
+	assert.NoError(t, err)
+	_, err = appServer.DeleteResource(ctx, &application.ApplicationResourceDeleteRequest{Name: pointer.String("test"), ResourceName: pointer.String("test"), Kind: pointer.String("Secret"), Namespace: pointer.String("test")})
+	assert.Equal(t, permissionDeniedErr.Error(), err.Error())
+}
+
 // setSyncRunningOperationState simulates starting a sync operation on the given app.
 func setSyncRunningOperationState(t *testing.T, appServer *Server) {
 	appIf := appServer.appclientset.ArgoprojV1alpha1().Applications("default")
@@ -1577,6 +1618,160 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestSyncApproval(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		_ = enf.SetUserPolicy(`
+p, alice, applications, *, approval/*, allow
+p, bob, applications, get, approval/*, allow
+p, bob, applications, approve, approval/*, allow
+p, carol, applications, get, approval/*, allow
+`)
+		enf.SetDefaultRole("")
+	}
+	approvalProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "approval", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApproval: &appsv1.SyncApproval{Enabled: true, Timeout: "1h"},
+		},
+	}
+	newApp := func() *appsv1.Application {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Spec.Project = "approval"
+			app.Status.Resources = []appsv1.ResourceStatus{
+				{Group: "apps", Kind: "Deployment", Version: "v1", Name: "guestbook", Namespace: "test", Status: appsv1.SyncStatusCodeOutOfSync},
+				{Kind: "Service", Version: "v1", Name: "guestbook", Namespace: "test", Status: appsv1.SyncStatusCodeSynced},
+			}
+		})
+	}
+	userCtx := func(user string) context.Context {
+		// nolint:staticcheck
+		return context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": user})
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		app, err := appServer.Sync(userCtx("alice"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		require.NotNil(t, app.Status.PendingSync)
+		assert.Equal(t, appsv1.PendingSyncPhasePending, app.Status.PendingSync.Phase)
+		assert.Equal(t, "alice", app.Status.PendingSync.Requester)
+		assert.Equal(t, "alice", app.Status.PendingSync.RequesterSubject)
+		assert.Equal(t, session.SessionManagerClaimsIssuer, app.Status.PendingSync.RequesterIssuer)
+		assert.Equal(t, "1 of 2 resources out of sync: Deployment test/guestbook", app.Status.PendingSync.DiffSummary)
+		assert.True(t, app.Status.PendingSync.IsPending(time.Now().Add(59*time.Minute)))
+		assert.False(t, app.Status.PendingSync.IsPending(time.Now().Add(61*time.Minute)))
+
+		_, err = appServer.Sync(userCtx("alice"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = appServer.SyncApprove(userCtx("alice"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "different user")
+
+		_, err = appServer.SyncApprove(userCtx("carol"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		app, err = appServer.SyncApprove(userCtx("bob"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Nil(t, app.Status.PendingSync)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "alice", app.Operation.InitiatedBy.Username)
+		assert.Contains(t, app.Operation.Info, &appsv1.Info{Name: "Approved by", Value: "bob"})
+
+		_, err = appServer.SyncApprove(userCtx("bob"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("RequesterIdentifiedBySubject", func(t *testing.T) {
+		ssoCtx := func(sub string, email string) context.Context {
+			// nolint:staticcheck
+			return context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "https://dex.example.com", "sub": sub, "email": email})
+		}
+		testApp := newApp()
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		app, err := appServer.Sync(ssoCtx("alice", "alice@example.com"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.NotNil(t, app.Status.PendingSync)
+		assert.Equal(t, "alice@example.com", app.Status.PendingSync.Requester)
+		assert.Equal(t, "alice", app.Status.PendingSync.RequesterSubject)
+		assert.Equal(t, "https://dex.example.com", app.Status.PendingSync.RequesterIssuer)
+
+		// changing the email does not make the requester a different user
+		_, err = appServer.SyncApprove(ssoCtx("alice", "other@example.com"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, err.Error(), "different user")
+
+		// a user with the same username but a different subject is a different user
+		app, err = appServer.SyncApprove(ssoCtx("bob", "alice@example.com"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Nil(t, app.Status.PendingSync)
+		assert.Contains(t, app.Operation.Info, &appsv1.Info{Name: "Approved by", Value: "alice@example.com"})
+	})
+
+	t.Run("AutomatedSyncRejected", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		updated := testApp.DeepCopy()
+		updated.Spec.SyncPolicy = &appsv1.SyncPolicy{Automated: &appsv1.SyncPolicyAutomated{}}
+		_, err := appServer.Update(userCtx("alice"), &application.ApplicationUpdateRequest{Application: updated})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "requires approval of syncs")
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		app, err := appServer.Sync(userCtx("alice"), &application.ApplicationSyncRequest{Name: &testApp.Name, DryRun: pointer.Bool(true)})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+		assert.Nil(t, app.Status.PendingSync)
+	})
+
+	t.Run("Withdraw", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		_, err := appServer.Sync(userCtx("alice"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		_, err = appServer.SyncApprove(userCtx("carol"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name, Reject: pointer.Bool(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		app, err := appServer.SyncApprove(userCtx("alice"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name, Reject: pointer.Bool(true)})
+		require.NoError(t, err)
+		assert.Nil(t, app.Status.PendingSync)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		testApp := newApp()
+		testApp.Status.PendingSync = &appsv1.PendingSync{
+			Operation:   appsv1.Operation{Sync: &appsv1.SyncOperation{Revision: "abc"}, InitiatedBy: appsv1.OperationInitiator{Username: "alice"}},
+			Requester:   "alice",
+			RequestedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+			ExpiresAt:   metav1.NewTime(time.Now().Add(-time.Hour)),
+			Phase:       appsv1.PendingSyncPhasePending,
+		}
+		appServer := newTestAppServerWithEnforcerConfigure(f, t, testApp, approvalProj)
+
+		_, err := appServer.SyncApprove(userCtx("bob"), &application.ApplicationSyncApprovalRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "expired")
+
+		// an expired sync does not block new syncs
+		app, err := appServer.Sync(userCtx("alice"), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.True(t, app.Status.PendingSync.IsPending(time.Now()))
+	})
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -1810,6 +2005,83 @@ func TestSplitStatusPatch(t *testing.T) {
 	}
 }
 
+func TestRunResourceActionCreatingResource(t *testing.T) {
+	cronJob := batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "batch/v1",
+			Kind:       "CronJob",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hello",
+			Namespace: test.FakeDestNamespace,
+			UID:       "123",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "* * * * *",
+		},
+	}
+	newApp := func(project string) *appsv1.Application {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Spec.Project = project
+			app.Status.Resources = []appsv1.ResourceStatus{{
+				Group:     "batch",
+				Kind:      "CronJob",
+				Version:   "v1",
+				Name:      cronJob.Name,
+				Namespace: cronJob.Namespace,
+			}}
+		})
+	}
+	restrictedProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:                []string{"*"},
+			Destinations:               []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "batch", Kind: "Job"}},
+		},
+	}
+	runCreateJob := func(t *testing.T, project string) (*dynamicfake.FakeDynamicClient, error) {
+		appServer := newTestAppServer(t, newApp(project), restrictedProj, kube.MustToUnstructured(&cronJob))
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+		kubectl := appServer.kubectl.(*kubetest.MockKubectlCmd)
+		kubectl.DynamicClient = dynamicClient
+		kubectl.APIResources = []kube.APIResourceInfo{{
+			GroupKind:            schema.GroupKind{Group: "batch", Kind: "Job"},
+			Meta:                 metav1.APIResource{Name: "jobs", Namespaced: true},
+			GroupVersionResource: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"},
+		}}
+		_, err := appServer.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{
+			Name:         pointer.String("test-app"),
+			ResourceName: pointer.String(cronJob.Name),
+			Namespace:    pointer.String(cronJob.Namespace),
+			Group:        pointer.String("batch"),
+			Version:      pointer.String("v1"),
+			Kind:         pointer.String("CronJob"),
+			Action:       pointer.String("create-job"),
+		})
+		return dynamicClient, err
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		dynamicClient, err := runCreateJob(t, "default")
+		require.NoError(t, err)
+		require.Len(t, dynamicClient.Actions(), 1)
+		createAction, ok := dynamicClient.Actions()[0].(kubetesting.CreateAction)
+		require.True(t, ok)
+		assert.Equal(t, "jobs", createAction.GetResource().Resource)
+		assert.Equal(t, test.FakeDestNamespace, createAction.GetNamespace())
+		job := createAction.GetObject().(*unstructured.Unstructured)
+		assert.Equal(t, "hello-", job.GetGenerateName())
+		assert.Equal(t, "CronJob", job.GetOwnerReferences()[0].Kind)
+	})
+
+	t.Run("KindNotPermitted", func(t *testing.T) {
+		dynamicClient, err := runCreateJob(t, "restricted")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, dynamicClient.Actions())
+	})
+}
+
 func TestLogsGetSelectedPod(t *testing.T) {
 	deployment := appsv1.ResourceRef{Group: "", Version: "v1", Kind: "Deployment", Name: "deployment", UID: "1"}
 	rs := appsv1.ResourceRef{Group: "", Version: "v1", Kind: "ReplicaSet", Name: "rs", UID: "2"}
@@ -1874,6 +2146,58 @@ func TestLogsGetSelectedPod(t *testing.T) {
 	})
 }
 
+func TestLogsGetRBACResources(t *testing.T) {
+	deployment := appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "ns", Name: "deployment", UID: "1"}
+	pod := appsv1.ResourceRef{Group: "", Version: "v1", Kind: "Pod", Namespace: "ns", Name: "pod", UID: "2"}
+	treeNodes := []appsv1.ResourceNode{
+		{ResourceRef: deployment, ParentRefs: nil},
+		{ResourceRef: pod, ParentRefs: []appsv1.ResourceRef{deployment}},
+	}
+	appName := "appName"
+
+	t.Run("PodNameOnly", func(t *testing.T) {
+		kind := "Pod"
+		name := "pod"
+		podQuery := application.ApplicationPodLogsQuery{
+			Name:         &appName,
+			Kind:         &kind,
+			ResourceName: &name,
+		}
+		keys := getLogsRBACResources(treeNodes, &podQuery)
+		assert.Equal(t, []kube.ResourceKey{{Group: "", Kind: "Pod", Namespace: "ns", Name: "pod"}}, keys)
+	})
+
+	t.Run("ResourceNameOnly", func(t *testing.T) {
+		name := "deployment"
+		podQuery := application.ApplicationPodLogsQuery{
+			Name:         &appName,
+			ResourceName: &name,
+		}
+		keys := getLogsRBACResources(treeNodes, &podQuery)
+		assert.Equal(t, []kube.ResourceKey{{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "deployment"}}, keys)
+	})
+
+	t.Run("AllPods", func(t *testing.T) {
+		podQuery := application.ApplicationPodLogsQuery{
+			Name: &appName,
+		}
+		keys := getLogsRBACResources(treeNodes, &podQuery)
+		assert.Equal(t, []kube.ResourceKey{{}}, keys)
+	})
+
+	t.Run("UnknownResource", func(t *testing.T) {
+		kind := "Pod"
+		name := "other"
+		podQuery := application.ApplicationPodLogsQuery{
+			Name:         &appName,
+			Kind:         &kind,
+			ResourceName: &name,
+		}
+		keys := getLogsRBACResources(treeNodes, &podQuery)
+		assert.Equal(t, []kube.ResourceKey{{Kind: "Pod", Name: "other"}}, keys)
+	})
+}
+
 // refreshAnnotationRemover runs an infinite loop until it detects and removes refresh annotation or given context is done
 func refreshAnnotationRemover(t *testing.T, ctx context.Context, patched *int32, appServer *Server, appName string, ch chan string) {
 	for ctx.Err() == nil {
@@ -2000,3 +2324,52 @@ func TestInferResourcesStatusHealth(t *testing.T) {
 	assert.Equal(t, health.HealthStatusDegraded, testApp.Status.Resources[0].Health.Status)
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
+
+func TestGetResourceRevisionDiffs(t *testing.T) {
+	newObj := func(kind, name string, data map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+			"data":       data,
+		}}
+	}
+	fromObjs := []*unstructured.Unstructured{
+		newObj("ConfigMap", "changed", map[string]interface{}{"key": "old"}),
+		newObj("ConfigMap", "removed", nil),
+		newObj("ConfigMap", "unchanged", map[string]interface{}{"key": "value"}),
+		newObj("Secret", "secret", map[string]interface{}{"password": "b2xk", "user": "YWRtaW4="}),
+	}
+	toObjs := []*unstructured.Unstructured{
+		newObj("ConfigMap", "added", nil),
+		newObj("ConfigMap", "changed", map[string]interface{}{"key": "new"}),
+		newObj("ConfigMap", "unchanged", map[string]interface{}{"key": "value"}),
+		newObj("Secret", "secret", map[string]interface{}{"password": "bmV3", "user": "YWRtaW4="}),
+	}
+
+	items, err := getResourceRevisionDiffs(fromObjs, toObjs)
+	require.NoError(t, err)
+	require.Len(t, items, 5)
+	byName := map[string]*application.ResourceRevisionDiff{}
+	for _, item := range items {
+		byName[item.GetName()] = item
+	}
+	assert.Equal(t, "added", items[0].GetName())
+
+	assert.True(t, byName["added"].GetModified())
+	assert.Empty(t, byName["added"].GetFromState())
+	assert.Empty(t, byName["added"].GetDiff())
+	assert.True(t, byName["removed"].GetModified())
+	assert.Empty(t, byName["removed"].GetToState())
+
+	assert.True(t, byName["changed"].GetModified())
+	assert.JSONEq(t, `{"data": {"key": "new"}}`, byName["changed"].GetDiff())
+	assert.False(t, byName["unchanged"].GetModified())
+
+	secret := byName["secret"]
+	assert.True(t, secret.GetModified())
+	assert.NotContains(t, secret.GetFromState(), "b2xk")
+	assert.NotContains(t, secret.GetToState(), "bmV3")
+	assert.NotContains(t, secret.GetDiff(), "bmV3")
+	assert.NotContains(t, secret.GetDiff(), "user")
+}
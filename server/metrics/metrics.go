@@ -14,8 +14,10 @@ import (
 
 type MetricsServer struct {
 	*http.Server
-	redisRequestCounter   *prometheus.CounterVec
-	redisRequestHistogram *prometheus.HistogramVec
+	redisRequestCounter     *prometheus.CounterVec
+	redisRequestHistogram   *prometheus.HistogramVec
+	throttledRequestCounter *prometheus.CounterVec
+	droppedAuditRecords     prometheus.Counter
 }
 
 var (
@@ -34,6 +36,19 @@ var (
 		},
 		[]string{"initiator"},
 	)
+	throttledRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_api_requests_throttled_total",
+			Help: "Number of API requests rejected by rate limits.",
+		},
+		[]string{"limit", "method"},
+	)
+	droppedAuditRecords = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "argocd_audit_records_dropped_total",
+			Help: "Number of audit records dropped because the audit log sinks could not keep up.",
+		},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects api server metrics
@@ -48,14 +63,18 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(throttledRequestCounter)
+	registry.MustRegister(droppedAuditRecords)
 
 	return &MetricsServer{
 		Server: &http.Server{
 			Addr:    fmt.Sprintf("%s:%d", host, port),
 			Handler: mux,
 		},
-		redisRequestCounter:   redisRequestCounter,
-		redisRequestHistogram: redisRequestHistogram,
+		redisRequestCounter:     redisRequestCounter,
+		redisRequestHistogram:   redisRequestHistogram,
+		throttledRequestCounter: throttledRequestCounter,
+		droppedAuditRecords:     droppedAuditRecords,
 	}
 }
 
@@ -67,3 +86,13 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-server").Observe(duration.Seconds())
 }
+
+// IncThrottledRequest increments the number of API requests rejected by the given rate limit
+func (m *MetricsServer) IncThrottledRequest(limit string, method string) {
+	m.throttledRequestCounter.WithLabelValues(limit, method).Inc()
+}
+
+// IncDroppedAuditRecord increments the number of dropped audit records
+func (m *MetricsServer) IncDroppedAuditRecord() {
+	m.droppedAuditRecords.Inc()
+}
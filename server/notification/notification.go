@@ -4,6 +4,11 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/notifications-engine/pkg/api"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/pointer"
@@ -12,11 +17,14 @@ import (
 // Server provides an Application service
 type Server struct {
 	apiFactory api.Factory
+	deliveries delivery.Store
+	enf        *rbac.Enforcer
+	ns         string
 }
 
 // NewServer returns a new instance of the Application service
-func NewServer(apiFactory api.Factory) notification.NotificationServiceServer {
-	s := &Server{apiFactory: apiFactory}
+func NewServer(apiFactory api.Factory, deliveries delivery.Store, enf *rbac.Enforcer, namespace string) notification.NotificationServiceServer {
+	s := &Server{apiFactory: apiFactory, deliveries: deliveries, enf: enf, ns: namespace}
 	return s
 }
 
@@ -66,3 +74,52 @@ func (s *Server) ListTemplates(ctx context.Context, q *notification.TemplatesLis
 	}
 	return &notification.TemplateList{Items: templates}, nil
 }
+
+// ListDeliveries returns the delivery history, or the dead-lettered deliveries, of the notifications about the
+// resources the user is allowed to get
+func (s *Server) ListDeliveries(ctx context.Context, q *notification.DeliveriesListRequest) (*notification.DeliveryList, error) {
+	var records []delivery.Record
+	var err error
+	if q.GetDeadLettered() {
+		records, err = s.deliveries.ListDeadLetters()
+	} else {
+		records, err = s.deliveries.List()
+	}
+	if err != nil {
+		return nil, err
+	}
+	items := []*notification.Delivery{}
+	for _, record := range delivery.Filter(records, q.GetKind(), q.GetNamespace(), q.GetName()) {
+		if !s.canGet(ctx, record) {
+			continue
+		}
+		items = append(items, &notification.Delivery{
+			Id:        pointer.String(record.ID),
+			Time:      pointer.Int64(record.Time.Unix()),
+			Kind:      pointer.String(record.Kind),
+			Namespace: pointer.String(record.Namespace),
+			Name:      pointer.String(record.Name),
+			Project:   pointer.String(record.Project),
+			Trigger:   pointer.String(record.Trigger),
+			Service:   pointer.String(record.Service),
+			Recipient: pointer.String(record.Recipient),
+			Status:    pointer.String(record.Status),
+			Attempt:   pointer.Int64(int64(record.Attempt)),
+			Error:     pointer.String(record.Error),
+		})
+	}
+	return &notification.DeliveryList{Items: items}, nil
+}
+
+// canGet returns whether the user is allowed to get the resource the delivered notification is about
+func (s *Server) canGet(ctx context.Context, record delivery.Record) bool {
+	claims := ctx.Value("claims")
+	switch record.Kind {
+	case settings.KindAppProject:
+		return s.enf.Enforce(claims, rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, record.Name)
+	case settings.KindApplicationSet:
+		return s.enf.Enforce(claims, rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, security.AppRBACName(s.ns, record.Project, record.Namespace, record.Name))
+	default:
+		return s.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, security.AppRBACName(s.ns, record.Project, record.Namespace, record.Name))
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"os"
 	"testing"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/notification"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/notifications-engine/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,13 +70,17 @@ func TestNotificationServer(t *testing.T) {
 	}
 	mockRepoClient := &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
 
-	argocdService, err := service.NewArgoCDService(kubeclientset, testNamespace, mockRepoClient)
+	argocdService, err := service.NewArgoCDService(kubeclientset, testNamespace, mockRepoClient, nil)
 	require.NoError(t, err)
 	defer argocdService.Close()
 	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm"), testNamespace, secretInformer, configMapInformer)
 
+	deliveries := delivery.NewStore(nil, 0)
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enf.SetDefaultRole("role:admin")
+
 	t.Run("TestListServices", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveries, enf, testNamespace)
 		services, err := server.ListServices(ctx, &notification.ServicesListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, services.Items, 1)
@@ -81,7 +88,7 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, services.Items[0])
 	})
 	t.Run("TestListTriggers", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveries, enf, testNamespace)
 		triggers, err := server.ListTriggers(ctx, &notification.TriggersListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, triggers.Items, 1)
@@ -89,11 +96,46 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, triggers.Items[0])
 	})
 	t.Run("TestListTemplates", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveries, enf, testNamespace)
 		templates, err := server.ListTemplates(ctx, &notification.TemplatesListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, templates.Items, 1)
 		assert.Equal(t, templates.Items[0].Name, pointer.String("app-created"))
 		assert.NotEmpty(t, templates.Items[0])
 	})
+	t.Run("TestListDeliveries", func(t *testing.T) {
+		store := delivery.NewStore(nil, 0)
+		records := []delivery.Record{
+			{ID: "1", Kind: "Application", Namespace: testNamespace, Name: "app1", Project: "default", Trigger: "on-created", Status: delivery.StatusSent, Attempt: 1},
+			{ID: "2", Kind: "Application", Namespace: testNamespace, Name: "app2", Project: "default", Trigger: "on-created", Status: delivery.StatusFailed, Attempt: 1, Error: "boom"},
+			{ID: "2", Kind: "Application", Namespace: testNamespace, Name: "app2", Project: "default", Trigger: "on-created", Status: delivery.StatusDeadLettered, Attempt: 2, Error: "boom"},
+			{ID: "3", Kind: "AppProject", Namespace: testNamespace, Name: "default", Project: "default", Trigger: "on-project-sync-window-opened", Status: delivery.StatusSent, Attempt: 1},
+		}
+		for _, record := range records {
+			require.NoError(t, store.Add(record))
+		}
+		restricted := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+		require.NoError(t, restricted.SetUserPolicy("p, role:restricted, applications, get, default/app2, allow\np, role:restricted, projects, get, default, allow"))
+		restricted.SetDefaultRole("role:restricted")
+		server := NewServer(apiFactory, store, restricted, testNamespace)
+
+		list, err := server.ListDeliveries(ctx, &notification.DeliveriesListRequest{})
+		require.NoError(t, err)
+		if assert.Len(t, list.Items, 3) {
+			assert.Equal(t, "3", list.Items[0].GetId())
+			assert.Equal(t, delivery.StatusDeadLettered, list.Items[1].GetStatus())
+			assert.Equal(t, int64(2), list.Items[1].GetAttempt())
+			assert.Equal(t, "boom", list.Items[1].GetError())
+		}
+
+		list, err = server.ListDeliveries(ctx, &notification.DeliveriesListRequest{Kind: pointer.String("Application")})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 2)
+
+		list, err = server.ListDeliveries(ctx, &notification.DeliveriesListRequest{DeadLettered: pointer.Bool(true)})
+		require.NoError(t, err)
+		if assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "app2", list.Items[0].GetName())
+		}
+	})
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/deeplinks"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/audit"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -348,8 +349,13 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if q.Project == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload 'project' in request")
 	}
+	// Project owners may update the project within the bounds of its ceiling, even if they lack the update permission
+	ownerOnly := false
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Project.Name); err != nil {
-		return nil, err
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionOwn, q.Project.Name) {
+			return nil, err
+		}
+		ownerOnly = true
 	}
 	q.Project.NormalizePolicies()
 	q.Project.NormalizeJWTTokens()
@@ -365,28 +371,35 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return nil, err
 	}
 
-	for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
+	if ownerOnly {
+		// the ceiling set by global admins replaces the cluster and repository permissions of project owners
+		if err := validateOwnerUpdate(oldProj, q.Project); err != nil {
 			return nil, err
 		}
-	}
-
-	for _, repoUrl := range difference(q.Project.Spec.SourceRepos, oldProj.Spec.SourceRepos) {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionUpdate, repoUrl); err != nil {
-			return nil, err
+	} else {
+		for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	clusterResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceWhitelist, oldProj.Spec.ClusterResourceWhitelist)
-	clusterResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceBlacklist, oldProj.Spec.ClusterResourceBlacklist)
-	namespacesResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceBlacklist, oldProj.Spec.NamespaceResourceBlacklist)
-	namespacesResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceWhitelist, oldProj.Spec.NamespaceResourceWhitelist)
-	if !clusterResourceWhitelistsEqual || !clusterResourceBlacklistsEqual || !namespacesResourceBlacklistsEqual || !namespacesResourceWhitelistsEqual {
-		for _, cluster := range q.Project.Spec.DestinationClusters() {
-			if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
+		for _, repoUrl := range difference(q.Project.Spec.SourceRepos, oldProj.Spec.SourceRepos) {
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionUpdate, repoUrl); err != nil {
 				return nil, err
 			}
 		}
+
+		clusterResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceWhitelist, oldProj.Spec.ClusterResourceWhitelist)
+		clusterResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceBlacklist, oldProj.Spec.ClusterResourceBlacklist)
+		namespacesResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceBlacklist, oldProj.Spec.NamespaceResourceBlacklist)
+		namespacesResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceWhitelist, oldProj.Spec.NamespaceResourceWhitelist)
+		if !clusterResourceWhitelistsEqual || !clusterResourceBlacklistsEqual || !namespacesResourceBlacklistsEqual || !namespacesResourceWhitelistsEqual {
+			for _, cluster := range q.Project.Spec.DestinationClusters() {
+				if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
+					return nil, err
+				}
+			}
+		}
 	}
 
 	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
@@ -448,10 +461,121 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, q.Project, metav1.UpdateOptions{})
 	if err == nil {
 		s.logEvent(res, ctx, argo.EventReasonResourceUpdated, "updated project")
+		audit.RecordChange(ctx, oldProj, res)
 	}
 	return res, err
 }
 
+// validateOwnerUpdate verifies that a project owner only changes the description, source repositories, destinations,
+// cluster resource whitelist, sync windows and roles of a project, and does not permit more than the ceiling of the
+// project allows, neither by adding allow patterns nor by removing deny patterns
+func validateOwnerUpdate(oldProj *v1alpha1.AppProject, newProj *v1alpha1.AppProject) error {
+	ownerFields := func(spec v1alpha1.AppProjectSpec) v1alpha1.AppProjectSpec {
+		spec.Description = ""
+		spec.SourceRepos = nil
+		spec.Destinations = nil
+		spec.ClusterResourceWhitelist = nil
+		spec.SyncWindows = nil
+		spec.Roles = nil
+		return spec
+	}
+	if !reflect.DeepEqual(ownerFields(oldProj.Spec), ownerFields(newProj.Spec)) || !reflect.DeepEqual(oldProj.Finalizers, newProj.Finalizers) ||
+		!equalStringMaps(oldProj.Labels, newProj.Labels) || !equalStringMaps(oldProj.Annotations, newProj.Annotations) {
+		return status.Errorf(codes.PermissionDenied, "project owners may only change the description, source repositories, destinations, cluster resource whitelist, sync windows and roles of project '%s'", newProj.Name)
+	}
+
+	ceiling := oldProj.Spec.Ceiling
+	for _, repoURL := range difference(newProj.Spec.SourceRepos, oldProj.Spec.SourceRepos) {
+		if !ceiling.IsSourceRepoPermitted(repoURL) {
+			return status.Errorf(codes.PermissionDenied, "source repository '%s' exceeds the ceiling of project '%s'", repoURL, newProj.Name)
+		}
+	}
+	for _, repoURL := range difference(oldProj.Spec.SourceRepos, newProj.Spec.SourceRepos) {
+		if !ceiling.IsSourceRepoRemovalPermitted(repoURL) {
+			return status.Errorf(codes.PermissionDenied, "removing source repository '%s' exceeds the ceiling of project '%s'", repoURL, newProj.Name)
+		}
+	}
+	for _, dest := range newProj.Spec.Destinations {
+		if containsDestination(oldProj.Spec.Destinations, dest) {
+			continue
+		}
+		if !ceiling.IsDestinationPermitted(dest) {
+			return status.Errorf(codes.PermissionDenied, "destination '%s' exceeds the ceiling of project '%s'", destinationString(dest), newProj.Name)
+		}
+	}
+	for _, dest := range oldProj.Spec.Destinations {
+		if containsDestination(newProj.Spec.Destinations, dest) {
+			continue
+		}
+		if !ceiling.IsDestinationRemovalPermitted(dest) {
+			return status.Errorf(codes.PermissionDenied, "removing destination '%s' exceeds the ceiling of project '%s'", destinationString(dest), newProj.Name)
+		}
+	}
+	for _, gk := range newProj.Spec.ClusterResourceWhitelist {
+		if containsGroupKind(oldProj.Spec.ClusterResourceWhitelist, gk) {
+			continue
+		}
+		if !ceiling.IsClusterResourcePermitted(gk) {
+			return status.Errorf(codes.PermissionDenied, "cluster resource '%s/%s' exceeds the ceiling of project '%s'", gk.Group, gk.Kind, newProj.Name)
+		}
+	}
+
+	// revoked tokens must not be restored
+	for _, role := range newProj.Spec.Roles {
+		var oldTokens []v1alpha1.JWTToken
+		if oldRole, _, err := oldProj.GetRoleByName(role.Name); err == nil {
+			oldTokens = append(oldTokens, oldRole.JWTTokens...)
+		}
+		oldTokens = append(oldTokens, oldProj.Status.JWTTokensByRole[role.Name].Items...)
+		for _, token := range role.JWTTokens {
+			if !containsToken(oldTokens, token) {
+				return status.Errorf(codes.PermissionDenied, "project owners may not add tokens to role '%s' of project '%s'", role.Name, newProj.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// equalStringMaps returns whether both maps have the same entries, treating nil and empty maps as equal
+func equalStringMaps(a map[string]string, b map[string]string) bool {
+	return (len(a) == 0 && len(b) == 0) || reflect.DeepEqual(a, b)
+}
+
+func containsDestination(destinations []v1alpha1.ApplicationDestination, dest v1alpha1.ApplicationDestination) bool {
+	for _, d := range destinations {
+		if d.Server == dest.Server && d.Name == dest.Name && d.Namespace == dest.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func containsGroupKind(groupKinds []metav1.GroupKind, gk metav1.GroupKind) bool {
+	for _, item := range groupKinds {
+		if item == gk {
+			return true
+		}
+	}
+	return false
+}
+
+func containsToken(tokens []v1alpha1.JWTToken, token v1alpha1.JWTToken) bool {
+	for _, t := range tokens {
+		// tokens of old projects may lack an ID, which is derived from the issue time on normalization
+		if t.IssuedAt == token.IssuedAt && (t.ID == token.ID || t.ID == "") {
+			return true
+		}
+	}
+	return false
+}
+
+func destinationString(dest v1alpha1.ApplicationDestination) string {
+	if dest.Server == "" && dest.Name != "" {
+		return fmt.Sprintf("%s/%s", dest.Name, dest.Namespace)
+	}
+	return fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)
+}
+
 // Delete deletes a project
 func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.EmptyResponse, error) {
 	if q.Name == v1alpha1.DefaultAppProjectName {
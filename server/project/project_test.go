@@ -161,6 +161,118 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: clusters, update, https://server1"), err)
 	})
 
+	t.Run("TestOwnerUpdate", func(t *testing.T) {
+		enforcer := newEnforcer(kubeclientset)
+		enforcer.SetDefaultRole("role:owner")
+		_ = enforcer.SetBuiltinPolicy("p, role:owner, projects, own, test, allow")
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		ownedProj := existingProj.DeepCopy()
+		ownedProj.Spec.Ceiling = &v1alpha1.ProjectCeiling{
+			SourceRepos:              []string{"https://github.com/my-org/*"},
+			Destinations:             []v1alpha1.ApplicationDestination{{Server: "https://server*", Namespace: "team-*"}},
+			ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+		}
+		ownedProj.Spec.Roles = []v1alpha1.ProjectRole{{Name: "owners", JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "1"}}}}
+		newServer := func() *Server {
+			return NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(ownedProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB)
+		}
+
+		t.Run("WithinCeiling", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.Description = "owned by team a"
+			updatedProj.Spec.SourceRepos = append(updatedProj.Spec.SourceRepos, "https://github.com/my-org/app", "!https://github.com/my-org/secret")
+			updatedProj.Spec.Destinations = append(updatedProj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: "https://server3", Namespace: "team-a"})
+			updatedProj.Spec.ClusterResourceWhitelist = []metav1.GroupKind{{Group: "", Kind: "Namespace"}}
+			updatedProj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"*"}}}
+			updatedProj.Spec.Roles[0].Policies = []string{"p, proj:test:owners, projects, own, test, allow"}
+			updatedProj.Spec.Roles[0].Groups = []string{"team-a"}
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.NoError(t, err)
+		})
+		t.Run("DestinationExceedsCeiling", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.Destinations = append(updatedProj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: "https://server3", Namespace: "kube-system"})
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "destination 'https://server3/kube-system' exceeds the ceiling of project 'test'"), err)
+		})
+		t.Run("SourceRepoExceedsCeiling", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.SourceRepos = append(updatedProj.Spec.SourceRepos, "*")
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "source repository '*' exceeds the ceiling of project 'test'"), err)
+		})
+		t.Run("ClusterResourceExceedsCeiling", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.ClusterResourceWhitelist = []metav1.GroupKind{{Group: "*", Kind: "*"}}
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "cluster resource '*/*' exceeds the ceiling of project 'test'"), err)
+		})
+		t.Run("DenyPatternRemoval", func(t *testing.T) {
+			deniedProj := ownedProj.DeepCopy()
+			deniedProj.Spec.SourceRepos = append(deniedProj.Spec.SourceRepos, "!https://github.com/my-org/secret", "!https://github.com/other-org/secret")
+			deniedProj.Spec.Destinations = append(deniedProj.Spec.Destinations,
+				v1alpha1.ApplicationDestination{Server: "https://server3", Namespace: "!team-secret"},
+				v1alpha1.ApplicationDestination{Server: "*", Namespace: "!kube-system"})
+			newDeniedServer := func() *Server {
+				return NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(deniedProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB)
+			}
+
+			updatedProj := deniedProj.DeepCopy()
+			updatedProj.Spec.SourceRepos = updatedProj.Spec.SourceRepos[:len(updatedProj.Spec.SourceRepos)-1]
+			_, err := newDeniedServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "removing source repository '!https://github.com/other-org/secret' exceeds the ceiling of project 'test'"), err)
+
+			updatedProj = deniedProj.DeepCopy()
+			updatedProj.Spec.Destinations = updatedProj.Spec.Destinations[:len(updatedProj.Spec.Destinations)-1]
+			_, err = newDeniedServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "removing destination '*/!kube-system' exceeds the ceiling of project 'test'"), err)
+
+			// deny patterns whose denied values are within the ceiling may be removed
+			updatedProj = deniedProj.DeepCopy()
+			updatedProj.Spec.SourceRepos = append(ownedProj.Spec.SourceRepos, "!https://github.com/other-org/secret")
+			updatedProj.Spec.Destinations = append(ownedProj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: "*", Namespace: "!kube-system"})
+			_, err = newDeniedServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.NoError(t, err)
+		})
+		t.Run("MetadataChanged", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Labels = map[string]string{"team": "a"}
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			updatedProj = ownedProj.DeepCopy()
+			updatedProj.Annotations = map[string]string{"notifications.argoproj.io/subscribe.on-sync-failed.slack": "team-a"}
+			_, err = newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("CeilingChanged", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.Ceiling.SourceRepos = []string{"*"}
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("TokenAdded", func(t *testing.T) {
+			updatedProj := ownedProj.DeepCopy()
+			updatedProj.Spec.Roles[0].JWTTokens = append(updatedProj.Spec.Roles[0].JWTTokens, v1alpha1.JWTToken{IssuedAt: 2, ID: "2"})
+
+			_, err := newServer().Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "project owners may not add tokens to role 'owners' of project 'test'"), err)
+		})
+		t.Run("NotOwner", func(t *testing.T) {
+			otherProj := ownedProj.DeepCopy()
+			otherProj.Name = "other"
+
+			projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(otherProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB)
+			_, err := projectServer.Update(context.Background(), &project.ProjectUpdateRequest{Project: otherProj})
+			assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: projects, update, other"), err)
+		})
+	})
+
 	enforcer = newEnforcer(kubeclientset)
 
 	t.Run("TestRemoveDestinationSuccessful", func(t *testing.T) {
@@ -32,6 +32,8 @@ const (
 	ActionUpdate   = "update"
 	ActionDelete   = "delete"
 	ActionSync     = "sync"
+	ActionApprove  = "approve"
+	ActionOwn      = "own"
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
@@ -55,6 +57,8 @@ var (
 		ActionUpdate,
 		ActionDelete,
 		ActionSync,
+		ActionApprove,
+		ActionOwn,
 		ActionOverride,
 	}
 )
@@ -159,6 +163,25 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	return false
 }
 
+// EnforceSubject enforces a request for a subject and the groups it is a member of, the same as for the claims of a
+// logged in user
+func (p *RBACPolicyEnforcer) EnforceSubject(subject string, groups []string, resource, action, object string) bool {
+	claims := jwt.MapClaims{"sub": subject}
+	for _, scope := range p.GetScopes() {
+		claims[scope] = groups
+	}
+	return p.EnforceClaims(claims, claims, resource, action, object)
+}
+
+// GetProjectPolicy returns the role policies of the project a request belongs to, which are evaluated along with the
+// built-in and user-defined policy when the request is enforced
+func (p *RBACPolicyEnforcer) GetProjectPolicy(resource, action, object string) string {
+	if proj := p.getProjectFromRequest("", resource, action, object); proj != nil {
+		return proj.ProjectPoliciesString()
+	}
+	return ""
+}
+
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
 // project (if it exists)
 func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alpha1.AppProject {
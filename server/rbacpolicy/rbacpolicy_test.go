@@ -190,3 +190,29 @@ func Test_getProjectFromRequest(t *testing.T) {
 
 	assert.Equal(t, project.Name, fp.Name)
 }
+
+func TestEnforceSubject(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetUserPolicy(`p, bob, applications, create, my-proj/*, allow` + "\n" + `p, bob, applications, create, my-proj/prod-*, deny`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	rbacEnf.SetScopes([]string{"groups", "email"})
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	assert.True(t, rbacEnf.EnforceSubject("bob", nil, "applications", "create", "my-proj/my-app"))
+	assert.False(t, rbacEnf.EnforceSubject("bob", nil, "applications", "create", "my-proj/prod-app"))
+	assert.True(t, rbacEnf.EnforceSubject("", []string{"my-org:my-team"}, "applications", "create", "my-proj/my-app"))
+	assert.False(t, rbacEnf.EnforceSubject("", []string{"my-org:my-team"}, "applications", "create", "other-proj/my-app"))
+	assert.False(t, rbacEnf.EnforceSubject("alice", []string{"my-org:other-team"}, "applications", "create", "my-proj/my-app"))
+}
+
+func TestGetProjectPolicy(t *testing.T) {
+	fp := newFakeProj()
+	rbacEnforcer := NewRBACPolicyEnforcer(nil, test.NewFakeProjLister(fp))
+
+	assert.Equal(t, fp.ProjectPoliciesString(), rbacEnforcer.GetProjectPolicy("applications", "create", "my-proj/my-app"))
+	assert.Equal(t, fp.ProjectPoliciesString(), rbacEnforcer.GetProjectPolicy("projects", "get", "my-proj"))
+	assert.Empty(t, rbacEnforcer.GetProjectPolicy("applications", "create", "other-proj/my-app"))
+	assert.Empty(t, rbacEnforcer.GetProjectPolicy("clusters", "get", "*"))
+}
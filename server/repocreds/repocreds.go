@@ -70,6 +70,9 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if q.Creds.CredentialProvider != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "credential providers can only be configured declaratively")
+	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionCreate, q.Creds.URL); err != nil {
 		return nil, err
 	}
@@ -107,6 +110,17 @@ func (s *Server) UpdateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionUpdate, q.Creds.URL); err != nil {
 		return nil, err
 	}
+	if q.Creds.CredentialProvider != "" {
+		// the provider returned by Get may be sent back unchanged, which keeps the declaratively configured provider
+		existing, err := s.db.GetRepositoryCredentials(ctx, q.Creds.URL)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil || existing.CredentialProvider != q.Creds.CredentialProvider {
+			return nil, status.Errorf(codes.InvalidArgument, "credential providers can only be configured declaratively")
+		}
+		q.Creds.CredentialProvider = ""
+	}
 	_, err := s.db.UpdateRepositoryCredentials(ctx, q.Creds)
 	return &appsv1.RepoCreds{URL: q.Creds.URL}, err
 }
@@ -164,6 +164,8 @@ func (s *Server) Get(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.R
 		Proxy:                      repo.Proxy,
 		Project:                    repo.Project,
 		InheritedCreds:             repo.InheritedCreds,
+		EnableSubmodules:           repo.EnableSubmodules,
+		SubmoduleCredentials:       removeSubmoduleCreds(repo.SubmoduleCredentials),
 	}
 
 	item.ConnectionState = s.getConnectionState(ctx, item.Repo, q.ForceRefresh)
@@ -187,17 +189,19 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 			}
 			// remove secrets
 			items = append(items, &appsv1.Repository{
-				Repo:               repo.Repo,
-				Type:               rType,
-				Name:               repo.Name,
-				Username:           repo.Username,
-				Insecure:           repo.IsInsecure(),
-				EnableLFS:          repo.EnableLFS,
-				EnableOCI:          repo.EnableOCI,
-				Proxy:              repo.Proxy,
-				Project:            repo.Project,
-				ForceHttpBasicAuth: repo.ForceHttpBasicAuth,
-				InheritedCreds:     repo.InheritedCreds,
+				Repo:                 repo.Repo,
+				Type:                 rType,
+				Name:                 repo.Name,
+				Username:             repo.Username,
+				Insecure:             repo.IsInsecure(),
+				EnableLFS:            repo.EnableLFS,
+				EnableOCI:            repo.EnableOCI,
+				Proxy:                repo.Proxy,
+				Project:              repo.Project,
+				ForceHttpBasicAuth:   repo.ForceHttpBasicAuth,
+				InheritedCreds:       repo.InheritedCreds,
+				EnableSubmodules:     repo.EnableSubmodules,
+				SubmoduleCredentials: removeSubmoduleCreds(repo.SubmoduleCredentials),
 			})
 		}
 	}
@@ -211,6 +215,15 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 	return &appsv1.RepositoryList{Items: items}, nil
 }
 
+// removeSubmoduleCreds returns the submodule credential mappings without the resolved credentials
+func removeSubmoduleCreds(mappings []appsv1.SubmoduleCredentials) []appsv1.SubmoduleCredentials {
+	var res []appsv1.SubmoduleCredentials
+	for _, mapping := range mappings {
+		res = append(res, appsv1.SubmoduleCredentials{URL: mapping.URL, CredentialTemplate: mapping.CredentialTemplate})
+	}
+	return res
+}
+
 func (s *Server) ListRefs(ctx context.Context, q *repositorypkg.RepoQuery) (*apiclient.Refs, error) {
 	repo, err := s.getRepo(ctx, q.Repo)
 	if err != nil {
@@ -381,6 +394,12 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if q.Repo.CredentialProvider != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "credential providers can only be configured declaratively")
+	}
+	if err := q.Repo.ValidateSubmoduleCredentials(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid submodule credentials: %v", err)
+	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionCreate, createRBACObject(q.Repo.Project, q.Repo.Repo)); err != nil {
 		return nil, err
@@ -445,11 +464,19 @@ func (s *Server) UpdateRepository(ctx context.Context, q *repositorypkg.RepoUpda
 	if q.Repo == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
+	if err := q.Repo.ValidateSubmoduleCredentials(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid submodule credentials: %v", err)
+	}
 
 	repo, err := s.getRepo(ctx, q.Repo.Repo)
 	if err != nil {
 		return nil, err
 	}
+	// the provider returned by Get may be sent back unchanged, which keeps the declaratively configured provider
+	if q.Repo.CredentialProvider != "" && q.Repo.CredentialProvider != repo.CredentialProvider {
+		return nil, status.Errorf(codes.InvalidArgument, "credential providers can only be configured declaratively")
+	}
+	q.Repo.CredentialProvider = ""
 
 	// verify that user can do update inside project where repository is located
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionUpdate, createRBACObject(repo.Project, repo.Repo)); err != nil {
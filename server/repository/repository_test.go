@@ -319,6 +319,74 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, repo.Repo, "test")
 	})
 
+	t.Run("Test_CreateRepositoryWithCredentialProvider", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+
+		db := &dbmocks.ArgoDB{}
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		_, err := s.CreateRepository(context.TODO(), &repository.RepoCreateRequest{
+			Repo: &appsv1.Repository{
+				Repo:               "test",
+				CredentialProvider: "git-token",
+			},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		db.AssertNotCalled(t, "CreateRepository", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Test_UpdateRepositoryWithCredentialProvider", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", context.TODO(), "test").Return(&appsv1.Repository{
+			Repo:               "test",
+			CredentialProvider: "git-token",
+		}, nil)
+		db.On("UpdateRepository", context.TODO(), mock.Anything).Return(nil, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		_, err := s.UpdateRepository(context.TODO(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{Repo: "test", CredentialProvider: "other-token"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		db.AssertNotCalled(t, "UpdateRepository", mock.Anything, mock.Anything)
+
+		// the provider returned by Get can be sent back, and is kept as configured
+		_, err = s.UpdateRepository(context.TODO(), &repository.RepoUpdateRequest{
+			Repo: &appsv1.Repository{Repo: "test", Username: "test", CredentialProvider: "git-token"},
+		})
+		assert.NoError(t, err)
+		db.AssertCalled(t, "UpdateRepository", context.TODO(), &appsv1.Repository{Repo: "test", Username: "test"})
+	})
+
+	t.Run("Test_CreateRepositoryWithMismatchedSubmoduleCredentials", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+
+		db := &dbmocks.ArgoDB{}
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		_, err := s.CreateRepository(context.TODO(), &repository.RepoCreateRequest{
+			Repo: &appsv1.Repository{
+				Repo: "test",
+				SubmoduleCredentials: []appsv1.SubmoduleCredentials{{
+					URL:                "https://attacker.example.com/",
+					CredentialTemplate: "https://github.com/",
+				}},
+			},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		db.AssertNotCalled(t, "CreateRepository", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Test_ListRepositories", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
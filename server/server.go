@@ -100,6 +100,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/version"
 	"github.com/argoproj/argo-cd/v2/ui"
 	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/audit"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/dex"
@@ -114,6 +115,7 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v2/util/notification/settings"
 	"github.com/argoproj/argo-cd/v2/util/oidc"
@@ -194,6 +196,7 @@ type ArgoCDServer struct {
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
 	serviceSet        *ArgoCDServiceSet
+	auditLogger       *audit.Logger
 }
 
 type ArgoCDServerOpts struct {
@@ -220,6 +223,8 @@ type ArgoCDServerOpts struct {
 	ContentSecurityPolicy string
 	ApplicationNamespaces []string
 	EnableProxyExtension  bool
+	// AuditLogSinks are the sinks which record mutating API calls, see audit.NewLoggerFromSpecs
+	AuditLogSinks []string
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -283,7 +288,8 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 		staticFS = io.NewComposableFS(staticFS, os.DirFS(opts.StaticAssetsDir))
 	}
 
-	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.Namespace, opts.RepoClientset)
+	// the API server only lists the configured notifications and does not render them, so it needs no app state cache
+	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.Namespace, opts.RepoClientset, nil)
 	errorsutil.CheckError(err)
 
 	secretInformer := k8s.NewSecretInformer(opts.KubeClientset, opts.Namespace, "argocd-notifications-secret")
@@ -291,6 +297,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 
 	apiFactory := api.NewFactory(settings_notif.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm"), opts.Namespace, secretInformer, configMapInformer)
 
+	auditLogger, err := audit.NewLoggerFromSpecs(opts.AuditLogSinks, opts.KubeClientset, opts.Namespace)
+	errorsutil.CheckError(err)
+
 	return &ArgoCDServer{
 		ArgoCDServerOpts:  opts,
 		log:               log.NewEntry(log.StandardLogger()),
@@ -311,6 +320,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 		apiFactory:        apiFactory,
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
+		auditLogger:       auditLogger,
 	}
 }
 
@@ -426,7 +436,13 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	a.userStateStorage.Init(ctx)
 	svcSet := newArgoCDServiceSet(a)
 	a.serviceSet = svcSet
-	grpcS, appResourceTreeFn := a.newGRPCServer()
+	metricsServ := metrics.NewMetricsServer(a.MetricsHost, a.MetricsPort)
+	if a.RedisClient != nil {
+		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
+	}
+	a.auditLogger.Start(ctx, metricsServ.IncDroppedAuditRecord)
+
+	grpcS, appResourceTreeFn := a.newGRPCServer(metricsServ)
 	grpcWebS := grpcweb.WrapServer(grpcS)
 	var httpS *http.Server
 	var httpsS *http.Server
@@ -448,11 +464,6 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		httpsS.Handler = &bug21955Workaround{handler: httpsS.Handler}
 	}
 
-	metricsServ := metrics.NewMetricsServer(a.MetricsHost, a.MetricsPort)
-	if a.RedisClient != nil {
-		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
-	}
-
 	// CMux is used to support servicing gRPC and HTTP1.1+JSON on the same port
 	tcpm := cmux.New(listeners.Main)
 	var tlsm cmux.CMux
@@ -637,11 +648,25 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 		}
 
 		a.policyEnforcer.SetScopes(scopes)
+		a.logLocalGroupWarnings(ctx, cm)
 		return nil
 	})
 	errorsutil.CheckError(err)
 }
 
+// logLocalGroupWarnings logs a warning for every local RBAC group which is referenced by the policy, but has no
+// known members, and for SSO subjects which are not used by any active session
+func (a *ArgoCDServer) logLocalGroupWarnings(ctx context.Context, cm *v1.ConfigMap) {
+	groups, err := rbac.ParseLocalGroups(cm.Data)
+	if err != nil {
+		return
+	}
+	accounts, ssoIdentities := a.sessionMgr.RBACIdentities(ctx)
+	for _, warning := range rbac.GroupWarnings(rbac.PolicyCSV(cm.Data), groups, accounts, ssoIdentities) {
+		log.Warnf("RBAC: %s", warning)
+	}
+}
+
 func (a *ArgoCDServer) useTLS() bool {
 	if a.Insecure || a.settings.Certificate == nil {
 		return false
@@ -649,7 +674,7 @@ func (a *ArgoCDServer) useTLS() bool {
 	return true
 }
 
-func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTreeFn) {
+func (a *ArgoCDServer) newGRPCServer(metricsServ *metrics.MetricsServer) (*grpc.Server, application.AppResourceTreeFn) {
 	if enableGRPCTimeHistogram {
 		grpc_prometheus.EnableHandlingTimeHistogram()
 	}
@@ -684,6 +709,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		// Remove from logs both because the contents are sensitive and because they may be very large.
 		"/application.ApplicationService/GetManifestsWithFiles": true,
 	}
+	rateLimiter := grpc_util.NewRateLimiter(a.settingsMgr.GetAPIRateLimits, metricsServ.IncThrottledRequest)
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
 	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
@@ -691,6 +717,8 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		grpc_logrus.StreamServerInterceptor(a.log),
 		grpc_prometheus.StreamServerInterceptor,
 		grpc_auth.StreamServerInterceptor(a.Authenticate),
+		grpc_util.RateLimitStreamServerInterceptor(rateLimiter),
+		audit.StreamServerInterceptor(a.auditLogger, a.policyEnforcer.GetScopes),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
@@ -705,6 +733,8 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_prometheus.UnaryServerInterceptor,
 		grpc_auth.UnaryServerInterceptor(a.Authenticate),
+		grpc_util.RateLimitUnaryServerInterceptor(rateLimiter),
+		audit.UnaryServerInterceptor(a.auditLogger, a.policyEnforcer.GetScopes),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
@@ -761,7 +791,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, a.enf, loginRateLimiter)
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -784,9 +814,9 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db)
 	appsInAnyNamespaceEnabled := len(a.ArgoCDServerOpts.ApplicationNamespaces) > 0
 	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.policyEnforcer, a.projLister)
 
-	notificationService := notification.NewServer(a.apiFactory)
+	notificationService := notification.NewServer(a.apiFactory, delivery.NewStore(a.Cache.GetCache(), 0), a.enf, a.Namespace)
 	certificateService := certificate.NewServer(a.RepoClientset, a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.RepoClientset, a.db, a.enf)
 	versionService := version.NewServer(a, func() (bool, error) {
@@ -1046,6 +1076,9 @@ func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	mux.HandleFunc(common.DexAPIEndpoint+"/", dexutil.NewDexHTTPReverseProxy(a.DexServerAddr, a.BaseHRef, a.DexTLSConfig))
 	a.ssoClientApp, err = oidc.NewClientApp(a.settings, a.DexServerAddr, a.DexTLSConfig, a.BaseHRef)
 	errorsutil.CheckError(err)
+	a.ssoClientApp.SetLoginHandler(func(ctx context.Context, idToken string, claims jwt.MapClaims) {
+		a.sessionMgr.RecordSSOSession(ctx, idToken, claims, jwtutil.GetGroups(claims, a.policyEnforcer.GetScopes()))
+	})
 	mux.HandleFunc(common.LoginEndpoint, a.ssoClientApp.HandleLogin)
 	mux.HandleFunc(common.CallbackEndpoint, a.ssoClientApp.HandleCallback)
 }
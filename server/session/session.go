@@ -7,12 +7,14 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	util "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
 )
 
@@ -22,6 +24,7 @@ type Server struct {
 	settingsMgr        *settings.SettingsManager
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
+	enf                *rbac.Enforcer
 	limitLoginAttempts func() (util.Closer, error)
 }
 
@@ -30,13 +33,13 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, enf *rbac.Enforcer, rateLimiter func() (util.Closer, error)) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, enf, rateLimiter}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
-// using username/password
-func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
+// using username/password, or of CI workloads using a token of a trusted OIDC issuer
+func (s *Server) Create(ctx context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
 	if s.limitLoginAttempts != nil {
 		closer, err := s.limitLoginAttempts()
 		if err != nil {
@@ -48,6 +51,17 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if q.Token != "" {
 		return nil, status.Errorf(codes.Unauthenticated, "token-based session creation no longer supported. please upgrade argocd cli to v0.7+")
 	}
+	if q.IdToken != "" {
+		uniqueId, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		jwtToken, err := s.mgr.ExchangeToken(ctx, q.IdToken, uniqueId.String())
+		if err != nil {
+			return nil, err
+		}
+		return &session.SessionResponse{Token: jwtToken}, nil
+	}
 	if q.Username == "" || q.Password == "" {
 		return nil, status.Errorf(codes.Unauthenticated, "no credentials supplied")
 	}
@@ -63,8 +77,9 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if err != nil {
 		return nil, err
 	}
-	jwtToken, err := s.mgr.Create(
-		fmt.Sprintf("%s:%s", q.Username, settings.AccountCapabilityLogin),
+	jwtToken, err := s.mgr.CreateSession(
+		ctx,
+		q.Username,
 		int64(argoCDSettings.UserSessionDuration.Seconds()),
 		uniqueId.String())
 
@@ -97,3 +112,58 @@ func (s *Server) GetUserInfo(ctx context.Context, q *session.GetUserInfoRequest)
 		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes()),
 	}, nil
 }
+
+// ListSessions returns the active login sessions of a user, or of all users the caller may see
+func (s *Server) ListSessions(ctx context.Context, q *session.SessionListRequest) (*session.SessionList, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	if q.User != "" {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionGet, q.User); err != nil {
+			return nil, err
+		}
+	}
+	sessions, err := s.mgr.ListSessions(ctx, q.User)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+	list := &session.SessionList{Items: make([]*session.Session, 0)}
+	for _, item := range sessions {
+		if q.User == "" && !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionGet, item.Subject) {
+			continue
+		}
+		list.Items = append(list.Items, &session.Session{
+			Id:        item.ID,
+			Subject:   item.Subject,
+			Issuer:    item.Issuer,
+			IssuedAt:  item.IssuedAt,
+			ExpiresAt: item.ExpiresAt,
+		})
+	}
+	return list, nil
+}
+
+// RevokeSessions revokes a login session of a user, or all sessions and tokens of the user
+func (s *Server) RevokeSessions(ctx context.Context, q *session.SessionRevokeRequest) (*session.SessionRevokeResponse, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Errorf(codes.Unauthenticated, "no session information")
+	}
+	if q.User == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user must be specified")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, q.User); err != nil {
+		return nil, err
+	}
+	if q.Id != "" {
+		if err := s.mgr.RevokeSession(ctx, q.User, q.Id); err != nil {
+			return nil, err
+		}
+		log.Infof("Session %s of %s revoked by %s", q.Id, q.User, sessionmgr.Username(ctx))
+		return &session.SessionRevokeResponse{}, nil
+	}
+	if err := s.mgr.RevokeSubject(ctx, q.User); err != nil {
+		return nil, fmt.Errorf("error revoking sessions of %s: %w", q.User, err)
+	}
+	log.Infof("All sessions and tokens of %s revoked by %s", q.User, sessionmgr.Username(ctx))
+	return &session.SessionRevokeResponse{}, nil
+}
@@ -392,6 +392,16 @@ func SetResourceOverridesSplitKeys(overrides map[string]v1alpha1.ResourceOverrid
 			if v.HealthLua != "" {
 				cm.Data[getResourceOverrideSplitKey(k, "health")] = v.HealthLua
 			}
+			if v.HealthCEL != "" {
+				cm.Data[getResourceOverrideSplitKey(k, "healthCEL")] = v.HealthCEL
+			}
+			if v.AggregatedHealth != nil {
+				yamlBytes, err := yaml.Marshal(v.AggregatedHealth)
+				if err != nil {
+					return err
+				}
+				cm.Data[getResourceOverrideSplitKey(k, "aggregatedHealth")] = string(yamlBytes)
+			}
 			cm.Data[getResourceOverrideSplitKey(k, "useOpenLibs")] = strconv.FormatBool(v.UseOpenLibs)
 			if v.Actions != "" {
 				cm.Data[getResourceOverrideSplitKey(k, "actions")] = v.Actions
@@ -1,5 +1,6 @@
 import {AutocompleteField, DataLoader, FormField, FormSelect, getNestedField} from 'argo-ui';
 import * as React from 'react';
+import * as jsYaml from 'js-yaml';
 import {FieldApi, FormApi, FormField as ReactFormField, Text, TextArea} from 'react-form';
 import {cloneDeep} from 'lodash-es';
 import {
@@ -34,6 +35,34 @@ const TextWithMetadataField = ReactFormField((props: {metadata: {value: string};
     return <input className={props.className} value={metadata.value} onChange={el => setValue({...metadata, value: el.target.value})} />;
 });
 
+// ValuesObjectField edits Helm values stored as an object as YAML. Invalid YAML keeps the last valid values until it is fixed.
+const ValuesObjectField = ReactFormField((props: {fieldApi: FieldApi; className: string}) => {
+    const {
+        fieldApi: {getValue, setValue}
+    } = props;
+    const [text, setText] = React.useState(() => jsYaml.safeDump(getValue() || {}));
+
+    return (
+        <textarea
+            className={props.className}
+            value={text}
+            onChange={el => {
+                setText(el.target.value);
+                try {
+                    const values = jsYaml.safeLoad(el.target.value);
+                    if (values === undefined || values === null) {
+                        setValue(null);
+                    } else if (typeof values === 'object' && !Array.isArray(values)) {
+                        setValue(values);
+                    }
+                } catch (e) {
+                    // the YAML is being edited
+                }
+            }}
+        />
+    );
+});
+
 function distinct<T>(first: IterableIterator<T>, second: IterableIterator<T>) {
     return Array.from(new Set(Array.from(first).concat(Array.from(second))));
 }
@@ -216,13 +245,17 @@ export const ApplicationParameters = (props: {
             title: 'VALUES',
             view: source.helm && (
                 <Expandable>
-                    <pre>{source.helm.values}</pre>
+                    <pre>{source.helm.valuesObject ? jsYaml.safeDump(source.helm.valuesObject) : source.helm.values}</pre>
                 </Expandable>
             ),
             edit: (formApi: FormApi) => (
                 <div>
                     <pre>
-                        <FormField formApi={formApi} field='spec.source.helm.values' component={TextArea} />
+                        {source.helm && source.helm.valuesObject ? (
+                            <FormField formApi={formApi} field='spec.source.helm.valuesObject' component={ValuesObjectField} />
+                        ) : (
+                            <FormField formApi={formApi} field='spec.source.helm.values' component={TextArea} />
+                        )}
                     </pre>
                 </div>
             )
@@ -202,6 +202,7 @@ export interface ApplicationSource {
 export interface ApplicationSourceHelm {
     valueFiles: string[];
     values?: string;
+    valuesObject?: any;
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
 }
@@ -427,6 +428,16 @@ export interface ApplicationStatus {
     health: HealthStatus;
     operationState?: OperationState;
     summary?: ApplicationSummary;
+    pendingSync?: PendingSync;
+}
+
+export interface PendingSync {
+    operation: Operation;
+    requester: string;
+    requestedAt: models.Time;
+    expiresAt: models.Time;
+    diffSummary?: string;
+    phase: 'Pending' | 'Expired';
 }
 
 export interface JwtTokens {
@@ -719,6 +730,8 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    syncApproval?: {enabled?: boolean; timeout?: string};
+    ceiling?: {sourceRepos?: string[]; destinations?: ApplicationDestination[]; clusterResourceWhitelist?: GroupKind[]};
 }
 
 export type SyncWindows = SyncWindow[];
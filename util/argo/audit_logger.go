@@ -36,13 +36,16 @@ type ObjectRef struct {
 }
 
 const (
-	EventReasonStatusRefreshed    = "StatusRefreshed"
-	EventReasonResourceCreated    = "ResourceCreated"
-	EventReasonResourceUpdated    = "ResourceUpdated"
-	EventReasonResourceDeleted    = "ResourceDeleted"
-	EventReasonResourceActionRan  = "ResourceActionRan"
-	EventReasonOperationStarted   = "OperationStarted"
-	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonStatusRefreshed       = "StatusRefreshed"
+	EventReasonResourceCreated       = "ResourceCreated"
+	EventReasonResourceUpdated       = "ResourceUpdated"
+	EventReasonResourceDeleted       = "ResourceDeleted"
+	EventReasonResourceActionRan     = "ResourceActionRan"
+	EventReasonOperationStarted      = "OperationStarted"
+	EventReasonOperationCompleted    = "OperationCompleted"
+	EventReasonSyncApprovalRequested = "SyncApprovalRequested"
+	EventReasonSyncApprovalRejected  = "SyncApprovalRejected"
+	EventReasonSyncApprovalExpired   = "SyncApprovalExpired"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string) {
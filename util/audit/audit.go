@@ -0,0 +1,297 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// OutcomeSuccess is the outcome of API calls which succeeded. Failed calls have the gRPC code of the error as outcome.
+	OutcomeSuccess = "Success"
+
+	// SinkKubernetes is the sink specification which records audit records as Kubernetes events
+	SinkKubernetes = "kubernetes"
+	// sinkFilePrefix is the prefix of sink specifications which append audit records to a JSON lines file
+	sinkFilePrefix = "file:"
+
+	redactedValue = "++++++++"
+
+	// recordBufferSize is the number of records which may be pending before further records are dropped
+	recordBufferSize = 1000
+	// sinkWriteTimeout is the maximum duration of writing a record to a sink
+	sinkWriteTimeout = 30 * time.Second
+)
+
+// ignoredChangePaths are the paths of the fields of objects which change with every update
+var ignoredChangePaths = map[string]bool{
+	"metadata.generation":      true,
+	"metadata.managedFields":   true,
+	"metadata.resourceVersion": true,
+}
+
+// sensitiveKeyRegexp matches the keys of request fields whose values are redacted from audit records
+var sensitiveKeyRegexp = regexp.MustCompile(`(?i)(password|token|secret|privatekey|keydata|certkey|accountkey|patch|^env$)`)
+
+// Record is an audit record of a mutating API call
+type Record struct {
+	Time time.Time `json:"time"`
+	// Subject is the subject of the token the call was made with
+	Subject string `json:"subject,omitempty"`
+	// Username is a human readable name of the subject, e.g. the email of SSO users
+	Username string   `json:"username,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+	// Service is the gRPC service of the call, e.g. application.ApplicationService
+	Service string `json:"service"`
+	// Action is the gRPC method of the call, e.g. Sync
+	Action string `json:"action"`
+	// Object is the name of the object the call refers to, if any
+	Object string `json:"object,omitempty"`
+	// Request is the request of the call with sensitive values redacted
+	Request json.RawMessage `json:"request,omitempty"`
+	// Changes are the changed fields of the object the call updated, if recorded by the called method
+	Changes []Change `json:"changes,omitempty"`
+	// Outcome is either Success or the gRPC code of the error the call failed with
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Change is a changed field of an object, whose sensitive values are redacted
+type Change struct {
+	// Path is the path of the field, e.g. spec.source.targetRevision
+	Path string `json:"path"`
+	// Before is the value of the field before the change, or nil if the field has been added
+	Before interface{} `json:"before,omitempty"`
+	// After is the value of the field after the change, or nil if the field has been removed
+	After interface{} `json:"after,omitempty"`
+}
+
+// Method returns the full gRPC method name of the recorded call
+func (r *Record) Method() string {
+	return fmt.Sprintf("/%s/%s", r.Service, r.Action)
+}
+
+// Sink stores audit records
+type Sink interface {
+	Write(ctx context.Context, record Record) error
+}
+
+// Logger writes audit records to multiple sinks. Records are buffered and written in the background, so that slow
+// sinks do not delay the audited calls.
+type Logger struct {
+	sinks   []Sink
+	records chan Record
+	onDrop  func()
+}
+
+// NewLogger returns a logger which writes audit records to the given sinks once it is started
+func NewLogger(sinks ...Sink) *Logger {
+	return &Logger{sinks: sinks, records: make(chan Record, recordBufferSize)}
+}
+
+// NewLoggerFromSpecs returns a logger for the given sink specifications. Each specification is either "kubernetes",
+// "file:<path>" or the http(s) URL of a webhook.
+func NewLoggerFromSpecs(specs []string, kubeClientset kubernetes.Interface, namespace string) (*Logger, error) {
+	var sinks []Sink
+	for _, spec := range specs {
+		switch {
+		case spec == "":
+			continue
+		case spec == SinkKubernetes:
+			sinks = append(sinks, NewEventSink(kubeClientset, namespace))
+		case strings.HasPrefix(spec, sinkFilePrefix):
+			sink, err := NewFileSink(strings.TrimPrefix(spec, sinkFilePrefix))
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+			sinks = append(sinks, NewWebhookSink(spec))
+		default:
+			return nil, fmt.Errorf("unsupported audit log sink %q: must be one of %q, '%s<path>' or a http(s) URL", spec, SinkKubernetes, sinkFilePrefix)
+		}
+	}
+	return NewLogger(sinks...), nil
+}
+
+// Enabled returns whether the logger has any sinks
+func (l *Logger) Enabled() bool {
+	return l != nil && len(l.sinks) > 0
+}
+
+// Start writes the logged records to the sinks in the background until the context is done. The onDrop function, if
+// any, is called for every record which is dropped because the sinks cannot keep up with the audited calls.
+func (l *Logger) Start(ctx context.Context, onDrop func()) {
+	if !l.Enabled() {
+		return
+	}
+	l.onDrop = onDrop
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case record := <-l.records:
+				l.write(record)
+			}
+		}
+	}()
+}
+
+// Log queues the record to be written to all sinks. Records are dropped if too many records are pending, so that
+// the audited call is never blocked.
+func (l *Logger) Log(record Record) {
+	if !l.Enabled() {
+		return
+	}
+	select {
+	case l.records <- record:
+	default:
+		log.WithField("method", record.Method()).Warn("Dropped audit record: too many records are pending")
+		if l.onDrop != nil {
+			l.onDrop()
+		}
+	}
+}
+
+// write writes the record to all sinks. The context of the audited call is not used, since the call has usually
+// completed by the time the record is written. Failures are logged, but do not fail the audited call.
+func (l *Logger) write(record Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), sinkWriteTimeout)
+	defer cancel()
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			log.WithField("method", record.Method()).Errorf("Failed to write audit record: %v", err)
+		}
+	}
+}
+
+// redact replaces the values of sensitive fields in the given JSON value
+func redact(val interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if sensitiveKeyRegexp.MatchString(k) {
+				v[k] = redactedValue
+			} else {
+				v[k] = redact(item)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return val
+}
+
+// objectChanges returns the changed fields between the states of an object before and after a change
+func objectChanges(before, after interface{}) ([]Change, error) {
+	var vals [2]interface{}
+	for i, obj := range []interface{}{before, after} {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &vals[i]); err != nil {
+			return nil, err
+		}
+	}
+	return diff("", vals[0], vals[1]), nil
+}
+
+// diff returns the changes between the JSON values before and after at the given path. Objects are compared field by
+// field, all other values, including lists, are compared as a whole.
+func diff(path string, before, after interface{}) []Change {
+	if ignoredChangePaths[path] {
+		return nil
+	}
+	beforeObj, beforeIsObj := before.(map[string]interface{})
+	afterObj, afterIsObj := after.(map[string]interface{})
+	if beforeIsObj && afterIsObj {
+		keys := map[string]bool{}
+		for k := range beforeObj {
+			keys[k] = true
+		}
+		for k := range afterObj {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+		var changes []Change
+		for _, k := range sortedKeys {
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			changes = append(changes, diff(fieldPath, beforeObj[k], afterObj[k])...)
+		}
+		return changes
+	}
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	change := Change{Path: path, Before: redact(before), After: redact(after)}
+	for _, field := range strings.Split(path, ".") {
+		if sensitiveKeyRegexp.MatchString(field) {
+			if change.Before != nil {
+				change.Before = redactedValue
+			}
+			if change.After != nil {
+				change.After = redactedValue
+			}
+			break
+		}
+	}
+	return []Change{change}
+}
+
+// objectName returns the name of the object a request refers to, as found in the well known fields of the requests
+// of the API server
+func objectName(req map[string]interface{}) string {
+	if name := fieldObjectName(req); name != "" {
+		return name
+	}
+	// requests which wrap the object, e.g. {"application": {"metadata": {"name": "guestbook"}}}
+	for _, val := range req {
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			if name, ok := metadata["name"].(string); ok && name != "" {
+				if ns, ok := metadata["namespace"].(string); ok && ns != "" {
+					return ns + "/" + name
+				}
+				return name
+			}
+		}
+		if name := fieldObjectName(obj); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+func fieldObjectName(obj map[string]interface{}) string {
+	for _, key := range []string{"name", "username", "server", "url", "repo", "id"} {
+		if s, ok := obj[key].(string); ok && s != "" {
+			if ns, ok := obj["appNamespace"].(string); ok && ns != "" && key == "name" {
+				return ns + "/" + s
+			}
+			return s
+		}
+	}
+	return ""
+}
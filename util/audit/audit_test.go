@@ -0,0 +1,292 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+)
+
+type fakeSink struct {
+	records []Record
+	err     error
+}
+
+func (s *fakeSink) Write(_ context.Context, record Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func TestIsMutatingMethod(t *testing.T) {
+	assert.True(t, IsMutatingMethod("/application.ApplicationService/Sync"))
+	assert.True(t, IsMutatingMethod("/application.ApplicationService/Delete"))
+	assert.True(t, IsMutatingMethod("/session.SessionService/Create"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/Get"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/ListResourceEvents"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/ResourceTree"))
+	assert.False(t, IsMutatingMethod("/account.AccountService/CanI"))
+	// only methods which are known to mutate state are recorded, regardless of their name
+	assert.False(t, IsMutatingMethod("/applicationset.ApplicationSetService/Generate"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/GetManifestsWithFiles"))
+	assert.False(t, IsMutatingMethod("/unknown.UnknownService/Create"))
+}
+
+func TestObjectChanges(t *testing.T) {
+	before := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "guestbook", "resourceVersion": "1", "labels": map[string]interface{}{"team": "a"}},
+		"spec":     map[string]interface{}{"source": map[string]interface{}{"targetRevision": "v1", "password": "old"}, "syncPolicy": map[string]interface{}{"automated": map[string]interface{}{}}},
+	}
+	after := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "guestbook", "resourceVersion": "2", "labels": map[string]interface{}{"team": "a", "tier": "frontend"}},
+		"spec":     map[string]interface{}{"source": map[string]interface{}{"targetRevision": "v2", "password": "new"}},
+	}
+	changes, err := objectChanges(before, after)
+	require.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Path: "metadata.labels.tier", After: "frontend"},
+		{Path: "spec.source.password", Before: redactedValue, After: redactedValue},
+		{Path: "spec.source.targetRevision", Before: "v1", After: "v2"},
+		{Path: "spec.syncPolicy", Before: map[string]interface{}{"automated": map[string]interface{}{}}},
+	}, changes)
+
+	changes, err = objectChanges(before, before)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestNewLoggerFromSpecs(t *testing.T) {
+	logger, err := NewLoggerFromSpecs(nil, nil, "argocd")
+	require.NoError(t, err)
+	assert.False(t, logger.Enabled())
+
+	logger, err = NewLoggerFromSpecs([]string{"kubernetes", "https://audit.example.com", "file:" + t.TempDir() + "/audit.log"}, nil, "argocd")
+	require.NoError(t, err)
+	assert.Len(t, logger.sinks, 3)
+
+	_, err = NewLoggerFromSpecs([]string{"syslog"}, nil, "argocd")
+	assert.ErrorContains(t, err, "unsupported audit log sink")
+}
+
+// writePending synchronously writes the records pending in the logger
+func writePending(l *Logger) {
+	for {
+		select {
+		case record := <-l.records:
+			l.write(record)
+		default:
+			return
+		}
+	}
+}
+
+func TestLogger_SinkFailure(t *testing.T) {
+	failing := &fakeSink{err: errors.New("disk full")}
+	sink := &fakeSink{}
+	logger := NewLogger(failing, sink)
+	logger.Log(Record{Service: "application.ApplicationService", Action: "Sync"})
+	writePending(logger)
+	assert.Len(t, failing.records, 1)
+	assert.Len(t, sink.records, 1)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	msgs []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.msgs) == 0 {
+		return errors.New("EOF")
+	}
+	*(m.(*application.ApplicationDeleteRequest)) = *(s.msgs[0].(*application.ApplicationDeleteRequest))
+	s.msgs = s.msgs[1:]
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	sink := &fakeSink{}
+	logger := NewLogger(sink)
+	interceptor := StreamServerInterceptor(logger, func() []string { return []string{"groups"} })
+	ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "argocd", "sub": "admin"})
+	name := "guestbook"
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		req := &application.ApplicationDeleteRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		RecordChange(stream.Context(), map[string]interface{}{"name": "guestbook"}, map[string]interface{}{"name": "guestbook", "deleted": true})
+		return nil
+	}
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		stream := &fakeServerStream{ctx: ctx, msgs: []interface{}{&application.ApplicationDeleteRequest{Name: &name}}}
+		err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/PodLogs"}, handler)
+		require.NoError(t, err)
+		writePending(logger)
+		assert.Empty(t, sink.records)
+	})
+
+	t.Run("Mutating", func(t *testing.T) {
+		stream := &fakeServerStream{ctx: ctx, msgs: []interface{}{&application.ApplicationDeleteRequest{Name: &name}}}
+		err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Delete"}, handler)
+		require.NoError(t, err)
+		writePending(logger)
+		require.Len(t, sink.records, 1)
+		record := sink.records[0]
+		assert.Equal(t, "admin", record.Subject)
+		assert.Equal(t, "guestbook", record.Object)
+		assert.Equal(t, OutcomeSuccess, record.Outcome)
+		assert.JSONEq(t, `{"name": "guestbook"}`, string(record.Request))
+		assert.Equal(t, []Change{{Path: "deleted", After: true}}, record.Changes)
+	})
+}
+
+type blockingSink struct {
+	written chan Record
+}
+
+func (s *blockingSink) Write(ctx context.Context, record Record) error {
+	select {
+	case s.written <- record:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestLogger_Start(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := &blockingSink{written: make(chan Record)}
+	logger := NewLogger(sink)
+	var dropped int
+	logger.Start(ctx, func() { dropped++ })
+
+	// records are written in the background, after the audited call returned
+	logger.Log(Record{Service: "application.ApplicationService", Action: "Sync"})
+	assert.Equal(t, "Sync", (<-sink.written).Action)
+
+	// records are dropped instead of blocking the audited calls if the sink cannot keep up
+	for i := 0; i < recordBufferSize+2; i++ {
+		logger.Log(Record{Service: "application.ApplicationService", Action: "Delete"})
+	}
+	assert.GreaterOrEqual(t, dropped, 1)
+}
+
+func TestRedact(t *testing.T) {
+	var val interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"repo": {"repo": "https://github.com/argoproj/argocd-example-apps", "password": "secret", "sshPrivateKey": "key"},
+		"patch": "{\"spec\": {}}",
+		"items": [{"bearerToken": "abc", "name": "in-cluster"}]
+	}`), &val))
+
+	data, err := json.Marshal(redact(val))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"repo": {"repo": "https://github.com/argoproj/argocd-example-apps", "password": "++++++++", "sshPrivateKey": "++++++++"},
+		"patch": "++++++++",
+		"items": [{"bearerToken": "++++++++", "name": "in-cluster"}]
+	}`, string(data))
+}
+
+func TestObjectName(t *testing.T) {
+	tests := []struct {
+		req      string
+		expected string
+	}{
+		{`{"name": "guestbook"}`, "guestbook"},
+		{`{"name": "guestbook", "appNamespace": "apps"}`, "apps/guestbook"},
+		{`{"application": {"metadata": {"name": "guestbook", "namespace": "apps"}}}`, "apps/guestbook"},
+		{`{"cluster": {"server": "https://kubernetes.default.svc"}}`, "https://kubernetes.default.svc"},
+		{`{"upsert": true}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.req, func(t *testing.T) {
+			var req map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.req), &req))
+			assert.Equal(t, tt.expected, objectName(req))
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &fakeSink{}
+	logger := NewLogger(sink)
+	interceptor := UnaryServerInterceptor(logger, func() []string { return []string{"groups"} })
+	ctx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{
+		"iss":    "https://dex.example.com",
+		"sub":    "CiQwOGE4Njg0Yi1kYjg4LTRiNzMtOTBhOS0zY2QxNjYxZjU0NjYSBWxvY2Fs",
+		"email":  "alice@example.com",
+		"groups": []interface{}{"my-org:team-alpha"},
+	})
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		_, err := interceptor(ctx, &application.ApplicationQuery{}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		require.NoError(t, err)
+		writePending(logger)
+		assert.Empty(t, sink.records)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		name, namespace := "guestbook", "apps"
+		_, err := interceptor(ctx, &application.ApplicationDeleteRequest{Name: &name, AppNamespace: &namespace}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Delete"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &application.ApplicationResponse{}, nil
+		})
+		require.NoError(t, err)
+		writePending(logger)
+		require.Len(t, sink.records, 1)
+		record := sink.records[0]
+		assert.Equal(t, "CiQwOGE4Njg0Yi1kYjg4LTRiNzMtOTBhOS0zY2QxNjYxZjU0NjYSBWxvY2Fs", record.Subject)
+		assert.Equal(t, "alice@example.com", record.Username)
+		assert.Equal(t, []string{"my-org:team-alpha"}, record.Groups)
+		assert.Equal(t, "/application.ApplicationService/Delete", record.Method())
+		assert.Equal(t, "apps/guestbook", record.Object)
+		assert.Equal(t, OutcomeSuccess, record.Outcome)
+		assert.JSONEq(t, `{"name": "guestbook", "appNamespace": "apps"}`, string(record.Request))
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		name := "guestbook"
+		_, err := interceptor(ctx, &application.ApplicationUpdateSpecRequest{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/UpdateSpec"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			RecordChange(ctx, map[string]interface{}{"spec": map[string]interface{}{"project": "default"}}, map[string]interface{}{"spec": map[string]interface{}{"project": "prod"}})
+			return nil, nil
+		})
+		require.NoError(t, err)
+		writePending(logger)
+		require.Len(t, sink.records, 2)
+		assert.Equal(t, []Change{{Path: "spec.project", Before: "default", After: "prod"}}, sink.records[1].Changes)
+		sink.records = sink.records[:1]
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		_, err := interceptor(ctx, &account.UpdatePasswordRequest{Name: "bob", NewPassword: "new", CurrentPassword: "old"}, &grpc.UnaryServerInfo{FullMethod: "/account.AccountService/UpdatePassword"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		})
+		assert.Error(t, err)
+		writePending(logger)
+		require.Len(t, sink.records, 2)
+		record := sink.records[1]
+		assert.Equal(t, "bob", record.Object)
+		assert.Equal(t, codes.PermissionDenied.String(), record.Outcome)
+		assert.Contains(t, record.Error, "permission denied")
+		assert.JSONEq(t, `{"name": "bob", "newPassword": "++++++++", "currentPassword": "++++++++"}`, string(record.Request))
+	})
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// Filter selects audit records. Empty fields match all records, string fields may contain glob patterns.
+type Filter struct {
+	Subject string
+	Group   string
+	Method  string
+	Object  string
+	Since   time.Time
+	// Failed selects only records of calls which failed
+	Failed bool
+}
+
+// Matches returns whether the record is selected by the filter
+func (f *Filter) Matches(record Record) bool {
+	if f.Subject != "" && !glob.Match(f.Subject, record.Subject) && !glob.Match(f.Subject, record.Username) {
+		return false
+	}
+	if f.Group != "" {
+		found := false
+		for _, group := range record.Groups {
+			if glob.Match(f.Group, group) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Method != "" && !glob.Match(f.Method, record.Method()) && !glob.Match(f.Method, record.Action) {
+		return false
+	}
+	if f.Object != "" && !glob.Match(f.Object, record.Object) {
+		return false
+	}
+	if !f.Since.IsZero() && record.Time.Before(f.Since) {
+		return false
+	}
+	if f.Failed && record.Outcome == OutcomeSuccess {
+		return false
+	}
+	return true
+}
+
+// Apply returns the records selected by the filter
+func (f *Filter) Apply(records []Record) []Record {
+	var res []Record
+	for _, record := range records {
+		if f.Matches(record) {
+			res = append(res, record)
+		}
+	}
+	return res
+}
@@ -0,0 +1,198 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+)
+
+// argoCDIssuer is the issuer of tokens issued by the API server, whose subject is human readable
+const argoCDIssuer = "argocd"
+
+// mutatingMethods are the full gRPC methods of the API server which mutate state. Methods have to be added explicitly
+// when they are introduced, so that read-only methods are never recorded because of their name, and vice versa.
+var mutatingMethods = map[string]bool{
+	"/account.AccountService/UpdatePassword":                  true,
+	"/account.AccountService/CreateToken":                     true,
+	"/account.AccountService/DeleteToken":                     true,
+	"/account.AccountService/CreatePersonalAccessToken":       true,
+	"/account.AccountService/DeletePersonalAccessToken":       true,
+	"/application.ApplicationService/Create":                  true,
+	"/application.ApplicationService/Update":                  true,
+	"/application.ApplicationService/UpdateSpec":              true,
+	"/application.ApplicationService/Patch":                   true,
+	"/application.ApplicationService/Delete":                  true,
+	"/application.ApplicationService/Sync":                    true,
+	"/application.ApplicationService/SyncApprove":             true,
+	"/application.ApplicationService/Rollback":                true,
+	"/application.ApplicationService/TerminateOperation":      true,
+	"/application.ApplicationService/PatchResource":           true,
+	"/application.ApplicationService/RunResourceAction":       true,
+	"/application.ApplicationService/DeleteResource":          true,
+	"/applicationset.ApplicationSetService/Create":            true,
+	"/applicationset.ApplicationSetService/Delete":            true,
+	"/certificate.CertificateService/CreateCertificate":       true,
+	"/certificate.CertificateService/DeleteCertificate":       true,
+	"/cluster.ClusterService/Create":                          true,
+	"/cluster.ClusterService/Update":                          true,
+	"/cluster.ClusterService/Delete":                          true,
+	"/cluster.ClusterService/RotateAuth":                      true,
+	"/cluster.ClusterService/InvalidateCache":                 true,
+	"/gpgkey.GPGKeyService/Create":                            true,
+	"/gpgkey.GPGKeyService/Delete":                            true,
+	"/project.ProjectService/Create":                          true,
+	"/project.ProjectService/Update":                          true,
+	"/project.ProjectService/Delete":                          true,
+	"/project.ProjectService/CreateToken":                     true,
+	"/project.ProjectService/DeleteToken":                     true,
+	"/repocreds.RepoCredsService/CreateRepositoryCredentials": true,
+	"/repocreds.RepoCredsService/UpdateRepositoryCredentials": true,
+	"/repocreds.RepoCredsService/DeleteRepositoryCredentials": true,
+	"/repository.RepositoryService/Create":                    true,
+	"/repository.RepositoryService/CreateRepository":          true,
+	"/repository.RepositoryService/Update":                    true,
+	"/repository.RepositoryService/UpdateRepository":          true,
+	"/repository.RepositoryService/Delete":                    true,
+	"/repository.RepositoryService/DeleteRepository":          true,
+	"/session.SessionService/Create":                          true,
+	"/session.SessionService/Delete":                          true,
+	"/session.SessionService/RevokeSessions":                  true,
+}
+
+// IsMutatingMethod returns whether the given full gRPC method of the API server mutates state
+func IsMutatingMethod(fullMethod string) bool {
+	return mutatingMethods[fullMethod]
+}
+
+type changeContextKey struct{}
+
+// objectChange holds the state of the object changed by an audited call before and after the change
+type objectChange struct {
+	before interface{}
+	after  interface{}
+}
+
+// RecordChange records the state of the object changed by the audited call of the given context before and after
+// the change, so that the changed fields are recorded along with the call. It does nothing for calls which are not
+// audited.
+func RecordChange(ctx context.Context, before, after interface{}) {
+	if change, ok := ctx.Value(changeContextKey{}).(*objectChange); ok {
+		change.before, change.after = before, after
+	}
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor which records all mutating calls using the given logger. The groups
+// of the caller are extracted from its claims using the scopes returned by getScopes.
+func UnaryServerInterceptor(logger *Logger, getScopes func() []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !logger.Enabled() || !IsMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		change := &objectChange{}
+		resp, err := handler(context.WithValue(ctx, changeContextKey{}, change), req)
+		logger.Log(newRecord(ctx, start, info.FullMethod, req, change, err, getScopes()))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor which records all mutating streaming calls using the given
+// logger. The first message received from the client is recorded as the request of the call.
+func StreamServerInterceptor(logger *Logger, getScopes func() []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !logger.Enabled() || !IsMutatingMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		change := &objectChange{}
+		stream := &recordingServerStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), changeContextKey{}, change)}
+		err := handler(srv, stream)
+		logger.Log(newRecord(ss.Context(), start, info.FullMethod, stream.req, change, err, getScopes()))
+		return err
+	}
+}
+
+// recordingServerStream records the first message received from the client
+type recordingServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req interface{}
+}
+
+func (s *recordingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+func newRecord(ctx context.Context, t time.Time, fullMethod string, req interface{}, change *objectChange, err error, scopes []string) Record {
+	record := Record{Time: t.UTC(), Outcome: OutcomeSuccess}
+	parts := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	record.Service, record.Action = parts[0], parts[len(parts)-1]
+
+	if claims, ok := ctx.Value("claims").(jwt.Claims); ok {
+		if mapClaims, err := jwtutil.MapClaims(claims); err == nil {
+			record.Subject = jwtutil.StringField(mapClaims, "sub")
+			record.Username = record.Subject
+			if jwtutil.StringField(mapClaims, "iss") != argoCDIssuer {
+				if email := jwtutil.StringField(mapClaims, "email"); email != "" {
+					record.Username = email
+				}
+			}
+			record.Groups = jwtutil.GetGroups(mapClaims, scopes)
+		}
+	}
+
+	if req != nil {
+		if data, err := marshalRequest(req); err == nil {
+			var val interface{}
+			if err := json.Unmarshal(data, &val); err == nil {
+				if obj, ok := val.(map[string]interface{}); ok {
+					record.Object = objectName(obj)
+				}
+				if data, err := json.Marshal(redact(val)); err == nil {
+					record.Request = data
+				}
+			}
+		}
+	}
+
+	if change != nil && change.before != nil && change.after != nil {
+		if changes, err := objectChanges(change.before, change.after); err == nil {
+			record.Changes = changes
+		}
+	}
+
+	if err != nil {
+		record.Outcome = status.Code(err).String()
+		record.Error = err.Error()
+	}
+	return record
+}
+
+func marshalRequest(req interface{}) ([]byte, error) {
+	if msg, ok := req.(proto.Message); ok {
+		var b bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&b, msg); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+	return json.Marshal(req)
+}
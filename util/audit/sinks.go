@@ -0,0 +1,199 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// EventReasonAuditLog is the reason of Kubernetes events which hold audit records
+	EventReasonAuditLog = "AuditLog"
+	// EventLabel is the label of Kubernetes events which hold audit records
+	EventLabel = "argocd.argoproj.io/audit-log"
+	// EventRecordAnnotation is the annotation of Kubernetes events which holds the JSON encoded audit record
+	EventRecordAnnotation = "argocd.argoproj.io/audit-record"
+
+	// maxEventRequestSize is the maximum size of the request and of the changes of an audit record stored in a
+	// Kubernetes event, larger requests and changes are omitted since the size of annotations is limited
+	maxEventRequestSize = 64 * 1024
+
+	webhookTimeout = 10 * time.Second
+)
+
+// fileSink appends audit records to a file as JSON lines
+type fileSink struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+// NewFileSink returns a sink which appends audit records to the file at the given path as JSON lines
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Write(_ context.Context, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// webhookSink posts audit records as JSON to a webhook
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink which posts each audit record as JSON to the given URL
+func NewWebhookSink(url string) Sink {
+	return &webhookSink{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+func (s *webhookSink) Write(ctx context.Context, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("audit log webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// eventSink records audit records as Kubernetes events
+type eventSink struct {
+	kubeClientset kubernetes.Interface
+	namespace     string
+	podName       string
+}
+
+// NewEventSink returns a sink which records audit records as Kubernetes events in the given namespace. The events
+// refer to the pod of the API server which handled the call.
+func NewEventSink(kubeClientset kubernetes.Interface, namespace string) Sink {
+	podName, _ := os.Hostname()
+	return &eventSink{kubeClientset: kubeClientset, namespace: namespace, podName: podName}
+}
+
+func (s *eventSink) Write(ctx context.Context, record Record) error {
+	if len(record.Request) > maxEventRequestSize {
+		record.Request = nil
+	}
+	if changes, err := json.Marshal(record.Changes); err == nil && len(changes) > maxEventRequestSize {
+		record.Changes = nil
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	eventType := v1.EventTypeNormal
+	if record.Outcome != OutcomeSuccess {
+		eventType = v1.EventTypeWarning
+	}
+	message := fmt.Sprintf("%s called %s", record.Username, record.Method())
+	if record.Object != "" {
+		message = fmt.Sprintf("%s on %s", message, record.Object)
+	}
+	t := metav1.Time{Time: record.Time}
+	event := v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("argocd-audit.%x", record.Time.UnixNano()),
+			Labels:      map[string]string{EventLabel: "true"},
+			Annotations: map[string]string{EventRecordAnnotation: string(data)},
+		},
+		Source: v1.EventSource{
+			Component: "argocd-server",
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       s.podName,
+			Namespace:  s.namespace,
+		},
+		FirstTimestamp: t,
+		LastTimestamp:  t,
+		Count:          1,
+		Message:        fmt.Sprintf("%s: %s", message, record.Outcome),
+		Type:           eventType,
+		Reason:         EventReasonAuditLog,
+	}
+	_, err = s.kubeClientset.CoreV1().Events(s.namespace).Create(ctx, &event, metav1.CreateOptions{})
+	return err
+}
+
+// ReadFile reads the audit records of a JSON lines file
+func ReadFile(r io.Reader) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid audit record in line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// ReadEvents reads the audit records of the Kubernetes events in the given namespace, sorted by time
+func ReadEvents(ctx context.Context, kubeClientset kubernetes.Interface, namespace string) ([]Record, error) {
+	events, err := kubeClientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{LabelSelector: EventLabel + "=true"})
+	if err != nil {
+		return nil, fmt.Errorf("error listing audit events: %w", err)
+	}
+	var records []Record
+	for _, event := range events.Items {
+		data, ok := event.Annotations[EventRecordAnnotation]
+		if !ok {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal([]byte(data), &record); err != nil {
+			return nil, fmt.Errorf("invalid audit record in event %s: %w", event.Name, err)
+		}
+		records = append(records, record)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	return records, nil
+}
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestRecord(t time.Time, action, outcome string) Record {
+	return Record{
+		Time:    t,
+		Subject: "admin",
+		Service: "application.ApplicationService",
+		Action:  action,
+		Object:  "guestbook",
+		Request: json.RawMessage(`{"name":"guestbook"}`),
+		Outcome: outcome,
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, sink.Write(context.Background(), newTestRecord(now, "Sync", OutcomeSuccess)))
+	require.NoError(t, sink.Write(context.Background(), newTestRecord(now.Add(time.Second), "Delete", "PermissionDenied")))
+
+	// records are appended to existing files
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), newTestRecord(now.Add(2*time.Second), "Rollback", OutcomeSuccess)))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := ReadFile(f)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, newTestRecord(now, "Sync", OutcomeSuccess), records[0])
+	assert.Equal(t, "Delete", records[1].Action)
+	assert.Equal(t, "Rollback", records[2].Action)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received Record
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	record := newTestRecord(time.Now().UTC().Truncate(time.Second), "Sync", OutcomeSuccess)
+	sink := NewWebhookSink(server.URL)
+	require.NoError(t, sink.Write(context.Background(), record))
+	assert.Equal(t, record, received)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, sink.Write(context.Background(), record))
+}
+
+func TestEventSink(t *testing.T) {
+	kubeClientset := fake.NewSimpleClientset(&v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "argocd"},
+	})
+	sink := NewEventSink(kubeClientset, "argocd")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, sink.Write(context.Background(), newTestRecord(now.Add(time.Second), "Delete", "PermissionDenied")))
+	require.NoError(t, sink.Write(context.Background(), newTestRecord(now, "Sync", OutcomeSuccess)))
+
+	events, err := kubeClientset.CoreV1().Events("argocd").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, events.Items, 3)
+
+	records, err := ReadEvents(context.Background(), kubeClientset, "argocd")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, newTestRecord(now, "Sync", OutcomeSuccess), records[0])
+	assert.Equal(t, "Delete", records[1].Action)
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Now().UTC()
+	records := []Record{
+		{Time: now.Add(-2 * time.Hour), Subject: "admin", Service: "application.ApplicationService", Action: "Sync", Object: "guestbook", Outcome: OutcomeSuccess},
+		{Time: now.Add(-time.Minute), Subject: "sso-sub", Username: "alice@example.com", Groups: []string{"my-org:team-alpha"}, Service: "application.ApplicationService", Action: "Delete", Object: "apps/guestbook", Outcome: "PermissionDenied"},
+		{Time: now, Subject: "admin", Service: "cluster.ClusterService", Action: "Create", Object: "https://kubernetes.default.svc", Outcome: OutcomeSuccess},
+	}
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected int
+	}{
+		{"All", Filter{}, 3},
+		{"Subject", Filter{Subject: "admin"}, 2},
+		{"Username", Filter{Subject: "*@example.com"}, 1},
+		{"Group", Filter{Group: "my-org:*"}, 1},
+		{"Method", Filter{Method: "/application.ApplicationService/*"}, 2},
+		{"Action", Filter{Method: "Sync"}, 1},
+		{"Object", Filter{Object: "*guestbook"}, 2},
+		{"Since", Filter{Since: now.Add(-time.Hour)}, 2},
+		{"Failed", Filter{Failed: true}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, tt.filter.Apply(records), tt.expected)
+		})
+	}
+}
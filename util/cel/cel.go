@@ -0,0 +1,109 @@
+package cel
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// objVariable is the name of the variable holding the resource in expressions
+	objVariable = "obj"
+	// costLimit bounds the work an expression may do during a single evaluation
+	costLimit = 1000000
+	// evalTimeout bounds the duration of a single evaluation, like the timeout of Lua scripts
+	evalTimeout = 1 * time.Second
+	// maxCachedPrograms bounds the number of cached programs, since expressions of replaced resource overrides are
+	// never evaluated again
+	maxCachedPrograms = 1000
+)
+
+// programKey identifies a compiled expression of a GVK
+type programKey struct {
+	gvk        schema.GroupVersionKind
+	expression string
+}
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs caches the compiled programs by GVK and expression, since several expressions might be evaluated for
+	// the same GVK, e.g. the health check and the actions. The least recently used programs are evicted.
+	programs     = lru.New(maxCachedPrograms)
+	programsLock sync.Mutex
+)
+
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(cel.Declarations(decls.NewVar(objVariable, decls.Dyn)))
+	})
+	return env, envErr
+}
+
+// Compile compiles the expression, which may refer to the resource as 'obj'
+func Compile(expression string) (cel.Program, error) {
+	e, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("error compiling CEL expression: %w", issues.Err())
+	}
+	program, err := e.Program(ast, cel.CostLimit(costLimit), cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL program: %w", err)
+	}
+	return program, nil
+}
+
+// getProgram returns the compiled expression, which is compiled once per GVK and expression
+func getProgram(gvk schema.GroupVersionKind, expression string) (cel.Program, error) {
+	key := programKey{gvk: gvk, expression: expression}
+	programsLock.Lock()
+	cached, ok := programs.Get(key)
+	programsLock.Unlock()
+	if ok {
+		return cached.(cel.Program), nil
+	}
+
+	program, err := Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	programsLock.Lock()
+	programs.Add(key, program)
+	programsLock.Unlock()
+	return program, nil
+}
+
+// Evaluate evaluates the expression on the resource and returns the result encoded as JSON
+func Evaluate(obj *unstructured.Unstructured, expression string) ([]byte, error) {
+	program, err := getProgram(obj.GroupVersionKind(), expression)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), evalTimeout)
+	defer cancel()
+	result, _, err := program.ContextEval(ctx, map[string]interface{}{objVariable: obj.Object})
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating CEL expression: %w", err)
+	}
+	value, err := result.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("error converting result of CEL expression of type %s: %w", result.Type().TypeName(), err)
+	}
+	return protojson.Marshal(value.(*structpb.Value))
+}
@@ -0,0 +1,87 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newObj() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "guestbook"},
+		"spec":       map[string]interface{}{"replicas": int64(2)},
+	}}
+}
+
+func TestEvaluate(t *testing.T) {
+	result, err := Evaluate(newObj(), `{"name": obj.metadata.name, "replicas": obj.spec.replicas, "paused": has(obj.spec.paused)}`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "guestbook", "replicas": 2, "paused": false}`, string(result))
+
+	t.Run("MissingField", func(t *testing.T) {
+		_, err := Evaluate(newObj(), `obj.status.replicas`)
+		assert.ErrorContains(t, err, "error evaluating CEL expression")
+	})
+
+	t.Run("CostLimitExceeded", func(t *testing.T) {
+		_, err := Evaluate(newObj(), `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(a, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(b, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(c, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(d, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(e, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(f, a + b + c + d + e + f > 0))))))`)
+		assert.ErrorContains(t, err, "cost limit exceeded")
+	})
+}
+
+func TestGetProgramIsCachedPerGVKAndExpression(t *testing.T) {
+	obj := newObj()
+	program, err := getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	cached, err := getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+
+	other, err := getProgram(obj.GroupVersionKind(), `obj.kind`)
+	require.NoError(t, err)
+	assert.NotSame(t, program, other)
+
+	// both expressions of the GVK stay cached
+	cached, err = getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+	cached, err = getProgram(obj.GroupVersionKind(), `obj.kind`)
+	require.NoError(t, err)
+	assert.Same(t, other, cached)
+}
+
+func TestGetProgramEvictsLeastRecentlyUsed(t *testing.T) {
+	programs = lru.New(2)
+	defer func() {
+		programs = lru.New(maxCachedPrograms)
+	}()
+	obj := newObj()
+	first, err := getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	second, err := getProgram(obj.GroupVersionKind(), `obj.kind`)
+	require.NoError(t, err)
+	_, err = getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	_, err = getProgram(obj.GroupVersionKind(), `obj.apiVersion`)
+	require.NoError(t, err)
+	assert.Equal(t, 2, programs.Len())
+
+	cached, err := getProgram(obj.GroupVersionKind(), `obj.metadata.name`)
+	require.NoError(t, err)
+	assert.Same(t, first, cached)
+	recompiled, err := getProgram(obj.GroupVersionKind(), `obj.kind`)
+	require.NoError(t, err)
+	assert.NotSame(t, second, recompiled)
+}
+
+func TestCompile(t *testing.T) {
+	_, err := Compile(`{"status": "Healthy"}`)
+	assert.NoError(t, err)
+	_, err = Compile(`{"status": `)
+	assert.ErrorContains(t, err, "error compiling CEL expression")
+}
@@ -241,6 +241,51 @@ func TestGetRepository(t *testing.T) {
 	}
 }
 
+func TestGetRepository_SubmoduleCredentials(t *testing.T) {
+	repoSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "repo",
+			Namespace: testNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+		},
+		Data: map[string][]byte{
+			"url":              []byte("https://git.example.com/repo"),
+			"enableSubmodules": []byte("false"),
+			"submoduleCredentials": []byte(`
+- url: https://other.example.com/org/
+- url: https://mirror.example.com/
+  credentialTemplate: https://other.example.com/
+- url: https://unknown.example.com/
+`),
+		},
+	}
+	credsSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "creds",
+			Namespace: testNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://other.example.com/"),
+			"username": []byte("other-username"),
+			"password": []byte("other-password"),
+		},
+	}
+	clientset := getClientset(nil, repoSecret, credsSecret)
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	repo, err := db.GetRepository(context.Background(), "https://git.example.com/repo")
+	assert.NoError(t, err)
+	assert.False(t, repo.IsSubmoduleEnabled(true))
+	otherCreds := &v1alpha1.RepoCreds{URL: "https://other.example.com/", Username: "other-username", Password: "other-password"}
+	assert.Equal(t, []v1alpha1.SubmoduleCredentials{
+		{URL: "https://other.example.com/org/", Creds: otherCreds},
+		// Credentials are never sent to URLs outside of the credential template
+		{URL: "https://mirror.example.com/", CredentialTemplate: "https://other.example.com/"},
+		{URL: "https://unknown.example.com/"},
+	}, repo.SubmoduleCredentials)
+}
+
 func newManagedSecret() *v1.Secret {
 	return &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -359,6 +359,21 @@ func (db *db) enrichCredsToRepo(ctx context.Context, repository *appsv1.Reposito
 		log.Debugf("%s has credentials", repository.Repo)
 	}
 
+	for i := range repository.SubmoduleCredentials {
+		mapping := &repository.SubmoduleCredentials[i]
+		creds, err := db.GetRepositoryCredentials(ctx, mapping.GetCredentialTemplate())
+		if err != nil {
+			return err
+		}
+		if creds == nil {
+			log.Warnf("No credential template found for submodules of %s with URL prefix %s", repository.Repo, mapping.URL)
+		} else if !mapping.MatchesCredentialTemplate(creds.URL) {
+			log.Warnf("Ignoring credential template %s for submodules of %s with URL prefix %s: the URL prefix must start with the URL of the credential template", creds.URL, repository.Repo, mapping.URL)
+			creds = nil
+		}
+		mapping.Creds = creds
+	}
+
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -314,6 +315,7 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 		Proxy:                      string(secret.Data["proxy"]),
 		Project:                    string(secret.Data["project"]),
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
+		CredentialProvider:         string(secret.Data["credentialProvider"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secret, "insecureIgnoreHostKey")
@@ -358,6 +360,18 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.ForceHttpBasicAuth = forceBasicAuth
 
+	enableSubmodules, err := boolOrNil(secret, "enableSubmodules")
+	if err != nil {
+		return repository, err
+	}
+	repository.EnableSubmodules = enableSubmodules
+
+	submoduleCredentials, err := submoduleCredentialsOrNil(secret, "submoduleCredentials")
+	if err != nil {
+		return repository, err
+	}
+	repository.SubmoduleCredentials = submoduleCredentials
+
 	return repository, nil
 }
 
@@ -386,6 +400,12 @@ func repositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) {
 	updateSecretString(secret, "proxy", repository.Proxy)
 	updateSecretString(secret, "gcpServiceAccountKey", repository.GCPServiceAccountKey)
 	updateSecretBool(secret, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
+	// credential providers are only configured declaratively, so updates which leave it empty keep the provider
+	if repository.CredentialProvider != "" {
+		updateSecretString(secret, "credentialProvider", repository.CredentialProvider)
+	}
+	updateSecretBoolPtr(secret, "enableSubmodules", repository.EnableSubmodules)
+	updateSecretSubmoduleCredentials(secret, "submoduleCredentials", repository.SubmoduleCredentials)
 	addSecretMetadata(secret, common.LabelValueSecretTypeRepository)
 }
 
@@ -402,6 +422,7 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		GitHubAppEnterpriseBaseURL: string(secret.Data["githubAppEnterpriseBaseUrl"]),
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
 		Proxy:                      string(secret.Data["proxy"]),
+		CredentialProvider:         string(secret.Data["credentialProvider"]),
 	}
 
 	enableOCI, err := boolOrFalse(secret, "enableOCI")
@@ -451,6 +472,9 @@ func repoCredsToSecret(repoCreds *appsv1.RepoCreds, secret *corev1.Secret) {
 	updateSecretString(secret, "gcpServiceAccountKey", repoCreds.GCPServiceAccountKey)
 	updateSecretString(secret, "proxy", repoCreds.Proxy)
 	updateSecretBool(secret, "forceHttpBasicAuth", repoCreds.ForceHttpBasicAuth)
+	if repoCreds.CredentialProvider != "" {
+		updateSecretString(secret, "credentialProvider", repoCreds.CredentialProvider)
+	}
 	addSecretMetadata(secret, common.LabelValueSecretTypeRepoCreds)
 }
 
@@ -497,3 +521,39 @@ func (s *secretsRepositoryBackend) getRepositoryCredentialIndex(repoCredentials
 	}
 	return idx
 }
+
+// submoduleCredentialsOrNil parses the submodule credential mappings, which are stored in YAML or JSON format
+func submoduleCredentialsOrNil(secret *corev1.Secret, key string) ([]appsv1.SubmoduleCredentials, error) {
+	val, present := secret.Data[key]
+	if !present || len(strings.TrimSpace(string(val))) == 0 {
+		return nil, nil
+	}
+	var mappings []appsv1.SubmoduleCredentials
+	if err := yaml.Unmarshal(val, &mappings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", key, err)
+	}
+	for i := range mappings {
+		// Credentials are always resolved from the credential templates
+		mappings[i].Creds = nil
+	}
+	return mappings, nil
+}
+
+func updateSecretSubmoduleCredentials(secret *corev1.Secret, key string, value []appsv1.SubmoduleCredentials) {
+	var data []byte
+	if len(value) > 0 {
+		mappings := make([]appsv1.SubmoduleCredentials, len(value))
+		for i := range value {
+			mappings[i] = appsv1.SubmoduleCredentials{URL: value[i].URL, CredentialTemplate: value[i].CredentialTemplate}
+		}
+		var err error
+		data, err = yaml.Marshal(mappings)
+		if err != nil {
+			log.Warnf("Failed to marshal %s: %v", key, err)
+			return
+		}
+	}
+	if _, present := secret.Data[key]; present || len(data) > 0 {
+		secret.Data[key] = data
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -793,3 +794,106 @@ func TestRepoCredsToSecret(t *testing.T) {
 	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, s.Annotations)
 	assert.Equal(t, map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds}, s.Labels)
 }
+
+func TestRepoCredsToSecret_CredentialProvider(t *testing.T) {
+	s := &corev1.Secret{}
+	creds := &appsv1.RepoCreds{
+		URL:                "URL",
+		CredentialProvider: "git-token",
+	}
+	repoCredsToSecret(creds, s)
+	assert.Equal(t, []byte("git-token"), s.Data["credentialProvider"])
+
+	backend := &secretsRepositoryBackend{}
+	parsed, err := backend.secretToRepoCred(s)
+	require.NoError(t, err)
+	assert.Equal(t, "git-token", parsed.CredentialProvider)
+
+	repo, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.Equal(t, "git-token", repo.CredentialProvider)
+}
+
+func TestSecretsRepositoryBackend_UpdateKeepsCredentialProvider(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argo-cd.git"
+	secrets := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "declarative-repo",
+				Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+			},
+			Data: map[string][]byte{
+				"url":                []byte(repoURL),
+				"credentialProvider": []byte("git-token"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "declarative-creds",
+				Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds},
+			},
+			Data: map[string][]byte{
+				"url":                []byte("https://github.com/argoproj"),
+				"credentialProvider": []byte("git-token"),
+			},
+		},
+	}
+	clientset := getClientset(map[string]string{}, secrets...)
+	testee := &secretsRepositoryBackend{db: &db{
+		ns:            testNamespace,
+		kubeclientset: clientset,
+		settingsMgr:   settings.NewSettingsManager(context.TODO(), clientset, testNamespace),
+	}}
+
+	_, err := testee.UpdateRepository(context.TODO(), &appsv1.Repository{Repo: repoURL, Username: "git"})
+	require.NoError(t, err)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), "declarative-repo", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "git", string(secret.Data["username"]))
+	assert.Equal(t, "git-token", string(secret.Data["credentialProvider"]))
+
+	_, err = testee.UpdateRepoCreds(context.TODO(), &appsv1.RepoCreds{URL: "https://github.com/argoproj", Username: "git"})
+	require.NoError(t, err)
+	secret, err = clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), "declarative-creds", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "git", string(secret.Data["username"]))
+	assert.Equal(t, "git-token", string(secret.Data["credentialProvider"]))
+}
+
+func TestRepositoryToSecret_SubmoduleSettings(t *testing.T) {
+	s := &corev1.Secret{}
+	enabled := true
+	repo := &appsv1.Repository{
+		Repo:             "https://git.example.com/repo",
+		EnableSubmodules: &enabled,
+		SubmoduleCredentials: []appsv1.SubmoduleCredentials{{
+			URL:                "https://other.example.com/org/",
+			CredentialTemplate: "https://other.example.com/",
+			Creds:              &appsv1.RepoCreds{Password: "password"},
+		}},
+	}
+	repositoryToSecret(repo, s)
+	assert.Equal(t, "true", string(s.Data["enableSubmodules"]))
+	// Resolved credentials are never stored with the repository
+	assert.Equal(t, "- credentialTemplate: https://other.example.com/\n  url: https://other.example.com/org/\n", string(s.Data["submoduleCredentials"]))
+
+	parsed, err := secretToRepository(s)
+	require.NoError(t, err)
+	assert.Equal(t, &enabled, parsed.EnableSubmodules)
+	assert.Equal(t, []appsv1.SubmoduleCredentials{{URL: "https://other.example.com/org/", CredentialTemplate: "https://other.example.com/"}}, parsed.SubmoduleCredentials)
+
+	repo.EnableSubmodules = nil
+	repo.SubmoduleCredentials = nil
+	repositoryToSecret(repo, s)
+	assert.NotContains(t, s.Data, "enableSubmodules")
+	parsed, err = secretToRepository(s)
+	require.NoError(t, err)
+	assert.Nil(t, parsed.EnableSubmodules)
+	assert.Nil(t, parsed.SubmoduleCredentials)
+
+	s.Data["submoduleCredentials"] = []byte("- url: [")
+	_, err = secretToRepository(s)
+	assert.ErrorContains(t, err, "failed to unmarshal submoduleCredentials")
+}
@@ -49,6 +49,19 @@ func boolOrFalse(secret *apiv1.Secret, key string) (bool, error) {
 	return strconv.ParseBool(string(val))
 }
 
+func boolOrNil(secret *apiv1.Secret, key string) (*bool, error) {
+	val, present := secret.Data[key]
+	if !present || len(val) == 0 {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(string(val))
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
 func intOrZero(secret *apiv1.Secret, key string) (int64, error) {
 	val, present := secret.Data[key]
 	if !present {
@@ -64,6 +77,14 @@ func updateSecretBool(secret *apiv1.Secret, key string, value bool) {
 	}
 }
 
+func updateSecretBoolPtr(secret *apiv1.Secret, key string, value *bool) {
+	if value == nil {
+		delete(secret.Data, key)
+		return
+	}
+	secret.Data[key] = []byte(strconv.FormatBool(*value))
+}
+
 func updateSecretInt(secret *apiv1.Secret, key string, value int64) {
 	if _, present := secret.Data[key]; present || value != 0 {
 		secret.Data[key] = []byte(strconv.FormatInt(value, 10))
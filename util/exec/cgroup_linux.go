@@ -0,0 +1,217 @@
+//go:build linux
+// +build linux
+
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	cgroupV2Mount = "/sys/fs/cgroup"
+	// cpuPeriod is the cpu.max period in microseconds
+	cpuPeriod = 100000
+	// unixWriteOK is the W_OK mode of access(2)
+	unixWriteOK = 0x2
+)
+
+var (
+	cgroupParentOnce sync.Once
+	cgroupParent     string
+	cgroupParentErr  error
+)
+
+// Cgroup is a cgroup v2 group which holds a single command invocation and all of its child processes
+type Cgroup struct {
+	path   string
+	dir    *os.File
+	limits ResourceLimits
+}
+
+// NewCgroup creates a new cgroup with the given limits. The cgroup is created below the cgroup configured with the
+// ARGOCD_EXEC_CGROUP_ROOT env variable, or below the cgroup of the current process if it is not set. Only the command
+// applied to the cgroup is started inside of it.
+func NewCgroup(limits ResourceLimits) (*Cgroup, error) {
+	parent, err := getCgroupParent()
+	if err != nil {
+		return nil, err
+	}
+	path, err := os.MkdirTemp(parent, "exec-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cgroup: %w", err)
+	}
+	c := &Cgroup{path: path, limits: limits}
+	if err := c.setLimits(); err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	c.dir, err = os.Open(path)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("failed to open cgroup: %w", err)
+	}
+	return c, nil
+}
+
+func (c *Cgroup) setLimits() error {
+	if c.limits.Memory > 0 {
+		if err := writeCgroupFile(c.path, "memory.max", strconv.FormatInt(c.limits.Memory, 10)); err != nil {
+			return err
+		}
+		// Swap would allow the command to exceed the memory limit, so it is disabled if the kernel supports it
+		if err := writeCgroupFile(c.path, "memory.swap.max", "0"); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if c.limits.CPUMillis > 0 {
+		quota := c.limits.CPUMillis * cpuPeriod / 1000
+		if err := writeCgroupFile(c.path, "cpu.max", fmt.Sprintf("%d %d", quota, cpuPeriod)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply makes the command start inside the cgroup
+func (c *Cgroup) Apply(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(c.dir.Fd())
+}
+
+// CheckError returns a descriptive error if the command failed because it was killed for exceeding its memory limit.
+// Otherwise, the given error is returned unchanged.
+func (c *Cgroup) CheckError(cmd *exec.Cmd, err error) error {
+	if err == nil || !c.oomKilled() {
+		return err
+	}
+	limit := resource.NewQuantity(c.limits.Memory, resource.BinarySI)
+	return fmt.Errorf("%s exceeded the memory limit of %s: %w", cmd.Args[0], limit.String(), err)
+}
+
+func (c *Cgroup) oomKilled() bool {
+	data, err := os.ReadFile(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			count, err := strconv.Atoi(fields[1])
+			return err == nil && count > 0
+		}
+	}
+	return false
+}
+
+// Close kills any process left in the cgroup and removes it
+func (c *Cgroup) Close() {
+	_ = c.dir.Close()
+	// cgroup.kill is only available since Linux 5.14, so the error is ignored
+	_ = writeCgroupFile(c.path, "cgroup.kill", "1")
+	var err error
+	for i := 0; i < 10; i++ {
+		// Removal fails with EBUSY until the killed processes have exited
+		if err = os.Remove(c.path); err == nil || errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	log.Warnf("Failed to remove cgroup %s: %v", c.path, err)
+}
+
+func getCgroupParent() (string, error) {
+	cgroupParentOnce.Do(func() {
+		cgroupParent, cgroupParentErr = initCgroupParent()
+	})
+	return cgroupParent, cgroupParentErr
+}
+
+// checkCgroupSupport returns an error if per-invocation cgroups cannot be created
+func checkCgroupSupport() error {
+	_, err := getCgroupParent()
+	return err
+}
+
+// initCgroupParent checks the cgroup below which per-invocation cgroups are created. cgroup v2 only allows to enable
+// controllers for child cgroups if the cgroup itself has no processes, so the parent must either have the memory and
+// cpu controllers enabled for its children already, or be an empty cgroup delegated to the process, e.g. one
+// configured with ARGOCD_EXEC_CGROUP_ROOT. Processes are never moved between cgroups, only the spawned commands are
+// started in their own cgroup.
+func initCgroupParent() (string, error) {
+	parent := os.Getenv("ARGOCD_EXEC_CGROUP_ROOT")
+	if parent == "" {
+		current, err := currentCgroup()
+		if err != nil {
+			return "", err
+		}
+		parent = filepath.Join(cgroupV2Mount, current)
+	}
+	controllers, err := os.ReadFile(filepath.Join(parent, "cgroup.controllers"))
+	if err != nil {
+		return "", fmt.Errorf("cgroup v2 is not available at %s: %w", parent, err)
+	}
+	for _, controller := range []string{"memory", "cpu"} {
+		if !containsField(string(controllers), controller) {
+			return "", fmt.Errorf("the %s controller is not available in cgroup %s", controller, parent)
+		}
+	}
+	if err := syscall.Access(parent, unixWriteOK); err != nil {
+		return "", fmt.Errorf("cgroup %s is not writable: %w", parent, err)
+	}
+	subtreeControl, err := os.ReadFile(filepath.Join(parent, "cgroup.subtree_control"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read controllers of cgroup %s: %w", parent, err)
+	}
+	if containsField(string(subtreeControl), "memory") && containsField(string(subtreeControl), "cpu") {
+		return parent, nil
+	}
+	if err := writeCgroupFile(parent, "cgroup.subtree_control", "+memory +cpu"); err != nil {
+		return "", fmt.Errorf("failed to enable controllers in cgroup %s, which must not contain any processes: %w", parent, err)
+	}
+	return parent, nil
+}
+
+// currentCgroup returns the cgroup v2 path of the current process, relative to the cgroup mount
+func currentCgroup() (string, error) {
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("failed to read cgroup of current process: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "0::") {
+			return strings.TrimPrefix(line, "0::"), nil
+		}
+	}
+	return "", fmt.Errorf("current process is not part of a cgroup v2 hierarchy")
+}
+
+func containsField(s string, field string) bool {
+	for _, f := range strings.Fields(s) {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func writeCgroupFile(dir string, name string, value string) error {
+	return os.WriteFile(filepath.Join(dir, name), []byte(value), 0)
+}
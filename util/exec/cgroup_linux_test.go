@@ -0,0 +1,95 @@
+//go:build linux
+// +build linux
+
+package exec
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setCgroupRoot points ARGOCD_EXEC_CGROUP_ROOT to the given directory and resets the detected cgroup parent
+func setCgroupRoot(t *testing.T, dir string) {
+	t.Setenv("ARGOCD_EXEC_CGROUP_ROOT", dir)
+	cgroupParentOnce = sync.Once{}
+	t.Cleanup(func() {
+		cgroupParentOnce = sync.Once{}
+	})
+}
+
+// fakeCgroup creates a directory which looks like a cgroup with the given controllers
+func fakeCgroup(t *testing.T, controllers string, subtreeControl string) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte(controllers), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte(subtreeControl), 0644))
+	return dir
+}
+
+func Test_initCgroupParent(t *testing.T) {
+	t.Run("ControllersEnabled", func(t *testing.T) {
+		dir := fakeCgroup(t, "cpuset cpu io memory pids\n", "memory cpu\n")
+		setCgroupRoot(t, dir)
+		parent, err := getCgroupParent()
+		require.NoError(t, err)
+		assert.Equal(t, dir, parent)
+	})
+	t.Run("ControllersNotEnabled", func(t *testing.T) {
+		dir := fakeCgroup(t, "cpu memory\n", "")
+		setCgroupRoot(t, dir)
+		_, err := getCgroupParent()
+		require.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(dir, "cgroup.subtree_control"))
+		require.NoError(t, err)
+		assert.Equal(t, "+memory +cpu", string(data))
+	})
+	t.Run("NotACgroup", func(t *testing.T) {
+		setCgroupRoot(t, t.TempDir())
+		_, err := getCgroupParent()
+		assert.ErrorContains(t, err, "cgroup v2 is not available")
+	})
+	t.Run("MissingController", func(t *testing.T) {
+		setCgroupRoot(t, fakeCgroup(t, "cpu pids\n", ""))
+		_, err := getCgroupParent()
+		assert.ErrorContains(t, err, "the memory controller is not available")
+	})
+}
+
+func Test_initResourceLimits_Cgroup(t *testing.T) {
+	t.Setenv("ARGOCD_EXEC_MEMORY_LIMIT", "512Mi")
+	t.Setenv("ARGOCD_EXEC_CPU_LIMIT", "500m")
+	t.Cleanup(func() {
+		resourceLimits = ResourceLimits{}
+		resourceLimitsErr = nil
+	})
+	t.Run("Available", func(t *testing.T) {
+		setCgroupRoot(t, fakeCgroup(t, "cpu memory\n", "memory cpu\n"))
+		initResourceLimits()
+		assert.Equal(t, ResourceLimits{Memory: 512 * 1024 * 1024, CPUMillis: 500}, GetResourceLimits())
+		assert.NoError(t, ResourceLimitsError())
+	})
+	t.Run("Unavailable", func(t *testing.T) {
+		hook := logtest.NewGlobal()
+		defer hook.Reset()
+		setCgroupRoot(t, t.TempDir())
+		initResourceLimits()
+		assert.Equal(t, ResourceLimits{Memory: 512 * 1024 * 1024, CPUMillis: 500}, GetResourceLimits())
+		assert.ErrorContains(t, ResourceLimitsError(), "cgroup v2 is not available")
+		assert.Contains(t, hook.LastEntry().Message, "Resource limits of executed commands cannot be applied")
+
+		// Commands which require limits fail instead of running without them
+		_, err := RunWithResourceLimits(exec.Command("echo", "hello"), nil)
+		assert.ErrorContains(t, err, "failed to apply resource limits to echo")
+
+		// Commands without limits still run
+		out, err := Run(exec.Command("echo", "hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", out)
+	})
+}
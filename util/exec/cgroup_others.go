@@ -0,0 +1,32 @@
+//go:build !linux
+// +build !linux
+
+package exec
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// Cgroup is not supported on this platform
+type Cgroup struct{}
+
+// checkCgroupSupport always fails, since resource limits rely on cgroup v2
+func checkCgroupSupport() error {
+	return fmt.Errorf("resource limits are only supported on Linux")
+}
+
+// NewCgroup always fails, since resource limits rely on cgroup v2
+func NewCgroup(limits ResourceLimits) (*Cgroup, error) {
+	return nil, fmt.Errorf("resource limits are only supported on Linux")
+}
+
+func (c *Cgroup) Apply(cmd *exec.Cmd) {
+}
+
+func (c *Cgroup) CheckError(cmd *exec.Cmd, err error) error {
+	return err
+}
+
+func (c *Cgroup) Close() {
+}
@@ -8,21 +8,46 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/utils/tracing"
 	argoexec "github.com/argoproj/pkg/exec"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/argoproj/argo-cd/v2/util/log"
 )
 
-var timeout time.Duration
+var (
+	timeout        time.Duration
+	resourceLimits ResourceLimits
+	// resourceLimitsErr is the reason why the configured resource limits cannot be applied
+	resourceLimitsErr error
+)
 
 type ExecRunOpts struct {
 	// Redactor redacts tokens from the output
 	Redactor func(text string) string
 	// TimeoutBehavior configures what to do in case of timeout
 	TimeoutBehavior argoexec.TimeoutBehavior
+	// ResourceLimits restricts the memory and CPU available to the command and all of its child processes. The command
+	// fails if the limits cannot be applied, e.g. because cgroup v2 is not available.
+	ResourceLimits ResourceLimits
+}
+
+// ResourceLimits restricts the resources available to a single command invocation. Limits are enforced by running
+// each invocation in its own cgroup, which is only supported on Linux with cgroup v2.
+type ResourceLimits struct {
+	// Memory is the maximum amount of memory in bytes. Zero means no limit.
+	Memory int64
+	// CPUMillis is the maximum amount of CPU in millicores. Zero means no limit.
+	CPUMillis int64
+}
+
+// IsZero returns true if no limit is set
+func (l ResourceLimits) IsZero() bool {
+	return l.Memory <= 0 && l.CPUMillis <= 0
 }
 
 func init() {
 	initTimeout()
+	initResourceLimits()
 }
 
 func initTimeout() {
@@ -33,6 +58,53 @@ func initTimeout() {
 	}
 }
 
+func initResourceLimits() {
+	resourceLimits = ResourceLimits{}
+	resourceLimitsErr = nil
+	if memory, ok := parseResourceLimit("ARGOCD_EXEC_MEMORY_LIMIT"); ok {
+		resourceLimits.Memory = memory.Value()
+	}
+	if cpu, ok := parseResourceLimit("ARGOCD_EXEC_CPU_LIMIT"); ok {
+		resourceLimits.CPUMillis = cpu.MilliValue()
+	}
+	if resourceLimits.IsZero() {
+		return
+	}
+	// Commands never run without the configured limits, so that a missing cgroup delegation is not mistaken for
+	// applied limits
+	if err := checkCgroupSupport(); err != nil {
+		resourceLimitsErr = err
+		logrus.Errorf("Resource limits of executed commands cannot be applied, commands which require them fail: %v", err)
+	}
+}
+
+// parseResourceLimit parses the quantity in the env variable. Invalid quantities are logged as errors, since a typo
+// would otherwise silently disable the limit.
+func parseResourceLimit(envVar string) (resource.Quantity, bool) {
+	value := os.Getenv(envVar)
+	if value == "" {
+		return resource.Quantity{}, false
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		logrus.Errorf("Invalid value %q of %s, no limit is applied: %v", value, envVar, err)
+		return resource.Quantity{}, false
+	}
+	return quantity, true
+}
+
+// GetResourceLimits returns the per-invocation resource limits configured with the ARGOCD_EXEC_MEMORY_LIMIT and
+// ARGOCD_EXEC_CPU_LIMIT env variables
+func GetResourceLimits() ResourceLimits {
+	return resourceLimits
+}
+
+// ResourceLimitsError returns why the configured resource limits cannot be applied, or nil if they can be applied or
+// none are configured
+func ResourceLimitsError() error {
+	return resourceLimitsErr
+}
+
 func Run(cmd *exec.Cmd) (string, error) {
 	return RunWithRedactor(cmd, nil)
 }
@@ -42,6 +114,13 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 	return RunWithExecRunOpts(cmd, opts)
 }
 
+// RunWithResourceLimits runs the command with the resource limits configured in the environment. It is meant for
+// tools that render manifests from user-provided input, such as helm or kustomize.
+func RunWithResourceLimits(cmd *exec.Cmd, redactor func(text string) string) (string, error) {
+	opts := ExecRunOpts{Redactor: redactor, ResourceLimits: GetResourceLimits()}
+	return RunWithExecRunOpts(cmd, opts)
+}
+
 func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
 	cmdOpts := argoexec.CmdOpts{Timeout: timeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior}
 	span := tracing.NewLoggingTracer(log.NewLogrusLogger(log.NewWithCurrentConfig())).StartSpan(fmt.Sprintf("exec %v", cmd.Args[0]))
@@ -52,5 +131,15 @@ func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
 		span.SetBaggageItem("args", fmt.Sprintf("%v", cmd.Args))
 	}
 	defer span.Finish()
-	return argoexec.RunCommandExt(cmd, cmdOpts)
+	if opts.ResourceLimits.IsZero() {
+		return argoexec.RunCommandExt(cmd, cmdOpts)
+	}
+	cgroup, err := NewCgroup(opts.ResourceLimits)
+	if err != nil {
+		return "", fmt.Errorf("failed to apply resource limits to %s: %w", cmd.Args[0], err)
+	}
+	defer cgroup.Close()
+	cgroup.Apply(cmd)
+	out, err := argoexec.RunCommandExt(cmd, cmdOpts)
+	return out, cgroup.CheckError(cmd, err)
 }
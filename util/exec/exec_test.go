@@ -9,6 +9,7 @@ import (
 	"time"
 
 	argoexec "github.com/argoproj/pkg/exec"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,3 +56,59 @@ func TestRunWithExecRunOpts(t *testing.T) {
 	_, err := RunWithExecRunOpts(exec.Command("sh", "-c", "trap 'trap - 15 && echo captured && exit' 15 && sleep 2"), opts)
 	assert.Contains(t, err.Error(), "failed timeout after 200ms")
 }
+
+func Test_parseResourceLimit(t *testing.T) {
+	t.Run("Unset", func(t *testing.T) {
+		_, ok := parseResourceLimit("ARGOCD_EXEC_MEMORY_LIMIT")
+		assert.False(t, ok)
+	})
+	t.Run("Memory", func(t *testing.T) {
+		t.Setenv("ARGOCD_EXEC_MEMORY_LIMIT", "512Mi")
+		quantity, ok := parseResourceLimit("ARGOCD_EXEC_MEMORY_LIMIT")
+		assert.True(t, ok)
+		assert.Equal(t, int64(512*1024*1024), quantity.Value())
+	})
+	t.Run("CPU", func(t *testing.T) {
+		t.Setenv("ARGOCD_EXEC_CPU_LIMIT", "1.5")
+		quantity, ok := parseResourceLimit("ARGOCD_EXEC_CPU_LIMIT")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1500), quantity.MilliValue())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		hook := logtest.NewGlobal()
+		defer hook.Reset()
+		t.Setenv("ARGOCD_EXEC_MEMORY_LIMIT", "lots")
+		_, ok := parseResourceLimit("ARGOCD_EXEC_MEMORY_LIMIT")
+		assert.False(t, ok)
+		assert.Contains(t, hook.LastEntry().Message, `Invalid value "lots" of ARGOCD_EXEC_MEMORY_LIMIT`)
+	})
+}
+
+func Test_resourceLimits(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		initResourceLimits()
+		assert.True(t, GetResourceLimits().IsZero())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		t.Setenv("ARGOCD_EXEC_MEMORY_LIMIT", "lots")
+		initResourceLimits()
+		assert.True(t, GetResourceLimits().IsZero())
+	})
+}
+
+func TestRunWithExecRunOpts_ResourceLimits(t *testing.T) {
+	limits := ResourceLimits{Memory: 32 * 1024 * 1024, CPUMillis: 500}
+	cgroup, err := NewCgroup(limits)
+	if err != nil {
+		t.Skipf("cgroup v2 is not available: %v", err)
+	}
+	cgroup.Close()
+
+	out, err := RunWithExecRunOpts(exec.Command("echo", "hello"), ExecRunOpts{ResourceLimits: limits})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", out)
+
+	// Allocates ~64Mi, which exceeds the memory limit
+	_, err = RunWithExecRunOpts(exec.Command("sh", "-c", "head -c 67108864 /dev/zero | tail > /dev/null"), ExecRunOpts{ResourceLimits: limits})
+	assert.ErrorContains(t, err, "exceeded the memory limit of 32Mi")
+}
@@ -2,6 +2,7 @@ package git
 
 import (
 	"crypto/tls"
+	"encoding/base64"
 	"fmt"
 	"math"
 	"net/http"
@@ -99,6 +100,21 @@ type nativeGitClient struct {
 	loadRefFromCache bool
 	// HTTP/HTTPS proxy used to access repository
 	proxy string
+	// Credentials used for submodules and LFS endpoints with matching URL prefixes
+	submoduleCreds []SubmoduleCreds
+}
+
+// SubmoduleCreds are the credentials used for submodules whose URL starts with URLPrefix
+type SubmoduleCreds struct {
+	URLPrefix string
+	Creds     Creds
+}
+
+// submodule is a submodule declared in .gitmodules
+type submodule struct {
+	name string
+	path string
+	url  string
 }
 
 var (
@@ -140,6 +156,14 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithSubmoduleCreds sets the credentials used for submodules and LFS endpoints, which are not accessible with the
+// credentials of the repository
+func WithSubmoduleCreds(creds []SubmoduleCreds) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.submoduleCreds = creds
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile("(/|:)")
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -278,6 +302,13 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 		}
 		auth := githttp.BasicAuth{Username: "x-access-token", Password: token}
 		return &auth, nil
+	case TokenProviderCreds:
+		token, err := creds.getToken()
+		if err != nil {
+			return nil, err
+		}
+		auth := githttp.BasicAuth{Username: creds.username, Password: token}
+		return &auth, nil
 	case GoogleCloudCreds:
 		username, err := creds.getUsername()
 		if err != nil {
@@ -355,7 +386,7 @@ func (m *nativeGitClient) Fetch(revision string) error {
 	if err == nil && m.IsLFSEnabled() {
 		largeFiles, err := m.LsLargeFiles()
 		if err == nil && len(largeFiles) > 0 {
-			err = m.runCredentialedCmd("lfs", "fetch", "--all")
+			err = m.runSubmoduleCredentialedCmd("lfs", "fetch", "--all")
 			if err != nil {
 				return err
 			}
@@ -421,12 +452,68 @@ func (m *nativeGitClient) Submodule() error {
 	if err := m.runCredentialedCmd("submodule", "sync", "--recursive"); err != nil {
 		return err
 	}
-	if err := m.runCredentialedCmd("submodule", "update", "--init", "--recursive"); err != nil {
+	submodules, err := m.listSubmodules()
+	if err != nil {
 		return err
 	}
+	// Submodules are updated one by one, so that failures can be attributed to the submodule which caused them
+	for _, sm := range submodules {
+		if err := m.runSubmoduleCredentialedCmd("submodule", "update", "--init", "--recursive", "--", sm.path); err != nil {
+			return fmt.Errorf("failed to update submodule %q from %s: %w", sm.name, sm.url, err)
+		}
+	}
 	return nil
 }
 
+// listSubmodules returns the submodules declared in .gitmodules in the order of their declaration
+func (m *nativeGitClient) listSubmodules() ([]submodule, error) {
+	out, err := m.runCmd("config", "--file", ".gitmodules", "--list")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .gitmodules: %w", err)
+	}
+	var submodules []*submodule
+	byName := map[string]*submodule{}
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || !strings.HasPrefix(key, "submodule.") {
+			continue
+		}
+		// Submodule names may contain dots, so the name is everything between the section and the variable name
+		lastDot := strings.LastIndex(key, ".")
+		name, variable := key[len("submodule."):lastDot], key[lastDot+1:]
+		sm, found := byName[name]
+		if !found {
+			sm = &submodule{name: name}
+			byName[name] = sm
+			submodules = append(submodules, sm)
+		}
+		switch variable {
+		case "path":
+			sm.path = value
+		case "url":
+			sm.url = value
+		}
+	}
+	// Entries of .gitmodules without a submodule in the index are skipped, like git submodule update does
+	out, err = m.runCmd("ls-files", "--stage")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submodules: %w", err)
+	}
+	gitlinks := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		if info, path, ok := strings.Cut(line, "\t"); ok && strings.HasPrefix(info, "160000 ") {
+			gitlinks[path] = true
+		}
+	}
+	var res []submodule
+	for _, sm := range submodules {
+		if gitlinks[sm.path] {
+			res = append(res, *sm)
+		}
+	}
+	return res, nil
+}
+
 // Checkout checkout specified revision
 func (m *nativeGitClient) Checkout(revision string, submoduleEnabled bool) error {
 	if revision == "" || revision == "HEAD" {
@@ -669,11 +756,57 @@ func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 // nolint:unparam
 func (m *nativeGitClient) runCredentialedCmd(args ...string) error {
+	return m.runCredentialedCmdWithEnv(nil, args...)
+}
+
+// runSubmoduleCredentialedCmd runs a git command with the credentials of the repository, which additionally sends the
+// submodule credentials to URLs matching their prefix
+func (m *nativeGitClient) runSubmoduleCredentialedCmd(args ...string) error {
+	configArgs, env, err := m.submoduleCredsConfig()
+	if err != nil {
+		return err
+	}
+	return m.runCredentialedCmdWithEnv(env, append(configArgs, args...)...)
+}
+
+// submoduleCredsConfig returns the git options and environment variables which configure git to send an authorization
+// header with the submodule credentials to URLs matching their prefix. Only credentials which can be sent as basic
+// auth header over HTTP(S) are supported.
+func (m *nativeGitClient) submoduleCredsConfig() ([]string, []string, error) {
+	var args, env []string
+	for i, sc := range m.submoduleCreds {
+		if !IsHTTPSURL(sc.URLPrefix) && !IsHTTPURL(sc.URLPrefix) {
+			log.Warnf("Ignoring credentials for submodules with URL prefix %s: only HTTP(S) URLs are supported", sc.URLPrefix)
+			continue
+		}
+		if _, ok := sc.Creds.(SSHCreds); ok {
+			log.Warnf("Ignoring credentials for submodules with URL prefix %s: SSH credentials are not supported", sc.URLPrefix)
+			continue
+		}
+		auth, err := newAuth(sc.URLPrefix, sc.Creds)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get credentials for submodules with URL prefix %s: %w", sc.URLPrefix, err)
+		}
+		basicAuth, ok := auth.(*githttp.BasicAuth)
+		if !ok {
+			log.Warnf("Ignoring credentials for submodules with URL prefix %s: only username/password and token credentials are supported", sc.URLPrefix)
+			continue
+		}
+		envName := fmt.Sprintf("%s_%d", submoduleAuthHeaderEnv, i)
+		args = append(args, "--config-env", fmt.Sprintf("http.%s.extraHeader=%s", sc.URLPrefix, envName))
+		env = append(env, fmt.Sprintf("%s=Authorization: Basic %s", envName, base64.StdEncoding.EncodeToString([]byte(basicAuth.Username+":"+basicAuth.Password))))
+	}
+	return args, env, nil
+}
+
+// runCredentialedCmdWithEnv runs a git command with username/password credentials and additional environment variables
+func (m *nativeGitClient) runCredentialedCmdWithEnv(env []string, args ...string) error {
 	closer, environ, err := m.creds.Environ()
 	if err != nil {
 		return err
 	}
 	defer func() { _ = closer.Close() }()
+	environ = append(environ, env...)
 
 	// If a basic auth header is explicitly set, tell Git to send it to the
 	// server to force use of basic auth instead of negotiating the auth scheme
@@ -41,6 +41,7 @@ const (
 	// githubAccessTokenUsername is a username that is used to with the github access token
 	githubAccessTokenUsername = "x-access-token"
 	forceBasicAuthHeaderEnv   = "ARGOCD_GIT_AUTH_HEADER"
+	submoduleAuthHeaderEnv    = "ARGOCD_GIT_SUBMODULE_AUTH_HEADER"
 )
 
 func init() {
@@ -453,6 +454,56 @@ func (g GitHubAppCreds) GetClientCertKey() string {
 	return g.clientCertKey
 }
 
+var _ GenericHTTPSCreds = TokenProviderCreds{}
+
+// TokenProviderCreds to authenticate with short-lived tokens from a TokenProvider
+type TokenProviderCreds struct {
+	username       string
+	provider       TokenProvider
+	clientCertData string
+	clientCertKey  string
+	insecure       bool
+	proxy          string
+	store          CredsStore
+	forceBasicAuth bool
+}
+
+// NewTokenProviderCreds provide credentials which use a token of the given provider as password
+func NewTokenProviderCreds(username string, provider TokenProvider, clientCertData string, clientCertKey string, insecure bool, proxy string, store CredsStore, forceBasicAuth bool) GenericHTTPSCreds {
+	if username == "" {
+		username = TokenProviderDefaultUsername
+	}
+	return TokenProviderCreds{username: username, provider: provider, clientCertData: clientCertData, clientCertKey: clientCertKey, insecure: insecure, proxy: proxy, store: store, forceBasicAuth: forceBasicAuth}
+}
+
+func (c TokenProviderCreds) Environ() (io.Closer, []string, error) {
+	token, err := c.getToken()
+	if err != nil {
+		return NopCloser{}, nil, err
+	}
+	return NewHTTPSCreds(c.username, token, c.clientCertData, c.clientCertKey, c.insecure, c.proxy, c.store, c.forceBasicAuth).Environ()
+}
+
+func (c TokenProviderCreds) getToken() (string, error) {
+	token, err := c.provider.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to get token from credential provider: %w", err)
+	}
+	return token, nil
+}
+
+func (c TokenProviderCreds) HasClientCert() bool {
+	return c.clientCertData != "" && c.clientCertKey != ""
+}
+
+func (c TokenProviderCreds) GetClientCertData() string {
+	return c.clientCertData
+}
+
+func (c TokenProviderCreds) GetClientCertKey() string {
+	return c.clientCertKey
+}
+
 // GoogleCloudCreds to authenticate to Google Cloud Source repositories
 type GoogleCloudCreds struct {
 	creds *google.Credentials
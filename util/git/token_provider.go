@@ -0,0 +1,374 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	gocache "github.com/patrickmn/go-cache"
+	"golang.org/x/oauth2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+const (
+	// TokenProviderDefaultUsername is the username used together with tokens of a TokenProvider if none is configured
+	TokenProviderDefaultUsername = "x-access-token"
+	// tokenRefreshBefore is how long before their expiry tokens are refreshed
+	tokenRefreshBefore = 1 * time.Minute
+	// defaultTokenLifetime is used for tokens which do not tell when they expire
+	defaultTokenLifetime = 5 * time.Minute
+	// tokenProviderTimeout is the maximum time to get a new token
+	tokenProviderTimeout = 30 * time.Second
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// In memory cache for storing token providers per repository URL
+var tokenProviders = gocache.New(24*time.Hour, 1*time.Hour)
+
+// credentialProvidersCache holds the parsed credential providers file, which is parsed again when it changes
+var credentialProvidersCache struct {
+	sync.Mutex
+	path      string
+	modTime   time.Time
+	size      int64
+	providers map[string]TokenProviderConfig
+}
+
+// TokenProvider provides short-lived tokens to authenticate at a repository
+type TokenProvider interface {
+	// Token returns a valid token, refreshing it if it is about to expire
+	Token() (string, error)
+}
+
+// TokenProviderConfig configures where the tokens of a TokenProvider come from. Exactly one of TokenFile and
+// ExecCommand must be set.
+type TokenProviderConfig struct {
+	// TokenFile is the path to a file containing a token, e.g. a projected service account token
+	TokenFile string
+	// TokenExchangeURL is the URL of an OAuth 2.0 token exchange endpoint (RFC 8693), at which the token read from
+	// TokenFile is exchanged for a repository token
+	TokenExchangeURL string
+	// TokenExchangeAudience is the audience requested from the token exchange endpoint
+	TokenExchangeAudience string
+	// ExecCommand is a command which prints a token in the ExecCredential format of Kubernetes exec plugins
+	ExecCommand string
+	// ExecArgs are the arguments passed to ExecCommand
+	ExecArgs []string
+	// ExecEnv are additional environment variables passed to ExecCommand
+	ExecEnv map[string]string
+	// ExecInstallHint is shown when ExecCommand cannot be found
+	ExecInstallHint string
+}
+
+// credentialProviderDefinition is the definition of a credential provider in the repository.credentialProviders key
+// of the argocd-cm ConfigMap
+type credentialProviderDefinition struct {
+	TokenFile             string `json:"tokenFile,omitempty"`
+	TokenExchangeURL      string `json:"tokenExchangeURL,omitempty"`
+	TokenExchangeAudience string `json:"tokenExchangeAudience,omitempty"`
+	Exec                  *struct {
+		Command     string            `json:"command"`
+		Args        []string          `json:"args,omitempty"`
+		Env         map[string]string `json:"env,omitempty"`
+		InstallHint string            `json:"installHint,omitempty"`
+	} `json:"exec,omitempty"`
+}
+
+// ParseCredentialProviders parses the definitions of credential providers, which map the names of the providers to
+// their configuration
+func ParseCredentialProviders(data []byte) (map[string]TokenProviderConfig, error) {
+	var definitions map[string]credentialProviderDefinition
+	if err := yaml.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal credential providers: %w", err)
+	}
+	res := make(map[string]TokenProviderConfig, len(definitions))
+	for name, definition := range definitions {
+		config := TokenProviderConfig{
+			TokenFile:             definition.TokenFile,
+			TokenExchangeURL:      definition.TokenExchangeURL,
+			TokenExchangeAudience: definition.TokenExchangeAudience,
+		}
+		if definition.Exec != nil {
+			config.ExecCommand = definition.Exec.Command
+			config.ExecArgs = definition.Exec.Args
+			config.ExecEnv = definition.Exec.Env
+			config.ExecInstallHint = definition.Exec.InstallHint
+		}
+		res[name] = config
+	}
+	return res, nil
+}
+
+// GetCredentialProvidersPath returns the path of the file containing the credential providers defined by the
+// administrator. If ARGOCD_CREDENTIAL_PROVIDERS_PATH environment is set, path is taken from there, otherwise the
+// default will be returned.
+func GetCredentialProvidersPath() string {
+	if envPath := os.Getenv(common.EnvVarCredentialProvidersPath); envPath != "" {
+		return envPath
+	}
+	return common.DefaultPathCredentialProvidersConfig
+}
+
+// getCredentialProviders returns the credential providers defined by the administrator. The file is only parsed
+// again when its modification time or size has changed, e.g. because the mounted ConfigMap was updated.
+func getCredentialProviders() (map[string]TokenProviderConfig, error) {
+	path := GetCredentialProvidersPath()
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read credential providers: %w", err)
+	}
+	c := &credentialProvidersCache
+	c.Lock()
+	defer c.Unlock()
+	if c.providers != nil && c.path == path && c.modTime.Equal(info.ModTime()) && c.size == info.Size() {
+		return c.providers, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential providers: %w", err)
+	}
+	providers, err := ParseCredentialProviders(data)
+	if err != nil {
+		return nil, err
+	}
+	c.path, c.modTime, c.size, c.providers = path, info.ModTime(), info.Size(), providers
+	return providers, nil
+}
+
+// GetNamedTokenProvider returns the token provider for the given repository using the credential provider with the
+// given name. Credential providers are defined by the administrator in the argocd-cm ConfigMap, which is mounted into
+// the repo server, and never by the repository configuration itself.
+func GetNamedTokenProvider(repoURL string, name string) TokenProvider {
+	providers, err := getCredentialProviders()
+	if err != nil {
+		return errTokenProvider{err}
+	}
+	config, ok := providers[name]
+	if !ok {
+		return errTokenProvider{fmt.Errorf("credential provider %q is not defined", name)}
+	}
+	return GetTokenProvider(repoURL, config)
+}
+
+// GetTokenProvider returns the token provider for the given repository and configuration. Providers are cached, so
+// that tokens are reused until they are about to expire. Invalid configurations are reported when requesting a token.
+func GetTokenProvider(repoURL string, config TokenProviderConfig) TokenProvider {
+	key, err := tokenProviderKey(repoURL, config)
+	if err != nil {
+		return errTokenProvider{err}
+	}
+	if provider, found := tokenProviders.Get(key); found {
+		return provider.(TokenProvider)
+	}
+	src, err := newTokenSource(repoURL, config)
+	if err != nil {
+		return errTokenProvider{err}
+	}
+	provider := &tokenSourceProvider{oauth2.ReuseTokenSourceWithExpiry(nil, src, tokenRefreshBefore)}
+	tokenProviders.Set(key, provider, gocache.DefaultExpiration)
+	return provider
+}
+
+// tokenProviderKey computes a hash of the repository URL and the configuration for lookup in the cache
+func tokenProviderKey(repoURL string, config TokenProviderConfig) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	_, err = h.Write([]byte(repoURL + " " + string(data)))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func newTokenSource(repoURL string, config TokenProviderConfig) (oauth2.TokenSource, error) {
+	switch {
+	case config.TokenFile != "" && config.ExecCommand != "":
+		return nil, errors.New("credential provider must not configure both a token file and a command")
+	case config.ExecCommand != "":
+		if config.TokenExchangeURL != "" {
+			return nil, errors.New("token exchange requires a token file")
+		}
+		return &execTokenSource{repoURL: repoURL, config: config}, nil
+	case config.TokenFile != "" && config.TokenExchangeURL != "":
+		audience := config.TokenExchangeAudience
+		if audience == "" {
+			audience = repoURL
+		}
+		return &tokenExchangeSource{
+			url:      config.TokenExchangeURL,
+			audience: audience,
+			subject:  &fileTokenSource{path: config.TokenFile},
+			client:   &http.Client{Timeout: tokenProviderTimeout},
+		}, nil
+	case config.TokenFile != "":
+		return &fileTokenSource{path: config.TokenFile}, nil
+	}
+	return nil, errors.New("credential provider must configure either a token file or a command")
+}
+
+type tokenSourceProvider struct {
+	src oauth2.TokenSource
+}
+
+func (p *tokenSourceProvider) Token() (string, error) {
+	token, err := p.src.Token()
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+type errTokenProvider struct {
+	err error
+}
+
+func (p errTokenProvider) Token() (string, error) {
+	return "", p.err
+}
+
+// fileTokenSource reads tokens from a file. The expiry is taken from the "exp" claim if the token is a JWT.
+type fileTokenSource struct {
+	path string
+}
+
+func (s *fileTokenSource) Token() (*oauth2.Token, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, fmt.Errorf("token file %s is empty", s.path)
+	}
+	return &oauth2.Token{AccessToken: token, Expiry: jwtExpiry(token)}, nil
+}
+
+// jwtExpiry returns the expiry of the given token if it is a JWT. The signature is not verified, since the token is
+// only passed on. Otherwise, the default token lifetime is assumed.
+func jwtExpiry(token string) time.Time {
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err == nil && claims.ExpiresAt != nil {
+		return claims.ExpiresAt.Time
+	}
+	return time.Now().Add(defaultTokenLifetime)
+}
+
+// tokenExchangeSource exchanges the token of a subject token source for a repository token (RFC 8693)
+type tokenExchangeSource struct {
+	url      string
+	audience string
+	subject  oauth2.TokenSource
+	client   *http.Client
+}
+
+type tokenExchangeResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (s *tokenExchangeSource) Token() (*oauth2.Token, error) {
+	subject, err := s.subject.Token()
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"subject_token":        {subject.AccessToken},
+		"subject_token_type":   {tokenTypeJWT},
+		"requested_token_type": {tokenTypeAccessToken},
+		"audience":             {s.audience},
+	}
+	resp, err := s.client.PostForm(s.url, form)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token exchange response: %w", err)
+	}
+	var res tokenExchangeResponse
+	if err := json.Unmarshal(body, &res); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to unmarshal token exchange response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token exchange failed with status %d: %s %s", resp.StatusCode, res.Error, res.ErrorDescription)
+	}
+	if res.AccessToken == "" {
+		return nil, errors.New("token exchange response does not contain an access token")
+	}
+	expiry := time.Now().Add(defaultTokenLifetime)
+	if res.ExpiresIn > 0 {
+		expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+	return &oauth2.Token{AccessToken: res.AccessToken, Expiry: expiry}, nil
+}
+
+// execTokenSource runs a command which prints a token in the ExecCredential format of Kubernetes exec plugins
+type execTokenSource struct {
+	repoURL string
+	config  TokenProviderConfig
+}
+
+type execCredential struct {
+	Status *struct {
+		Token               string     `json:"token"`
+		ExpirationTimestamp *time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+}
+
+func (s *execTokenSource) Token() (*oauth2.Token, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenProviderTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, s.config.ExecCommand, s.config.ExecArgs...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("ARGOCD_REPO_URL=%s", s.repoURL))
+	for k, v := range s.config.ExecEnv {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) && s.config.ExecInstallHint != "" {
+			return nil, fmt.Errorf("failed to run %s: %w\n%s", s.config.ExecCommand, err, s.config.ExecInstallHint)
+		}
+		return nil, fmt.Errorf("failed to run %s: %w: %s", s.config.ExecCommand, err, strings.TrimSpace(stderr.String()))
+	}
+	var cred execCredential
+	if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal output of %s: %w", s.config.ExecCommand, err)
+	}
+	if cred.Status == nil || cred.Status.Token == "" {
+		return nil, fmt.Errorf("output of %s does not contain a token", s.config.ExecCommand)
+	}
+	expiry := time.Now().Add(defaultTokenLifetime)
+	if cred.Status.ExpirationTimestamp != nil {
+		expiry = *cred.Status.ExpirationTimestamp
+	}
+	return &oauth2.Token{AccessToken: cred.Status.Token, Expiry: expiry}, nil
+}
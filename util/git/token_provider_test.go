@@ -0,0 +1,243 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func writeTokenFile(t *testing.T, token string) string {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0600))
+	return path
+}
+
+func TestFileTokenSource(t *testing.T) {
+	t.Run("Plain token", func(t *testing.T) {
+		src := &fileTokenSource{path: writeTokenFile(t, "plain-token")}
+		token, err := src.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "plain-token", token.AccessToken)
+		assert.WithinDuration(t, time.Now().Add(defaultTokenLifetime), token.Expiry, 10*time.Second)
+	})
+	t.Run("JWT", func(t *testing.T) {
+		expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+		jwtToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(expiry)}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		src := &fileTokenSource{path: writeTokenFile(t, jwtToken)}
+		token, err := src.Token()
+		require.NoError(t, err)
+		assert.Equal(t, jwtToken, token.AccessToken)
+		assert.True(t, expiry.Equal(token.Expiry))
+	})
+	t.Run("Missing file", func(t *testing.T) {
+		src := &fileTokenSource{path: filepath.Join(t.TempDir(), "missing")}
+		_, err := src.Token()
+		assert.ErrorContains(t, err, "failed to read token file")
+	})
+	t.Run("Empty file", func(t *testing.T) {
+		src := &fileTokenSource{path: writeTokenFile(t, "")}
+		_, err := src.Token()
+		assert.ErrorContains(t, err, "is empty")
+	})
+}
+
+func TestTokenExchangeSource(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, tokenExchangeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, tokenTypeJWT, r.PostForm.Get("subject_token_type"))
+		assert.Equal(t, "https://git.example.com/repo", r.PostForm.Get("audience"))
+		if r.PostForm.Get("subject_token") != "subject-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request","error_description":"invalid subject token"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"repo-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	t.Run("Success", func(t *testing.T) {
+		provider := GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{
+			TokenFile:        writeTokenFile(t, "subject-token"),
+			TokenExchangeURL: server.URL,
+		})
+		token, err := provider.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "repo-token", token)
+
+		// The token is cached until it is about to expire
+		token, err = provider.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "repo-token", token)
+		assert.Equal(t, 1, requests)
+	})
+	t.Run("Error", func(t *testing.T) {
+		provider := GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{
+			TokenFile:        writeTokenFile(t, "wrong-token"),
+			TokenExchangeURL: server.URL,
+		})
+		_, err := provider.Token()
+		assert.ErrorContains(t, err, "token exchange failed with status 400: invalid_request invalid subject token")
+	})
+}
+
+func TestExecTokenSource(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	output := fmt.Sprintf(`{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"exec-token","expirationTimestamp":"%s"}}`, expiry.Format(time.RFC3339))
+
+	t.Run("Success", func(t *testing.T) {
+		src := &execTokenSource{repoURL: "https://git.example.com/repo", config: TokenProviderConfig{
+			ExecCommand: "sh",
+			ExecArgs:    []string{"-c", `test "$ARGOCD_REPO_URL" = https://git.example.com/repo && test "$FOO" = bar && echo "$OUTPUT"`},
+			ExecEnv:     map[string]string{"FOO": "bar", "OUTPUT": output},
+		}}
+		token, err := src.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "exec-token", token.AccessToken)
+		assert.True(t, expiry.Equal(token.Expiry))
+	})
+	t.Run("Command fails", func(t *testing.T) {
+		src := &execTokenSource{config: TokenProviderConfig{
+			ExecCommand: "sh",
+			ExecArgs:    []string{"-c", "echo denied >&2; exit 1"},
+		}}
+		_, err := src.Token()
+		assert.ErrorContains(t, err, "denied")
+	})
+	t.Run("Command not found", func(t *testing.T) {
+		src := &execTokenSource{config: TokenProviderConfig{
+			ExecCommand:     "argocd-missing-token-command",
+			ExecInstallHint: "install the token command",
+		}}
+		_, err := src.Token()
+		assert.ErrorContains(t, err, "install the token command")
+	})
+	t.Run("Missing token", func(t *testing.T) {
+		src := &execTokenSource{config: TokenProviderConfig{
+			ExecCommand: "echo",
+			ExecArgs:    []string{`{"status":{}}`},
+		}}
+		_, err := src.Token()
+		assert.ErrorContains(t, err, "does not contain a token")
+	})
+}
+
+func TestGetTokenProvider(t *testing.T) {
+	t.Run("Cached per repository", func(t *testing.T) {
+		config := TokenProviderConfig{TokenFile: writeTokenFile(t, "token")}
+		assert.Same(t, GetTokenProvider("https://git.example.com/repo", config), GetTokenProvider("https://git.example.com/repo", config))
+		assert.NotSame(t, GetTokenProvider("https://git.example.com/repo", config), GetTokenProvider("https://git.example.com/other-repo", config))
+	})
+	t.Run("Invalid configuration", func(t *testing.T) {
+		_, err := GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{}).Token()
+		assert.ErrorContains(t, err, "must configure either a token file or a command")
+
+		_, err = GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{TokenFile: "token", ExecCommand: "cmd"}).Token()
+		assert.ErrorContains(t, err, "must not configure both")
+
+		_, err = GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{ExecCommand: "cmd", TokenExchangeURL: "http://localhost"}).Token()
+		assert.ErrorContains(t, err, "token exchange requires a token file")
+	})
+}
+
+func TestParseCredentialProviders(t *testing.T) {
+	providers, err := ParseCredentialProviders([]byte(`
+git-token:
+  tokenFile: /var/run/secrets/tokens/git-token
+  tokenExchangeURL: http://localhost:8090/token
+get-token:
+  exec:
+    command: get-token
+    args: [--host, git.example.com]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]TokenProviderConfig{
+		"git-token": {TokenFile: "/var/run/secrets/tokens/git-token", TokenExchangeURL: "http://localhost:8090/token"},
+		"get-token": {ExecCommand: "get-token", ExecArgs: []string{"--host", "git.example.com"}},
+	}, providers)
+
+	_, err = ParseCredentialProviders([]byte("git-token: ["))
+	assert.ErrorContains(t, err, "failed to unmarshal credential providers")
+}
+
+func TestGetNamedTokenProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "providers.yaml")
+	t.Setenv(common.EnvVarCredentialProvidersPath, path)
+
+	_, err := GetNamedTokenProvider("https://git.example.com/repo", "git-token").Token()
+	assert.ErrorContains(t, err, `credential provider "git-token" is not defined`)
+
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("git-token:\n  tokenFile: %s\n", writeTokenFile(t, "token"))), 0600))
+	token, err := GetNamedTokenProvider("https://git.example.com/repo", "git-token").Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+
+	_, err = GetNamedTokenProvider("https://git.example.com/repo", "other").Token()
+	assert.ErrorContains(t, err, `credential provider "other" is not defined`)
+}
+
+func Test_getCredentialProviders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "providers.yaml")
+	t.Setenv(common.EnvVarCredentialProvidersPath, path)
+
+	providers, err := getCredentialProviders()
+	require.NoError(t, err)
+	assert.Empty(t, providers)
+
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.WriteFile(path, []byte("aaa:\n  tokenFile: /a\n"), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	providers, err = getCredentialProviders()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]TokenProviderConfig{"aaa": {TokenFile: "/a"}}, providers)
+
+	// The file is not parsed again as long as it has the same modification time and size
+	require.NoError(t, os.WriteFile(path, []byte("bbb:\n  tokenFile: /b\n"), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	providers, err = getCredentialProviders()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]TokenProviderConfig{"aaa": {TokenFile: "/a"}}, providers)
+
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now()))
+	providers, err = getCredentialProviders()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]TokenProviderConfig{"bbb": {TokenFile: "/b"}}, providers)
+}
+
+func TestTokenProviderCreds_Environ(t *testing.T) {
+	store := &memoryCredsStore{creds: make(map[string]cred)}
+	provider := GetTokenProvider("https://git.example.com/repo", TokenProviderConfig{TokenFile: writeTokenFile(t, "token")})
+	creds := NewTokenProviderCreds("", provider, "", "", false, "", store, false)
+
+	closer, env, err := creds.Environ()
+	require.NoError(t, err)
+	var nonce string
+	for _, envVar := range env {
+		if strings.HasPrefix(envVar, ASKPASS_NONCE_ENV) {
+			nonce = envVar[len(ASKPASS_NONCE_ENV)+1:]
+			break
+		}
+	}
+	assert.Equal(t, cred{username: TokenProviderDefaultUsername, password: "token"}, store.creds[nonce])
+	io.Close(closer)
+	assert.NotContains(t, store.creds, nonce)
+
+	_, _, err = NewTokenProviderCreds("", errTokenProvider{fmt.Errorf("unavailable")}, "", "", false, "", store, false).Environ()
+	assert.ErrorContains(t, err, "failed to get token from credential provider: unavailable")
+}
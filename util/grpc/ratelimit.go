@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// rateLimitsReloadInterval is the interval at which the rate limits are reloaded from the settings
+	rateLimitsReloadInterval = 10 * time.Second
+	// rateLimitBucketsSweepInterval is the interval at which buckets of inactive subjects are removed
+	rateLimitBucketsSweepInterval = 5 * time.Minute
+	// projectSubjectPrefix is the prefix of the subjects of project role tokens
+	projectSubjectPrefix = "proj:"
+)
+
+type rateLimitBucketKey struct {
+	limit string
+	key   string
+}
+
+type rateLimitBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+	// idleTimeout is the duration after which an unused bucket is full again, and can be removed
+	idleTimeout time.Duration
+}
+
+// RateLimiter limits the rate of API calls of each subject according to the rate limits of the settings
+type RateLimiter struct {
+	getLimits   func() ([]settings.APIRateLimit, error)
+	onThrottled func(limit string, method string)
+
+	lock     sync.Mutex
+	limits   []settings.APIRateLimit
+	loadedAt time.Time
+	sweptAt  time.Time
+	buckets  map[rateLimitBucketKey]*rateLimitBucket
+}
+
+// NewRateLimiter returns a rate limiter which loads its limits using getLimits and calls onThrottled for each
+// rejected call
+func NewRateLimiter(getLimits func() ([]settings.APIRateLimit, error), onThrottled func(limit string, method string)) *RateLimiter {
+	return &RateLimiter{
+		getLimits:   getLimits,
+		onThrottled: onThrottled,
+		buckets:     make(map[rateLimitBucketKey]*rateLimitBucket),
+	}
+}
+
+// Allow takes a token from the buckets of all limits which apply to the call of the given method, and returns a
+// ResourceExhausted error if any of them is empty
+func (l *RateLimiter) Allow(ctx context.Context, method string) error {
+	subject, key := rateLimitSubject(ctx)
+	now := time.Now()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.reload(now)
+	l.sweep(now)
+	for _, limit := range l.limits {
+		if !limit.Matches(method, subject) {
+			continue
+		}
+		bucketKey := rateLimitBucketKey{limit: limit.Name, key: key}
+		bucket, ok := l.buckets[bucketKey]
+		if !ok {
+			burst := limit.GetBurst()
+			bucket = &rateLimitBucket{
+				limiter:     rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst),
+				idleTimeout: time.Duration(float64(burst) / limit.RequestsPerSecond * float64(time.Second)),
+			}
+			l.buckets[bucketKey] = bucket
+		}
+		bucket.lastUsed = now
+		if !bucket.limiter.AllowN(now, 1) {
+			if l.onThrottled != nil {
+				l.onThrottled(limit.Name, method)
+			}
+			return status.Errorf(codes.ResourceExhausted, "rate limit %q exceeded: at most %s requests per second are allowed", limit.Name, formatRate(limit.RequestsPerSecond))
+		}
+	}
+	return nil
+}
+
+// reload loads the limits if they were last loaded before rateLimitsReloadInterval. The buckets are reset if the
+// limits have changed.
+func (l *RateLimiter) reload(now time.Time) {
+	if now.Sub(l.loadedAt) < rateLimitsReloadInterval {
+		return
+	}
+	l.loadedAt = now
+	limits, err := l.getLimits()
+	if err != nil {
+		log.Warnf("Failed to load API rate limits, keeping the previous limits: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(limits, l.limits) {
+		l.limits = limits
+		l.buckets = make(map[rateLimitBucketKey]*rateLimitBucket)
+	}
+}
+
+// sweep removes the buckets which are full again, since they are equivalent to new buckets
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < rateLimitBucketsSweepInterval {
+		return
+	}
+	l.sweptAt = now
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.lastUsed) > bucket.idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// rateLimitSubject returns the subject of the caller, and the key of its buckets. Each token of a project role has
+// its own buckets.
+func rateLimitSubject(ctx context.Context) (string, string) {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return "", ""
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return "", ""
+	}
+	subject := jwtutil.StringField(mapClaims, "sub")
+	if !strings.HasPrefix(subject, projectSubjectPrefix) {
+		return subject, subject
+	}
+	id := jwtutil.StringField(mapClaims, "jti")
+	if id == "" {
+		iat, _ := jwtutil.IssuedAt(mapClaims)
+		id = fmt.Sprintf("%d", iat)
+	}
+	return subject, subject + ":" + id
+}
+
+func formatRate(requestsPerSecond float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%f", requestsPerSecond), "0"), ".")
+}
+
+// RateLimitUnaryServerInterceptor returns a UnaryServerInterceptor which rejects calls exceeding the rate limits
+func RateLimitUnaryServerInterceptor(limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limiter.Allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamServerInterceptor returns a StreamServerInterceptor which rejects streams exceeding the rate limits.
+// Each stream counts as a single call.
+func RateLimitStreamServerInterceptor(limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := limiter.Allow(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const resourceTreeMethod = "/application.ApplicationService/ResourceTree"
+
+func contextWithClaims(claims jwt.MapClaims) context.Context {
+	// nolint:staticcheck
+	return context.WithValue(context.Background(), "claims", claims)
+}
+
+func newTestRateLimiter(limits ...settings.APIRateLimit) (*RateLimiter, map[string]int) {
+	throttled := make(map[string]int)
+	limiter := NewRateLimiter(func() ([]settings.APIRateLimit, error) {
+		return limits, nil
+	}, func(limit string, method string) {
+		throttled[limit+" "+method]++
+	})
+	return limiter, throttled
+}
+
+func TestRateLimiter_Burst(t *testing.T) {
+	limiter, throttled := newTestRateLimiter(settings.APIRateLimit{Name: "default", RequestsPerSecond: 0.001, Burst: 2})
+	ctx := contextWithClaims(jwt.MapClaims{"sub": "admin"})
+
+	assert.NoError(t, limiter.Allow(ctx, resourceTreeMethod))
+	assert.NoError(t, limiter.Allow(ctx, resourceTreeMethod))
+	err := limiter.Allow(ctx, resourceTreeMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), `rate limit "default" exceeded`)
+	assert.Equal(t, map[string]int{"default " + resourceTreeMethod: 1}, throttled)
+
+	// other subjects have their own buckets
+	assert.NoError(t, limiter.Allow(contextWithClaims(jwt.MapClaims{"sub": "alice"}), resourceTreeMethod))
+}
+
+func TestRateLimiter_ProjectTokens(t *testing.T) {
+	limiter, _ := newTestRateLimiter(settings.APIRateLimit{Name: "projects", Subjects: []string{"proj:*"}, RequestsPerSecond: 0.001})
+
+	token1 := contextWithClaims(jwt.MapClaims{"sub": "proj:default:ci", "jti": "token-1"})
+	token2 := contextWithClaims(jwt.MapClaims{"sub": "proj:default:ci", "jti": "token-2"})
+	legacyToken := contextWithClaims(jwt.MapClaims{"sub": "proj:default:ci", "iat": float64(1600000000)})
+
+	assert.NoError(t, limiter.Allow(token1, resourceTreeMethod))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(limiter.Allow(token1, resourceTreeMethod)))
+	assert.NoError(t, limiter.Allow(token2, resourceTreeMethod))
+	assert.NoError(t, limiter.Allow(legacyToken, resourceTreeMethod))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(limiter.Allow(legacyToken, resourceTreeMethod)))
+
+	// users are not limited
+	admin := contextWithClaims(jwt.MapClaims{"sub": "admin"})
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, limiter.Allow(admin, resourceTreeMethod))
+	}
+}
+
+func TestRateLimiter_Methods(t *testing.T) {
+	limiter, _ := newTestRateLimiter(
+		settings.APIRateLimit{Name: "resource-tree", Methods: []string{resourceTreeMethod}, RequestsPerSecond: 0.001},
+		settings.APIRateLimit{Name: "applications", Methods: []string{"/application.ApplicationService/*"}, RequestsPerSecond: 0.001, Burst: 3},
+	)
+	ctx := contextWithClaims(jwt.MapClaims{"sub": "admin"})
+
+	assert.NoError(t, limiter.Allow(ctx, resourceTreeMethod))
+	err := limiter.Allow(ctx, resourceTreeMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "resource-tree")
+
+	// the limits are checked in order, so the rejected call did not consume a token of the applications limit
+	assert.NoError(t, limiter.Allow(ctx, "/application.ApplicationService/Get"))
+	assert.NoError(t, limiter.Allow(ctx, "/application.ApplicationService/Get"))
+	err = limiter.Allow(ctx, "/application.ApplicationService/Get")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "applications")
+
+	assert.NoError(t, limiter.Allow(ctx, "/cluster.ClusterService/List"))
+}
+
+func TestRateLimiter_Anonymous(t *testing.T) {
+	limiter, _ := newTestRateLimiter(settings.APIRateLimit{Name: "default", RequestsPerSecond: 0.001})
+
+	assert.NoError(t, limiter.Allow(context.Background(), resourceTreeMethod))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(limiter.Allow(context.Background(), resourceTreeMethod)))
+}
+
+func TestRateLimiter_Reload(t *testing.T) {
+	limits := []settings.APIRateLimit{{Name: "default", RequestsPerSecond: 0.001}}
+	limiter := NewRateLimiter(func() ([]settings.APIRateLimit, error) {
+		return limits, nil
+	}, nil)
+	ctx := contextWithClaims(jwt.MapClaims{"sub": "admin"})
+
+	assert.NoError(t, limiter.Allow(ctx, resourceTreeMethod))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(limiter.Allow(ctx, resourceTreeMethod)))
+
+	limits = nil
+	limiter.loadedAt = limiter.loadedAt.Add(-rateLimitsReloadInterval)
+	assert.NoError(t, limiter.Allow(ctx, resourceTreeMethod))
+}
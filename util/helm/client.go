@@ -43,6 +43,28 @@ type Creds struct {
 	CertData           []byte
 	KeyData            []byte
 	InsecureSkipVerify bool
+	// TokenProvider provides short-lived tokens which are used instead of Password
+	TokenProvider TokenProvider
+}
+
+// TokenProvider provides short-lived tokens to authenticate at a repository
+type TokenProvider interface {
+	// Token returns a valid token, refreshing it if it is about to expire
+	Token() (string, error)
+}
+
+// resolveToken returns the credentials with the password set to a token of the TokenProvider, if any
+func (c Creds) resolveToken() (Creds, error) {
+	if c.TokenProvider == nil {
+		return c, nil
+	}
+	token, err := c.TokenProvider.Token()
+	if err != nil {
+		return c, fmt.Errorf("failed to get token from credential provider: %w", err)
+	}
+	c.Password = token
+	c.TokenProvider = nil
+	return c, nil
 }
 
 type indexCache interface {
@@ -164,25 +186,30 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, passCredent
 		}
 		defer func() { _ = os.RemoveAll(tempDest) }()
 
+		creds, err := c.creds.resolveToken()
+		if err != nil {
+			return "", nil, err
+		}
+
 		if c.enableOci {
-			if c.creds.Password != "" && c.creds.Username != "" {
-				_, err = helmCmd.RegistryLogin(c.repoURL, c.creds)
+			if creds.Password != "" && creds.Username != "" {
+				_, err = helmCmd.RegistryLogin(c.repoURL, creds)
 				if err != nil {
 					return "", nil, err
 				}
 
 				defer func() {
-					_, _ = helmCmd.RegistryLogout(c.repoURL, c.creds)
+					_, _ = helmCmd.RegistryLogout(c.repoURL, creds)
 				}()
 			}
 
 			// 'helm pull' ensures that chart is downloaded into temp directory
-			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds)
+			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, creds)
 			if err != nil {
 				return "", nil, err
 			}
 		} else {
-			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, c.creds, passCredentials)
+			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, creds, passCredentials)
 			if err != nil {
 				return "", nil, err
 			}
@@ -265,15 +292,20 @@ func (c *nativeHelmChart) TestHelmOCI() (bool, error) {
 	}
 	defer helmCmd.Close()
 
+	creds, err := c.creds.resolveToken()
+	if err != nil {
+		return false, err
+	}
+
 	// Looks like there is no good way to test access to OCI repo if credentials are not provided
 	// just assume it is accessible
-	if c.creds.Username != "" && c.creds.Password != "" {
-		_, err = helmCmd.RegistryLogin(c.repoURL, c.creds)
+	if creds.Username != "" && creds.Password != "" {
+		_, err = helmCmd.RegistryLogin(c.repoURL, creds)
 		if err != nil {
 			return false, err
 		}
 		defer func() {
-			_, _ = helmCmd.RegistryLogout(c.repoURL, c.creds)
+			_, _ = helmCmd.RegistryLogout(c.repoURL, creds)
 		}()
 
 		log.WithFields(log.Fields{"seconds": time.Since(start).Seconds()}).Info("took to test helm oci repository")
@@ -291,12 +323,16 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	if c.creds.Username != "" || c.creds.Password != "" {
+	creds, err := c.creds.resolveToken()
+	if err != nil {
+		return nil, err
+	}
+	if creds.Username != "" || creds.Password != "" {
 		// only basic supported
-		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		req.SetBasicAuth(creds.Username, creds.Password)
 	}
 
-	tlsConf, err := newTLSConfig(c.creds)
+	tlsConf, err := newTLSConfig(creds)
 	if err != nil {
 		return nil, err
 	}
@@ -406,7 +442,11 @@ func (c *nativeHelmChart) GetTags(chart string, noCache bool) (*TagsList, error)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize repository: %v", err)
 		}
-		tlsConf, err := newTLSConfig(c.creds)
+		creds, err := c.creds.resolveToken()
+		if err != nil {
+			return nil, err
+		}
+		tlsConf, err := newTLSConfig(creds)
 		if err != nil {
 			return nil, fmt.Errorf("failed setup tlsConfig: %v", err)
 		}
@@ -419,8 +459,8 @@ func (c *nativeHelmChart) GetTags(chart string, noCache bool) (*TagsList, error)
 			Client: client,
 			Cache:  nil,
 			Credential: auth.StaticCredential(c.repoURL, auth.Credential{
-				Username: c.creds.Username,
-				Password: c.creds.Password,
+				Username: creds.Username,
+				Password: creds.Password,
 			}),
 		}
 
@@ -176,3 +176,41 @@ func TestGetTagsFromUrl(t *testing.T) {
 	assert.Equal(t, tags.Tags[0], "first")
 	assert.Equal(t, tags.Tags[1], "second")
 }
+
+type staticTokenProvider string
+
+func (p staticTokenProvider) Token() (string, error) {
+	if p == "" {
+		return "", fmt.Errorf("no token")
+	}
+	return string(p), nil
+}
+
+func TestCreds_resolveToken(t *testing.T) {
+	creds, err := Creds{Username: "user", Password: "password"}.resolveToken()
+	require.NoError(t, err)
+	assert.Equal(t, Creds{Username: "user", Password: "password"}, creds)
+
+	creds, err = Creds{Username: "user", TokenProvider: staticTokenProvider("token")}.resolveToken()
+	require.NoError(t, err)
+	assert.Equal(t, Creds{Username: "user", Password: "token"}, creds)
+
+	_, err = Creds{Username: "user", TokenProvider: staticTokenProvider("")}.resolveToken()
+	assert.ErrorContains(t, err, "failed to get token from credential provider: no token")
+}
+
+func TestLoadRepoIndex_TokenProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("apiVersion: v1\nentries: {}\n"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, Creds{Username: "user", TokenProvider: staticTokenProvider("token")}, false, "")
+	_, err := client.GetIndex(true)
+	assert.NoError(t, err)
+}
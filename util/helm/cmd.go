@@ -50,6 +50,16 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(args ...string) (string, error) {
+	return c.runWith(executil.RunWithRedactor, args...)
+}
+
+// runWithResourceLimits runs helm with the resource limits of rendering manifests. Only the rendering of charts is
+// limited, other subcommands like fetching dependencies are run without limits.
+func (c Cmd) runWithResourceLimits(args ...string) (string, error) {
+	return c.runWith(executil.RunWithResourceLimits, args...)
+}
+
+func (c Cmd) runWith(runFunc func(cmd *exec.Cmd, redactor func(text string) string) (string, error), args ...string) (string, error) {
 	cmd := exec.Command(c.binaryName, args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -67,7 +77,7 @@ func (c Cmd) run(args ...string) (string, error) {
 
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy)
 
-	return executil.RunWithRedactor(cmd, redactor)
+	return runFunc(cmd, redactor)
 }
 
 func (c *Cmd) Init() (string, error) {
@@ -310,7 +320,7 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, error) {
 		args = append(args, "--include-crds")
 	}
 
-	return c.run(args...)
+	return c.runWithResourceLimits(args...)
 }
 
 func (c *Cmd) Freestyle(args ...string) (string, error) {
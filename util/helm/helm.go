@@ -83,13 +83,17 @@ func (h *helm) DependencyBuild() error {
 
 	for i := range h.repos {
 		repo := h.repos[i]
+		creds, err := repo.Creds.resolveToken()
+		if err != nil {
+			return err
+		}
 		if repo.EnableOci {
 			h.cmd.IsHelmOci = true
-			if repo.Creds.Username != "" && repo.Creds.Password != "" {
-				_, err := h.cmd.RegistryLogin(repo.Repo, repo.Creds)
+			if creds.Username != "" && creds.Password != "" {
+				_, err := h.cmd.RegistryLogin(repo.Repo, creds)
 
 				defer func() {
-					_, _ = h.cmd.RegistryLogout(repo.Repo, repo.Creds)
+					_, _ = h.cmd.RegistryLogout(repo.Repo, creds)
 				}()
 
 				if err != nil {
@@ -97,7 +101,7 @@ func (h *helm) DependencyBuild() error {
 				}
 			}
 		} else {
-			_, err := h.cmd.RepoAdd(repo.Name, repo.Repo, repo.Creds, h.passCredentials)
+			_, err := h.cmd.RepoAdd(repo.Name, repo.Repo, creds, h.passCredentials)
 
 			if err != nil {
 				return err
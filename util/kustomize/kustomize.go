@@ -232,7 +232,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	}
 
 	cmd.Env = append(cmd.Env, environ...)
-	out, err := executil.Run(cmd)
+	out, err := executil.RunWithResourceLimits(cmd, nil)
 	if err != nil {
 		return nil, nil, err
 	}
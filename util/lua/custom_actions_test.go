@@ -66,7 +66,14 @@ type IndividualActionTest struct {
 	Action             string `yaml:"action"`
 	InputPath          string `yaml:"inputPath"`
 	ExpectedOutputPath string `yaml:"expectedOutputPath"`
-	InputStr           string `yaml:"input"`
+	// ExpectedOutputs are the resources impacted by actions which do not just patch the input resource
+	ExpectedOutputs []ExpectedActionOutput `yaml:"expectedOutputs"`
+	InputStr        string                 `yaml:"input"`
+}
+
+type ExpectedActionOutput struct {
+	Operation K8SOperation `yaml:"operation"`
+	Path      string       `yaml:"path"`
 }
 
 func TestLuaResourceActionsScript(t *testing.T) {
@@ -117,14 +124,24 @@ func TestLuaResourceActionsScript(t *testing.T) {
 				result, err := vm.ExecuteResourceAction(obj, action.ActionLua)
 				assert.NoError(t, err)
 
-				expectedObj := getObj(filepath.Join(dir, test.ExpectedOutputPath))
-				// Ideally, we would use a assert.Equal to detect the difference, but the Lua VM returns a object with float64 instead of the original int32.  As a result, the assert.Equal is never true despite that the change has been applied.
-				diffResult, err := diff.Diff(expectedObj, result, diff.WithNormalizer(testNormalizer{}))
-				assert.NoError(t, err)
-				if diffResult.Modified {
-					t.Error("Output does not match input:")
-					err = cli.PrintDiff(test.Action, expectedObj, result)
+				expectedOutputs := test.ExpectedOutputs
+				if test.ExpectedOutputPath != "" {
+					expectedOutputs = []ExpectedActionOutput{{Operation: PatchOperation, Path: test.ExpectedOutputPath}}
+				}
+				if !assert.Len(t, result, len(expectedOutputs)) {
+					return
+				}
+				for i, expectedOutput := range expectedOutputs {
+					assert.Equal(t, expectedOutput.Operation, result[i].K8SOperation)
+					expectedObj := getObj(filepath.Join(dir, expectedOutput.Path))
+					// Ideally, we would use a assert.Equal to detect the difference, but the Lua VM returns a object with float64 instead of the original int32.  As a result, the assert.Equal is never true despite that the change has been applied.
+					diffResult, err := diff.Diff(expectedObj, result[i].UnstructuredObj, diff.WithNormalizer(testNormalizer{}))
 					assert.NoError(t, err)
+					if diffResult.Modified {
+						t.Error("Output does not match input:")
+						err = cli.PrintDiff(test.Action, expectedObj, result[i].UnstructuredObj)
+						assert.NoError(t, err)
+					}
 				}
 			})
 		}
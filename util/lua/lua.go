@@ -1,6 +1,7 @@
 package lua
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -16,12 +17,15 @@ import (
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/resource_customizations"
+	"github.com/argoproj/argo-cd/v2/util/cel"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 )
 
 const (
 	incorrectReturnType       = "expect %s output from Lua script, not %s"
 	invalidHealthStatus       = "Lua returned an invalid health status"
+	incorrectCELReturnType    = "expect %s output from CEL expression, not %s"
+	invalidCELHealthStatus    = "CEL expression returned an invalid health status"
 	healthScriptFile          = "health.lua"
 	actionScriptFile          = "action.lua"
 	actionDiscoveryScriptFile = "discovery.lua"
@@ -33,6 +37,9 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
+	if expression := luaVM.GetHealthCEL(obj); expression != "" {
+		return luaVM.ExecuteHealthCEL(obj, expression)
+	}
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
 		return nil, err
@@ -104,40 +111,69 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 		if err != nil {
 			return nil, err
 		}
-		healthStatus := &health.HealthStatus{}
-		err = json.Unmarshal(jsonBytes, healthStatus)
-		if err != nil {
-			return nil, err
-		}
-		if !isValidHealthStatusCode(healthStatus.Status) {
-			return &health.HealthStatus{
-				Status:  health.HealthStatusUnknown,
-				Message: invalidHealthStatus,
-			}, nil
-		}
-
-		return healthStatus, nil
+		return healthStatusFromJSON(jsonBytes, invalidHealthStatus)
 	}
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
-// GetHealthScript attempts to read lua script from config and then filesystem for that resource
-func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, error) {
-	// first, search the gvk as is in the ResourceOverrides
-	key := GetConfigMapKey(obj.GroupVersionKind())
+// ExecuteHealthCEL evaluates the CEL expression to generate the health status of a resource
+func (vm VM) ExecuteHealthCEL(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	jsonBytes, err := cel.Evaluate(obj, expression)
+	if err != nil {
+		return nil, err
+	}
+	if !isJSONObject(jsonBytes) {
+		return nil, fmt.Errorf(incorrectCELReturnType, "map", string(jsonBytes))
+	}
+	return healthStatusFromJSON(jsonBytes, invalidCELHealthStatus)
+}
 
-	if script, ok := vm.ResourceOverrides[key]; ok && script.HealthLua != "" {
-		return script.HealthLua, script.UseOpenLibs, nil
+func healthStatusFromJSON(jsonBytes []byte, invalidMessage string) (*health.HealthStatus, error) {
+	healthStatus := &health.HealthStatus{}
+	err := json.Unmarshal(jsonBytes, healthStatus)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidMessage,
+		}, nil
 	}
+	return healthStatus, nil
+}
 
-	// if not found as is, perhaps it matches wildcard entries in the configmap
-	wildcardKey := GetWildcardConfigMapKey(vm, obj.GroupVersionKind())
+// GetHealthCEL returns the CEL expression assessing the health of the resource, or an empty string if its health is
+// not customized with CEL
+func (vm VM) GetHealthCEL(obj *unstructured.Unstructured) string {
+	if override, ok := vm.getHealthOverride(obj); ok {
+		return override.HealthCEL
+	}
+	return ""
+}
 
-	if wildcardKey != "" {
-		if wildcardScript, ok := vm.ResourceOverrides[wildcardKey]; ok && wildcardScript.HealthLua != "" {
-			return wildcardScript.HealthLua, wildcardScript.UseOpenLibs, nil
+// getHealthOverride returns the resource override customizing the health of the resource, either as is or matching a
+// wildcard entry
+func (vm VM) getHealthOverride(obj *unstructured.Unstructured) (appv1.ResourceOverride, bool) {
+	key := GetConfigMapKey(obj.GroupVersionKind())
+	if override, ok := vm.ResourceOverrides[key]; ok && (override.HealthLua != "" || override.HealthCEL != "") {
+		return override, true
+	}
+	if wildcardKey := GetWildcardConfigMapKey(vm, obj.GroupVersionKind()); wildcardKey != "" {
+		if override, ok := vm.ResourceOverrides[wildcardKey]; ok && (override.HealthLua != "" || override.HealthCEL != "") {
+			return override, true
 		}
 	}
+	return appv1.ResourceOverride{}, false
+}
+
+// GetHealthScript attempts to read lua script from config and then filesystem for that resource
+func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, error) {
+	// first, search the gvk as is in the ResourceOverrides, then the wildcard entries in the configmap
+	if override, ok := vm.getHealthOverride(obj); ok {
+		return override.HealthLua, override.UseOpenLibs, nil
+	}
+	key := GetConfigMapKey(obj.GroupVersionKind())
 
 	// if not found in the ResourceOverrides at all, search it as is in the built-in scripts
 	// (as built-in scripts are files in folders, named after the GVK, currently there is no wildcard support for them)
@@ -146,26 +182,95 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, erro
 	return builtInScript, true, err
 }
 
-func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
+// K8SOperation is the operation a resource action performs on an impacted resource
+type K8SOperation string
+
+const (
+	// CreateOperation creates a new resource
+	CreateOperation K8SOperation = "create"
+	// PatchOperation patches the resource the action was run on
+	PatchOperation K8SOperation = "patch"
+)
+
+// ImpactedResource is a resource which is created or patched by a resource action
+type ImpactedResource struct {
+	UnstructuredObj *unstructured.Unstructured
+	K8SOperation    K8SOperation
+}
+
+// ExecuteResourceAction runs the action script and returns the resources impacted by the action. The script either
+// returns the modified object, which is patched, or a list of operations like {operation = "create", resource = job}.
+func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) ([]ImpactedResource, error) {
 	l, err := vm.runLua(obj, script)
 	if err != nil {
 		return nil, err
 	}
 	returnValue := l.Get(-1)
-	if returnValue.Type() == lua.LTTable {
-		jsonBytes, err := luajson.Encode(returnValue)
+	if returnValue.Type() != lua.LTTable {
+		return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+	}
+	jsonBytes, err := luajson.Encode(returnValue)
+	if err != nil {
+		return nil, err
+	}
+	if !isJSONArray(jsonBytes) {
+		newObj, err := unmarshalReturnedObj(jsonBytes, obj)
 		if err != nil {
 			return nil, err
 		}
-		newObj, err := appv1.UnmarshalToUnstructured(string(jsonBytes))
+		return []ImpactedResource{{UnstructuredObj: newObj, K8SOperation: PatchOperation}}, nil
+	}
+
+	var operations []struct {
+		Operation K8SOperation    `json:"operation"`
+		Resource  json.RawMessage `json:"resource"`
+	}
+	if err := json.Unmarshal(jsonBytes, &operations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal operations returned by Lua script: %w", err)
+	}
+	impactedResources := make([]ImpactedResource, len(operations))
+	for i, operation := range operations {
+		if operation.Operation != CreateOperation && operation.Operation != PatchOperation {
+			return nil, fmt.Errorf("unsupported operation '%s' returned by Lua script", operation.Operation)
+		}
+		if len(operation.Resource) == 0 {
+			return nil, fmt.Errorf("operation '%s' returned by Lua script has no resource", operation.Operation)
+		}
+		// created objects are not derived from the object, so empty tables cannot be restored from it
+		var origObj *unstructured.Unstructured
+		if operation.Operation == PatchOperation {
+			origObj = obj
+		}
+		newObj, err := unmarshalReturnedObj(operation.Resource, origObj)
 		if err != nil {
 			return nil, err
 		}
-		cleanedNewObj := cleanReturnedObj(newObj.Object, obj.Object)
-		newObj.Object = cleanedNewObj
-		return newObj, nil
+		impactedResources[i] = ImpactedResource{UnstructuredObj: newObj, K8SOperation: operation.Operation}
 	}
-	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+	return impactedResources, nil
+}
+
+// unmarshalReturnedObj unmarshals an object returned by a Lua script. If the object was derived from an original
+// object, empty tables are restored from it.
+func unmarshalReturnedObj(jsonBytes []byte, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	newObj, err := appv1.UnmarshalToUnstructured(string(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	if obj != nil {
+		newObj.Object = cleanReturnedObj(newObj.Object, obj.Object)
+	}
+	return newObj, nil
+}
+
+func isJSONObject(jsonBytes []byte) bool {
+	trimmed := bytes.TrimSpace(jsonBytes)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+func isJSONArray(jsonBytes []byte) bool {
+	trimmed := bytes.TrimSpace(jsonBytes)
+	return len(trimmed) > 0 && trimmed[0] == '['
 }
 
 // cleanReturnedObj Lua cannot distinguish an empty table as an array or map, and the library we are using choose to
@@ -235,37 +340,53 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 		if err != nil {
 			return nil, err
 		}
-		availableActions := make([]appv1.ResourceAction, 0)
-		if noAvailableActions(jsonBytes) {
-			return availableActions, nil
+		return resourceActionsFromJSON(jsonBytes)
+	}
+
+	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+}
+
+// ExecuteResourceActionDiscoveryCEL evaluates the CEL expression to discover the available actions of a resource
+func (vm VM) ExecuteResourceActionDiscoveryCEL(obj *unstructured.Unstructured, expression string) ([]appv1.ResourceAction, error) {
+	jsonBytes, err := cel.Evaluate(obj, expression)
+	if err != nil {
+		return nil, err
+	}
+	if !isJSONObject(jsonBytes) {
+		return nil, fmt.Errorf(incorrectCELReturnType, "map", string(jsonBytes))
+	}
+	return resourceActionsFromJSON(jsonBytes)
+}
+
+func resourceActionsFromJSON(jsonBytes []byte) ([]appv1.ResourceAction, error) {
+	availableActions := make([]appv1.ResourceAction, 0)
+	if noAvailableActions(jsonBytes) {
+		return availableActions, nil
+	}
+	availableActionsMap := make(map[string]interface{})
+	err := json.Unmarshal(jsonBytes, &availableActionsMap)
+	if err != nil {
+		return nil, err
+	}
+	for key := range availableActionsMap {
+		value := availableActionsMap[key]
+		resourceAction := appv1.ResourceAction{Name: key, Disabled: isActionDisabled(value)}
+		if emptyResourceActionFromLua(value) {
+			availableActions = append(availableActions, resourceAction)
+			continue
 		}
-		availableActionsMap := make(map[string]interface{})
-		err = json.Unmarshal(jsonBytes, &availableActionsMap)
+		resourceActionBytes, err := json.Marshal(value)
 		if err != nil {
 			return nil, err
 		}
-		for key := range availableActionsMap {
-			value := availableActionsMap[key]
-			resourceAction := appv1.ResourceAction{Name: key, Disabled: isActionDisabled(value)}
-			if emptyResourceActionFromLua(value) {
-				availableActions = append(availableActions, resourceAction)
-				continue
-			}
-			resourceActionBytes, err := json.Marshal(value)
-			if err != nil {
-				return nil, err
-			}
 
-			err = json.Unmarshal(resourceActionBytes, &resourceAction)
-			if err != nil {
-				return nil, err
-			}
-			availableActions = append(availableActions, resourceAction)
+		err = json.Unmarshal(resourceActionBytes, &resourceAction)
+		if err != nil {
+			return nil, err
 		}
-		return availableActions, err
+		availableActions = append(availableActions, resourceAction)
 	}
-
-	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+	return availableActions, nil
 }
 
 // Actions are enabled by default
@@ -295,6 +416,20 @@ func noAvailableActions(jsonBytes []byte) bool {
 	return string(jsonBytes) == "[]"
 }
 
+// GetResourceActionDiscoveryCEL returns the CEL expression discovering the available actions of the resource, or an
+// empty string if they are not discovered with CEL
+func (vm VM) GetResourceActionDiscoveryCEL(obj *unstructured.Unstructured) (string, error) {
+	override, ok := vm.ResourceOverrides[GetConfigMapKey(obj.GroupVersionKind())]
+	if !ok || override.Actions == "" {
+		return "", nil
+	}
+	actions, err := override.GetActions()
+	if err != nil {
+		return "", err
+	}
+	return actions.ActionDiscoveryCEL, nil
+}
+
 func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) (string, error) {
 	key := GetConfigMapKey(obj.GroupVersionKind())
 	override, ok := vm.ResourceOverrides[key]
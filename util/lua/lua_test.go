@@ -386,9 +386,60 @@ func TestExecuteResourceAction(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	expectedObj := StrToUnstructured(expectedUpdatedObj)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, validActionLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, validActionLua)
 	assert.Nil(t, err)
-	assert.Equal(t, expectedObj, newObj)
+	assert.Equal(t, []ImpactedResource{{UnstructuredObj: expectedObj, K8SOperation: PatchOperation}}, newObjects)
+}
+
+const multipleOperationsLua = `
+local job = {}
+job.apiVersion = "batch/v1"
+job.kind = "Job"
+job.metadata = {}
+job.metadata.name = obj.metadata.name .. "-job"
+job.metadata.namespace = obj.metadata.namespace
+
+obj.metadata.labels["test"] = "test"
+
+return {{operation = "patch", resource = obj}, {operation = "create", resource = job}}
+`
+
+func TestExecuteResourceActionMultipleOperations(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	newObjects, err := vm.ExecuteResourceAction(testObj, multipleOperationsLua)
+	assert.NoError(t, err)
+	assert.Equal(t, []ImpactedResource{{
+		UnstructuredObj: StrToUnstructured(expectedUpdatedObj),
+		K8SOperation:    PatchOperation,
+	}, {
+		UnstructuredObj: StrToUnstructured(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: helm-guestbook-job
+  namespace: default
+`),
+		K8SOperation: CreateOperation,
+	}}, newObjects)
+}
+
+func TestExecuteResourceActionNoOperations(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	newObjects, err := vm.ExecuteResourceAction(testObj, "return {}")
+	assert.NoError(t, err)
+	assert.Empty(t, newObjects)
+}
+
+func TestExecuteResourceActionInvalidOperation(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	_, err := vm.ExecuteResourceAction(testObj, `return {{operation = "delete", resource = obj}}`)
+	assert.ErrorContains(t, err, "unsupported operation 'delete'")
+
+	_, err = vm.ExecuteResourceAction(testObj, `return {{operation = "create"}}`)
+	assert.ErrorContains(t, err, "has no resource")
 }
 
 func TestExecuteResourceActionNonTableReturn(t *testing.T) {
@@ -461,9 +512,10 @@ func TestCleanPatch(t *testing.T) {
 	testObj := StrToUnstructured(objWithEmptyStruct)
 	expectedObj := StrToUnstructured(expectedUpdatedObjWithEmptyStruct)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua)
 	assert.Nil(t, err)
-	assert.Equal(t, expectedObj, newObj)
+	assert.Len(t, newObjects, 1)
+	assert.Equal(t, expectedObj, newObjects[0].UnstructuredObj)
 
 }
 
@@ -547,3 +599,135 @@ return hs`
 		assert.Nil(t, status)
 	})
 }
+
+const newHealthStatusCEL = `{
+	"status": "Healthy",
+	"message": obj.metadata.name == "helm-guestbook" ? "testMessage" : "NeedsToBeChanged"
+}`
+
+func TestExecuteHealthCEL(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	status, err := vm.ExecuteHealthCEL(testObj, newHealthStatusCEL)
+	assert.Nil(t, err)
+	expectedHealthStatus := &health.HealthStatus{
+		Status:  "Healthy",
+		Message: "testMessage",
+	}
+	assert.Equal(t, expectedHealthStatus, status)
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		status, err := vm.ExecuteHealthCEL(testObj, `{"status": "test"}`)
+		assert.Nil(t, err)
+		expectedStatus := &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidCELHealthStatus,
+		}
+		assert.Equal(t, expectedStatus, status)
+	})
+
+	t.Run("NonMapReturn", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `1`)
+		assert.Equal(t, fmt.Errorf(incorrectCELReturnType, "map", "1"), err)
+	})
+
+	t.Run("CompileError", func(t *testing.T) {
+		_, err := vm.ExecuteHealthCEL(testObj, `{"status": `)
+		assert.ErrorContains(t, err, "error compiling CEL expression")
+	})
+}
+
+func TestGetResourceHealthCEL(t *testing.T) {
+	const healthCEL = `has(obj.status) && has(obj.status.phase) && obj.status.phase == "Running" ?
+	{"status": "Healthy"} :
+	{"status": "Progressing", "message": "Waiting for rollout"}`
+
+	testObj := StrToUnstructured(objJSON)
+
+	t.Run("CELOverride", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{HealthCEL: healthCEL},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		assert.Nil(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for rollout"}, status)
+
+		running := testObj.DeepCopy()
+		running.Object["status"] = map[string]interface{}{"phase": "Running"}
+		status, err = overrides.GetResourceHealth(running)
+		assert.Nil(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
+
+	t.Run("CELTakesPrecedenceOverLua", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{HealthCEL: `{"status": "Degraded"}`, HealthLua: newHealthStatusFunction},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		assert.Nil(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded}, status)
+	})
+
+	t.Run("ExactLuaTakesPrecedenceOverWildcardCEL", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"argoproj.io/Rollout": appv1.ResourceOverride{HealthLua: newHealthStatusFunction},
+			"argoproj.io/*":       appv1.ResourceOverride{HealthCEL: `{"status": "Degraded"}`},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		assert.Nil(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "testMessage"}, status)
+	})
+
+	t.Run("WildcardCEL", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"*.aws.crossplane.io/*": appv1.ResourceOverride{HealthCEL: `{"status": "Healthy"}`},
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(ec2AWSCrossplaneObjJson))
+		assert.Nil(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy}, status)
+	})
+}
+
+const validDiscoveryCEL = `{
+	"scale": {"params": [{"name": "replicas", "type": "number"}]},
+	"resume": {"disabled": !(has(obj.spec) && has(obj.spec.paused) && obj.spec.paused)},
+	"test": {}
+}`
+
+func TestResourceActionDiscoveryCEL(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					ActionDiscoveryCEL: validDiscoveryCEL,
+				})),
+			},
+		},
+	}
+	discoveryCEL, err := vm.GetResourceActionDiscoveryCEL(testObj)
+	assert.Nil(t, err)
+	assert.Equal(t, validDiscoveryCEL, discoveryCEL)
+
+	actions, err := vm.ExecuteResourceActionDiscoveryCEL(testObj, discoveryCEL)
+	assert.Nil(t, err)
+	expectedActions := []appv1.ResourceAction{
+		{
+			Name:     "resume",
+			Disabled: true,
+		}, {
+			Name: "scale",
+			Params: []appv1.ResourceActionParam{{
+				Name: "replicas",
+				Type: "number",
+			}},
+		}, {
+			Name: "test",
+		},
+	}
+	assert.ElementsMatch(t, expectedActions, actions)
+
+	discoveryCEL, err = VM{}.GetResourceActionDiscoveryCEL(testObj)
+	assert.Nil(t, err)
+	assert.Empty(t, discoveryCEL)
+}
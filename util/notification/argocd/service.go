@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/shared"
 
@@ -19,9 +20,20 @@ import (
 type Service interface {
 	GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string) (*shared.CommitMetadata, error)
 	GetAppDetails(ctx context.Context, appSource *v1alpha1.ApplicationSource) (*shared.AppDetail, error)
+	GetAppResourcesTree(ctx context.Context, appNamespace string, appName string) (*v1alpha1.ApplicationTree, error)
+	GetAppManagedResources(ctx context.Context, appNamespace string, appName string) ([]*v1alpha1.ResourceDiff, error)
 }
 
-func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClientset apiclient.Clientset) (*argoCDService, error) {
+// AppStateCache provides the resource trees and managed resources of applications cached by the application controller
+type AppStateCache interface {
+	GetAppResourcesTree(appName string, res *v1alpha1.ApplicationTree) error
+	GetAppManagedResources(appName string, res *[]*v1alpha1.ResourceDiff) error
+}
+
+var errAppStateCacheNotConfigured = errors.New("application state cache is not configured")
+
+// NewArgoCDService returns a service backed by the repo server and, if not nil, the application state cache
+func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClientset apiclient.Clientset, appStateCache AppStateCache) (*argoCDService, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	settingsMgr := settings.NewSettingsManager(ctx, clientset, namespace)
 	closer, repoClient, err := repoClientset.NewRepoServerClient()
@@ -36,7 +48,7 @@ func NewArgoCDService(clientset kubernetes.Interface, namespace string, repoClie
 			log.Warnf("Failed to close repo server connection: %v", err)
 		}
 	}
-	return &argoCDService{settingsMgr: settingsMgr, namespace: namespace, repoServerClient: repoClient, dispose: dispose}, nil
+	return &argoCDService{settingsMgr: settingsMgr, namespace: namespace, repoServerClient: repoClient, appStateCache: appStateCache, dispose: dispose}, nil
 }
 
 type argoCDService struct {
@@ -44,6 +56,7 @@ type argoCDService struct {
 	namespace        string
 	settingsMgr      *settings.SettingsManager
 	repoServerClient apiclient.RepoServerServiceClient
+	appStateCache    AppStateCache
 	dispose          func()
 }
 
@@ -122,6 +135,36 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, appSource *v1alpha1
 	}, nil
 }
 
+// appInstanceName returns the name under which the application controller caches the state of the application
+func (svc *argoCDService) appInstanceName(appNamespace string, appName string) string {
+	app := v1alpha1.Application{}
+	app.Namespace = appNamespace
+	app.Name = appName
+	return app.InstanceName(svc.namespace)
+}
+
+func (svc *argoCDService) GetAppResourcesTree(_ context.Context, appNamespace string, appName string) (*v1alpha1.ApplicationTree, error) {
+	if svc.appStateCache == nil {
+		return nil, errAppStateCacheNotConfigured
+	}
+	tree := &v1alpha1.ApplicationTree{}
+	if err := svc.appStateCache.GetAppResourcesTree(svc.appInstanceName(appNamespace, appName), tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+func (svc *argoCDService) GetAppManagedResources(_ context.Context, appNamespace string, appName string) ([]*v1alpha1.ResourceDiff, error) {
+	if svc.appStateCache == nil {
+		return nil, errAppStateCacheNotConfigured
+	}
+	var managedResources []*v1alpha1.ResourceDiff
+	if err := svc.appStateCache.GetAppManagedResources(svc.appInstanceName(appNamespace, appName), &managedResources); err != nil {
+		return nil, err
+	}
+	return managedResources, nil
+}
+
 func (svc *argoCDService) Close() {
 	svc.dispose()
 }
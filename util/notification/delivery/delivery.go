@@ -0,0 +1,193 @@
+package delivery
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+)
+
+const (
+	// StatusSent indicates that the notification was delivered
+	StatusSent = "Sent"
+	// StatusFailed indicates that the delivery failed and will be retried
+	StatusFailed = "Failed"
+	// StatusDeadLettered indicates that the delivery failed and will not be retried anymore
+	StatusDeadLettered = "DeadLettered"
+
+	// DefaultHistorySize is the default number of delivery records kept in the history and in the dead-letter list
+	DefaultHistorySize = 1000
+
+	historyKey     = "notifications|delivery-history"
+	deadLettersKey = "notifications|dead-letters"
+	// expiration is the time the records are kept in Redis after the last delivery
+	expiration = 7 * 24 * time.Hour
+	// maxPendingRecords is the number of records added since the last flush at which the records are flushed
+	maxPendingRecords = 100
+)
+
+// Record is an attempt to deliver a notification
+type Record struct {
+	// ID identifies the notification. All delivery attempts of a notification have the same ID.
+	ID string `json:"id"`
+	// Time is the time of the delivery attempt
+	Time time.Time `json:"time"`
+	// Kind is the kind of the resource the notification is about, e.g. Application
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource the notification is about
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource the notification is about
+	Name string `json:"name"`
+	// Project is the project of the resource the notification is about
+	Project string `json:"project,omitempty"`
+	// Trigger is the trigger which caused the notification
+	Trigger string `json:"trigger"`
+	// Service is the notification service used for the delivery
+	Service string `json:"service"`
+	// Recipient is the recipient of the notification
+	Recipient string `json:"recipient"`
+	// Status is the status of the delivery attempt
+	Status string `json:"status"`
+	// Attempt is the number of the delivery attempt, starting with 1
+	Attempt int `json:"attempt"`
+	// Error is the error of the failed delivery attempt
+	Error string `json:"error,omitempty"`
+}
+
+// Store keeps a bounded history of the delivery attempts, and a bounded list of the deliveries which failed for good
+type Store interface {
+	// Add adds a delivery attempt to the history, and to the dead-letter list if its status is StatusDeadLettered.
+	// Records are written to the cache in batches, so they are listed by other stores only after they were flushed.
+	Add(record Record) error
+	// Flush writes the records added since the last flush to the cache
+	Flush() error
+	// List returns the delivery attempts of the history, the most recent first
+	List() ([]Record, error)
+	// ListDeadLetters returns the deliveries of the dead-letter list, the most recent first
+	ListDeadLetters() ([]Record, error)
+}
+
+// NewStore returns a store which keeps up to size records in the given cache, or in memory if cache is nil. If size
+// is not positive, DefaultHistorySize is used.
+func NewStore(cache *cacheutil.Cache, size int) Store {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &store{cache: cache, size: size}
+}
+
+type store struct {
+	lock        sync.Mutex
+	cache       *cacheutil.Cache
+	size        int
+	history     []Record
+	deadLetters []Record
+	// pendingHistory and pendingDeadLetters are the records added since the last flush, the most recent first
+	pendingHistory     []Record
+	pendingDeadLetters []Record
+}
+
+func (s *store) Add(record Record) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pendingHistory = s.prepend(s.pendingHistory, record)
+	if record.Status == StatusDeadLettered {
+		s.pendingDeadLetters = s.prepend(s.pendingDeadLetters, record)
+	}
+	if s.cache != nil && len(s.pendingHistory) < maxPendingRecords {
+		return nil
+	}
+	return s.flush()
+}
+
+func (s *store) Flush() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.flush()
+}
+
+func (s *store) List() ([]Record, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records, err := s.get(historyKey, s.history)
+	if err != nil {
+		return nil, err
+	}
+	return s.prepend(records, s.pendingHistory...), nil
+}
+
+func (s *store) ListDeadLetters() ([]Record, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	records, err := s.get(deadLettersKey, s.deadLetters)
+	if err != nil {
+		return nil, err
+	}
+	return s.prepend(records, s.pendingDeadLetters...), nil
+}
+
+// flush writes the pending records to the lists. The pending records are kept if the lists cannot be written, so
+// they are written by the next flush.
+func (s *store) flush() error {
+	if len(s.pendingHistory) > 0 {
+		if err := s.add(historyKey, &s.history, s.pendingHistory); err != nil {
+			return err
+		}
+		s.pendingHistory = nil
+	}
+	if len(s.pendingDeadLetters) > 0 {
+		if err := s.add(deadLettersKey, &s.deadLetters, s.pendingDeadLetters); err != nil {
+			return err
+		}
+		s.pendingDeadLetters = nil
+	}
+	return nil
+}
+
+// add prepends the records to the list with the given key and trims the list to the size of the store
+func (s *store) add(key string, records *[]Record, added []Record) error {
+	current, err := s.get(key, *records)
+	if err != nil {
+		return err
+	}
+	updated := s.prepend(current, added...)
+	if s.cache == nil {
+		*records = updated
+		return nil
+	}
+	return s.cache.SetItem(key, updated, expiration, false)
+}
+
+// prepend returns the records preceded by the added records, trimmed to the size of the store
+func (s *store) prepend(records []Record, added ...Record) []Record {
+	res := append(append([]Record{}, added...), records...)
+	if len(res) > s.size {
+		res = res[:s.size]
+	}
+	return res
+}
+
+func (s *store) get(key string, records []Record) ([]Record, error) {
+	if s.cache == nil {
+		return append([]Record{}, records...), nil
+	}
+	var res []Record
+	err := s.cache.GetItem(key, &res)
+	if errors.Is(err, cacheutil.ErrCacheMiss) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// Filter returns the records about the resources of the given kind, namespace and name. Empty values match any
+// resource.
+func Filter(records []Record, kind string, namespace string, name string) []Record {
+	var res []Record
+	for _, record := range records {
+		if (kind == "" || record.Kind == kind) && (namespace == "" || record.Namespace == namespace) && (name == "" || record.Name == name) {
+			res = append(res, record)
+		}
+	}
+	return res
+}
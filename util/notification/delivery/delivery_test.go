@@ -0,0 +1,78 @@
+package delivery
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+)
+
+func TestStore(t *testing.T) {
+	for name, cache := range map[string]*cacheutil.Cache{
+		"memory": nil,
+		"cache":  cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := NewStore(cache, 2)
+			records, err := store.List()
+			require.NoError(t, err)
+			assert.Empty(t, records)
+
+			for i := 1; i <= 3; i++ {
+				require.NoError(t, store.Add(Record{ID: fmt.Sprintf("%d", i), Status: StatusFailed}))
+			}
+			require.NoError(t, store.Add(Record{ID: "1", Status: StatusDeadLettered}))
+
+			records, err = store.List()
+			require.NoError(t, err)
+			assert.Equal(t, []Record{{ID: "1", Status: StatusDeadLettered}, {ID: "3", Status: StatusFailed}}, records)
+
+			deadLetters, err := store.ListDeadLetters()
+			require.NoError(t, err)
+			assert.Equal(t, []Record{{ID: "1", Status: StatusDeadLettered}}, deadLetters)
+		})
+	}
+}
+
+func TestStore_Flush(t *testing.T) {
+	cache := cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour))
+	store := NewStore(cache, 0)
+	other := NewStore(cache, 0)
+
+	require.NoError(t, store.Add(Record{ID: "1", Status: StatusDeadLettered}))
+	records, err := other.List()
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	require.NoError(t, store.Flush())
+	records, err = other.List()
+	require.NoError(t, err)
+	assert.Equal(t, []Record{{ID: "1", Status: StatusDeadLettered}}, records)
+	deadLetters, err := other.ListDeadLetters()
+	require.NoError(t, err)
+	assert.Equal(t, []Record{{ID: "1", Status: StatusDeadLettered}}, deadLetters)
+
+	// the records are flushed when enough records are pending
+	for i := 2; i <= maxPendingRecords+1; i++ {
+		require.NoError(t, store.Add(Record{ID: fmt.Sprintf("%d", i), Status: StatusSent}))
+	}
+	records, err = other.List()
+	require.NoError(t, err)
+	assert.Len(t, records, maxPendingRecords+1)
+}
+
+func TestFilter(t *testing.T) {
+	records := []Record{
+		{ID: "1", Kind: "Application", Namespace: "argocd", Name: "guestbook"},
+		{ID: "2", Kind: "Application", Namespace: "apps", Name: "guestbook"},
+		{ID: "3", Kind: "AppProject", Namespace: "argocd", Name: "default"},
+	}
+	assert.Equal(t, records, Filter(records, "", "", ""))
+	assert.Equal(t, records[:2], Filter(records, "Application", "", ""))
+	assert.Equal(t, records[1:2], Filter(records, "", "apps", "guestbook"))
+	assert.Empty(t, Filter(records, "ApplicationSet", "", ""))
+}
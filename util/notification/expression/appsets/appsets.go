@@ -0,0 +1,94 @@
+package appsets
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func NewExprs() map[string]interface{} {
+	return map[string]interface{}{
+		"Condition":        condition,
+		"CurrentStep":      currentStep,
+		"CurrentStepKey":   currentStepKey,
+		"StepApplications": stepApplications,
+	}
+}
+
+func toApplicationSet(obj map[string]interface{}) *v1alpha1.ApplicationSet {
+	var appSet v1alpha1.ApplicationSet
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &appSet); err != nil {
+		panic(err)
+	}
+	return &appSet
+}
+
+// condition returns the condition of the given type if its status is True, or nil
+func condition(obj map[string]interface{}, conditionType string) map[string]interface{} {
+	for _, c := range toApplicationSet(obj).Status.Conditions {
+		if string(c.Type) == conditionType && c.Status == v1alpha1.ApplicationSetConditionStatusTrue {
+			return map[string]interface{}{
+				"type":    string(c.Type),
+				"status":  string(c.Status),
+				"reason":  c.Reason,
+				"message": c.Message,
+			}
+		}
+	}
+	return nil
+}
+
+// currentStep returns the step of a progressive sync whose applications are being updated, or an empty string
+func currentStep(obj map[string]interface{}) string {
+	current := -1
+	for _, status := range toApplicationSet(obj).Status.ApplicationStatus {
+		if status.Status != "Pending" && status.Status != "Progressing" {
+			continue
+		}
+		step, err := strconv.Atoi(status.Step)
+		if err != nil {
+			continue
+		}
+		if current == -1 || step < current {
+			current = step
+		}
+	}
+	if current == -1 {
+		return ""
+	}
+	return strconv.Itoa(current)
+}
+
+// currentStepKey returns a key which identifies the current step of the current rollout of a progressive sync, or an
+// empty string if no step is being updated. The rollout is identified by the time its RolloutProgressing condition
+// became true, or by the generation of the application set if the condition is missing, so that the key changes
+// whenever a new rollout reaches a step.
+func currentStepKey(obj map[string]interface{}) string {
+	step := currentStep(obj)
+	if step == "" {
+		return ""
+	}
+	appSet := toApplicationSet(obj)
+	for _, c := range appSet.Status.Conditions {
+		if c.Type == v1alpha1.ApplicationSetConditionRolloutProgressing && c.Status == v1alpha1.ApplicationSetConditionStatusTrue && c.LastTransitionTime != nil {
+			return fmt.Sprintf("%d:%s", c.LastTransitionTime.Unix(), step)
+		}
+	}
+	return fmt.Sprintf("generation-%d:%s", appSet.Generation, step)
+}
+
+// stepApplications returns the names of the applications of the given step of a progressive sync
+func stepApplications(obj map[string]interface{}, step string) []string {
+	var apps []string
+	for _, status := range toApplicationSet(obj).Status.ApplicationStatus {
+		if status.Step == step {
+			apps = append(apps, status.Application)
+		}
+	}
+	sort.Strings(apps)
+	return apps
+}
@@ -0,0 +1,72 @@
+package appsets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAppSet() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "ApplicationSet",
+		"metadata":   map[string]interface{}{"name": "guestbook"},
+		"spec":       map[string]interface{}{},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "ErrorOccurred", "status": "True", "reason": "ApplicationGenerationFromParamsError", "message": "boom"},
+				map[string]interface{}{"type": "ResourcesUpToDate", "status": "False", "reason": "ApplicationGenerationFromParamsError", "message": "boom"},
+			},
+			"applicationStatus": []interface{}{
+				map[string]interface{}{"application": "app-b", "status": "Progressing", "step": "2"},
+				map[string]interface{}{"application": "app-a", "status": "Pending", "step": "2"},
+				map[string]interface{}{"application": "app-c", "status": "Waiting", "step": "3"},
+				map[string]interface{}{"application": "app-d", "status": "Healthy", "step": "1"},
+			},
+		},
+	}
+}
+
+func TestCondition(t *testing.T) {
+	appSet := newAppSet()
+	assert.Equal(t, map[string]interface{}{
+		"type":    "ErrorOccurred",
+		"status":  "True",
+		"reason":  "ApplicationGenerationFromParamsError",
+		"message": "boom",
+	}, condition(appSet, "ErrorOccurred"))
+	assert.Nil(t, condition(appSet, "ResourcesUpToDate"))
+	assert.Nil(t, condition(appSet, "RolloutProgressing"))
+}
+
+func TestCurrentStep(t *testing.T) {
+	appSet := newAppSet()
+	assert.Equal(t, "2", currentStep(appSet))
+
+	appSet["status"].(map[string]interface{})["applicationStatus"] = []interface{}{
+		map[string]interface{}{"application": "app-a", "status": "Healthy", "step": "1"},
+	}
+	assert.Equal(t, "", currentStep(appSet))
+}
+
+func TestCurrentStepKey(t *testing.T) {
+	appSet := newAppSet()
+	appSet["metadata"].(map[string]interface{})["generation"] = int64(3)
+	assert.Equal(t, "generation-3:2", currentStepKey(appSet))
+
+	status := appSet["status"].(map[string]interface{})
+	status["conditions"] = append(status["conditions"].([]interface{}),
+		map[string]interface{}{"type": "RolloutProgressing", "status": "True", "reason": "ApplicationSetModified", "message": "started", "lastTransitionTime": "2023-06-01T10:00:00Z"})
+	assert.Equal(t, "1685613600:2", currentStepKey(appSet))
+
+	status["applicationStatus"] = []interface{}{
+		map[string]interface{}{"application": "app-a", "status": "Healthy", "step": "1"},
+	}
+	assert.Equal(t, "", currentStepKey(appSet))
+}
+
+func TestStepApplications(t *testing.T) {
+	appSet := newAppSet()
+	assert.Equal(t, []string{"app-a", "app-b"}, stepApplications(appSet, "2"))
+	assert.Nil(t, stepApplications(appSet, "4"))
+}
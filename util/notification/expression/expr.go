@@ -5,8 +5,11 @@ import (
 
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
 
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/appsets"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/repo"
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/resources"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/strings"
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/syncwindows"
 	"github.com/argoproj/argo-cd/v2/util/notification/expression/time"
 )
 
@@ -16,6 +19,8 @@ func init() {
 	helpers = make(map[string]interface{})
 	register("time", time.NewExprs())
 	register("strings", strings.NewExprs())
+	register("appsets", appsets.NewExprs())
+	register("syncWindows", syncwindows.NewExprs())
 }
 
 func register(namespace string, entry map[string]interface{}) {
@@ -31,6 +36,7 @@ func Spawn(app *unstructured.Unstructured, argocdService service.Service, vars m
 		clone[namespace] = helper
 	}
 	clone["repo"] = repo.NewExprs(argocdService, app)
+	clone["resources"] = resources.NewExprs(argocdService, app)
 
 	return clone
 }
@@ -10,7 +10,10 @@ func TestExpr(t *testing.T) {
 	namespaces := []string{
 		"time",
 		"repo",
+		"resources",
 		"strings",
+		"appsets",
+		"syncWindows",
 	}
 
 	for _, ns := range namespaces {
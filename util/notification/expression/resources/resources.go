@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+)
+
+// Resource is a resource of the application
+type Resource struct {
+	Group     string
+	Version   string
+	Kind      string
+	Namespace string
+	Name      string
+	// Health is the health status of the resource, if known
+	Health string
+	// Message is the health message of the resource
+	Message string
+	// Images are the container images used by the resource
+	Images []string
+}
+
+// podSpecPaths are the paths of the pod specs in the supported kinds of resources
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+func NewExprs(argocdService service.Service, app *unstructured.Unstructured) map[string]interface{} {
+	var tree *v1alpha1.ApplicationTree
+	getTree := func() *v1alpha1.ApplicationTree {
+		if tree == nil {
+			res, err := argocdService.GetAppResourcesTree(context.Background(), app.GetNamespace(), app.GetName())
+			if err != nil {
+				panic(err)
+			}
+			tree = res
+		}
+		return tree
+	}
+	var diffs []*v1alpha1.ResourceDiff
+	getDiffs := func() []*v1alpha1.ResourceDiff {
+		if diffs == nil {
+			res, err := argocdService.GetAppManagedResources(context.Background(), app.GetNamespace(), app.GetName())
+			if err != nil {
+				panic(err)
+			}
+			diffs = res
+		}
+		return diffs
+	}
+	return map[string]interface{}{
+		"GetAll": func() []Resource {
+			return withHealth(getTree())
+		},
+		"WithHealth": func(statuses ...string) []Resource {
+			return withHealth(getTree(), statuses...)
+		},
+		"Degraded": func() []Resource {
+			return withHealth(getTree(), "Degraded")
+		},
+		"Changed": func() []Resource {
+			return changed(getDiffs())
+		},
+		"ChangedImages": func() []string {
+			return changedImages(getDiffs())
+		},
+	}
+}
+
+// withHealth returns the resources of the tree with one of the given health statuses, or all resources if no status
+// is given
+func withHealth(tree *v1alpha1.ApplicationTree, statuses ...string) []Resource {
+	var res []Resource
+	for _, node := range tree.Nodes {
+		resource := Resource{
+			Group:     node.Group,
+			Version:   node.Version,
+			Kind:      node.Kind,
+			Namespace: node.Namespace,
+			Name:      node.Name,
+			Images:    node.Images,
+		}
+		if node.Health != nil {
+			resource.Health = string(node.Health.Status)
+			resource.Message = node.Health.Message
+		}
+		if len(statuses) > 0 && !contains(statuses, resource.Health) {
+			continue
+		}
+		res = append(res, resource)
+	}
+	return res
+}
+
+// changed returns the managed resources whose live state differs from the target state
+func changed(diffs []*v1alpha1.ResourceDiff) []Resource {
+	var res []Resource
+	for _, diff := range diffs {
+		if diff.Hook || !diff.Modified {
+			continue
+		}
+		res = append(res, Resource{
+			Group:     diff.Group,
+			Kind:      diff.Kind,
+			Namespace: diff.Namespace,
+			Name:      diff.Name,
+			Images:    images(diff.TargetState),
+		})
+	}
+	return res
+}
+
+// changedImages returns the container images of the target states of the changed resources which are not used by
+// their live states
+func changedImages(diffs []*v1alpha1.ResourceDiff) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, diff := range diffs {
+		if diff.Hook || !diff.Modified {
+			continue
+		}
+		live := images(diff.LiveState)
+		for _, image := range images(diff.TargetState) {
+			if !contains(live, image) && !seen[image] {
+				seen[image] = true
+				res = append(res, image)
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// images returns the container images of the pod spec of the given resource manifest
+func images(manifest string) []string {
+	if manifest == "" || manifest == "null" {
+		return nil
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(manifest), &obj); err != nil {
+		return nil
+	}
+	var res []string
+	for _, path := range podSpecPaths {
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, ok, err := unstructured.NestedSlice(obj, append(append([]string{}, path...), field)...)
+			if !ok || err != nil {
+				continue
+			}
+			for _, container := range containers {
+				if c, ok := container.(map[string]interface{}); ok {
+					if image, ok := c["image"].(string); ok && image != "" && !contains(res, image) {
+						res = append(res, image)
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/notification/expression/shared"
+)
+
+type fakeService struct {
+	tree  *v1alpha1.ApplicationTree
+	diffs []*v1alpha1.ResourceDiff
+	calls int
+}
+
+func (s *fakeService) GetCommitMetadata(context.Context, string, string) (*shared.CommitMetadata, error) {
+	return nil, nil
+}
+
+func (s *fakeService) GetAppDetails(context.Context, *v1alpha1.ApplicationSource) (*shared.AppDetail, error) {
+	return nil, nil
+}
+
+func (s *fakeService) GetAppResourcesTree(context.Context, string, string) (*v1alpha1.ApplicationTree, error) {
+	s.calls++
+	return s.tree, nil
+}
+
+func (s *fakeService) GetAppManagedResources(context.Context, string, string) ([]*v1alpha1.ResourceDiff, error) {
+	s.calls++
+	return s.diffs, nil
+}
+
+func newApp() *unstructured.Unstructured {
+	app := &unstructured.Unstructured{}
+	app.SetNamespace("argocd")
+	app.SetName("guestbook")
+	return app
+}
+
+func TestWithHealth(t *testing.T) {
+	svc := &fakeService{tree: &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{
+			ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook-1"},
+			Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, Message: "CrashLoopBackOff"},
+			Images:      []string{"guestbook:v2"},
+		},
+		{
+			ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+			Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+		},
+		{
+			ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "guestbook"},
+		},
+	}}}
+	exprs := NewExprs(svc, newApp())
+
+	degraded := exprs["Degraded"].(func() []Resource)()
+	assert.Equal(t, []Resource{{
+		Version:   "v1",
+		Kind:      "Pod",
+		Namespace: "default",
+		Name:      "guestbook-1",
+		Health:    "Degraded",
+		Message:   "CrashLoopBackOff",
+		Images:    []string{"guestbook:v2"},
+	}}, degraded)
+
+	unhealthy := exprs["WithHealth"].(func(...string) []Resource)("Degraded", "Progressing")
+	assert.Len(t, unhealthy, 2)
+
+	all := exprs["GetAll"].(func() []Resource)()
+	assert.Len(t, all, 3)
+	assert.Equal(t, "", all[2].Health)
+
+	assert.Equal(t, 1, svc.calls)
+}
+
+func TestChanged(t *testing.T) {
+	svc := &fakeService{diffs: []*v1alpha1.ResourceDiff{
+		{
+			Group:       "apps",
+			Kind:        "Deployment",
+			Namespace:   "default",
+			Name:        "guestbook",
+			TargetState: `{"spec":{"template":{"spec":{"initContainers":[{"image":"init:v1"}],"containers":[{"image":"guestbook:v2"},{"image":"sidecar:v1"}]}}}}`,
+			LiveState:   `{"spec":{"template":{"spec":{"initContainers":[{"image":"init:v1"}],"containers":[{"image":"guestbook:v1"},{"image":"sidecar:v1"}]}}}}`,
+			Modified:    true,
+		},
+		{
+			Group:       "batch",
+			Kind:        "CronJob",
+			Namespace:   "default",
+			Name:        "cleanup",
+			TargetState: `{"spec":{"jobTemplate":{"spec":{"template":{"spec":{"containers":[{"image":"cleanup:v2"}]}}}}}}`,
+			LiveState:   "null",
+			Modified:    true,
+		},
+		{
+			Kind:        "Pod",
+			Namespace:   "default",
+			Name:        "migrate",
+			TargetState: `{"spec":{"containers":[{"image":"migrate:v2"}]}}`,
+			Hook:        true,
+			Modified:    true,
+		},
+		{
+			Kind:        "Pod",
+			Namespace:   "default",
+			Name:        "unchanged",
+			TargetState: `{"spec":{"containers":[{"image":"unchanged:v1"}]}}`,
+			LiveState:   `{"spec":{"containers":[{"image":"unchanged:v1"}]}}`,
+		},
+	}}
+	exprs := NewExprs(svc, newApp())
+
+	changed := exprs["Changed"].(func() []Resource)()
+	if assert.Len(t, changed, 2) {
+		assert.Equal(t, "guestbook", changed[0].Name)
+		assert.Equal(t, []string{"init:v1", "guestbook:v2", "sidecar:v1"}, changed[0].Images)
+		assert.Equal(t, "cleanup", changed[1].Name)
+	}
+
+	images := exprs["ChangedImages"].(func() []string)()
+	assert.Equal(t, []string{"cleanup:v2", "guestbook:v2"}, images)
+	assert.Equal(t, 1, svc.calls)
+}
@@ -0,0 +1,58 @@
+package syncwindows
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func NewExprs() map[string]interface{} {
+	return map[string]interface{}{
+		"Active":    active,
+		"ActiveKey": activeKey,
+	}
+}
+
+func activeWindows(obj map[string]interface{}) v1alpha1.SyncWindows {
+	var proj v1alpha1.AppProject
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, &proj); err != nil {
+		panic(err)
+	}
+	if !proj.Spec.SyncWindows.HasWindows() {
+		return nil
+	}
+	if windows := proj.Spec.SyncWindows.Active(); windows != nil {
+		return *windows
+	}
+	return nil
+}
+
+// active returns the sync windows of the project which are currently open
+func active(obj map[string]interface{}) []map[string]interface{} {
+	var res []map[string]interface{}
+	for _, window := range activeWindows(obj) {
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(window)
+		if err != nil {
+			panic(err)
+		}
+		res = append(res, un)
+	}
+	return res
+}
+
+// activeKey returns a key which identifies the set of sync windows of the project which are currently open, including
+// the times at which they opened, so that the key changes whenever a window opens again, or an empty string if no
+// window is open
+func activeKey(obj map[string]interface{}) string {
+	var keys []string
+	for _, window := range activeWindows(obj) {
+		openedAt, _ := window.OpenedAt()
+		keys = append(keys, fmt.Sprintf("%s:%s:%s:%d", window.Kind, window.Schedule, window.Duration, openedAt.Unix()))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
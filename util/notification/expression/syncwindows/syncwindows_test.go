@@ -0,0 +1,42 @@
+package syncwindows
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newProject(windows ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "AppProject",
+		"metadata":   map[string]interface{}{"name": "default"},
+		"spec":       map[string]interface{}{"syncWindows": windows},
+	}
+}
+
+func TestActive(t *testing.T) {
+	proj := newProject(
+		map[string]interface{}{"kind": "allow", "schedule": "* * * * *", "duration": "1h"},
+		map[string]interface{}{"kind": "deny", "schedule": "0 0 1 1 *", "duration": "1m"},
+	)
+	windows := active(proj)
+	if assert.Len(t, windows, 1) {
+		assert.Equal(t, "allow", windows[0]["kind"])
+		assert.Equal(t, "* * * * *", windows[0]["schedule"])
+	}
+	assert.Nil(t, active(newProject()))
+}
+
+func TestActiveKey(t *testing.T) {
+	proj := newProject(
+		map[string]interface{}{"kind": "deny", "schedule": "0 0 * * *", "duration": "48h"},
+		map[string]interface{}{"kind": "allow", "schedule": "0 0 * * *", "duration": "24h"},
+	)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.Add(-24 * time.Hour)
+	assert.Equal(t, fmt.Sprintf("allow:0 0 * * *:24h:%d,deny:0 0 * * *:48h:%d", today.Unix(), yesterday.Unix()), activeKey(proj))
+	assert.Equal(t, "", activeKey(newProject()))
+}
@@ -0,0 +1,61 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// KindDigest is the kind of the objects digest notifications are rendered for
+	KindDigest = "Digest"
+
+	// digestKeyPrefix is the prefix of the keys of the digest definitions in the notifications ConfigMap
+	digestKeyPrefix = "digest."
+	// defaultDigestMaxEvents is the default maximum number of events of a digest notification
+	defaultDigestMaxEvents = 1000
+)
+
+// Digest collects the notifications of a trigger about applications, and sends them as a single notification to each
+// subscriber on a schedule
+type Digest struct {
+	// Schedule is the cron schedule of the digest notifications
+	Schedule string `json:"schedule"`
+	// Trigger is the trigger whose notifications are collected
+	Trigger string `json:"trigger"`
+	// Send are the templates of the digest notifications
+	Send []string `json:"send"`
+	// MaxEvents is the maximum number of events collected for a subscriber until the next digest notification
+	MaxEvents int `json:"maxEvents,omitempty"`
+}
+
+// ParseDigests parses the digest definitions of the notifications ConfigMap data
+func ParseDigests(data map[string]string) (map[string]Digest, error) {
+	digests := map[string]Digest{}
+	for k, v := range data {
+		if !strings.HasPrefix(k, digestKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, digestKeyPrefix)
+		var digest Digest
+		if err := yaml.Unmarshal([]byte(v), &digest); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal digest %s: %w", name, err)
+		}
+		if _, err := cron.ParseStandard(digest.Schedule); err != nil {
+			return nil, fmt.Errorf("invalid schedule of digest %s: %w", name, err)
+		}
+		if digest.Trigger == "" {
+			return nil, fmt.Errorf("digest %s has no trigger", name)
+		}
+		if len(digest.Send) == 0 {
+			return nil, fmt.Errorf("digest %s has no templates", name)
+		}
+		if digest.MaxEvents <= 0 {
+			digest.MaxEvents = defaultDigestMaxEvents
+		}
+		digests[name] = digest
+	}
+	return digests, nil
+}
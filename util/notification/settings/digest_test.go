@@ -0,0 +1,33 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDigests(t *testing.T) {
+	digests, err := ParseDigests(map[string]string{
+		"trigger.on-deployed": "- when: 'true'\n  send: [app-deployed]",
+		"digest.daily":        "schedule: '0 9 * * *'\ntrigger: on-deployed\nsend: [app-deployed-digest]",
+		"digest.hourly":       "schedule: '@hourly'\ntrigger: on-deployed\nsend: [app-deployed-digest]\nmaxEvents: 10",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Digest{
+		"daily":  {Schedule: "0 9 * * *", Trigger: "on-deployed", Send: []string{"app-deployed-digest"}, MaxEvents: defaultDigestMaxEvents},
+		"hourly": {Schedule: "@hourly", Trigger: "on-deployed", Send: []string{"app-deployed-digest"}, MaxEvents: 10},
+	}, digests)
+
+	for name, data := range map[string]string{
+		"invalid schedule": "schedule: 'daily'\ntrigger: on-deployed\nsend: [app-deployed-digest]",
+		"no trigger":       "schedule: '@daily'\nsend: [app-deployed-digest]",
+		"no templates":     "schedule: '@daily'\ntrigger: on-deployed",
+		"invalid yaml":     "schedule: [",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseDigests(map[string]string{"digest.invalid": data})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package settings
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/argoproj/notifications-engine/pkg/api"
 	"github.com/argoproj/notifications-engine/pkg/services"
 	v1 "k8s.io/api/core/v1"
@@ -12,6 +15,85 @@ import (
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
 )
 
+const (
+	KindApplication    = "Application"
+	KindApplicationSet = "ApplicationSet"
+	KindAppProject     = "AppProject"
+
+	// triggerKeyPrefix is the prefix of the keys of the trigger definitions in the notifications ConfigMap
+	triggerKeyPrefix = "trigger."
+)
+
+// TriggerCondition is a condition of a trigger as defined in the notifications ConfigMap. Besides the fields of the
+// conditions of the notifications engine, it holds the kind of the resources the trigger is evaluated for, which is
+// ignored by the engine.
+type TriggerCondition struct {
+	OncePer     string   `json:"oncePer,omitempty"`
+	When        string   `json:"when,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Send        []string `json:"send,omitempty"`
+	// Kind is the kind of the resources the trigger is evaluated for
+	Kind string `json:"kind,omitempty"`
+}
+
+// ParseTriggerKinds returns the kinds of the resources the triggers of the notifications ConfigMap data are evaluated
+// for. The kind is set with the kind field of the conditions of a trigger, which must be the same for all its
+// conditions, and defaults to Application.
+func ParseTriggerKinds(data map[string]string) (map[string]string, error) {
+	kinds := map[string]string{}
+	for k, v := range data {
+		if !strings.HasPrefix(k, triggerKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, triggerKeyPrefix)
+		var conditions []TriggerCondition
+		if err := yaml.Unmarshal([]byte(v), &conditions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal trigger %s: %w", name, err)
+		}
+		kind := ""
+		for _, condition := range conditions {
+			conditionKind := condition.Kind
+			if conditionKind == "" {
+				conditionKind = KindApplication
+			}
+			switch conditionKind {
+			case KindApplication, KindApplicationSet, KindAppProject:
+			default:
+				return nil, fmt.Errorf("invalid kind %s of trigger %s", condition.Kind, name)
+			}
+			if kind != "" && kind != conditionKind {
+				return nil, fmt.Errorf("conditions of trigger %s have different kinds", name)
+			}
+			kind = conditionKind
+		}
+		if kind == "" {
+			kind = KindApplication
+		}
+		kinds[name] = kind
+	}
+	return kinds, nil
+}
+
+// TriggerKind returns the kind of the resources the trigger is evaluated for, given the kinds of the triggers returned
+// by ParseTriggerKinds. Unknown triggers are evaluated for applications.
+func TriggerKind(kinds map[string]string, trigger string) string {
+	if kind, ok := kinds[trigger]; ok {
+		return kind
+	}
+	return KindApplication
+}
+
+// FilterDestinations drops the subscriptions to triggers which are not evaluated for the given kind of resources
+func FilterDestinations(destinations services.Destinations, kinds map[string]string, kind string) services.Destinations {
+	res := services.Destinations{}
+	for trigger, dests := range destinations {
+		if TriggerKind(kinds, trigger) == kind {
+			res[trigger] = dests
+		}
+	}
+	return res
+}
+
 func GetFactorySettings(argocdService service.Service, secretName, configMapName string) api.Settings {
 	return api.Settings{
 		SecretName:    secretName,
@@ -34,9 +116,20 @@ func initGetVars(argocdService service.Service, cfg *api.Config, configMap *v1.C
 	}
 
 	return func(obj map[string]interface{}, dest services.Destination) map[string]interface{} {
-		return expression.Spawn(&unstructured.Unstructured{Object: obj}, argocdService, map[string]interface{}{
-			"app":     obj,
+		un := &unstructured.Unstructured{Object: obj}
+		vars := map[string]interface{}{
 			"context": injectLegacyVar(context, dest.Service),
-		})
+		}
+		switch un.GetKind() {
+		case KindApplicationSet:
+			vars["appset"] = obj
+		case KindAppProject:
+			vars["project"] = obj
+		case KindDigest:
+			vars["digest"] = obj
+		default:
+			vars["app"] = obj
+		}
+		return expression.Spawn(un, argocdService, vars)
 	}, nil
 }
@@ -0,0 +1,55 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTriggerKinds(t *testing.T) {
+	kinds, err := ParseTriggerKinds(map[string]string{
+		"trigger.on-sync-succeeded":             "- when: app.status.operationState.phase in ['Succeeded']\n  send: [app-sync-succeeded]",
+		"trigger.on-appset-error":               "- when: appsets.Condition(appset, 'ErrorOccurred') != nil\n  kind: ApplicationSet\n  send: [appset-error]",
+		"trigger.on-project-sync-window-opened": "- when: syncWindows.ActiveKey(project) != ''\n  kind: AppProject\n  send: [project-sync-window-opened]",
+		"template.app-sync-succeeded":           "message: synced",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"on-sync-succeeded":             KindApplication,
+		"on-appset-error":               KindApplicationSet,
+		"on-project-sync-window-opened": KindAppProject,
+	}, kinds)
+
+	_, err = ParseTriggerKinds(map[string]string{"trigger.on-error": "- when: 'true'\n  kind: Pod"})
+	assert.EqualError(t, err, "invalid kind Pod of trigger on-error")
+	_, err = ParseTriggerKinds(map[string]string{"trigger.on-error": "- when: 'true'\n  kind: AppProject\n- when: 'false'"})
+	assert.EqualError(t, err, "conditions of trigger on-error have different kinds")
+}
+
+func TestTriggerKind(t *testing.T) {
+	kinds := map[string]string{"on-appset-error": KindApplicationSet}
+	assert.Equal(t, KindApplicationSet, TriggerKind(kinds, "on-appset-error"))
+	assert.Equal(t, KindApplication, TriggerKind(kinds, "on-sync-succeeded"))
+	assert.Equal(t, KindApplication, TriggerKind(nil, "on-project-sync-window-opened"))
+}
+
+func TestFilterDestinations(t *testing.T) {
+	destinations := services.Destinations{
+		"on-sync-succeeded":             {{Service: "slack", Recipient: "app"}},
+		"on-appset-error":               {{Service: "slack", Recipient: "appset"}},
+		"on-project-sync-window-opened": {{Service: "slack", Recipient: "project"}},
+	}
+	kinds := map[string]string{
+		"on-sync-succeeded":             KindApplication,
+		"on-appset-error":               KindApplicationSet,
+		"on-project-sync-window-opened": KindAppProject,
+	}
+	assert.Equal(t, services.Destinations{
+		"on-appset-error": {{Service: "slack", Recipient: "appset"}},
+	}, FilterDestinations(destinations, kinds, KindApplicationSet))
+	assert.Equal(t, services.Destinations{
+		"on-sync-succeeded": {{Service: "slack", Recipient: "app"}},
+	}, FilterDestinations(destinations, kinds, KindApplication))
+}
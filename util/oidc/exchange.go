@@ -0,0 +1,171 @@
+package oidc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// ExternalIdentity is the identity of the subject of a token issued by a trusted issuer
+type ExternalIdentity struct {
+	// Issuer is the name of the trusted issuer
+	Issuer string
+	// Subject is the value of the subject claim of the token
+	Subject string
+	// Groups are the groups of the subject, derived from the claims of the token
+	Groups []string
+}
+
+// TokenExchanger verifies tokens of trusted issuers, e.g. CI systems or Kubernetes service accounts, so that they
+// can be exchanged for Argo CD sessions
+type TokenExchanger struct {
+	lock      sync.Mutex
+	providers map[string]*providerImpl
+	// newClient returns the HTTP client used to query the issuer
+	newClient func(issuer *settings.TrustedIssuer) *http.Client
+}
+
+// NewTokenExchanger returns a new token exchanger
+func NewTokenExchanger() *TokenExchanger {
+	return &TokenExchanger{
+		providers: make(map[string]*providerImpl),
+		newClient: func(issuer *settings.TrustedIssuer) *http.Client {
+			return &http.Client{
+				Transport: &http.Transport{
+					Proxy: http.ProxyFromEnvironment,
+					Dial: (&net.Dialer{
+						Timeout:   30 * time.Second,
+						KeepAlive: 30 * time.Second,
+					}).Dial,
+					TLSClientConfig:       issuer.TLSConfig(),
+					TLSHandshakeTimeout:   10 * time.Second,
+					ExpectContinueTimeout: 1 * time.Second,
+				},
+			}
+		},
+	}
+}
+
+// Verify verifies the signature, expiry and audience of a token issued by one of the trusted issuers, and returns
+// the identity of its subject
+func (e *TokenExchanger) Verify(tokenString string, issuers []settings.TrustedIssuer) (*ExternalIdentity, error) {
+	var unverifiedClaims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified(tokenString, &unverifiedClaims); err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	var issuer *settings.TrustedIssuer
+	for i := range issuers {
+		if issuers[i].Issuer == unverifiedClaims.Issuer {
+			issuer = &issuers[i]
+			break
+		}
+	}
+	if issuer == nil {
+		return nil, fmt.Errorf("issuer %q is not trusted", unverifiedClaims.Issuer)
+	}
+
+	prov := e.provider(issuer)
+	var idToken *gooidc.IDToken
+	var err error
+	// Token must be verified for at least one allowed audience
+	for _, aud := range issuer.Audiences {
+		idToken, err = prov.verify(aud, tokenString, false)
+		tokenExpiredError := &gooidc.TokenExpiredError{}
+		if err == nil || errors.As(err, &tokenExpiredError) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	var claims jwt.MapClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	subject, ok := claims[issuer.GetSubjectClaim()].(string)
+	if !ok || subject == "" {
+		return nil, fmt.Errorf("token does not have a %s claim", issuer.GetSubjectClaim())
+	}
+	return &ExternalIdentity{
+		Issuer:  issuer.Name,
+		Subject: subject,
+		Groups:  mapClaimsToGroups(claims, issuer),
+	}, nil
+}
+
+// provider returns the memoized provider of the issuer. A new provider is created if the TLS configuration of the
+// issuer changed.
+func (e *TokenExchanger) provider(issuer *settings.TrustedIssuer) *providerImpl {
+	key := issuer.Issuer + "\n" + issuer.RootCA
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	prov, ok := e.providers[key]
+	if !ok {
+		prov = &providerImpl{issuerURL: issuer.Issuer, client: e.newClient(issuer)}
+		e.providers[key] = prov
+	}
+	return prov
+}
+
+// mapClaimsToGroups returns the values of the groups claim and the groups of all matching group mappings
+func mapClaimsToGroups(claims jwt.MapClaims, issuer *settings.TrustedIssuer) []string {
+	var groups []string
+	seen := make(map[string]bool)
+	add := func(group string) {
+		if group != "" && !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	if issuer.GroupsClaim != "" {
+		for _, group := range claimValues(claims, issuer.GroupsClaim) {
+			add(group)
+		}
+	}
+	for _, mapping := range issuer.GroupMappings {
+		if claimMatches(claimValues(claims, mapping.Claim), mapping.Values) {
+			for _, group := range mapping.Groups {
+				add(group)
+			}
+		}
+	}
+	return groups
+}
+
+// claimValues returns the value of a string claim, or the string values of a list claim
+func claimValues(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func claimMatches(values []string, patterns []string) bool {
+	for _, value := range values {
+		for _, pattern := range patterns {
+			if glob.Match(pattern, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
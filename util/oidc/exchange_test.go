@@ -0,0 +1,105 @@
+package oidc
+
+import (
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/test"
+)
+
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(test.PrivateKey)
+	require.NoError(t, err)
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodRS512, claims).SignedString(key)
+	require.NoError(t, err)
+	return tokenString
+}
+
+func TestTokenExchanger_Verify(t *testing.T) {
+	oidcTestServer := test.GetOIDCTestServer(t)
+	t.Cleanup(oidcTestServer.Close)
+	rootCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: oidcTestServer.TLS.Certificates[0].Certificate[0]}))
+
+	issuers := []settings.TrustedIssuer{{
+		Name:        "ci",
+		Issuer:      oidcTestServer.URL,
+		Audiences:   []string{"other", "argocd"},
+		RootCA:      rootCA,
+		GroupsClaim: "teams",
+		GroupMappings: []settings.ClaimGroupMapping{
+			{Claim: "repository", Values: []string{"my-org/*"}, Groups: []string{"my-org-ci"}},
+			{Claim: "repository", Values: []string{"other-org/*"}, Groups: []string{"other-org-ci"}},
+		},
+	}}
+	claims := func(aud string, exp time.Duration) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":        oidcTestServer.URL,
+			"sub":        "repo:my-org/app:ref:refs/heads/main",
+			"aud":        aud,
+			"exp":        time.Now().Add(exp).Unix(),
+			"repository": "my-org/app",
+			"teams":      []string{"deployers", "my-org-ci"},
+		}
+	}
+	exchanger := NewTokenExchanger()
+
+	t.Run("Valid", func(t *testing.T) {
+		identity, err := exchanger.Verify(signTestToken(t, claims("argocd", time.Hour)), issuers)
+		require.NoError(t, err)
+		assert.Equal(t, &ExternalIdentity{
+			Issuer:  "ci",
+			Subject: "repo:my-org/app:ref:refs/heads/main",
+			Groups:  []string{"deployers", "my-org-ci"},
+		}, identity)
+	})
+	t.Run("WrongAudience", func(t *testing.T) {
+		_, err := exchanger.Verify(signTestToken(t, claims("someone-else", time.Hour)), issuers)
+		assert.Error(t, err)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, err := exchanger.Verify(signTestToken(t, claims("argocd", -time.Hour)), issuers)
+		assert.ErrorContains(t, err, "expired")
+	})
+	t.Run("UntrustedIssuer", func(t *testing.T) {
+		c := claims("argocd", time.Hour)
+		c["iss"] = "https://untrusted.example.com"
+		_, err := exchanger.Verify(signTestToken(t, c), issuers)
+		assert.ErrorContains(t, err, "is not trusted")
+	})
+	t.Run("InvalidSignature", func(t *testing.T) {
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(test.PrivateKey2)
+		require.NoError(t, err)
+		tokenString, err := jwt.NewWithClaims(jwt.SigningMethodRS512, claims("argocd", time.Hour)).SignedString(key)
+		require.NoError(t, err)
+		_, err = exchanger.Verify(tokenString, issuers)
+		assert.Error(t, err)
+	})
+	t.Run("MissingSubjectClaim", func(t *testing.T) {
+		customIssuers := []settings.TrustedIssuer{issuers[0]}
+		customIssuers[0].SubjectClaim = "workflow"
+		_, err := exchanger.Verify(signTestToken(t, claims("argocd", time.Hour)), customIssuers)
+		assert.ErrorContains(t, err, "does not have a workflow claim")
+	})
+}
+
+func TestMapClaimsToGroups(t *testing.T) {
+	issuer := &settings.TrustedIssuer{
+		GroupMappings: []settings.ClaimGroupMapping{
+			{Claim: "sub", Values: []string{"system:serviceaccount:ci:*"}, Groups: []string{"ci", "deployers"}},
+			{Claim: "namespaces", Values: []string{"prod"}, Groups: []string{"prod-deployers"}},
+		},
+	}
+	groups := mapClaimsToGroups(jwt.MapClaims{
+		"sub":        "system:serviceaccount:ci:deployer",
+		"namespaces": []interface{}{"dev", "prod"},
+	}, issuer)
+	assert.Equal(t, []string{"ci", "deployers", "prod-deployers"}, groups)
+
+	assert.Empty(t, mapClaimsToGroups(jwt.MapClaims{"sub": "system:serviceaccount:default:deployer"}, issuer))
+}
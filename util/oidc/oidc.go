@@ -1,6 +1,7 @@
 package oidc
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -70,6 +71,14 @@ type ClientApp struct {
 	encryptionKey []byte
 	// provider is the OIDC provider
 	provider Provider
+	// onLogin is called with the ID token and its claims after each successful login
+	onLogin func(ctx context.Context, idToken string, claims jwt.MapClaims)
+}
+
+// SetLoginHandler sets the function which is called with the ID token and its claims after each successful login,
+// e.g. to record the login session
+func (a *ClientApp) SetLoginHandler(onLogin func(ctx context.Context, idToken string, claims jwt.MapClaims)) {
+	a.onLogin = onLogin
 }
 
 func GetScopesOrDefault(scopes []string) []string {
@@ -389,6 +398,10 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if a.onLogin != nil {
+		a.onLogin(r.Context(), idTokenRAW, claims)
+	}
+
 	claimsJSON, _ := json.Marshal(claims)
 	log.Infof("Web login successful. Claims: %s", claimsJSON)
 	if os.Getenv(common.EnvVarSSODebug) == "1" {
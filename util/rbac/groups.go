@@ -0,0 +1,172 @@
+package rbac
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMapGroupsKey designates the key of the local group definitions in the RBAC ConfigMap
+const ConfigMapGroupsKey = "groups"
+
+// LocalGroup is a group defined in the RBAC ConfigMap. Local groups can be used as subjects of policies like the
+// groups of SSO users, and let local accounts be members of groups.
+type LocalGroup struct {
+	// Name is the name of the group used in policies
+	Name string `json:"name"`
+	// Description is an optional description of the group
+	Description string `json:"description,omitempty"`
+	// Accounts are the local accounts which are members of the group
+	Accounts []string `json:"accounts,omitempty"`
+	// Subjects are the SSO subjects, e.g. users or groups of the identity provider, which are members of the group
+	Subjects []string `json:"subjects,omitempty"`
+}
+
+// Members returns the accounts and subjects which are members of the group
+func (g *LocalGroup) Members() []string {
+	return append(append([]string{}, g.Accounts...), g.Subjects...)
+}
+
+// ParseLocalGroups parses and validates the local group definitions of the RBAC ConfigMap data
+func ParseLocalGroups(data map[string]string) ([]LocalGroup, error) {
+	value, ok := data[ConfigMapGroupsKey]
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var groups []LocalGroup
+	if err := yaml.Unmarshal([]byte(value), &groups); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", ConfigMapGroupsKey, err)
+	}
+	names := make(map[string]bool)
+	for _, group := range groups {
+		if group.Name == "" {
+			return nil, fmt.Errorf("invalid %s: groups must have a name", ConfigMapGroupsKey)
+		}
+		if strings.HasPrefix(group.Name, "role:") || strings.HasPrefix(group.Name, "proj:") || strings.ContainsAny(group.Name, ",\"\n") {
+			return nil, fmt.Errorf("invalid %s: invalid group name %q", ConfigMapGroupsKey, group.Name)
+		}
+		if names[group.Name] {
+			return nil, fmt.Errorf("invalid %s: duplicate group %q", ConfigMapGroupsKey, group.Name)
+		}
+		names[group.Name] = true
+		for _, member := range group.Members() {
+			if member == "" || strings.ContainsAny(member, ",\"\n") {
+				return nil, fmt.Errorf("invalid %s: invalid member %q of group %s", ConfigMapGroupsKey, member, group.Name)
+			}
+		}
+	}
+	return groups, nil
+}
+
+// localGroupsPolicyCSV returns the grouping policies which assign the members of the local groups to the groups
+func localGroupsPolicyCSV(groups []LocalGroup) string {
+	var strBuilder strings.Builder
+	for _, group := range groups {
+		for _, member := range group.Members() {
+			strBuilder.WriteString(fmt.Sprintf("g, %s, %s\n", member, group.Name))
+		}
+	}
+	return strBuilder.String()
+}
+
+// GroupWarnings returns warnings about local groups which are referenced by the policy, but have no known members,
+// e.g. because all member accounts were deleted or disabled. The accounts map the names of the local accounts to
+// whether they are enabled. If accounts is nil, the member accounts are not checked.
+// The ssoIdentities are the subjects and groups of the active SSO sessions. If they are not nil, SSO subjects of local
+// groups and of permission and grouping policies which are not among them are reported, since they might refer to a
+// group which was renamed in the identity provider. Roles assigned by grouping policies are not reported.
+func GroupWarnings(policyCSV string, groups []LocalGroup, accounts map[string]bool, ssoIdentities map[string]bool) []string {
+	referenced := referencedSubjects(policyCSV)
+	var warnings []string
+	local := make(map[string]bool)
+	for _, group := range groups {
+		local[group.Name] = true
+		known := 0
+		for _, subject := range group.Subjects {
+			local[subject] = true
+			if ssoIdentities != nil && !ssoIdentities[subject] {
+				warnings = append(warnings, fmt.Sprintf("group %s has member subject %s which is not used by any active SSO session", group.Name, subject))
+				continue
+			}
+			known++
+		}
+		for _, account := range group.Accounts {
+			local[account] = true
+			if accounts == nil {
+				known++
+				continue
+			}
+			enabled, ok := accounts[account]
+			switch {
+			case !ok:
+				warnings = append(warnings, fmt.Sprintf("group %s has member account %s which does not exist", group.Name, account))
+			case !enabled:
+				warnings = append(warnings, fmt.Sprintf("group %s has member account %s which is disabled", group.Name, account))
+			default:
+				known++
+			}
+		}
+		if known == 0 && referenced[group.Name] {
+			warnings = append(warnings, fmt.Sprintf("group %s is referenced by the policy, but has no known members", group.Name))
+		}
+	}
+	if ssoIdentities == nil {
+		return warnings
+	}
+	roles := groupingRoles(policyCSV)
+	var subjects []string
+	for subject := range referenced {
+		_, isAccount := accounts[subject]
+		if isAccount || local[subject] || roles[subject] || ssoIdentities[subject] || strings.HasPrefix(subject, "role:") || strings.HasPrefix(subject, "proj:") {
+			continue
+		}
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+	for _, subject := range subjects {
+		warnings = append(warnings, fmt.Sprintf("subject %s of the policy is not used by any active SSO session", subject))
+	}
+	return warnings
+}
+
+// referencedSubjects returns the subjects of the permission and grouping policies of the given policy CSV
+func referencedSubjects(policyCSV string) map[string]bool {
+	return policySubjects(policyCSV, "p", "g")
+}
+
+// groupingRoles returns the roles which are assigned by the grouping policies of the given policy CSV
+func groupingRoles(policyCSV string) map[string]bool {
+	return policyFields(policyCSV, 2, "g")
+}
+
+// policySubjects returns the subjects of the policies of the given types of the given policy CSV
+func policySubjects(policyCSV string, types ...string) map[string]bool {
+	return policyFields(policyCSV, 1, types...)
+}
+
+// policyFields returns the values of the field with the given index of the policies of the given types of the given
+// policy CSV
+func policyFields(policyCSV string, index int, types ...string) map[string]bool {
+	values := make(map[string]bool)
+	for _, line := range strings.Split(policyCSV, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(line))
+		reader.TrimLeadingSpace = true
+		record, err := reader.Read()
+		if err != nil || len(record) <= index {
+			continue
+		}
+		for _, t := range types {
+			if record[0] == t {
+				values[record[index]] = true
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,125 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testLocalGroups = `
+- name: platform-team
+  description: Operators of the platform
+  accounts: [alice, bob]
+  subjects: [platform-admins]
+- name: auditors
+  accounts: [carol]
+`
+
+func TestParseLocalGroups(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		groups, err := ParseLocalGroups(map[string]string{})
+		require.NoError(t, err)
+		assert.Empty(t, groups)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		groups, err := ParseLocalGroups(map[string]string{ConfigMapGroupsKey: testLocalGroups})
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+		assert.Equal(t, []string{"alice", "bob", "platform-admins"}, groups[0].Members())
+		assert.Equal(t, "g, alice, platform-team\ng, bob, platform-team\ng, platform-admins, platform-team\ng, carol, auditors\n", localGroupsPolicyCSV(groups))
+	})
+	for name, value := range map[string]string{
+		"Malformed":     `name: platform-team`,
+		"NoName":        `[{"accounts": ["alice"]}]`,
+		"RoleName":      `[{"name": "role:admin", "accounts": ["alice"]}]`,
+		"ProjectName":   `[{"name": "proj:default:ci", "accounts": ["alice"]}]`,
+		"DuplicateName": `[{"name": "a", "accounts": ["alice"]}, {"name": "a", "accounts": ["bob"]}]`,
+		"InvalidMember": `[{"name": "a", "subjects": ["alice, role:admin"]}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseLocalGroups(map[string]string{ConfigMapGroupsKey: value})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLocalGroupsEnforcement(t *testing.T) {
+	enf := NewEnforcer(fake.NewSimpleClientset(), fakeNamespace, fakeConfigMapName, nil)
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyCSVKey] = `
+p, platform-team, clusters, update, *, allow
+g, auditors, role:readonly
+`
+	cm.Data[ConfigMapGroupsKey] = testLocalGroups
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	require.NoError(t, enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow`))
+
+	assert.True(t, enf.Enforce("alice", "clusters", "update", "https://kubernetes.default.svc"))
+	assert.True(t, enf.Enforce("platform-admins", "clusters", "update", "https://kubernetes.default.svc"))
+	assert.False(t, enf.Enforce("carol", "clusters", "update", "https://kubernetes.default.svc"))
+	assert.True(t, enf.Enforce("carol", "applications", "get", "default/guestbook"))
+	assert.False(t, enf.Enforce("dave", "applications", "get", "default/guestbook"))
+
+	cm.Data[ConfigMapGroupsKey] = `[{"name": "role:admin", "accounts": ["dave"]}]`
+	assert.Error(t, enf.syncUpdate(cm, noOpUpdate))
+}
+
+func TestGroupWarnings(t *testing.T) {
+	groups, err := ParseLocalGroups(map[string]string{ConfigMapGroupsKey: `
+- name: platform-team
+  accounts: [alice, bob]
+- name: auditors
+  accounts: [carol]
+- name: sso-team
+  subjects: [platform-admins]
+- name: unused
+`})
+	require.NoError(t, err)
+	policy := `
+p, platform-team, clusters, update, *, allow
+g, auditors, role:readonly
+g, sso-team, role:admin
+`
+
+	t.Run("UnknownAccounts", func(t *testing.T) {
+		assert.Empty(t, GroupWarnings(policy, groups, nil, nil))
+	})
+	t.Run("KnownAccounts", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"group platform-team has member account bob which is disabled",
+			"group auditors has member account carol which does not exist",
+			"group auditors is referenced by the policy, but has no known members",
+		}, GroupWarnings(policy, groups, map[string]bool{"alice": true, "bob": false}, nil))
+	})
+	t.Run("NoMembers", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"group unused is referenced by the policy, but has no known members",
+		}, GroupWarnings("p, unused, applications, get, */*, allow", groups, nil, nil))
+	})
+	t.Run("SSOIdentities", func(t *testing.T) {
+		policy := policy + "g, my-org:old-team, role:admin\ng, my-org:new-team, role:admin\ng, alice, role:admin\n" +
+			"p, my-org:renamed-team, applications, get, */*, allow\np, my-org:new-team, applications, sync, */*, allow\n" +
+			"g, my-org:new-team, deployers\np, deployers, applications, sync, */*, allow\n"
+		assert.Equal(t, []string{
+			"group sso-team has member subject platform-admins which is not used by any active SSO session",
+			"group sso-team is referenced by the policy, but has no known members",
+			"subject my-org:old-team of the policy is not used by any active SSO session",
+			"subject my-org:renamed-team of the policy is not used by any active SSO session",
+		}, GroupWarnings(policy, groups, map[string]bool{"alice": true, "bob": true, "carol": true}, map[string]bool{"my-org:new-team": true}))
+	})
+}
+
+func TestEnforcer_GroupWarnings(t *testing.T) {
+	enf := NewEnforcer(fake.NewSimpleClientset(), fakeNamespace, fakeConfigMapName, nil)
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyCSVKey] = `p, platform-team, clusters, update, *, allow`
+	cm.Data[ConfigMapGroupsKey] = testLocalGroups
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+
+	assert.Equal(t, []string{
+		"group platform-team has member subject platform-admins which is not used by any active SSO session",
+		"group auditors has member account carol which does not exist",
+	}, enf.GroupWarnings(map[string]bool{"alice": true, "bob": true}, map[string]bool{"my-org:team": true}))
+}
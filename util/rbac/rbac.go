@@ -41,8 +41,21 @@ const (
 	RegexMatchMode            = "regex"
 
 	defaultRBACSyncPeriod = 10 * time.Minute
+
+	// TokenScopesClaim is the name of the JWT claim which restricts a token to a subset of the permissions of its
+	// subject. Each scope is of the form <resource>:<action>:<object> and may contain glob patterns.
+	TokenScopesClaim = "tokenScopes"
+
+	// resourceSelectorSegments is the number of segments of a resource selector (<group>/<kind>/<namespace>/<name>)
+	resourceSelectorSegments = 4
 )
 
+// resourceSelectorResources are the RBAC resources whose objects may carry a resource selector after the application
+var resourceSelectorResources = map[string]bool{
+	"applications": true,
+	"logs":         true,
+}
+
 // CasbinEnforcer represents methods that must be implemented by a Casbin enforces
 type CasbinEnforcer interface {
 	EnableLog(bool)
@@ -51,6 +64,8 @@ type CasbinEnforcer interface {
 	EnableEnforce(bool)
 	AddFunction(name string, function govaluate.ExpressionFunction)
 	GetGroupingPolicy() [][]string
+	GetPolicy() [][]string
+	EnforceEx(rvals ...interface{}) (bool, []string, error)
 }
 
 // Enforcer is a wrapper around an Casbin enforcer that:
@@ -72,6 +87,7 @@ type Enforcer struct {
 	model              model.Model
 	defaultRole        string
 	matchMode          string
+	localGroups        []LocalGroup
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
@@ -133,6 +149,7 @@ func (e *Enforcer) tryGetCabinEnforcer(project string, policy string) (CasbinEnf
 	}
 
 	enforcer.AddFunction("globOrRegexMatch", matchFunc)
+	enforcer.AddFunction("appResourceMatch", appResourceMatchFunc(matchFunc))
 	enforcer.EnableLog(e.enableLog)
 	enforcer.EnableEnforce(e.enabled)
 	e.enforcerCache.SetDefault(project, &cachedEnforcer{enforcer: enforcer, policy: policy})
@@ -154,6 +171,7 @@ func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...inter
 		return nil, err
 	}
 	enfs.AddFunction("globOrRegexMatch", matchFunction)
+	enfs.AddFunction("appResourceMatch", appResourceMatchFunc(matchFunction))
 	return enfs, nil
 }
 
@@ -210,6 +228,30 @@ func globMatchFunc(args ...interface{}) (interface{}, error) {
 	return glob.Match(pattern, val), nil
 }
 
+// appResourceMatchFunc returns a function which matches the application of an object with a resource selector, i.e.
+// <project>/[<namespace>/]<application>/<group>/<kind>/<namespace>/<name>, against the object of a policy. This way,
+// policies without a resource selector apply to all resources of an application.
+func appResourceMatchFunc(matchFunc govaluate.ExpressionFunction) govaluate.ExpressionFunction {
+	return func(args ...interface{}) (interface{}, error) {
+		if len(args) < 3 {
+			return false, nil
+		}
+		res, ok := args[0].(string)
+		if !ok || !resourceSelectorResources[res] {
+			return false, nil
+		}
+		obj, ok := args[1].(string)
+		if !ok {
+			return false, nil
+		}
+		parts := strings.Split(obj, "/")
+		if len(parts) != 2+resourceSelectorSegments && len(parts) != 3+resourceSelectorSegments {
+			return false, nil
+		}
+		return matchFunc(strings.Join(parts[:len(parts)-resourceSelectorSegments], "/"), args[2])
+	}
+}
+
 // SetMatchMode set match mode on runtime, glob match or regex match
 func (e *Enforcer) SetMatchMode(mode string) {
 	e.invalidateCache(func() {
@@ -289,8 +331,57 @@ func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...interf
 	return enforce(enf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// tokenScopesPermit returns whether the scopes of the given claims, if any, permit the request
+func tokenScopesPermit(claims jwt.Claims, rvals ...interface{}) bool {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return false
+	}
+	scopes, ok := mapClaims[TokenScopesClaim]
+	if !ok {
+		return true
+	}
+	scopeList, ok := scopes.([]interface{})
+	if !ok {
+		return false
+	}
+	vals := make([]string, len(rvals))
+	for i := range rvals {
+		if vals[i], ok = rvals[i].(string); !ok {
+			return false
+		}
+	}
+	for _, scope := range scopeList {
+		scopeStr, ok := scope.(string)
+		if !ok {
+			continue
+		}
+		patterns := strings.SplitN(scopeStr, ":", len(vals))
+		if len(patterns) != len(vals) {
+			continue
+		}
+		matched := true
+		for i := range vals {
+			if !glob.Match(patterns[i], vals[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 // enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
 func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...interface{}) bool {
+	// tokens restricted to scopes are never allowed to exceed their scopes, not even through the default role
+	if len(rvals) > 0 {
+		if claims, ok := rvals[0].(jwt.Claims); ok && !tokenScopesPermit(claims, rvals[1:]...) {
+			return false
+		}
+	}
 	// check the default role
 	if defaultRole != "" && len(rvals) >= 2 {
 		if ok, err := enf.Enforce(append([]interface{}{defaultRole}, rvals[1:]...)...); ok && err == nil {
@@ -400,6 +491,9 @@ func (e *Enforcer) runInformer(ctx context.Context, onUpdated func(cm *apiv1.Con
 // that matches the policy key name convention:
 //
 //	policy[.overlay].csv
+//
+// The memberships of the local groups are appended as grouping
+// policies.
 func PolicyCSV(data map[string]string) string {
 	var strBuilder strings.Builder
 	// add the main policy first
@@ -424,6 +518,14 @@ func PolicyCSV(data map[string]string) string {
 			strBuilder.WriteString(value)
 		}
 	}
+
+	groups, err := ParseLocalGroups(data)
+	if err != nil {
+		log.Warnf("Ignoring local groups: %v", err)
+	} else if len(groups) > 0 {
+		strBuilder.WriteString("\n")
+		strBuilder.WriteString(localGroupsPolicyCSV(groups))
+	}
 	return strBuilder.String()
 }
 
@@ -431,11 +533,29 @@ func PolicyCSV(data map[string]string) string {
 func (e *Enforcer) syncUpdate(cm *apiv1.ConfigMap, onUpdated func(cm *apiv1.ConfigMap) error) error {
 	e.SetDefaultRole(cm.Data[ConfigMapPolicyDefaultKey])
 	e.SetMatchMode(cm.Data[ConfigMapMatchModeKey])
+	groups, err := ParseLocalGroups(cm.Data)
+	if err != nil {
+		return err
+	}
 	policyCSV := PolicyCSV(cm.Data)
 	if err := onUpdated(cm); err != nil {
 		return err
 	}
-	return e.SetUserPolicy(policyCSV)
+	if err := e.SetUserPolicy(policyCSV); err != nil {
+		return err
+	}
+	e.lock.Lock()
+	e.localGroups = groups
+	e.lock.Unlock()
+	return nil
+}
+
+// GroupWarnings returns the warnings about the local groups of the loaded RBAC ConfigMap, see GroupWarnings
+func (e *Enforcer) GroupWarnings(accounts map[string]bool, ssoIdentities map[string]bool) []string {
+	e.lock.Lock()
+	policyCSV, groups := e.adapter.userDefinedPolicy, e.localGroups
+	e.lock.Unlock()
+	return GroupWarnings(policyCSV, groups, accounts, ssoIdentities)
 }
 
 // ValidatePolicy verifies a policy string is acceptable to casbin
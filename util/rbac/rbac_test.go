@@ -451,6 +451,37 @@ p, alice, clusters, get, "https://github.com/argo[a-z]{4}/argo-[a-z]+.git", allo
 
 }
 
+func TestResourceSelector(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	err := enf.syncUpdate(fakeConfigMap(), noOpUpdate)
+	assert.Nil(t, err)
+	policy := `
+p, oncall, applications, delete, default/*/apps/Deployment/*/*, allow
+p, owner, applications, delete, default/guestbook, allow
+p, owner, applications, delete, default/*//Secret/*/*, deny
+p, viewer, logs, get, default/guestbook, allow
+p, viewer, clusters, get, default/guestbook, allow
+`
+	_ = enf.SetUserPolicy(policy)
+
+	// Policies with resource selector only apply to matching resources
+	assert.True(t, enf.Enforce("oncall", "applications", "delete", "default/guestbook/apps/Deployment/prod/guestbook"))
+	assert.True(t, enf.Enforce("oncall", "applications", "delete", "default/apps/guestbook/apps/Deployment/prod/guestbook"))
+	assert.False(t, enf.Enforce("oncall", "applications", "delete", "default/guestbook//Secret/prod/guestbook"))
+	assert.False(t, enf.Enforce("oncall", "applications", "delete", "default/guestbook"))
+
+	// Policies without resource selector apply to all resources of the application
+	assert.True(t, enf.Enforce("owner", "applications", "delete", "default/guestbook"))
+	assert.True(t, enf.Enforce("owner", "applications", "delete", "default/guestbook/apps/Deployment/prod/guestbook"))
+	assert.False(t, enf.Enforce("owner", "applications", "delete", "default/guestbook//Secret/prod/guestbook"))
+	assert.False(t, enf.Enforce("owner", "applications", "delete", "default/other/apps/Deployment/prod/other"))
+	assert.True(t, enf.Enforce("viewer", "logs", "get", "default/guestbook//Pod/prod/guestbook-1234"))
+
+	// Other resources do not support resource selectors
+	assert.False(t, enf.Enforce("viewer", "clusters", "get", "default/guestbook//Pod/prod/guestbook-1234"))
+}
+
 func TestGlobMatchFunc(t *testing.T) {
 	ok, _ := globMatchFunc("arg1")
 	assert.False(t, ok.(bool))
@@ -521,3 +552,21 @@ func TestLoadPolicyLine(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestEnforceTokenScopes(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enf.SetDefaultRole("role:admin")
+
+	claims := jwt.MapClaims{"sub": "alice", TokenScopesClaim: []interface{}{"applications:sync:default/*", "clusters:get:https://*"}}
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "default/guestbook"))
+	assert.True(t, enf.Enforce(claims, "clusters", "get", "https://kubernetes.default.svc"))
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "default/guestbook"))
+	assert.False(t, enf.Enforce(claims, "applications", "sync", "other/guestbook"))
+
+	// tokens without scopes are not restricted
+	assert.True(t, enf.Enforce(jwt.MapClaims{"sub": "alice"}, "applications", "delete", "default/guestbook"))
+	// malformed scopes deny everything
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "alice", TokenScopesClaim: "*:*:*"}, "applications", "sync", "default/guestbook"))
+}
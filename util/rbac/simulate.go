@@ -0,0 +1,138 @@
+package rbac
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/casbin/casbin/v2/util"
+)
+
+// PolicyRule is a single permission rule of an RBAC policy
+type PolicyRule struct {
+	Subject  string
+	Resource string
+	Action   string
+	Object   string
+	Effect   string
+}
+
+// PolicyMatch is a policy rule which applies to a subject, either directly or through the roles assigned to it
+type PolicyMatch struct {
+	// Subject is the subject, group or role the rule applies to
+	Subject string
+	Rule    PolicyRule
+}
+
+func newPolicyRule(rule []string) PolicyRule {
+	r := PolicyRule{}
+	for i, field := range []*string{&r.Subject, &r.Resource, &r.Action, &r.Object, &r.Effect} {
+		if i < len(rule) {
+			*field = rule[i]
+		}
+	}
+	return r
+}
+
+// newSimulationEnforcer returns an uncached Casbin enforcer for the built-in, user-defined and the given run-time policy
+func (e *Enforcer) newSimulationEnforcer(runtimePolicy string) (CasbinEnforcer, error) {
+	e.lock.Lock()
+	matchFunc := globMatchFunc
+	if e.matchMode == RegexMatchMode {
+		matchFunc = util.RegexMatchFunc
+	}
+	adapter := newAdapter(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, runtimePolicy)
+	e.lock.Unlock()
+
+	enf, err := newEnforcerSafe(matchFunc, newBuiltInModel(), adapter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policy: %w", err)
+	}
+	return enf, nil
+}
+
+// SubjectRules returns the rules of the built-in, user-defined and the given run-time policy which apply to any of the
+// given subjects or to the default role, either directly or through the roles assigned to them. Both allow and deny
+// rules are returned as they are written. A rule does not tell whether its request is effectively allowed, since it
+// can be overridden by a deny rule or be scoped to a project, so callers need to enforce the request of every rule.
+func (e *Enforcer) SubjectRules(runtimePolicy string, subjects ...string) ([]PolicyMatch, error) {
+	enf, err := e.newSimulationEnforcer(runtimePolicy)
+	if err != nil {
+		return nil, err
+	}
+	if e.defaultRole != "" {
+		subjects = append(subjects, e.defaultRole)
+	}
+
+	roles := map[string][]string{}
+	for _, g := range enf.GetGroupingPolicy() {
+		if len(g) >= 2 {
+			roles[g[0]] = append(roles[g[0]], g[1])
+		}
+	}
+	// via maps every role to the first of the given subjects it has been assigned to
+	via := map[string]string{}
+	for _, subject := range subjects {
+		queue := []string{subject}
+		for len(queue) > 0 {
+			role := queue[0]
+			queue = queue[1:]
+			if _, ok := via[role]; ok {
+				continue
+			}
+			via[role] = subject
+			queue = append(queue, roles[role]...)
+		}
+	}
+
+	var matches []PolicyMatch
+	for _, p := range enf.GetPolicy() {
+		rule := newPolicyRule(p)
+		if subject, ok := via[rule.Subject]; ok {
+			matches = append(matches, PolicyMatch{Subject: subject, Rule: rule})
+		}
+	}
+	return matches, nil
+}
+
+// AllowedSubjects returns every subject, group and role of the built-in, user-defined and the given run-time policy,
+// as well as the default role, which is allowed to perform the given action on the given resource and object. Each
+// subject is returned along with the rule which allows the request. The run-time policy must only contain the roles
+// of the project the request belongs to, as the roles of other projects are not evaluated during enforcement.
+func (e *Enforcer) AllowedSubjects(runtimePolicy string, resource, action, object string) ([]PolicyMatch, error) {
+	enf, err := e.newSimulationEnforcer(runtimePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := map[string]bool{}
+	if e.defaultRole != "" {
+		candidates[e.defaultRole] = true
+	}
+	for _, p := range enf.GetPolicy() {
+		if len(p) > 0 {
+			candidates[p[0]] = true
+		}
+	}
+	for _, g := range enf.GetGroupingPolicy() {
+		for _, subject := range g {
+			candidates[subject] = true
+		}
+	}
+	subjects := make([]string, 0, len(candidates))
+	for subject := range candidates {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+
+	var matches []PolicyMatch
+	for _, subject := range subjects {
+		ok, explain, err := enf.EnforceEx(subject, resource, action, object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enforce policy for subject %q: %w", subject, err)
+		}
+		if ok {
+			matches = append(matches, PolicyMatch{Subject: subject, Rule: newPolicyRule(explain)})
+		}
+	}
+	return matches, nil
+}
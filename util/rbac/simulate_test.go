@@ -0,0 +1,76 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/util/assets"
+)
+
+func newSimulationTestEnforcer(t *testing.T) *Enforcer {
+	enf := NewEnforcer(fake.NewSimpleClientset(), fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	require.NoError(t, enf.SetUserPolicy(`
+p, role:deployer, applications, sync, default/*, allow
+p, role:deployer, applications, sync, default/prod-*, deny
+g, role:deployer, role:readonly
+g, my-org:team, role:deployer
+g, alice, role:admin
+`))
+	return enf
+}
+
+func TestSubjectRules(t *testing.T) {
+	enf := newSimulationTestEnforcer(t)
+	runtimePolicy := `
+p, proj:other:ci, applications, sync, other/*, allow
+g, my-org:ci, proj:other:ci
+`
+
+	matches, err := enf.SubjectRules(runtimePolicy, "bob", "my-org:team", "my-org:ci")
+	require.NoError(t, err)
+	assert.Contains(t, matches, PolicyMatch{Subject: "my-org:team", Rule: PolicyRule{"role:deployer", "applications", "sync", "default/*", "allow"}})
+	assert.Contains(t, matches, PolicyMatch{Subject: "my-org:team", Rule: PolicyRule{"role:deployer", "applications", "sync", "default/prod-*", "deny"}})
+	assert.Contains(t, matches, PolicyMatch{Subject: "my-org:team", Rule: PolicyRule{"role:readonly", "applications", "get", "*/*", "allow"}})
+	assert.Contains(t, matches, PolicyMatch{Subject: "my-org:ci", Rule: PolicyRule{"proj:other:ci", "applications", "sync", "other/*", "allow"}})
+	for _, match := range matches {
+		assert.NotEqual(t, "role:admin", match.Rule.Subject)
+	}
+
+	matches, err = enf.SubjectRules("", "nobody")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	enf.SetDefaultRole("role:readonly")
+	matches, err = enf.SubjectRules("", "nobody")
+	require.NoError(t, err)
+	assert.Contains(t, matches, PolicyMatch{Subject: "role:readonly", Rule: PolicyRule{"role:readonly", "applications", "get", "*/*", "allow"}})
+}
+
+func TestAllowedSubjects(t *testing.T) {
+	enf := newSimulationTestEnforcer(t)
+
+	matches, err := enf.AllowedSubjects("", "applications", "sync", "default/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []PolicyMatch{
+		{Subject: "admin", Rule: PolicyRule{"role:admin", "applications", "sync", "*/*", "allow"}},
+		{Subject: "alice", Rule: PolicyRule{"role:admin", "applications", "sync", "*/*", "allow"}},
+		{Subject: "my-org:team", Rule: PolicyRule{"role:deployer", "applications", "sync", "default/*", "allow"}},
+		{Subject: "role:admin", Rule: PolicyRule{"role:admin", "applications", "sync", "*/*", "allow"}},
+		{Subject: "role:deployer", Rule: PolicyRule{"role:deployer", "applications", "sync", "default/*", "allow"}},
+	}, matches)
+
+	matches, err = enf.AllowedSubjects("", "applications", "sync", "default/prod-guestbook")
+	require.NoError(t, err)
+	for _, match := range matches {
+		assert.NotEqual(t, "my-org:team", match.Subject)
+	}
+
+	enf.SetDefaultRole("role:admin")
+	matches, err = enf.AllowedSubjects("", "applications", "sync", "default/prod-guestbook")
+	require.NoError(t, err)
+	assert.Contains(t, matches, PolicyMatch{Subject: "role:admin", Rule: PolicyRule{"role:admin", "applications", "sync", "*/*", "allow"}})
+}
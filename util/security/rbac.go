@@ -12,3 +12,9 @@ func AppRBACName(defaultNS string, project string, namespace string, name string
 		return fmt.Sprintf("%s/%s", project, name)
 	}
 }
+
+// AppResourceRBACName constructs name of a resource of the app for use in RBAC checks. The resource is appended to the
+// name of the app as <group>/<kind>/<namespace>/<name>.
+func AppResourceRBACName(appRBACName string, group string, kind string, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", appRBACName, group, kind, namespace, name)
+}
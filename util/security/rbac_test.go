@@ -50,3 +50,8 @@ func Test_AppRBACName(t *testing.T) {
 		})
 	}
 }
+
+func Test_AppResourceRBACName(t *testing.T) {
+	assert.Equal(t, "default/app/apps/Deployment/prod/guestbook", AppResourceRBACName("default/app", "apps", "Deployment", "prod", "guestbook"))
+	assert.Equal(t, "default/test/app//Namespace//prod", AppResourceRBACName("default/test/app", "", "Namespace", "", "prod"))
+}
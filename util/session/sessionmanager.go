@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -10,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -29,6 +32,7 @@ import (
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	oidcutil "github.com/argoproj/argo-cd/v2/util/oidc"
 	passwordutil "github.com/argoproj/argo-cd/v2/util/password"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -38,9 +42,15 @@ type SessionManager struct {
 	projectsLister                v1alpha1.AppProjectNamespaceLister
 	client                        *http.Client
 	prov                          oidcutil.Provider
+	tokenExchanger                *oidcutil.TokenExchanger
 	storage                       UserStateStorage
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
+
+	// tokenUsageLock protects tokenUsage, which holds the time at which the last usage of each personal access token
+	// was recorded by this instance
+	tokenUsageLock sync.Mutex
+	tokenUsage     map[string]time.Time
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -55,6 +65,8 @@ const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
 	AuthErrorCtxKey            = "auth-error"
+	// TrustedIssuerClaim holds the name of the trusted issuer of the token which was exchanged for the session
+	TrustedIssuerClaim = "trusted_issuer"
 
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError           = "Invalid username or password"
@@ -63,6 +75,10 @@ const (
 	usernameTooLongError        = "Username is too long (%d bytes max)"
 	userDoesNotHaveCapability   = "Account %s does not have %s capability"
 	autoRegenerateTokenDuration = time.Minute * 5
+
+	// personalAccessTokenLastUsedResolution is the minimum interval between updates of the last usage of a personal
+	// access token, to avoid updating the secret on every request
+	personalAccessTokenLastUsedResolution = time.Minute * 5
 )
 
 const (
@@ -117,6 +133,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		storage:                       storage,
 		sleep:                         time.Sleep,
 		projectsLister:                projectsLister,
+		tokenExchanger:                oidcutil.NewTokenExchanger(),
 		verificationDelayNoiseEnabled: true,
 	}
 	settings, err := settingsMgr.GetSettings()
@@ -174,6 +191,264 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 	return mgr.signClaims(claims)
 }
 
+// CreatePersonalAccessToken creates a new personal access token for the given subject, which is restricted to the given
+// RBAC scopes and expires at the given time. The email and the group claims of the identity, i.e. the claims named by
+// groupScopes, are copied into the token, so that the token is authorized by the same policies as the identity. Group
+// memberships are captured when the token is created and are dropped together with the token when it is deleted.
+func (mgr *SessionManager) CreatePersonalAccessToken(subject string, identity jwt.MapClaims, groupScopes []string, scopes []string, expiresAt time.Time, id string) (string, error) {
+	now := time.Now().UTC()
+	claims := jwt.MapClaims{}
+	if email := jwtutil.StringField(identity, "email"); email != "" {
+		claims["email"] = email
+	}
+	for _, scope := range groupScopes {
+		if groups, ok := identity[scope]; ok {
+			claims[scope] = groups
+		}
+	}
+	claims["iss"] = SessionManagerClaimsIssuer
+	claims["sub"] = subject
+	claims["iat"] = now.Unix()
+	claims["nbf"] = now.Unix()
+	claims["exp"] = expiresAt.Unix()
+	claims["jti"] = id
+	claims[rbac.TokenScopesClaim] = scopes
+	return mgr.signClaims(claims)
+}
+
+// CreateSession creates a new login session token for the given local account and records the session, so that it
+// can be listed and revoked.
+func (mgr *SessionManager) CreateSession(ctx context.Context, username string, secondsBeforeExpiry int64, id string) (string, error) {
+	now := time.Now()
+	token, err := mgr.Create(fmt.Sprintf("%s:%s", username, settings.AccountCapabilityLogin), secondsBeforeExpiry, id)
+	if err != nil {
+		return "", err
+	}
+	session := Session{ID: id, Subject: username, IssuedAt: now.Unix()}
+	if secondsBeforeExpiry > 0 {
+		session.ExpiresAt = now.Add(time.Duration(secondsBeforeExpiry) * time.Second).Unix()
+	} else {
+		session.ExpiresAt = now.Add(mgr.maxSessionDuration()).Unix()
+	}
+	mgr.recordSession(ctx, session)
+	return token, nil
+}
+
+// maxSessionDuration returns the duration for which sessions without expiry are listed
+func (mgr *SessionManager) maxSessionDuration() time.Duration {
+	if argoCDSettings, err := mgr.settingsMgr.GetSettings(); err == nil && argoCDSettings.UserSessionDuration > 0 {
+		return argoCDSettings.UserSessionDuration
+	}
+	return 24 * time.Hour
+}
+
+// recordSession records a login session. Failures are only logged, since they must not prevent logins.
+func (mgr *SessionManager) recordSession(ctx context.Context, session Session) {
+	if err := mgr.storage.RecordSession(ctx, session); err != nil {
+		log.Warnf("Failed to record session %s of %s: %v", session.ID, session.Subject, err)
+	}
+}
+
+// ListSessions returns the active login sessions of the given subject, or of all subjects if the subject is empty
+func (mgr *SessionManager) ListSessions(ctx context.Context, subject string) ([]Session, error) {
+	return mgr.storage.ListSessions(ctx, subject)
+}
+
+// RBACIdentities returns the identities which the local groups of the RBAC policy are checked against, see
+// rbac.GroupWarnings: the local accounts mapped to whether they are enabled, and the subjects and groups of the active
+// sessions of SSO users and trusted issuers. The SSO identities are nil if there are no such sessions.
+func (mgr *SessionManager) RBACIdentities(ctx context.Context) (accounts map[string]bool, ssoIdentities map[string]bool) {
+	if localAccounts, err := mgr.settingsMgr.GetAccounts(); err != nil {
+		log.Warnf("Failed to get local accounts to check RBAC groups: %v", err)
+	} else {
+		accounts = make(map[string]bool)
+		for name, account := range localAccounts {
+			accounts[name] = account.Enabled
+		}
+	}
+	if sessions, err := mgr.storage.ListSessions(ctx, ""); err != nil {
+		log.Warnf("Failed to list sessions to check RBAC groups: %v", err)
+	} else {
+		for _, session := range sessions {
+			if session.Issuer == "" {
+				continue
+			}
+			if ssoIdentities == nil {
+				ssoIdentities = make(map[string]bool)
+			}
+			ssoIdentities[session.Subject] = true
+			for _, group := range session.Groups {
+				ssoIdentities[group] = true
+			}
+		}
+	}
+	return accounts, ssoIdentities
+}
+
+// RevokeSession revokes the login session of the given subject with the given identifier
+func (mgr *SessionManager) RevokeSession(ctx context.Context, subject string, id string) error {
+	sessions, err := mgr.storage.ListSessions(ctx, subject)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.ID == id {
+			return mgr.storage.RevokeToken(ctx, id, time.Until(time.Unix(session.ExpiresAt, 0)))
+		}
+	}
+	return status.Errorf(codes.NotFound, "session %s of %s does not exist", id, subject)
+}
+
+// RevokeSubject revokes all sessions and tokens of the given subject which have been issued so far, including
+// tokens issued by the SSO provider. The revocation is pushed to all API server replicas.
+func (mgr *SessionManager) RevokeSubject(ctx context.Context, subject string) error {
+	lifetime, err := MaxTokenLifetime(ctx, mgr.settingsMgr, mgr.storage, subject)
+	if err != nil {
+		return err
+	}
+	return mgr.storage.RevokeSubject(ctx, subject, time.Now(), lifetime)
+}
+
+// MaxTokenLifetime returns the duration for which the tokens of the given subject issued so far may be valid, which is
+// how long the revocation of the subject has to be kept. Zero is returned if the subject has tokens which never expire.
+func MaxTokenLifetime(ctx context.Context, settingsMgr *settings.SettingsManager, storage UserStateStorage, subject string) (time.Duration, error) {
+	argoCDSettings, err := settingsMgr.GetSettings()
+	if err != nil {
+		return 0, err
+	}
+	// ID tokens of the SSO provider usually expire within a day
+	lifetime := 24 * time.Hour
+	for _, d := range []time.Duration{argoCDSettings.UserSessionDuration, argoCDSettings.PersonalAccessTokenMaxDuration} {
+		if d > lifetime {
+			lifetime = d
+		}
+	}
+	issuers, err := settingsMgr.GetTrustedIssuers()
+	if err != nil {
+		return 0, err
+	}
+	for i := range issuers {
+		if d := issuers[i].GetSessionDuration(); d > lifetime {
+			lifetime = d
+		}
+	}
+	now := time.Now()
+	sessions, err := storage.ListSessions(ctx, subject)
+	if err != nil {
+		return 0, err
+	}
+	for _, session := range sessions {
+		if d := time.Unix(session.ExpiresAt, 0).Sub(now); d > lifetime {
+			lifetime = d
+		}
+	}
+	if account, err := settingsMgr.GetAccount(subject); err == nil {
+		for _, token := range account.Tokens {
+			if token.ExpiresAt == 0 {
+				return 0, nil
+			}
+			if d := time.Unix(token.ExpiresAt, 0).Sub(now); d > lifetime {
+				lifetime = d
+			}
+		}
+	}
+	// the maximum duration of personal access tokens may have been lowered or disabled after they were created
+	tokens, err := settingsMgr.GetPersonalAccessTokens()
+	if err != nil {
+		return 0, err
+	}
+	for _, token := range tokens {
+		if token.Subject != subject {
+			continue
+		}
+		if d := time.Unix(token.ExpiresAt, 0).Sub(now); d > lifetime {
+			lifetime = d
+		}
+	}
+	return lifetime, nil
+}
+
+// RecordSSOSession records the login session of an SSO user with the given ID token and the groups of its claims, so
+// that it can be listed and revoked. Failures are only logged, since they must not prevent logins.
+func (mgr *SessionManager) RecordSSOSession(ctx context.Context, tokenString string, claims jwt.MapClaims, groups []string) {
+	issuedAt, err := jwtutil.IssuedAtTime(claims)
+	if err != nil {
+		issuedAt = time.Now()
+	}
+	expiresAt, err := jwtutil.ExpirationTime(claims)
+	if err != nil {
+		expiresAt = issuedAt.Add(mgr.maxSessionDuration())
+	}
+	mgr.recordSession(ctx, Session{
+		ID:        ssoSessionID(tokenString, claims),
+		Subject:   jwtutil.StringField(claims, "sub"),
+		Issuer:    jwtutil.StringField(claims, "iss"),
+		IssuedAt:  issuedAt.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		Groups:    groups,
+	})
+}
+
+// ssoSessionID returns the identifier of the session of an ID token of the SSO provider, which is the "jti" claim of
+// the token if present, or the hash of the token otherwise
+func ssoSessionID(tokenString string, claims jwt.MapClaims) string {
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		return id
+	}
+	hash := sha256.Sum256([]byte(tokenString))
+	return hex.EncodeToString(hash[:16])
+}
+
+// ExchangeToken verifies a token of a trusted issuer and creates a session for its subject, with the groups mapped
+// from its claims. The subject of the session is prefixed with the name of the issuer.
+func (mgr *SessionManager) ExchangeToken(ctx context.Context, tokenString string, id string) (string, error) {
+	issuers, err := mgr.settingsMgr.GetTrustedIssuers()
+	if err != nil {
+		return "", err
+	}
+	if len(issuers) == 0 {
+		return "", status.Errorf(codes.Unauthenticated, "no trusted issuers are configured")
+	}
+	identity, err := mgr.tokenExchanger.Verify(tokenString, issuers)
+	if err != nil {
+		log.Warnf("Failed to exchange token: %v", err)
+		return "", status.Errorf(codes.Unauthenticated, "failed to exchange token: %v", err)
+	}
+	var duration time.Duration
+	for i := range issuers {
+		if issuers[i].Name == identity.Issuer {
+			duration = issuers[i].GetSessionDuration()
+		}
+	}
+	now := time.Now().UTC()
+	claims := jwt.MapClaims{
+		"iss":              SessionManagerClaimsIssuer,
+		"sub":              fmt.Sprintf("%s:%s", identity.Issuer, identity.Subject),
+		"iat":              now.Unix(),
+		"nbf":              now.Unix(),
+		"exp":              now.Add(duration).Unix(),
+		"jti":              id,
+		TrustedIssuerClaim: identity.Issuer,
+	}
+	if len(identity.Groups) > 0 {
+		claims["groups"] = identity.Groups
+	}
+	log.Infof("Exchanged token of trusted issuer %s for a session of %s", identity.Issuer, claims["sub"])
+	token, err := mgr.signClaims(claims)
+	if err != nil {
+		return "", err
+	}
+	mgr.recordSession(ctx, Session{
+		ID:        id,
+		Subject:   claims["sub"].(string),
+		Issuer:    identity.Issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(duration).Unix(),
+		Groups:    identity.Groups,
+	})
+	return token, nil
+}
+
 func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	settings, err := mgr.settingsMgr.GetSettings()
@@ -229,6 +504,27 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	subject := jwtutil.StringField(claims, "sub")
 	id := jwtutil.StringField(claims, "jti")
 
+	if _, ok := claims[rbac.TokenScopesClaim]; ok {
+		if mgr.storage.IsSubjectRevoked(subject, issuedAt) {
+			return nil, "", errors.New("token is revoked")
+		}
+		if err := mgr.verifyPersonalAccessToken(subject, id, issuedAt); err != nil {
+			return nil, "", err
+		}
+		return token.Claims, "", nil
+	}
+
+	if issuer, ok := claims[TrustedIssuerClaim].(string); ok {
+		// the issuer must still be trusted
+		if _, err := mgr.settingsMgr.GetTrustedIssuer(issuer); err != nil {
+			return nil, "", err
+		}
+		if id == "" || mgr.storage.IsTokenRevoked(id) || mgr.storage.IsSubjectRevoked(subject, issuedAt) {
+			return nil, "", errors.New("token is revoked, please re-login")
+		}
+		return token.Claims, "", nil
+	}
+
 	if projName, role, ok := rbacpolicy.GetProjectRoleFromSubject(subject); ok {
 		proj, err := mgr.projectsLister.Get(projName)
 		if err != nil {
@@ -258,7 +554,7 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return nil, "", fmt.Errorf("account %s does not have '%s' capability", subject, capability)
 	}
 
-	if id == "" || mgr.storage.IsTokenRevoked(id) {
+	if id == "" || mgr.storage.IsTokenRevoked(id) || mgr.storage.IsSubjectRevoked(subject, issuedAt) {
 		return nil, "", errors.New("token is revoked, please re-login")
 	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
 		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
@@ -275,7 +571,7 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 
 		if remainingDuration < autoRegenerateTokenDuration && capability == settings.AccountCapabilityLogin {
 			if uniqueId, err := uuid.NewRandom(); err == nil {
-				if val, err := mgr.Create(fmt.Sprintf("%s:%s", subject, settings.AccountCapabilityLogin), int64(tokenExpDuration.Seconds()), uniqueId.String()); err == nil {
+				if val, err := mgr.CreateSession(context.Background(), subject, int64(tokenExpDuration.Seconds()), uniqueId.String()); err == nil {
 					newToken = val
 				}
 			}
@@ -284,6 +580,71 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	return token.Claims, newToken, nil
 }
 
+// verifyPersonalAccessToken verifies that the personal access token with the given identifier has been issued to the
+// subject and has not been revoked, and records the usage of the token.
+func (mgr *SessionManager) verifyPersonalAccessToken(subject string, id string, issuedAt time.Time) error {
+	if id == "" || mgr.storage.IsTokenRevoked(id) {
+		return errors.New("token is revoked")
+	}
+	pat, err := mgr.settingsMgr.GetPersonalAccessToken(id)
+	if err != nil || pat.Subject != subject {
+		return fmt.Errorf("personal access token with id %s does not exist", id)
+	}
+	// tokens of local accounts are bound to the state of the account
+	if account, err := mgr.settingsMgr.GetAccount(subject); err == nil {
+		if !account.Enabled {
+			return fmt.Errorf("account %s is disabled", subject)
+		}
+		if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
+			return fmt.Errorf("account password has changed since token issued")
+		}
+	}
+
+	now := time.Now()
+	if now.Sub(time.Unix(pat.LastUsedAt, 0)) >= personalAccessTokenLastUsedResolution && mgr.shouldRecordTokenUsage(id, now) {
+		// the secret is updated in the background, so that requests are not delayed by recording the usage
+		go mgr.recordTokenUsage(id, now)
+	}
+	return nil
+}
+
+// recordTokenUsage records the last usage of the personal access token with the given identifier. Failures are only
+// logged, since they must not fail the request made with the token.
+func (mgr *SessionManager) recordTokenUsage(id string, now time.Time) {
+	err := mgr.settingsMgr.UpdatePersonalAccessTokens(func(tokens []settings.PersonalAccessToken) ([]settings.PersonalAccessToken, error) {
+		for i := range tokens {
+			if tokens[i].ID == id {
+				tokens[i].LastUsedAt = now.Unix()
+			}
+		}
+		return tokens, nil
+	})
+	if err != nil {
+		log.Warnf("Failed to record usage of personal access token %s: %v", id, err)
+	}
+}
+
+// shouldRecordTokenUsage returns whether the usage of the personal access token with the given identifier should be
+// recorded. Since the settings informer may lag behind the secret, the usage is recorded at most once per resolution
+// by each instance, no matter how many requests are made with the token in the meantime.
+func (mgr *SessionManager) shouldRecordTokenUsage(id string, now time.Time) bool {
+	mgr.tokenUsageLock.Lock()
+	defer mgr.tokenUsageLock.Unlock()
+	if mgr.tokenUsage == nil {
+		mgr.tokenUsage = map[string]time.Time{}
+	}
+	if last, ok := mgr.tokenUsage[id]; ok && now.Sub(last) < personalAccessTokenLastUsedResolution {
+		return false
+	}
+	for tokenID, last := range mgr.tokenUsage {
+		if now.Sub(last) >= personalAccessTokenLastUsedResolution {
+			delete(mgr.tokenUsage, tokenID)
+		}
+	}
+	mgr.tokenUsage[id] = now
+	return true
+}
+
 // GetLoginFailures retrieves the login failure information from the cache
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -554,6 +915,9 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		if mgr.storage.IsTokenRevoked(ssoSessionID(tokenString, claims)) || mgr.storage.IsSubjectRevoked(idToken.Subject, idToken.IssuedAt) {
+			return nil, "", errors.New("token is revoked, please re-login")
+		}
 		return claims, "", nil
 	}
 }
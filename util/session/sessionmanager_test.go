@@ -31,6 +31,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	utiltest "github.com/argoproj/argo-cd/v2/util/test"
@@ -179,6 +180,239 @@ func TestSessionManager_AdminToken_LoginCapabilityDisabled(t *testing.T) {
 	assert.Contains(t, err.Error(), "account admin does not have 'apiKey' capability")
 }
 
+func TestSessionManager_PersonalAccessToken(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	storage := NewUserStateStorage(redisClient)
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	expiresAt := time.Now().Add(time.Hour)
+	err := settingsMgr.UpdatePersonalAccessTokens(func(tokens []settings.PersonalAccessToken) ([]settings.PersonalAccessToken, error) {
+		return append(tokens, settings.PersonalAccessToken{ID: "123", Subject: "sso-user", Scopes: []string{"applications:get:*"}, IssuedAt: time.Now().Unix(), ExpiresAt: expiresAt.Unix()}), nil
+	})
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		token, err := mgr.CreatePersonalAccessToken("sso-user", jwt.MapClaims{"iss": "https://dex", "sub": "sso-user", "email": "user@example.com", "groups": []string{"my-org:team"}, "nonce": "abc"}, []string{"groups"}, []string{"applications:get:*"}, expiresAt, "123")
+		require.NoError(t, err)
+
+		claims, newToken, err := mgr.Parse(token)
+		require.NoError(t, err)
+		assert.Empty(t, newToken)
+		mapClaims := *(claims.(*jwt.MapClaims))
+		assert.Equal(t, "sso-user", mapClaims["sub"])
+		assert.Equal(t, SessionManagerClaimsIssuer, mapClaims["iss"])
+		assert.Equal(t, "user@example.com", mapClaims["email"])
+		assert.Equal(t, []interface{}{"applications:get:*"}, mapClaims["tokenScopes"])
+		// group memberships are captured in the token, other claims of the identity are not
+		assert.Equal(t, []interface{}{"my-org:team"}, mapClaims["groups"])
+		assert.NotContains(t, mapClaims, "nonce")
+
+		// the usage is recorded in the background
+		assert.Eventually(t, func() bool {
+			pat, err := settingsMgr.GetPersonalAccessToken("123")
+			return err == nil && pat.LastUsedAt != 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("UsageThrottled", func(t *testing.T) {
+		now := time.Now()
+		assert.True(t, mgr.shouldRecordTokenUsage("456", now))
+		assert.False(t, mgr.shouldRecordTokenUsage("456", now.Add(time.Minute)))
+		assert.True(t, mgr.shouldRecordTokenUsage("789", now.Add(time.Minute)))
+		assert.True(t, mgr.shouldRecordTokenUsage("456", now.Add(personalAccessTokenLastUsedResolution)))
+	})
+
+	t.Run("WrongSubject", func(t *testing.T) {
+		token, err := mgr.CreatePersonalAccessToken("other-user", jwt.MapClaims{}, nil, []string{"*:*:*"}, expiresAt, "123")
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(token)
+		assert.EqualError(t, err, "personal access token with id 123 does not exist")
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		token, err := mgr.CreatePersonalAccessToken("sso-user", jwt.MapClaims{}, nil, []string{"applications:get:*"}, expiresAt, "123")
+		require.NoError(t, err)
+		require.NoError(t, mgr.RevokeToken(context.Background(), "123", time.Hour))
+
+		_, _, err = mgr.Parse(token)
+		assert.EqualError(t, err, "token is revoked")
+	})
+}
+
+func TestSessionManager_RevokeSubject(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.CreateSession(context.Background(), "admin", 3600, "123")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	sessions, err := mgr.ListSessions(context.Background(), "admin")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "123", sessions[0].ID)
+
+	require.NoError(t, mgr.RevokeSubject(context.Background(), "admin"))
+	_, _, err = mgr.Parse(token)
+	assert.EqualError(t, err, "token is revoked, please re-login")
+	// the revocation is kept as long as tokens issued before it may be valid
+	ttl, err := redisClient.TTL(context.Background(), revokedSubjectPrefix+"admin").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, 23*time.Hour)
+	sessions, err = mgr.ListSessions(context.Background(), "admin")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	// sessions created after the revocation are valid
+	time.Sleep(time.Second)
+	token, err = mgr.CreateSession(context.Background(), "admin", 3600, "456")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	assert.NoError(t, err)
+
+	err = mgr.RevokeSession(context.Background(), "admin", "456")
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	assert.EqualError(t, err, "token is revoked, please re-login")
+	assert.Equal(t, codes.NotFound, status.Code(mgr.RevokeSession(context.Background(), "admin", "789")))
+}
+
+func TestMaxTokenLifetime(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	storage := NewUserStateStorage(redisClient)
+
+	lifetime, err := MaxTokenLifetime(context.Background(), settingsMgr, storage, "sso-user")
+	require.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, lifetime)
+
+	// personal access tokens may outlive the configured maximum duration, e.g. if it has been lowered since
+	expiresAt := time.Now().Add(200 * 24 * time.Hour)
+	err = settingsMgr.UpdatePersonalAccessTokens(func(tokens []settings.PersonalAccessToken) ([]settings.PersonalAccessToken, error) {
+		return append(tokens,
+			settings.PersonalAccessToken{ID: "123", Subject: "sso-user", IssuedAt: time.Now().Unix(), ExpiresAt: expiresAt.Unix()},
+			settings.PersonalAccessToken{ID: "456", Subject: "other-user", IssuedAt: time.Now().Unix(), ExpiresAt: expiresAt.Add(time.Hour).Unix()},
+		), nil
+	})
+	require.NoError(t, err)
+
+	lifetime, err = MaxTokenLifetime(context.Background(), settingsMgr, storage, "sso-user")
+	require.NoError(t, err)
+	assert.InDelta(t, time.Until(expiresAt).Seconds(), lifetime.Seconds(), 5)
+}
+
+func TestSessionManager_RecordSSOSession(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	now := time.Now()
+	claims := jwt.MapClaims{"iss": "https://dex.example.com", "sub": "alice", "iat": float64(now.Unix()), "exp": float64(now.Add(time.Hour).Unix())}
+	mgr.RecordSSOSession(context.Background(), "id-token", claims, []string{"my-org:team"})
+
+	sessions, err := mgr.ListSessions(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, ssoSessionID("id-token", claims), sessions[0].ID)
+	assert.Equal(t, "https://dex.example.com", sessions[0].Issuer)
+	assert.Equal(t, now.Add(time.Hour).Unix(), sessions[0].ExpiresAt)
+	assert.Equal(t, []string{"my-org:team"}, sessions[0].Groups)
+
+	accounts, ssoIdentities := mgr.RBACIdentities(context.Background())
+	assert.Equal(t, map[string]bool{"admin": true}, accounts)
+	assert.Equal(t, map[string]bool{"alice": true, "my-org:team": true}, ssoIdentities)
+
+	require.NoError(t, mgr.RevokeSession(context.Background(), "alice", sessions[0].ID))
+	assert.True(t, mgr.storage.IsTokenRevoked(ssoSessionID("id-token", claims)))
+	assert.False(t, mgr.storage.IsTokenRevoked(ssoSessionID("other-id-token", claims)))
+
+	// the jti claim identifies the session if present
+	claims["jti"] = "abc"
+	assert.Equal(t, "abc", ssoSessionID("id-token", claims))
+}
+
+func TestSessionManager_ExchangeToken(t *testing.T) {
+	oidcTestServer := utiltest.GetOIDCTestServer(t)
+	t.Cleanup(oidcTestServer.Close)
+	rootCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: oidcTestServer.TLS.Certificates[0].Certificate[0]})
+
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+	storage := NewUserStateStorage(redisClient)
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClientWithConfig(map[string]string{
+		"oidc.trustedIssuers": fmt.Sprintf(`
+- name: ci
+  issuer: %s
+  audiences: [argocd]
+  sessionDuration: 10m
+  rootCA: |
+    %s
+  groupMappings:
+  - claim: repository
+    values: ["my-org/*"]
+    groups: [my-org-ci]
+`, oidcTestServer.URL, strings.Replace(string(rootCA), "\n", "\n    ", -1)),
+	}, nil), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), storage)
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+	require.NoError(t, err)
+	idToken, err := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
+		"iss":        oidcTestServer.URL,
+		"sub":        "repo:my-org/app",
+		"aud":        "argocd",
+		"exp":        time.Now().Add(5 * time.Minute).Unix(),
+		"repository": "my-org/app",
+	}).SignedString(key)
+	require.NoError(t, err)
+
+	token, err := mgr.ExchangeToken(context.Background(), idToken, "123")
+	require.NoError(t, err)
+	claims, newToken, err := mgr.Parse(token)
+	require.NoError(t, err)
+	assert.Empty(t, newToken)
+	mapClaims := *(claims.(*jwt.MapClaims))
+	assert.Equal(t, "ci:repo:my-org/app", mapClaims["sub"])
+	assert.Equal(t, SessionManagerClaimsIssuer, mapClaims["iss"])
+	assert.Equal(t, []interface{}{"my-org-ci"}, mapClaims["groups"])
+	exp, err := jwtutil.ExpirationTime(mapClaims)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), exp, time.Minute)
+
+	t.Run("UntrustedIssuer", func(t *testing.T) {
+		otherSettingsMgr := settings.NewSettingsManager(context.Background(), getKubeClientWithConfig(nil, nil), "argocd")
+		otherMgr := newSessionManager(otherSettingsMgr, getProjLister(), storage)
+		_, err := otherMgr.ExchangeToken(context.Background(), idToken, "456")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, _, err = otherMgr.Parse(token)
+		assert.EqualError(t, err, "trusted issuer ci does not exist")
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		_, err := mgr.ExchangeToken(context.Background(), idToken+"x", "456")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		require.NoError(t, mgr.RevokeToken(context.Background(), "123", time.Hour))
+		_, _, err := mgr.Parse(token)
+		assert.EqualError(t, err, "token is revoked, please re-login")
+	})
+}
+
 func TestSessionManager_ProjectToken(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
 
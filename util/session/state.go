@@ -2,6 +2,10 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,26 +17,44 @@ import (
 )
 
 const (
-	revokedTokenPrefix = "revoked-token|"
-	newRevokedTokenKey = "new-revoked-token"
+	revokedTokenPrefix   = "revoked-token|"
+	newRevokedTokenKey   = "new-revoked-token"
+	revokedSubjectPrefix = "revoked-subject|"
+	newRevokedSubjectKey = "new-revoked-subject"
+	sessionsPrefix       = "sessions|"
 )
 
+// Session holds the information about an active login session
+type Session struct {
+	ID      string `json:"id"`
+	Subject string `json:"sub"`
+	// Issuer is the name of the trusted issuer of the token which was exchanged for the session, or the issuer URL of
+	// SSO sessions
+	Issuer    string `json:"issuer,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	// Groups are the groups of the subject of SSO sessions and sessions of trusted issuers
+	Groups []string `json:"groups,omitempty"`
+}
+
 type userStateStorage struct {
-	attempts       map[string]LoginAttempts
-	redis          *redis.Client
-	revokedTokens  map[string]bool
-	lock           sync.RWMutex
-	resyncDuration time.Duration
+	attempts        map[string]LoginAttempts
+	redis           *redis.Client
+	revokedTokens   map[string]bool
+	revokedSubjects map[string]time.Time
+	lock            sync.RWMutex
+	resyncDuration  time.Duration
 }
 
 var _ UserStateStorage = &userStateStorage{}
 
 func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 	return &userStateStorage{
-		attempts:       map[string]LoginAttempts{},
-		revokedTokens:  map[string]bool{},
-		resyncDuration: time.Hour,
-		redis:          redis,
+		attempts:        map[string]LoginAttempts{},
+		revokedTokens:   map[string]bool{},
+		revokedSubjects: map[string]time.Time{},
+		resyncDuration:  time.Hour,
+		redis:           redis,
 	}
 }
 
@@ -52,7 +74,7 @@ func (storage *userStateStorage) Init(ctx context.Context) {
 }
 
 func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
-	pubsub := storage.redis.Subscribe(ctx, newRevokedTokenKey)
+	pubsub := storage.redis.Subscribe(ctx, newRevokedTokenKey, newRevokedSubjectKey)
 	defer util.Close(pubsub)
 
 	ch := pubsub.Channel()
@@ -62,12 +84,38 @@ func (storage *userStateStorage) watchRevokedTokens(ctx context.Context) {
 			return
 		case val := <-ch:
 			storage.lock.Lock()
-			storage.revokedTokens[val.Payload] = true
+			switch val.Channel {
+			case newRevokedSubjectKey:
+				if subject, revokedAt, err := parseRevokedSubject(val.Payload); err == nil {
+					storage.revokedSubjects[subject] = revokedAt
+				} else {
+					log.Warnf("Unexpected revoked subject notification: %v", err)
+				}
+			default:
+				storage.revokedTokens[val.Payload] = true
+			}
 			storage.lock.Unlock()
 		}
 	}
 }
 
+// formatRevokedSubject formats the revocation of all tokens of a subject as <unix time>|<subject>
+func formatRevokedSubject(subject string, revokedAt time.Time) string {
+	return fmt.Sprintf("%d|%s", revokedAt.Unix(), subject)
+}
+
+func parseRevokedSubject(value string) (string, time.Time, error) {
+	parts := strings.SplitN(value, "|", 2)
+	if len(parts) != 2 {
+		return "", time.Time{}, fmt.Errorf("expected <unix time>|<subject> but got '%s'", value)
+	}
+	revokedAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid revocation time in '%s': %w", value, err)
+	}
+	return parts[1], time.Unix(revokedAt, 0), nil
+}
+
 func (storage *userStateStorage) loadRevokedTokensSafe() {
 	err := storage.loadRevokedTokens()
 	for err != nil {
@@ -96,7 +144,23 @@ func (storage *userStateStorage) loadRevokedTokens() error {
 		return iterator.Err()
 	}
 
-	return nil
+	storage.revokedSubjects = map[string]time.Time{}
+	iterator = storage.redis.Scan(context.Background(), 0, revokedSubjectPrefix+"*", -1).Iterator()
+	for iterator.Next(context.Background()) {
+		value, err := storage.redis.Get(context.Background(), iterator.Val()).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return err
+		}
+		subject, revokedAt, err := parseRevokedSubject(value)
+		if err != nil {
+			log.Warnf("Unexpected value of redis key '%s': %v", iterator.Val(), err)
+			continue
+		}
+		storage.revokedSubjects[subject] = revokedAt
+	}
+	return iterator.Err()
 }
 
 func (storage *userStateStorage) GetLoginAttempts(attempts *map[string]LoginAttempts) error {
@@ -125,6 +189,134 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) RevokeSubject(ctx context.Context, subject string, revokedAt time.Time, expiringAt time.Duration) error {
+	// tokens only carry the time they were issued at in seconds
+	revokedAt = revokedAt.Truncate(time.Second)
+	storage.lock.Lock()
+	storage.revokedSubjects[subject] = revokedAt
+	storage.lock.Unlock()
+	value := formatRevokedSubject(subject, revokedAt)
+	if err := storage.redis.Set(ctx, revokedSubjectPrefix+subject, value, expiringAt).Err(); err != nil {
+		return err
+	}
+	if err := storage.redis.Del(ctx, sessionsPrefix+subject).Err(); err != nil {
+		return err
+	}
+	return storage.redis.Publish(ctx, newRevokedSubjectKey, value).Err()
+}
+
+func (storage *userStateStorage) IsSubjectRevoked(subject string, issuedAt time.Time) bool {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	revokedAt, ok := storage.revokedSubjects[subject]
+	return ok && isRevokedAt(issuedAt, revokedAt)
+}
+
+// isRevokedAt returns whether a token issued at the given time is revoked by a revocation at the given time. Tokens
+// are only valid if they were issued after the revocation, which is compared in seconds since tokens carry the time
+// they were issued at in seconds, so tokens issued in the second of the revocation are revoked as well.
+func isRevokedAt(issuedAt time.Time, revokedAt time.Time) bool {
+	return issuedAt.Unix() <= revokedAt.Unix()
+}
+
+func (storage *userStateStorage) RecordSession(ctx context.Context, session Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	key := sessionsPrefix + session.Subject
+	if err := storage.redis.HSet(ctx, key, session.ID, data).Err(); err != nil {
+		return err
+	}
+	// the index of the subject expires with its last session
+	expiresIn := time.Until(time.Unix(session.ExpiresAt, 0))
+	ttl, err := storage.redis.TTL(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if ttl < expiresIn {
+		return storage.redis.Expire(ctx, key, expiresIn).Err()
+	}
+	return nil
+}
+
+func (storage *userStateStorage) ListSessions(ctx context.Context, subject string) ([]Session, error) {
+	var keys []string
+	if subject != "" {
+		keys = []string{sessionsPrefix + subject}
+	} else {
+		iterator := storage.redis.Scan(ctx, 0, sessionsPrefix+"*", -1).Iterator()
+		for iterator.Next(ctx) {
+			keys = append(keys, iterator.Val())
+		}
+		if err := iterator.Err(); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	sessions := make([]Session, 0)
+	for _, key := range keys {
+		values, err := storage.redis.HGetAll(ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		var expired []string
+		for id, value := range values {
+			var session Session
+			if err := json.Unmarshal([]byte(value), &session); err != nil {
+				log.Warnf("Failed to unmarshal session %s of redis key '%s': %v", id, key, err)
+				continue
+			}
+			if session.ExpiresAt <= now.Unix() {
+				expired = append(expired, id)
+				continue
+			}
+			revoked, err := storage.isSessionRevoked(ctx, session)
+			if err != nil {
+				return nil, err
+			}
+			if !revoked {
+				sessions = append(sessions, session)
+			}
+		}
+		if len(expired) > 0 {
+			if err := storage.redis.HDel(ctx, key, expired...).Err(); err != nil {
+				log.Warnf("Failed to remove expired sessions of redis key '%s': %v", key, err)
+			}
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Subject != sessions[j].Subject {
+			return sessions[i].Subject < sessions[j].Subject
+		}
+		return sessions[i].IssuedAt < sessions[j].IssuedAt
+	})
+	return sessions, nil
+}
+
+// isSessionRevoked checks in redis if the session is revoked, since the revocations might not have been loaded yet,
+// e.g. in the CLI
+func (storage *userStateStorage) isSessionRevoked(ctx context.Context, session Session) (bool, error) {
+	if storage.IsTokenRevoked(session.ID) || storage.IsSubjectRevoked(session.Subject, time.Unix(session.IssuedAt, 0)) {
+		return true, nil
+	}
+	exists, err := storage.redis.Exists(ctx, revokedTokenPrefix+session.ID).Result()
+	if err != nil || exists > 0 {
+		return exists > 0, err
+	}
+	value, err := storage.redis.Get(ctx, revokedSubjectPrefix+session.Subject).Result()
+	if err == redis.Nil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	_, revokedAt, err := parseRevokedSubject(value)
+	if err != nil {
+		return false, err
+	}
+	return isRevokedAt(time.Unix(session.IssuedAt, 0), revokedAt), nil
+}
+
 type UserStateStorage interface {
 	Init(ctx context.Context)
 	// GetLoginAttempts return number of concurrent login attempts
@@ -135,4 +327,13 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// RevokeSubject revokes all tokens of the given subject issued until the given time (information about revocation
+	// expires after specified timeout, which never happens if it is zero)
+	RevokeSubject(ctx context.Context, subject string, revokedAt time.Time, expiringAt time.Duration) error
+	// IsSubjectRevoked checks if the tokens of given subject issued at the given time are revoked
+	IsSubjectRevoked(subject string, issuedAt time.Time) bool
+	// RecordSession adds a login session to the sessions of its subject
+	RecordSession(ctx context.Context, session Session) error
+	// ListSessions returns the active login sessions of the given subject, or of all subjects if the subject is empty
+	ListSessions(ctx context.Context, subject string) ([]Session, error)
 }
@@ -27,3 +27,73 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_Sessions(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	ctx := context.Background()
+	storage := NewUserStateStorage(redis)
+	now := time.Now()
+	sessions := []Session{
+		{ID: "1", Subject: "alice", IssuedAt: now.Add(-time.Hour).Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+		{ID: "2", Subject: "alice", IssuedAt: now.Add(-time.Minute).Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+		{ID: "3", Subject: "bob", IssuedAt: now.Add(-2 * time.Hour).Unix(), ExpiresAt: now.Add(-time.Hour).Unix()},
+		{ID: "4", Subject: "ci:repo:my-org/app", Issuer: "ci", IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+	}
+	for _, session := range sessions {
+		require.NoError(t, storage.RecordSession(ctx, session))
+	}
+
+	list, err := storage.ListSessions(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []Session{sessions[0], sessions[1], sessions[3]}, list)
+
+	list, err = storage.ListSessions(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, []Session{sessions[0], sessions[1]}, list)
+
+	// revoked sessions are not listed, even by storages which have not loaded the revocations
+	require.NoError(t, storage.RevokeToken(ctx, "1", time.Hour))
+	list, err = NewUserStateStorage(redis).ListSessions(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, []Session{sessions[1]}, list)
+
+	require.NoError(t, storage.RevokeSubject(ctx, "alice", now, time.Hour))
+	assert.True(t, storage.IsSubjectRevoked("alice", now))
+	assert.False(t, storage.IsSubjectRevoked("alice", now.Add(time.Second)))
+	assert.False(t, storage.IsSubjectRevoked("bob", now))
+	// revocations are compared in seconds, like the times at which tokens are issued
+	assert.True(t, storage.IsSubjectRevoked("alice", now.Truncate(time.Second).Add(999*time.Millisecond)))
+	ttl, err := redis.TTL(ctx, revokedSubjectPrefix+"alice").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, time.Duration(0))
+	list, err = NewUserStateStorage(redis).ListSessions(ctx, "alice")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestUserStateStorage_LoadRevokedSubjects(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	revokedAt := time.Now().Truncate(time.Second)
+	err := redis.Set(context.Background(), revokedSubjectPrefix+"alice", formatRevokedSubject("alice", revokedAt), 0).Err()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage := NewUserStateStorage(redis)
+	storage.Init(ctx)
+	time.Sleep(time.Millisecond * 100)
+
+	assert.True(t, storage.IsSubjectRevoked("alice", revokedAt.Add(-time.Hour)))
+	assert.False(t, storage.IsSubjectRevoked("alice", revokedAt.Add(time.Second)))
+
+	// revocations by other replicas are received
+	require.NoError(t, NewUserStateStorage(redis).RevokeSubject(ctx, "bob", revokedAt, time.Hour))
+	assert.Eventually(t, func() bool {
+		return storage.IsSubjectRevoked("bob", revokedAt)
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,109 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+const (
+	// settingPersonalAccessTokensKey designates the key for personal access tokens inside a Kubernetes secret.
+	settingPersonalAccessTokensKey = "personalAccessTokens"
+	// defaultPersonalAccessTokenMaxDuration is the maximum duration of personal access tokens unless configured otherwise
+	defaultPersonalAccessTokenMaxDuration = 90 * 24 * time.Hour
+)
+
+// PersonalAccessToken holds the information about a personal access token issued to a local or SSO user.
+type PersonalAccessToken struct {
+	ID          string   `json:"id"`
+	Subject     string   `json:"sub"`
+	Description string   `json:"description,omitempty"`
+	Scopes      []string `json:"scopes"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
+	LastUsedAt  int64    `json:"lastUsedAt,omitempty"`
+}
+
+// IsExpired returns true if the token has expired at the given time.
+func (t *PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt <= now.Unix()
+}
+
+// GetPersonalAccessTokens returns all personal access tokens which have not expired yet.
+func (mgr *SettingsManager) GetPersonalAccessTokens() ([]PersonalAccessToken, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	if err != nil {
+		return nil, err
+	}
+	return parsePersonalAccessTokens(secret, time.Now())
+}
+
+// GetPersonalAccessToken returns the personal access token with the given identifier.
+func (mgr *SettingsManager) GetPersonalAccessToken(id string) (*PersonalAccessToken, error) {
+	tokens, err := mgr.GetPersonalAccessTokens()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tokens {
+		if tokens[i].ID == id {
+			return &tokens[i], nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "personal access token with id '%s' does not exist", id)
+}
+
+// UpdatePersonalAccessTokens runs the callback function against the list of personal access tokens and persists the
+// list returned by the callback. Expired tokens are removed from the list.
+func (mgr *SettingsManager) UpdatePersonalAccessTokens(callback func(tokens []PersonalAccessToken) ([]PersonalAccessToken, error)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		return mgr.updateSecret(func(secret *v1.Secret) error {
+			tokens, err := parsePersonalAccessTokens(secret, time.Now())
+			if err != nil {
+				return err
+			}
+			tokens, err = callback(tokens)
+			if err != nil {
+				return err
+			}
+			if len(tokens) == 0 {
+				delete(secret.Data, settingPersonalAccessTokensKey)
+				return nil
+			}
+			data, err := json.Marshal(tokens)
+			if err != nil {
+				return err
+			}
+			secret.Data[settingPersonalAccessTokensKey] = data
+			return nil
+		})
+	})
+}
+
+func parsePersonalAccessTokens(secret *v1.Secret, now time.Time) ([]PersonalAccessToken, error) {
+	data, ok := secret.Data[settingPersonalAccessTokensKey]
+	if !ok || len(data) == 0 {
+		return nil, nil
+	}
+	var tokens []PersonalAccessToken
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("secret '%s' has invalid key %s: %w", secret.Name, settingPersonalAccessTokensKey, err)
+	}
+	unexpired := make([]PersonalAccessToken, 0, len(tokens))
+	for _, t := range tokens {
+		if !t.IsExpired(now) {
+			unexpired = append(unexpired, t)
+		}
+	}
+	return unexpired, nil
+}
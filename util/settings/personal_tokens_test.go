@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+func TestPersonalAccessTokens(t *testing.T) {
+	expired := time.Now().Add(-time.Minute).Unix()
+	valid := time.Now().Add(time.Hour).Unix()
+	kubeClient, settingsManager := fixtures(nil, func(secret *v1.Secret) {
+		secret.Data[settingPersonalAccessTokensKey] = []byte(fmt.Sprintf(`[{"id":"1","sub":"alice","scopes":["*:*:*"],"iat":1583789194,"exp":%d},{"id":"2","sub":"bob","scopes":["*:*:*"],"iat":1583789194,"exp":%d}]`, expired, valid))
+	})
+
+	tokens, err := settingsManager.GetPersonalAccessTokens()
+	require.NoError(t, err)
+	assert.Equal(t, []PersonalAccessToken{{ID: "2", Subject: "bob", Scopes: []string{"*:*:*"}, IssuedAt: 1583789194, ExpiresAt: valid}}, tokens)
+
+	_, err = settingsManager.GetPersonalAccessToken("1")
+	assert.Error(t, err)
+	token, err := settingsManager.GetPersonalAccessToken("2")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", token.Subject)
+
+	err = settingsManager.UpdatePersonalAccessTokens(func(tokens []PersonalAccessToken) ([]PersonalAccessToken, error) {
+		return append(tokens, PersonalAccessToken{ID: "3", Subject: "alice", Scopes: []string{"applications:get:*"}, IssuedAt: 1583789194, ExpiresAt: valid}), nil
+	})
+	require.NoError(t, err)
+	secret, err := kubeClient.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, string(secret.Data[settingPersonalAccessTokensKey]), `"id":"1"`)
+	assert.Contains(t, string(secret.Data[settingPersonalAccessTokensKey]), `"id":"3"`)
+
+	err = settingsManager.UpdatePersonalAccessTokens(func(tokens []PersonalAccessToken) ([]PersonalAccessToken, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	secret, err = kubeClient.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, secret.Data, settingPersonalAccessTokensKey)
+}
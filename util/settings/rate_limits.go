@@ -0,0 +1,85 @@
+package settings
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// apiRateLimitsKey designates the key for the rate limits of the API server in argocd-cm
+const apiRateLimitsKey = "server.api.rateLimits"
+
+// APIRateLimit limits the rate of the API calls of each subject to a group of gRPC methods using a token bucket.
+// Subjects are users, SSO subjects or project roles. Each token of a project role has its own bucket.
+type APIRateLimit struct {
+	// Name identifies the limit in errors and metrics
+	Name string `json:"name"`
+	// Methods are glob patterns of the full gRPC method names the limit applies to, e.g.
+	// /application.ApplicationService/ResourceTree. The limit applies to all methods if empty.
+	Methods []string `json:"methods,omitempty"`
+	// Subjects are glob patterns of the subjects the limit applies to, e.g. proj:* for all project roles. The limit
+	// applies to all subjects, including anonymous users, if empty.
+	Subjects []string `json:"subjects,omitempty"`
+	// RequestsPerSecond is the rate at which the bucket of each subject is refilled
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// Burst is the size of the bucket of each subject. Defaults to RequestsPerSecond, but at least 1.
+	Burst int `json:"burst,omitempty"`
+}
+
+// GetBurst returns the size of the bucket of each subject
+func (l *APIRateLimit) GetBurst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	if l.RequestsPerSecond > 1 {
+		return int(l.RequestsPerSecond)
+	}
+	return 1
+}
+
+// Matches returns whether the limit applies to calls of the given method by the given subject
+func (l *APIRateLimit) Matches(method string, subject string) bool {
+	return matchesAny(l.Methods, method) && matchesAny(l.Subjects, subject)
+}
+
+func matchesAny(patterns []string, text string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if glob.Match(pattern, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAPIRateLimits loads the rate limits of the API server from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetAPIRateLimits() ([]APIRateLimit, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	limits := make([]APIRateLimit, 0)
+	if value, ok := argoCDCM.Data[apiRateLimitsKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &limits); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", apiRateLimitsKey, err)
+		}
+	}
+	names := make(map[string]bool)
+	for _, limit := range limits {
+		if limit.Name == "" {
+			return nil, fmt.Errorf("invalid %s: rate limits must have a name", apiRateLimitsKey)
+		}
+		if names[limit.Name] {
+			return nil, fmt.Errorf("invalid %s: duplicate rate limit %q", apiRateLimitsKey, limit.Name)
+		}
+		names[limit.Name] = true
+		if limit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("invalid %s: requestsPerSecond of rate limit %q must be positive", apiRateLimitsKey, limit.Name)
+		}
+	}
+	return limits, nil
+}
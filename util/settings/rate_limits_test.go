@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAPIRateLimits(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		limits, err := settingsManager.GetAPIRateLimits()
+		require.NoError(t, err)
+		assert.Empty(t, limits)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{apiRateLimitsKey: `
+- name: project-tokens
+  subjects: ["proj:*"]
+  methods: ["/application.ApplicationService/ResourceTree"]
+  requestsPerSecond: 0.5
+  burst: 5
+- name: default
+  requestsPerSecond: 20
+`})
+		limits, err := settingsManager.GetAPIRateLimits()
+		require.NoError(t, err)
+		require.Len(t, limits, 2)
+		assert.Equal(t, APIRateLimit{Name: "project-tokens", Subjects: []string{"proj:*"}, Methods: []string{"/application.ApplicationService/ResourceTree"}, RequestsPerSecond: 0.5, Burst: 5}, limits[0])
+		assert.Equal(t, 5, limits[0].GetBurst())
+		assert.Equal(t, 20, limits[1].GetBurst())
+	})
+	for name, value := range map[string]string{
+		"NoName":        `[{"requestsPerSecond": 1}]`,
+		"DuplicateName": `[{"name": "a", "requestsPerSecond": 1}, {"name": "a", "requestsPerSecond": 2}]`,
+		"NoRate":        `[{"name": "a"}]`,
+		"Malformed":     `name: a`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{apiRateLimitsKey: value})
+			_, err := settingsManager.GetAPIRateLimits()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAPIRateLimit_Matches(t *testing.T) {
+	limit := APIRateLimit{Methods: []string{"/application.ApplicationService/*"}, Subjects: []string{"proj:*", "ci"}}
+	assert.True(t, limit.Matches("/application.ApplicationService/Get", "proj:default:ci"))
+	assert.True(t, limit.Matches("/application.ApplicationService/Get", "ci"))
+	assert.False(t, limit.Matches("/application.ApplicationService/Get", "admin"))
+	assert.False(t, limit.Matches("/cluster.ClusterService/List", "ci"))
+	assert.True(t, (&APIRateLimit{}).Matches("/cluster.ClusterService/List", ""))
+	assert.Equal(t, 1, (&APIRateLimit{RequestsPerSecond: 0.1}).GetBurst())
+}
@@ -79,6 +79,8 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// Specifies the maximum duration for which personal access tokens are valid
+	PersonalAccessTokenMaxDuration time.Duration `json:"personalAccessTokenMaxDuration,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"`
 	// Content of UI Banner
@@ -433,6 +435,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// personalAccessTokenMaxDurationKey is the key which specifies the maximum duration of personal access tokens
+	personalAccessTokenMaxDurationKey = "users.personalAccessTokens.maxDuration"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUiCssURLKey designates the key for user-defined CSS URL for UI customization
@@ -473,6 +477,9 @@ const (
 	execShellsKey = "exec.shells"
 	// oidcTLSInsecureSkipVerifyKey is the key to configure whether TLS cert verification is skipped for OIDC connections
 	oidcTLSInsecureSkipVerifyKey = "oidc.tls.insecure.skip.verify"
+	// commitStatusAPIURLsKey is the key to configure the API URLs of the Git providers the state of applications is
+	// reported to, by the host of the repositories
+	commitStatusAPIURLsKey = "commitStatus.apiURLs"
 	// ApplicationDeepLinks is the application deep link key
 	ApplicationDeepLinks = "application.links"
 	// ProjectDeepLinks is the project deep link key
@@ -758,6 +765,23 @@ func (mgr *SettingsManager) GetDeepLinks(deeplinkType string) ([]DeepLink, error
 	return deepLinks, nil
 }
 
+// GetCommitStatusAPIURLs returns the API URLs of the Git providers the state of applications is reported to, by the
+// host of the repositories
+func (mgr *SettingsManager) GetCommitStatusAPIURLs() (map[string]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	apiURLs := map[string]string{}
+	if value, ok := argoCDCM.Data[commitStatusAPIURLsKey]; ok && value != "" {
+		err := yaml.Unmarshal([]byte(value), &apiURLs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", commitStatusAPIURLsKey, err)
+		}
+	}
+	return apiURLs, nil
+}
+
 func (mgr *SettingsManager) GetEnabledSourceTypes() (map[string]bool, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -878,6 +902,15 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 		switch customizationType {
 		case "health":
 			overrideVal.HealthLua = v
+		case "healthCEL":
+			overrideVal.HealthCEL = v
+		case "aggregatedHealth":
+			aggregatedHealth := v1alpha1.OverrideAggregatedHealth{}
+			err := yaml.Unmarshal([]byte(v), &aggregatedHealth)
+			if err != nil {
+				return err
+			}
+			overrideVal.AggregatedHealth = &aggregatedHealth
 		case "useOpenLibs":
 			useOpenLibs, err := strconv.ParseBool(v)
 			if err != nil {
@@ -1340,6 +1373,14 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 			settings.UserSessionDuration = *val
 		}
 	}
+	settings.PersonalAccessTokenMaxDuration = defaultPersonalAccessTokenMaxDuration
+	if maxDurationStr, ok := argoCDCM.Data[personalAccessTokenMaxDurationKey]; ok {
+		if val, err := timeutil.ParseDuration(maxDurationStr); err != nil {
+			log.Warnf("Failed to parse '%s' key: %v", personalAccessTokenMaxDurationKey, err)
+		} else {
+			settings.PersonalAccessTokenMaxDuration = *val
+		}
+	}
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
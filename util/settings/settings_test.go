@@ -194,6 +194,30 @@ func TestGetServerRBACLogEnforceEnableKey(t *testing.T) {
 	assert.Equal(t, true, serverRBACLogEnforceEnable)
 }
 
+func TestGetCommitStatusAPIURLs(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		apiURLs, err := settingsManager.GetCommitStatusAPIURLs()
+		assert.NoError(t, err)
+		assert.Empty(t, apiURLs)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"commitStatus.apiURLs": "gitlab.example.com: https://gitlab-api.example.com",
+		})
+		apiURLs, err := settingsManager.GetCommitStatusAPIURLs()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"gitlab.example.com": "https://gitlab-api.example.com"}, apiURLs)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"commitStatus.apiURLs": "[",
+		})
+		_, err := settingsManager.GetCommitStatusAPIURLs()
+		assert.Error(t, err)
+	})
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},
@@ -369,6 +393,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.healthCEL.apps_Deployment":                  "baz",
+			"resource.customizations.aggregatedHealth.Iamrole":                   "childKinds: [Pod]",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -398,6 +424,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, true, overrides["cert-manager.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
+		assert.Equal(t, "baz", overrides["apps/Deployment"].HealthCEL)
+		assert.Equal(t, []string{"Pod"}, overrides["Iamrole"].AggregatedHealth.ChildKinds)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
 		assert.Equal(t, 1, len(overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers))
@@ -0,0 +1,151 @@
+package settings
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// trustedIssuersKey designates the key for the OIDC issuers whose tokens can be exchanged for Argo CD sessions
+	trustedIssuersKey = "oidc.trustedIssuers"
+	// defaultTrustedIssuerSessionDuration is the duration of sessions created from exchanged tokens by default
+	defaultTrustedIssuerSessionDuration = time.Hour
+)
+
+// TrustedIssuer is an external OIDC issuer, e.g. the token service of a CI system or a Kubernetes cluster, whose
+// tokens can be exchanged for Argo CD sessions without a browser.
+type TrustedIssuer struct {
+	// Name identifies the issuer. The subjects of the sessions are prefixed with the name, e.g. github:repo:my-org/app.
+	Name string `json:"name"`
+	// Issuer is the URL of the issuer, which must match the iss claim of the tokens
+	Issuer string `json:"issuer"`
+	// Audiences are the accepted values of the aud claim of the tokens
+	Audiences []string `json:"audiences"`
+	// RootCA is the PEM encoded root certificate used to verify the TLS certificate of the issuer
+	RootCA string `json:"rootCA,omitempty"`
+	// SubjectClaim is the claim used as subject of the sessions. Defaults to sub.
+	SubjectClaim string `json:"subjectClaim,omitempty"`
+	// GroupsClaim is an optional claim whose values are used as groups of the sessions
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// GroupMappings grant groups to the sessions based on the claims of the tokens
+	GroupMappings []ClaimGroupMapping `json:"groupMappings,omitempty"`
+	// SessionDuration is the duration of the sessions. Defaults to 1h.
+	SessionDuration string `json:"sessionDuration,omitempty"`
+}
+
+// ClaimGroupMapping grants groups to the sessions of tokens with a claim matching any of the values
+type ClaimGroupMapping struct {
+	// Claim is the name of the claim, e.g. repository
+	Claim string `json:"claim"`
+	// Values are glob patterns matched against the claim, e.g. my-org/*
+	Values []string `json:"values"`
+	// Groups are the groups granted to matching tokens
+	Groups []string `json:"groups"`
+}
+
+// GetSubjectClaim returns the claim used as subject of the sessions
+func (i *TrustedIssuer) GetSubjectClaim() string {
+	if i.SubjectClaim == "" {
+		return "sub"
+	}
+	return i.SubjectClaim
+}
+
+// GetSessionDuration returns the duration of the sessions created from tokens of the issuer
+func (i *TrustedIssuer) GetSessionDuration() time.Duration {
+	if i.SessionDuration == "" {
+		return defaultTrustedIssuerSessionDuration
+	}
+	duration, err := time.ParseDuration(i.SessionDuration)
+	if err != nil {
+		log.Warnf("Invalid session duration of trusted issuer %s: %v, using default", i.Name, err)
+		return defaultTrustedIssuerSessionDuration
+	}
+	return duration
+}
+
+// TLSConfig returns the TLS configuration used to connect to the issuer
+func (i *TrustedIssuer) TLSConfig() *tls.Config {
+	tlsConfig := &tls.Config{}
+	if i.RootCA != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(i.RootCA)) {
+			log.Warnf("failed to append certificates from PEM of trusted issuer %s: proceeding without custom rootCA", i.Name)
+		} else {
+			tlsConfig.RootCAs = certPool
+		}
+	}
+	return tlsConfig
+}
+
+func (i *TrustedIssuer) validate() error {
+	if i.Name == "" {
+		return fmt.Errorf("trusted issuers must have a name")
+	}
+	if strings.Contains(i.Name, ":") || i.Name == "proj" {
+		return fmt.Errorf("invalid name of trusted issuer %q", i.Name)
+	}
+	if u, err := url.Parse(i.Issuer); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("trusted issuer %s must have a valid issuer URL", i.Name)
+	}
+	if len(i.Audiences) == 0 {
+		return fmt.Errorf("trusted issuer %s must have at least one audience", i.Name)
+	}
+	if i.SessionDuration != "" {
+		if duration, err := time.ParseDuration(i.SessionDuration); err != nil || duration <= 0 {
+			return fmt.Errorf("trusted issuer %s has an invalid session duration %q", i.Name, i.SessionDuration)
+		}
+	}
+	for _, mapping := range i.GroupMappings {
+		if mapping.Claim == "" || len(mapping.Values) == 0 || len(mapping.Groups) == 0 {
+			return fmt.Errorf("group mappings of trusted issuer %s must have a claim, values and groups", i.Name)
+		}
+	}
+	return nil
+}
+
+// GetTrustedIssuers loads the OIDC issuers whose tokens can be exchanged for Argo CD sessions from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetTrustedIssuers() ([]TrustedIssuer, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	issuers := make([]TrustedIssuer, 0)
+	if value, ok := argoCDCM.Data[trustedIssuersKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &issuers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", trustedIssuersKey, err)
+		}
+	}
+	names := make(map[string]bool)
+	for i := range issuers {
+		if err := issuers[i].validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", trustedIssuersKey, err)
+		}
+		if names[issuers[i].Name] {
+			return nil, fmt.Errorf("invalid %s: duplicate trusted issuer %q", trustedIssuersKey, issuers[i].Name)
+		}
+		names[issuers[i].Name] = true
+	}
+	return issuers, nil
+}
+
+// GetTrustedIssuer returns the trusted issuer with the given name
+func (mgr *SettingsManager) GetTrustedIssuer(name string) (*TrustedIssuer, error) {
+	issuers, err := mgr.GetTrustedIssuers()
+	if err != nil {
+		return nil, err
+	}
+	for i := range issuers {
+		if issuers[i].Name == name {
+			return &issuers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("trusted issuer %s does not exist", name)
+}
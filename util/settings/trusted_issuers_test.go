@@ -0,0 +1,63 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrustedIssuers(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		issuers, err := settingsManager.GetTrustedIssuers()
+		require.NoError(t, err)
+		assert.Empty(t, issuers)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{trustedIssuersKey: `
+- name: github
+  issuer: https://token.actions.githubusercontent.com
+  audiences: [argocd]
+  groupMappings:
+  - claim: repository
+    values: ["my-org/*"]
+    groups: [my-org-ci]
+- name: k8s
+  issuer: https://kubernetes.default.svc.cluster.local
+  audiences: [argocd]
+  groupsClaim: groups
+  sessionDuration: 15m
+`})
+		issuers, err := settingsManager.GetTrustedIssuers()
+		require.NoError(t, err)
+		require.Len(t, issuers, 2)
+		assert.Equal(t, "sub", issuers[0].GetSubjectClaim())
+		assert.Equal(t, time.Hour, issuers[0].GetSessionDuration())
+		assert.Equal(t, []ClaimGroupMapping{{Claim: "repository", Values: []string{"my-org/*"}, Groups: []string{"my-org-ci"}}}, issuers[0].GroupMappings)
+		assert.Equal(t, 15*time.Minute, issuers[1].GetSessionDuration())
+
+		issuer, err := settingsManager.GetTrustedIssuer("k8s")
+		require.NoError(t, err)
+		assert.Equal(t, "https://kubernetes.default.svc.cluster.local", issuer.Issuer)
+		_, err = settingsManager.GetTrustedIssuer("gitlab")
+		assert.Error(t, err)
+	})
+	for name, value := range map[string]string{
+		"NoName":          `[{"issuer": "https://example.com", "audiences": ["argocd"]}]`,
+		"ReservedName":    `[{"name": "proj", "issuer": "https://example.com", "audiences": ["argocd"]}]`,
+		"NameWithColon":   `[{"name": "a:b", "issuer": "https://example.com", "audiences": ["argocd"]}]`,
+		"DuplicateName":   `[{"name": "a", "issuer": "https://example.com", "audiences": ["argocd"]}, {"name": "a", "issuer": "https://example.org", "audiences": ["argocd"]}]`,
+		"InvalidIssuer":   `[{"name": "a", "issuer": "example.com", "audiences": ["argocd"]}]`,
+		"NoAudience":      `[{"name": "a", "issuer": "https://example.com"}]`,
+		"InvalidDuration": `[{"name": "a", "issuer": "https://example.com", "audiences": ["argocd"], "sessionDuration": "forever"}]`,
+		"InvalidMapping":  `[{"name": "a", "issuer": "https://example.com", "audiences": ["argocd"], "groupMappings": [{"claim": "repository"}]}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{trustedIssuersKey: value})
+			_, err := settingsManager.GetTrustedIssuers()
+			assert.Error(t, err)
+		})
+	}
+}